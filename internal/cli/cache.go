@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
+)
+
+// addCacheCommand wires `toolkit cache`, housekeeping for the on-disk
+// parse cache of repo-backed data.
+func addCacheCommand(rootCmd *cobra.Command, cfgFile *string) {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect or clear the on-disk parse cache",
+		Long: `toolkit caches the data it parses from the repo (limits, properties,
+tenancy overrides, service tenancies, model artifacts and GPU pools)
+under cache-dir, keyed by the content of the files each part was read
+from. Unchanged parts of the repo then load from the cache; an edited,
+added or removed file is re-parsed on the next load.
+
+The cache is safe to delete at any time. --no-cache bypasses it for a
+single run.`,
+	}
+
+	var format string
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show the cache location, size and entries per kind",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by cache stats; use table, json, or yaml", fmtChoice)
+			}
+			c, err := parseCacheFromConfig(cfgFile)
+			if err != nil {
+				return err
+			}
+			st, err := c.Stats()
+			if err != nil {
+				return fmt.Errorf("read cache %s: %w", c.Dir(), err)
+			}
+			opts := output.Options{Format: fmtChoice, Pretty: true}
+			if fmtChoice != output.FormatTable {
+				return writeEncoded(cmd.OutOrStdout(), opts, st)
+			}
+			return writeCacheStats(cmd.OutOrStdout(), st, opts)
+		},
+	}
+	statsCmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|yaml")
+
+	clearCmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Remove every cache entry",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			c, err := parseCacheFromConfig(cfgFile)
+			if err != nil {
+				return err
+			}
+			n, err := c.Clear()
+			if err != nil {
+				return fmt.Errorf("clear cache %s: %w", c.Dir(), err)
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "Removed %d cache entries from %s\n", n, c.Dir())
+			return err
+		},
+	}
+
+	cacheCmd.AddCommand(statsCmd, clearCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
+
+// parseCacheFromConfig opens the cache at the configured cache-dir. Only
+// cache-dir is needed, so the rest of the config is not validated.
+func parseCacheFromConfig(cfgFile *string) (*parsecache.Cache, error) {
+	if err := readConfigFile(cfgFile); err != nil {
+		return nil, err
+	}
+	var cfg config.Config
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal config: %w", err)
+	}
+	if cfg.CacheDir == "" {
+		return nil, errors.New("cache-dir is not set and no user cache directory was found")
+	}
+	return parsecache.New(cfg.CacheDir), nil
+}
+
+// writeCacheStats prints a summary line, then one row per entry kind.
+func writeCacheStats(w writer, st parsecache.Stats, opts output.Options) error {
+	if _, err := fmt.Fprintf(w, "Cache: %s\nEntries: %d (%s)\n", st.Dir, st.Entries, formatBytes(st.Bytes)); err != nil {
+		return err
+	}
+	if st.Entries == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintf(w, "Written: %s – %s\n\n",
+		st.Oldest.Local().Format(time.DateTime), st.Newest.Local().Format(time.DateTime)); err != nil {
+		return err
+	}
+	kinds := slices.Sorted(maps.Keys(st.Kinds))
+	rows := make([][]string, len(kinds))
+	for i, kind := range kinds {
+		rows[i] = []string{kind, strconv.Itoa(st.Kinds[kind])}
+	}
+	return output.WriteTable(w, []string{"KIND", "ENTRIES"}, rows, opts)
+}
+
+// formatBytes renders n with a binary unit, e.g. "12.3 KiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,91 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
+)
+
+// stageParseCache points cache-dir at a temp dir holding one "test" entry.
+func stageParseCache(t *testing.T) string {
+	t.Helper()
+	stageMutationEnv(t)
+	cacheDir := t.TempDir()
+	t.Setenv("TOOLKIT_CACHE_DIR", cacheDir)
+
+	input := filepath.Join(t.TempDir(), "input.json")
+	if err := os.WriteFile(input, []byte(`{}`), 0o600); err != nil { //nolint:gosec // test helper; 0o600 is fine for temp files
+		t.Fatal(err)
+	}
+	// Backdated, so the value is not withheld as possibly mid-edit.
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(input, old, old); err != nil {
+		t.Fatal(err)
+	}
+	ctx := parsecache.WithContext(context.Background(), parsecache.New(cacheDir))
+	if _, err := parsecache.Load(ctx, "test", input, func(in *parsecache.Inputs) (int, error) {
+		in.AddFiles(input)
+		return 1, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return cacheDir
+}
+
+func TestCacheStatsAndClear(t *testing.T) {
+	cacheDir := stageParseCache(t)
+
+	out, err := runRootCmd(t, []string{"cache", "stats"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	for _, want := range []string{filepath.Join(cacheDir, "parse"), "Entries: 1", "test"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("stats missing %q:\n%s", want, out)
+		}
+	}
+
+	out, err = runRootCmd(t, []string{"cache", "stats", "-o", "json"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	var st parsecache.Stats
+	if err := json.Unmarshal([]byte(out), &st); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if st.Entries != 1 || st.Kinds["test"] != 1 || st.Bytes == 0 {
+		t.Errorf("unexpected stats: %+v", st)
+	}
+
+	out, err = runRootCmd(t, []string{"cache", "clear"}, "")
+	if err != nil || !strings.Contains(out, "Removed 1 cache entries") {
+		t.Fatalf("clear: %v\n%s", err, out)
+	}
+	out, err = runRootCmd(t, []string{"cache", "stats"}, "")
+	if err != nil || !strings.Contains(out, "Entries: 0") {
+		t.Errorf("stats after clear: %v\n%s", err, out)
+	}
+}
+
+func TestCacheStats_RejectsCSV(t *testing.T) {
+	stageParseCache(t)
+	if _, err := runRootCmd(t, []string{"cache", "stats", "-o", "csv"}, ""); err == nil ||
+		!strings.Contains(err.Error(), "not supported") {
+		t.Errorf("want csv rejection, got %v", err)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[int64]string{0: "0 B", 1023: "1023 B", 1536: "1.5 KiB", 3 << 20: "3.0 MiB"} {
+		if got := formatBytes(n); got != want {
+			t.Errorf("formatBytes(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"path/filepath"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
+)
+
+// configureClients applies the settings OCI and Kubernetes clients are
+// built with: the OCI auth profiles, and the cache-dir file the shared
+// circuit breakers record their state in, where `toolkit doctor` reads
+// it back. Commands that call OCI or Kubernetes run it at startup.
+func configureClients(cfg config.Config) {
+	oci.Auth = ociAuth(cfg.OCIAuth)
+	if cfg.CacheDir != "" {
+		resilience.Default.SetStateFile(breakerStatePath(cfg))
+	}
+}
+
+// ociAuth converts the oci-auth section. Validate rejects unknown modes.
+func ociAuth(a config.OCIAuth) oci.AuthConfig {
+	profile := func(p config.OCIAuthProfile) oci.AuthProfile {
+		return oci.AuthProfile{Mode: oci.AuthMode(p.Mode), ConfigFile: p.ConfigFile, Profile: p.Profile}
+	}
+	result := oci.AuthConfig{Default: profile(a.OCIAuthProfile)}
+	if len(a.Environments) > 0 {
+		result.Environments = make(map[string]oci.AuthProfile, len(a.Environments))
+		for env, p := range a.Environments {
+			result.Environments[env] = profile(p)
+		}
+	}
+	return result
+}
+
+// breakerStatePath is where the breakers' state is recorded for cfg.
+func breakerStatePath(cfg config.Config) string {
+	return filepath.Join(cfg.CacheDir, resilience.StateFileName)
+}
@@ -292,6 +292,41 @@ func TestConfigCmd_ValidateFailsOnMissingFields(t *testing.T) {
 	}
 }
 
+func TestConfigCmd_ValidateRejectsOCIAuthMode(t *testing.T) {
+	// oci-auth's top-level keys are squashed into its default profile;
+	// a bad mode at either level must reach cfg.Validate().
+	t.Cleanup(viper.Reset)
+	for _, auth := range []string{
+		"oci-auth:\n  mode: password\n",
+		"oci-auth:\n  mode: api-key\n  environments:\n    dev-phx:\n      mode: password\n",
+	} {
+		tmp := t.TempDir()
+		cfgPath := filepath.Join(tmp, "cfg.yaml")
+		contents := []byte("repo-path: /tmp/repo\n" +
+			"env-type: dev\n" +
+			"env-region: us-phoenix-1\n" +
+			"env-realm: oc1\n" +
+			"category: tenant\n" + auth)
+		if err := os.WriteFile(cfgPath, contents, 0o600); err != nil {
+			t.Fatalf("seed config: %v", err)
+		}
+		t.Setenv("HOME", tmp)
+		viper.Reset()
+
+		cmd := NewRootCmd("vtest")
+		cmd.SetArgs([]string{"--config", cfgPath, "config", "--validate", "-o", "json"})
+		stdout := new(bytes.Buffer)
+		cmd.SetOut(stdout)
+		cmd.SetErr(new(bytes.Buffer))
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected validation failure for:\n%s", auth)
+		}
+		if !strings.Contains(stdout.String(), `mode \"password\" must be one of`) {
+			t.Errorf("unexpected output for:\n%s\n%s", auth, stdout.String())
+		}
+	}
+}
+
 func TestConfigCmd_ValidateYAMLDefault(t *testing.T) {
 	// Default format is yaml; verify the shape is sane and the failure
 	// message reaches the human-readable output.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jingle2008/toolkit/internal/config"
 	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/resolve"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -30,8 +31,9 @@ func addUncordonCommand(rootCmd *cobra.Command, cfgFile *string) {
 // Both go through the idempotent k8s.SetCordon path.
 func addCordonOrUncordon(rootCmd *cobra.Command, cfgFile *string, verb string, unschedulable bool, short string) {
 	var (
-		dryRun bool
-		yes    bool
+		dryRun  bool
+		yes     bool
+		cluster string
 	)
 	long := `Mark a Kubernetes node unschedulable. Existing pods stay
 where they are; new pods skip the node.
@@ -63,7 +65,7 @@ Examples:
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			nodeName := args[0]
-			return withMutationSetup(cfgFile, true, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+			return withMutationSetup(cfgFile, verb, true, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
 				out := cmd.OutOrStdout()
 				return runMutation(ctx, cmd.InOrStdin(), out, mutationPlan{
 					Action:  verb,
@@ -73,7 +75,11 @@ Examples:
 					DryRun:  dryRun,
 					Yes:     yes,
 				}, func(ctx context.Context) error {
-					changed, err := setCordonFn(ctx, cfg.KubeConfig, env.KubeContext(), nodeName, unschedulable)
+					kubeCtx, err := resolve.NodeContext(ctx, locateNodeFn, cfg.KubeConfig, cfg.KubeContexts, env, cluster, nodeName)
+					if err != nil {
+						return err
+					}
+					changed, err := setCordonFn(ctx, cfg.KubeConfig, kubeCtx, nodeName, unschedulable)
 					if err != nil {
 						return err
 					}
@@ -87,5 +93,6 @@ Examples:
 	}
 	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print what would happen and exit")
 	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the interactive confirmation prompt")
+	cmd.Flags().StringVar(&cluster, "cluster", "", "Kube context of the node's cluster, one of the environment's (default: the one that has the node)")
 	rootCmd.AddCommand(cmd)
 }
@@ -4,6 +4,8 @@ package cli
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -148,3 +150,74 @@ func TestCordonCmd_MissingKubeConfig(t *testing.T) {
 		t.Errorf("error should mention kubeconfig: %v", err)
 	}
 }
+
+// stageKubeContexts writes a config file mapping the staged dev-iad
+// environment to two kube contexts.
+func stageKubeContexts(t *testing.T) {
+	t.Helper()
+	dir := filepath.Join(os.Getenv("HOME"), ".config", "toolkit")
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	cfg := "kube-contexts:\n  dev-iad: [dp-dev-phx-a, dp-dev-phx-b]\n"
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte(cfg), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+}
+
+func TestCordonCmd_ClusterFlagSkipsLookup(t *testing.T) {
+	stageMutationEnv(t)
+	stageKubeContexts(t)
+	var gotContext string
+	defer swap(&setCordonFn, func(_ context.Context, _, ctxName, _ string, _ bool) (bool, error) {
+		gotContext = ctxName
+		return true, nil
+	})()
+	defer swap(&locateNodeFn, func(context.Context, string, []string, string) (string, error) {
+		t.Error("--cluster must skip the node lookup")
+		return "", nil
+	})()
+
+	if _, err := runRootCmd(t, []string{"cordon", "node-a", "-y", "--cluster", "dp-dev-phx-b"}, ""); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotContext != "dp-dev-phx-b" {
+		t.Errorf("kube context: got %q, want the --cluster value", gotContext)
+	}
+}
+
+func TestCordonCmd_RejectsUnknownCluster(t *testing.T) {
+	stageMutationEnv(t)
+	stageKubeContexts(t)
+	defer swap(&setCordonFn, func(context.Context, string, string, string, bool) (bool, error) {
+		t.Error("a cluster outside the environment must not be cordoned")
+		return true, nil
+	})()
+
+	_, err := runRootCmd(t, []string{"cordon", "node-a", "-y", "--cluster", "dp-prod-iad"}, "")
+	if err == nil || !strings.Contains(err.Error(), `cluster "dp-prod-iad" is not a kube context of dev-iad (dp-dev-phx-a, dp-dev-phx-b)`) {
+		t.Fatalf("expected an unknown-cluster error, got %v", err)
+	}
+}
+
+func TestCordonCmd_LocatesNodeCluster(t *testing.T) {
+	stageMutationEnv(t)
+	var gotContext string
+	defer swap(&setCordonFn, func(_ context.Context, _, ctxName, _ string, _ bool) (bool, error) {
+		gotContext = ctxName
+		return true, nil
+	})()
+	defer swap(&locateNodeFn, func(_ context.Context, _ string, contexts []string, node string) (string, error) {
+		if len(contexts) != 1 || !strings.HasPrefix(contexts[0], "dp-") || node != "node-a" {
+			t.Errorf("lookup: got contexts %v, node %q", contexts, node)
+		}
+		return "dp-dev-phx-b", nil
+	})()
+
+	if _, err := runRootCmd(t, []string{"cordon", "node-a", "-y"}, ""); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotContext != "dp-dev-phx-b" {
+		t.Errorf("kube context: got %q, want the located cluster", gotContext)
+	}
+}
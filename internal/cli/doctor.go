@@ -1,16 +1,21 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/jingle2008/toolkit/internal/cli/output"
 	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
+	"github.com/jingle2008/toolkit/pkg/models"
 )
 
 // addDoctorCommand wires `toolkit doctor`, a read-only health check
@@ -38,8 +43,12 @@ precondition scripts:
   fi
 
 doctor never makes a network call — it is purely a local file +
-schema audit. (Cluster reachability + OCI credential checks may be
-added under a future --connectivity flag.)
+schema audit. For session-token OCI auth it reports how long the
+token has left before OCI calls and mutations start failing.
+(Cluster reachability + OCI credential checks may be added under a
+future --connectivity flag.) The circuit breakers that guard OCI and
+Kubernetes calls are reported as the last session left them, read
+from cache-dir: a breaker still open FAILs.
 
 Examples:
   toolkit doctor
@@ -117,7 +126,7 @@ func runDoctor(w io.Writer, cfgFile, format string) error {
 // collectChecks runs each individual probe and returns the rows. The
 // list order is stable so output diff-tests cleanly across runs.
 func collectChecks(cfgFile string, cfg config.Config, unmarshalErr error) []checkResult {
-	return []checkResult{
+	checks := []checkResult{
 		checkConfigSchema(cfg, unmarshalErr),
 		checkConfigFile(cfgFile),
 		checkPath("repo-path", cfg.RepoPath, true, "set --repo-path or `repo-path:` in config.yaml"),
@@ -129,7 +138,9 @@ func collectChecks(cfgFile string, cfg config.Config, unmarshalErr error) []chec
 		// feature — until then doctor must not contradict Validate.
 		checkPath("kubeconfig", cfg.KubeConfig, true, "set --kubeconfig or run `kind/minikube/oke` setup"),
 		checkMetadataFile(cfg.MetadataFile),
+		checkOCIAuth(cfg, time.Now()),
 	}
+	return append(checks, checkBreakers(cfg, time.Now())...)
 }
 
 func checkConfigSchema(cfg config.Config, unmarshalErr error) checkResult {
@@ -232,6 +243,107 @@ func checkMetadataFile(path string) checkResult {
 	return r
 }
 
+// sessionRefreshWindow is how close to expiry a session token gets a
+// refresh hint while it still passes.
+const sessionRefreshWindow = 15 * time.Minute
+
+// checkOCIAuth reports how the configured environment authenticates to
+// OCI. A session token is checked for expiry, since every OCI call and
+// mutation fails once it lapses; an API-key profile must exist; the
+// principal modes have nothing to check locally. A missing OCI config
+// file SKIPs: repo and cluster categories work without OCI.
+func checkOCIAuth(cfg config.Config, now time.Time) checkResult {
+	r := checkResult{Name: "oci-auth"}
+	if cfg.EnvRealm == "" {
+		r.Status = statusSkip
+		r.Detail = "env-realm not set"
+		return r
+	}
+	env := models.Environment{Type: cfg.EnvType, Region: cfg.EnvRegion, Realm: cfg.EnvRealm}
+	p := ociAuth(cfg.OCIAuth).For(env)
+	authenticate := fmt.Sprintf("run `oci session authenticate --profile-name %s --region %s`", p.Profile, cfg.EnvRegion)
+	switch p.Mode {
+	case oci.AuthSessionToken:
+		exp, err := oci.SessionTokenExpiry(p)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			r.Status = statusSkip
+			r.Detail = p.ConfigFile + " not present"
+			r.Hint = authenticate + " to use OCI categories and actions"
+		case err != nil:
+			r.Status = statusFail
+			r.Detail = err.Error()
+			r.Hint = authenticate
+		case !now.Before(exp):
+			r.Status = statusFail
+			r.Detail = fmt.Sprintf("%s: session token expired %s ago", p, now.Sub(exp).Round(time.Minute))
+			r.Hint = authenticate
+		default:
+			left := exp.Sub(now)
+			r.Status = statusPass
+			r.Detail = fmt.Sprintf("%s: session token expires in %s (%s); OCI calls and mutations fail after that",
+				p, left.Round(time.Minute), exp.Local().Format(time.DateTime))
+			if left < sessionRefreshWindow {
+				r.Hint = fmt.Sprintf("run `oci session refresh --profile %s`", p.Profile)
+			}
+		}
+	case oci.AuthAPIKey:
+		if err := oci.CheckProfile(p); err != nil {
+			r.Status = statusFail
+			r.Detail = err.Error()
+			r.Hint = "set oci-auth.config-file / profile, or run `oci setup config`"
+			return r
+		}
+		r.Status = statusPass
+		r.Detail = p.String()
+	default:
+		r.Status = statusSkip
+		r.Detail = p.String() + ": credentials come from the runtime; nothing to check locally"
+	}
+	return r
+}
+
+// checkBreakers reports each service's circuit breaker as recorded in
+// the cache dir by the last command that called it: FAIL while one is
+// open, PASS once closed or its cooldown has elapsed (the next call
+// probes the service). With nothing recorded it is a single SKIP row.
+func checkBreakers(cfg config.Config, now time.Time) []checkResult {
+	skip := []checkResult{{Name: "breakers", Status: statusSkip}}
+	if cfg.CacheDir == "" {
+		skip[0].Detail = "cache-dir not set"
+		return skip
+	}
+	statuses, err := resilience.LoadStatus(breakerStatePath(cfg))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		skip[0].Detail = "no OCI or Kubernetes call recorded yet"
+		return skip
+	case err != nil:
+		return []checkResult{{Name: "breakers", Status: statusFail, Detail: err.Error(),
+			Hint: "delete the file; it is rewritten on the next state change"}}
+	case len(statuses) == 0:
+		skip[0].Detail = "no breakers recorded"
+		return skip
+	}
+	results := make([]checkResult, 0, len(statuses))
+	for _, s := range statuses {
+		r := checkResult{Name: "breaker:" + s.Service, Status: statusPass, Detail: s.State.String()}
+		if s.State != resilience.Closed {
+			if now.Before(s.Until) {
+				r.Status = statusFail
+				r.Detail = fmt.Sprintf("open until %s after %d failed calls: %s",
+					s.Until.Local().Format(time.DateTime), s.Failures, s.LastError)
+				r.Hint = "the service is failing or throttling; calls to it fail fast until then"
+			} else {
+				r.Detail = fmt.Sprintf("%s at %s, cooldown elapsed: %s",
+					s.State, s.Changed.Local().Format(time.DateTime), s.LastError)
+			}
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
 func countFails(results []checkResult) int {
 	n := 0
 	for _, r := range results {
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"encoding/base64"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/config"
 )
 
 func TestCheckConfigFile(t *testing.T) {
@@ -136,3 +141,149 @@ func TestCountFails(t *testing.T) {
 		{Status: statusFail}, {Status: statusPass}, {Status: statusFail},
 	}))
 }
+
+func TestCheckBreakers(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("nothing recorded skips", func(t *testing.T) {
+		t.Parallel()
+		r := checkBreakers(config.Config{}, now)
+		require.Len(t, r, 1)
+		assert.Equal(t, statusSkip, r[0].Status)
+
+		r = checkBreakers(config.Config{CacheDir: t.TempDir()}, now)
+		require.Len(t, r, 1)
+		assert.Equal(t, statusSkip, r[0].Status)
+		assert.Contains(t, r[0].Detail, "no OCI or Kubernetes call")
+	})
+
+	t.Run("a row per service, open fails until its cooldown ends", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Config{CacheDir: t.TempDir()}
+		state := `{"breakers": [
+			{"service": "k8s/dev", "state": "closed", "failures": 0, "changed": "2026-03-04T11:00:00Z"},
+			{"service": "oci/compute/us-ashburn-1", "state": "open", "failures": 5, "until": "2026-03-04T11:59:00Z",
+			 "lastError": "GET /instances: 503 Service Unavailable", "changed": "2026-03-04T11:58:30Z"},
+			{"service": "oci/generativeai/us-ashburn-1", "state": "open", "failures": 5, "until": "2026-03-04T12:00:30Z",
+			 "lastError": "GET /dedicatedAiClusters: 429 Too Many Requests", "changed": "2026-03-04T12:00:00Z"}
+		]}`
+		require.NoError(t, os.WriteFile(breakerStatePath(cfg), []byte(state), 0o600))
+
+		r := checkBreakers(cfg, now)
+		require.Len(t, r, 3)
+		assert.Equal(t, checkResult{Name: "breaker:k8s/dev", Status: statusPass, Detail: "closed"}, r[0])
+
+		assert.Equal(t, "breaker:oci/compute/us-ashburn-1", r[1].Name)
+		assert.Equal(t, statusPass, r[1].Status)
+		assert.Contains(t, r[1].Detail, "cooldown elapsed")
+
+		assert.Equal(t, "breaker:oci/generativeai/us-ashburn-1", r[2].Name)
+		assert.Equal(t, statusFail, r[2].Status)
+		assert.Contains(t, r[2].Detail, "429 Too Many Requests")
+		assert.NotEmpty(t, r[2].Hint)
+	})
+
+	t.Run("an unreadable state file fails", func(t *testing.T) {
+		t.Parallel()
+		cfg := config.Config{CacheDir: t.TempDir()}
+		require.NoError(t, os.WriteFile(breakerStatePath(cfg), []byte("{"), 0o600))
+		r := checkBreakers(cfg, now)
+		require.Len(t, r, 1)
+		assert.Equal(t, statusFail, r[0].Status)
+	})
+}
+
+// writeOCIConfig writes an OCI CLI config with a session-token profile
+// OC1 whose token expires at exp, and an api-key profile OPS.
+func writeOCIConfig(t *testing.T, exp time.Time) string {
+	t.Helper()
+	dir := t.TempDir()
+	enc := base64.RawURLEncoding.EncodeToString
+	token := enc([]byte(`{}`)) + "." + enc(fmt.Appendf(nil, `{"exp":%d}`, exp.Unix())) + ".sig"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "token"), []byte(token), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), []byte("key"), 0o600))
+	path := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(path, []byte(
+		"[OC1]\nsecurity_token_file = "+filepath.Join(dir, "token")+"\n"+
+			"[OPS]\nkey_file = "+filepath.Join(dir, "key.pem")+"\n"+
+			"[BROKEN]\nkey_file = "+filepath.Join(dir, "missing.pem")+"\n"), 0o600))
+	return path
+}
+
+func TestCheckOCIAuth(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	base := config.Config{EnvType: "prod", EnvRegion: "us-ashburn-1", EnvRealm: "oc1"}
+	withAuth := func(configFile string, p config.OCIAuthProfile) config.Config {
+		cfg := base
+		if p.ConfigFile == "" {
+			p.ConfigFile = configFile
+		}
+		cfg.OCIAuth = config.OCIAuth{OCIAuthProfile: p}
+		return cfg
+	}
+
+	t.Run("session token time left", func(t *testing.T) {
+		t.Parallel()
+		path := writeOCIConfig(t, now.Add(42*time.Minute))
+		r := checkOCIAuth(withAuth(path, config.OCIAuthProfile{}), now)
+		assert.Equal(t, statusPass, r.Status)
+		assert.Contains(t, r.Detail, "profile OC1")
+		assert.Contains(t, r.Detail, "expires in 42m0s")
+		assert.Empty(t, r.Hint)
+
+		r = checkOCIAuth(withAuth(path, config.OCIAuthProfile{}), now.Add(30*time.Minute))
+		assert.Equal(t, statusPass, r.Status)
+		assert.Contains(t, r.Hint, "oci session refresh --profile OC1")
+	})
+
+	t.Run("expired session token fails", func(t *testing.T) {
+		t.Parallel()
+		path := writeOCIConfig(t, now.Add(-2*time.Hour))
+		r := checkOCIAuth(withAuth(path, config.OCIAuthProfile{}), now)
+		assert.Equal(t, statusFail, r.Status)
+		assert.Contains(t, r.Detail, "expired 2h0m0s ago")
+		assert.Contains(t, r.Hint, "oci session authenticate --profile-name OC1 --region us-ashburn-1")
+	})
+
+	t.Run("missing config skips, missing profile fails", func(t *testing.T) {
+		t.Parallel()
+		r := checkOCIAuth(withAuth(filepath.Join(t.TempDir(), "config"), config.OCIAuthProfile{}), now)
+		assert.Equal(t, statusSkip, r.Status)
+
+		path := writeOCIConfig(t, now)
+		r = checkOCIAuth(withAuth(path, config.OCIAuthProfile{Profile: "NOPE"}), now)
+		assert.Equal(t, statusFail, r.Status)
+		assert.Contains(t, r.Detail, "profile NOPE not found")
+	})
+
+	t.Run("api key and principals", func(t *testing.T) {
+		t.Parallel()
+		path := writeOCIConfig(t, now)
+		r := checkOCIAuth(withAuth(path, config.OCIAuthProfile{Mode: "api-key", Profile: "OPS"}), now)
+		assert.Equal(t, statusPass, r.Status)
+
+		r = checkOCIAuth(withAuth(path, config.OCIAuthProfile{Mode: "api-key", Profile: "BROKEN"}), now)
+		assert.Equal(t, statusFail, r.Status)
+		assert.Contains(t, r.Detail, "key_file")
+
+		r = checkOCIAuth(withAuth(path, config.OCIAuthProfile{Mode: "instance-principal"}), now)
+		assert.Equal(t, statusSkip, r.Status)
+	})
+
+	t.Run("per-environment override", func(t *testing.T) {
+		t.Parallel()
+		path := writeOCIConfig(t, now)
+		cfg := withAuth(path, config.OCIAuthProfile{})
+		cfg.OCIAuth.Environments = map[string]config.OCIAuthProfile{"prod-iad": {Mode: "api-key", Profile: "OPS"}}
+		r := checkOCIAuth(cfg, now)
+		assert.Equal(t, statusPass, r.Status)
+		assert.Contains(t, r.Detail, "api-key (profile OPS")
+	})
+
+	t.Run("no realm skips", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, statusSkip, checkOCIAuth(config.Config{}, now).Status)
+	})
+}
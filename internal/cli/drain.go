@@ -7,6 +7,7 @@ import (
 
 	"github.com/jingle2008/toolkit/internal/config"
 	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/resolve"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -15,8 +16,9 @@ var drainNodeFn = k8s.DrainNode
 
 func addDrainCommand(rootCmd *cobra.Command, cfgFile *string) {
 	var (
-		dryRun bool
-		yes    bool
+		dryRun  bool
+		yes     bool
+		cluster string
 	)
 	cmd := &cobra.Command{
 		Use:   "drain <node>",
@@ -28,7 +30,7 @@ node so workloads relocate cleanly.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			nodeName := args[0]
-			return withMutationSetup(cfgFile, true, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+			return withMutationSetup(cfgFile, "drain", true, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
 				return runMutation(ctx, cmd.InOrStdin(), cmd.OutOrStdout(), mutationPlan{
 					Action:  "drain",
 					Kind:    "node",
@@ -37,12 +39,17 @@ node so workloads relocate cleanly.`,
 					DryRun:  dryRun,
 					Yes:     yes,
 				}, func(ctx context.Context) error {
-					return drainNodeFn(ctx, cfg.KubeConfig, env.KubeContext(), nodeName)
+					kubeCtx, err := resolve.NodeContext(ctx, locateNodeFn, cfg.KubeConfig, cfg.KubeContexts, env, cluster, nodeName)
+					if err != nil {
+						return err
+					}
+					return drainNodeFn(ctx, cfg.KubeConfig, kubeCtx, nodeName)
 				})
 			})
 		},
 	}
 	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Print what would happen and exit")
 	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the interactive confirmation prompt")
+	cmd.Flags().StringVar(&cluster, "cluster", "", "Kube context of the node's cluster, one of the environment's (default: the one that has the node)")
 	rootCmd.AddCommand(cmd)
 }
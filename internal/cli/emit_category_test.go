@@ -77,6 +77,13 @@ func (l emitLoader) LoadGPUWorkloadsByNode(context.Context, string, models.Envir
 	return map[string][]models.GPUWorkload{"node-a": {{Name: "wl-a"}}}, nil
 }
 
+func (l emitLoader) LoadPendingGPUWorkloads(context.Context, string, models.Environment) ([]models.PendingGPUWorkload, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return []models.PendingGPUWorkload{{Name: "pgw-a", GPUs: 8}}, nil
+}
+
 func (l emitLoader) LoadDedicatedAIClusters(context.Context, string, models.Environment) (map[string][]models.DedicatedAICluster, error) {
 	if l.err != nil {
 		return nil, l.err
@@ -86,6 +93,31 @@ func (l emitLoader) LoadDedicatedAIClusters(context.Context, string, models.Envi
 	}, nil
 }
 
+func (l emitLoader) LoadEndpoints(context.Context, string, models.Environment) (map[string][]models.Endpoint, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return map[string][]models.Endpoint{
+		"dac-a": {{Name: "ep-a", DedicatedAICluster: "dac-a", LifecycleState: "ACTIVE"}},
+	}, nil
+}
+
+func (l emitLoader) LoadEvents(context.Context, string, models.Environment) (map[string][]models.Event, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return map[string][]models.Event{
+		"node-a": {{Name: "ev-a", ObjectKind: models.EventObjectNode, Object: "node-a", Type: models.EventTypeWarning}},
+	}, nil
+}
+
+func (l emitLoader) LoadWorkRequests(context.Context, string, models.Environment) ([]models.WorkRequest, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return []models.WorkRequest{{ID: "wr-a", Service: models.WorkRequestServiceCompute, Operation: "TerminateInstance", Status: "SUCCEEDED", PercentComplete: 100}}, nil
+}
+
 func (l emitLoader) LoadTenancyOverrideGroup(context.Context, string, models.Environment) (models.TenancyOverrideGroup, error) {
 	if l.err != nil {
 		return models.TenancyOverrideGroup{}, l.err
@@ -137,7 +169,11 @@ var emitCategories = []struct {
 	{domain.ImportedModel, "im-a"},
 	{domain.GPUNode, "node-a"},
 	{domain.GPUWorkload, "wl-a"},
+	{domain.PendingGPUWorkload, "pgw-a"},
 	{domain.DedicatedAICluster, "dac-a"},
+	{domain.Endpoint, "ep-a"},
+	{domain.Event, "ev-a"},
+	{domain.WorkRequest, "wr-a"},
 	{domain.Tenant, "tenant-a"},
 	{domain.LimitTenancyOverride, "lim-a"},
 	{domain.ConsolePropertyTenancyOverride, "cp-a"},
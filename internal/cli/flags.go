@@ -7,7 +7,7 @@ import (
 )
 
 // addPersistentFlags adds persistent flags to the root command.
-func addPersistentFlags(rootCmd *cobra.Command, cfgFile *string, defaultKube, defaultConfig, defaultMetadata string) {
+func addPersistentFlags(rootCmd *cobra.Command, cfgFile *string, defaultKube, defaultConfig, defaultMetadata, defaultCache string) {
 	rootCmd.PersistentFlags().StringVar(cfgFile, "config", defaultConfig, "Path to config file (YAML or JSON)")
 	rootCmd.PersistentFlags().String("repo-path", "", "Path to the repository")
 	rootCmd.PersistentFlags().String("env-type", "", "Environment type (e.g. dev, prod)")
@@ -19,6 +19,8 @@ func addPersistentFlags(rootCmd *cobra.Command, cfgFile *string, defaultKube, de
 	})
 	rootCmd.PersistentFlags().StringP("filter", "f", "", "Initial filter for current category")
 	rootCmd.PersistentFlags().String("metadata-file", defaultMetadata, "Optional path to a YAML or JSON file with additional metadata (e.g. tenants)")
+	rootCmd.PersistentFlags().String("cache-dir", defaultCache, "Directory for the on-disk cache of parsed repo data")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Parse the repo on every load instead of using the on-disk cache")
 	rootCmd.PersistentFlags().String("kubeconfig", defaultKube, "Path to kubeconfig file")
 	rootCmd.PersistentFlags().String("log-file", "toolkit.log", "Path to log file")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Enable debug logging")
@@ -34,6 +36,7 @@ func addPersistentFlags(rootCmd *cobra.Command, cfgFile *string, defaultKube, de
 	_ = rootCmd.MarkFlagFilename("metadata-file")
 	_ = rootCmd.MarkFlagFilename("kubeconfig")
 	_ = rootCmd.MarkFlagFilename("log-file")
+	_ = rootCmd.MarkFlagDirname("cache-dir")
 	// Shell completion for enumerated flags.
 	_ = rootCmd.RegisterFlagCompletionFunc("log-format", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{"console", "json", "slog"}, cobra.ShellCompDirectiveNoFileComp
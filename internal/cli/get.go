@@ -18,7 +18,6 @@ import (
 	"github.com/jingle2008/toolkit/internal/config"
 	"github.com/jingle2008/toolkit/internal/domain"
 	"github.com/jingle2008/toolkit/internal/infra/loader"
-	production "github.com/jingle2008/toolkit/internal/infra/loader/production"
 	"github.com/jingle2008/toolkit/internal/infra/terraform"
 	"github.com/jingle2008/toolkit/internal/resolve"
 	"github.com/jingle2008/toolkit/pkg/infra/logging"
@@ -35,7 +34,7 @@ func addGetCommand(rootCmd *cobra.Command, cfgFile *string) {
 		selectedColumns string
 	)
 	getCmd := &cobra.Command{
-		Use:   "get <category>",
+		Use:   "get <category> [name]",
 		Short: "Print a category's data to stdout (table/json/jsonl/yaml/csv/tsv)",
 		Long: `Headless equivalent of the TUI's category view.
 
@@ -49,24 +48,33 @@ Examples:
   toolkit get tenant --limit 10
   toolkit get gpunode --columns name,status,total,free
   toolkit get basemodel --columns help
+  toolkit get gpuworkload ns1/serving-pod-0 -o raw
+
+-o raw prints the live Kubernetes object behind one item (the CR, Node or
+Pod, with managed fields stripped) as YAML, for the fields toolkit does
+not model. It takes the item name; namespaced items may be qualified as
+<namespace>/<name>.
 
 Category aliases match the TUI (e.g. "tenant"/"t", "gpunode"/"gn",
 "dac", "basemodel"/"bm"). Run with shell completion enabled to
 discover them.`,
-		Args: cobra.ExactArgs(1),
-		ValidArgsFunction: func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		Args: cobra.RangeArgs(1, 2),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
 			return domain.Aliases, cobra.ShellCompDirectiveNoFileComp
 		},
 		RunE: runGet(cfgFile, &format, &noHeaders, &pretty, &limit, &selectedColumns),
 	}
-	getCmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml|csv|tsv")
+	getCmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml|csv|tsv|raw")
 	getCmd.Flags().BoolVar(&noHeaders, "no-headers", false, "omit header row (table/csv/tsv only)")
 	getCmd.Flags().BoolVar(&pretty, "pretty", true, "pretty-print JSON/YAML output")
 	getCmd.Flags().IntVar(&limit, "limit", 0, "max items to render (client-side, applied after the fuzzy --filter match); 0 = unlimited. For grouped categories the cap is across the whole flattened result, not per group.")
 	getCmd.Flags().StringVar(&selectedColumns, "columns", "",
 		"comma-separated column keys (table/csv/tsv only; default: all columns). Use --columns help to list valid keys.")
 	_ = getCmd.RegisterFlagCompletionFunc("output", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
-		return []string{"table", "json", "jsonl", "yaml", "csv", "tsv"}, cobra.ShellCompDirectiveNoFileComp
+		return []string{"table", "json", "jsonl", "yaml", "csv", "tsv", formatRaw}, cobra.ShellCompDirectiveNoFileComp
 	})
 	// Known limitation: this completion func returns the full key list without
 	// filtering by the in-progress token (toComplete) or handling the
@@ -107,10 +115,16 @@ func runGet(cfgFile *string, format *string, noHeaders, pretty *bool, limit *int
 			return output.WriteTable(cmd.OutOrStdout(), headers, rows, output.Options{})
 		}
 
-		fmtChoice, err := output.ParseFormat(*format)
-		if err != nil {
+		raw := strings.EqualFold(*format, formatRaw)
+		if err := validateRawArgs(cat, args, raw, *selectedColumns); err != nil {
 			return err
 		}
+		var fmtChoice output.Format
+		if !raw {
+			if fmtChoice, err = output.ParseFormat(*format); err != nil {
+				return err
+			}
+		}
 
 		selected, err := parseColumnsFlag(*selectedColumns)
 		if err != nil {
@@ -144,6 +158,7 @@ func runGet(cfgFile *string, format *string, noHeaders, pretty *bool, limit *int
 		if err != nil {
 			return err
 		}
+		configureClients(cfg)
 		logger = logger.WithFields("cmd", "get")
 		defer func() { _ = logger.Sync() }()
 
@@ -152,7 +167,11 @@ func runGet(cfgFile *string, format *string, noHeaders, pretty *bool, limit *int
 		ctx = logging.WithContext(ctx, logger)
 
 		env := models.Environment{Type: cfg.EnvType, Region: cfg.EnvRegion, Realm: cfg.EnvRealm}
-		ld := production.New(ctx, cfg.MetadataFile)
+		columns.SetMultiCluster(len(cfg.KubeContexts.For(env)) > 1)
+		if raw {
+			return writeRawObject(ctx, cmd.OutOrStdout(), cfg, env, cat, args[1])
+		}
+		ld := newLoader(ctx, cfg)
 
 		filter := strings.ToLower(strings.TrimSpace(cfg.Filter))
 		opts := output.Options{Format: fmtChoice, NoHeaders: *noHeaders, Pretty: *pretty}
@@ -263,14 +282,14 @@ func emitCategory(
 		return writeAliases(w, filter, limit, opts, selected)
 	case domain.BaseModel:
 		items, err := ld.LoadBaseModels(ctx, cfg.KubeConfig, env)
-		if err != nil {
-			return fmt.Errorf("load base models: %w", err)
+		if err := allowPartial(ctx, "load base models", err); err != nil {
+			return err
 		}
 		return writeSlice(w, collections.FilterSlice(items, nil, filter, nil), limit, opts, domain.BaseModel, env, selected)
 	case domain.ImportedModel:
 		grouped, err := ld.LoadImportedModels(ctx, cfg.KubeConfig, env)
-		if err != nil {
-			return fmt.Errorf("load imported models: %w", err)
+		if err := allowPartial(ctx, "load imported models", err); err != nil {
+			return err
 		}
 		// No top-level `tenant` injection: each item carries its
 		// own `tenantId` field already (same shape as DAC after
@@ -278,30 +297,22 @@ func emitCategory(
 		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.ImportedModel, env, selected)
 	case domain.GPUPool:
 		items, err := ld.LoadGPUPools(ctx, cfg.RepoPath, env)
-		if err != nil {
-			// Partial success: items has the rows we could load; surface
-			// the per-source failures on stderr so scripts and LLM
-			// consumers know the result is incomplete, then proceed.
-			if partial, ok := errors.AsType[*terraform.PartialLoadError](err); ok {
-				logging.FromContext(ctx).Warnw("load gpu pools: partial failure", "error", partial)
-				fmt.Fprintf(os.Stderr, "warning: load gpu pools: %s\n", partial.Error())
-			} else {
-				return fmt.Errorf("load gpu pools: %w", err)
-			}
+		if err := allowPartial(ctx, "load gpu pools", err); err != nil {
+			return err
 		}
 		// Enrich ActualSize / Status from OCI's ListInstancePools (same
 		// step the TUI runs after load). Degrades to placeholder on
 		// failure so an offline / no-OCI-auth session still prints the
 		// Terraform-derived columns.
-		if err := resolve.EnrichGPUPools(ctx, items, cfg.KubeConfig, env); err != nil {
+		if err := resolve.EnrichGPUPools(ctx, items, cfg.KubeConfig, cfg.KubeContexts, env); err != nil {
 			logging.FromContext(ctx).Warnw("gpu pool enrichment incomplete", "error", err)
 			fmt.Fprintf(os.Stderr, "warning: gpu pool enrichment incomplete: %s\n", err)
 		}
 		return writeSlice(w, collections.FilterSlice(items, nil, filter, nil), limit, opts, domain.GPUPool, env, selected)
 	case domain.GPUNode:
 		grouped, err := ld.LoadGPUNodesByPool(ctx, cfg.KubeConfig, env)
-		if err != nil {
-			return fmt.Errorf("load gpu nodes: %w", err)
+		if err := allowPartial(ctx, "load gpu nodes", err); err != nil {
+			return err
 		}
 		// No top-level `pool` injection: GPUNode.NodePool (json
 		// `poolName`) already carries the group key; the loader sets
@@ -309,19 +320,47 @@ func emitCategory(
 		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.GPUNode, env, selected)
 	case domain.GPUWorkload:
 		grouped, err := ld.LoadGPUWorkloadsByNode(ctx, cfg.KubeConfig, env)
-		if err != nil {
-			return fmt.Errorf("load gpu workloads: %w", err)
+		if err := allowPartial(ctx, "load gpu workloads", err); err != nil {
+			return err
 		}
 		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.GPUWorkload, env, selected)
+	case domain.PendingGPUWorkload:
+		items, err := ld.LoadPendingGPUWorkloads(ctx, cfg.KubeConfig, env)
+		if err := allowPartial(ctx, "load pending gpu workloads", err); err != nil {
+			return err
+		}
+		return writeSlice(w, collections.FilterSlice(items, nil, filter, nil), limit, opts, domain.PendingGPUWorkload, env, selected)
 	case domain.DedicatedAICluster:
 		grouped, err := ld.LoadDedicatedAIClusters(ctx, cfg.KubeConfig, env)
-		if err != nil {
-			return fmt.Errorf("load dedicated AI clusters: %w", err)
+		if err := allowPartial(ctx, "load dedicated AI clusters", err); err != nil {
+			return err
 		}
 		// No top-level `tenant` injection: the loader keys this map
 		// by dac.TenantID (internal/infra/k8s/dac.go:157), which is
 		// already the flat `tenantId` field on each value.
 		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.DedicatedAICluster, env, selected)
+	case domain.Endpoint:
+		grouped, err := ld.LoadEndpoints(ctx, cfg.KubeConfig, env)
+		if err := allowPartial(ctx, "load endpoints", err); err != nil {
+			return err
+		}
+		// No top-level `dac` injection: Endpoint.DedicatedAICluster
+		// (json `dedicatedAiCluster`) already carries the group key.
+		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.Endpoint, env, selected)
+	case domain.Event:
+		grouped, err := ld.LoadEvents(ctx, cfg.KubeConfig, env)
+		if err := allowPartial(ctx, "load events", err); err != nil {
+			return err
+		}
+		// No top-level `object` injection: Event.Object (json `object`)
+		// already carries the group key.
+		return writeMap(w, collections.FilterMapOrAll(grouped, filter), limit, opts, domain.Event, env, selected)
+	case domain.WorkRequest:
+		items, err := ld.LoadWorkRequests(ctx, cfg.KubeConfig, env)
+		if err := allowPartial(ctx, "load work requests", err); err != nil {
+			return err
+		}
+		return writeSlice(w, collections.FilterSlice(items, nil, filter, nil), limit, opts, domain.WorkRequest, env, selected)
 	case domain.Tenant,
 		domain.LimitTenancyOverride,
 		domain.ConsolePropertyTenancyOverride,
@@ -578,3 +617,21 @@ func writeAliases(w writer, filter string, limit int, opts output.Options, selec
 		return fmt.Errorf("unsupported format %q", opts.Format)
 	}
 }
+
+// allowPartial returns err wrapped with what, unless it is a partial
+// load: then the items hold the rows that did load (every GPU pool source
+// or kube context that answered), so the failures go to stderr, letting
+// scripts and LLM consumers know the result is incomplete, and nil is
+// returned to proceed.
+func allowPartial(ctx context.Context, what string, err error) error {
+	if err == nil {
+		return nil
+	}
+	partial, ok := errors.AsType[*terraform.PartialLoadError](err)
+	if !ok {
+		return fmt.Errorf("%s: %w", what, err)
+	}
+	logging.FromContext(ctx).Warnw(what+": partial failure", "error", partial)
+	fmt.Fprintf(os.Stderr, "warning: %s: %s\n", what, partial.Error())
+	return nil
+}
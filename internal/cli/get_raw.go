@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// formatRaw is the `get`-only output format that prints the live
+// Kubernetes object behind one item instead of toolkit's model. It is not
+// an output.Format: it selects a different lookup, not an encoding.
+const formatRaw = "raw"
+
+// rawObjectFn is the seam tests use to fake the cluster lookup. Production
+// builds a fresh loader and reads through the optional RawObjectReader
+// capability (same path the TUI uses).
+var rawObjectFn = func(
+	ctx context.Context, cfg config.Config, env models.Environment,
+	cat domain.Category, namespace, name string,
+) (map[string]any, error) {
+	ld := newLoader(ctx, cfg)
+	reader, ok := ld.(loader.RawObjectReader)
+	if !ok {
+		return nil, errors.New("loader does not support reading raw objects")
+	}
+	return reader.RawObject(ctx, cfg.KubeConfig, env, cat, "", namespace, name)
+}
+
+// validateRawArgs checks the name argument and flags against -o raw
+// before any config is read: a name is required with raw and rejected
+// without it, and only categories backed by one Kubernetes object qualify.
+func validateRawArgs(cat domain.Category, args []string, raw bool, selectedColumns string) error {
+	switch {
+	case !raw && len(args) > 1:
+		return fmt.Errorf("a name argument is only accepted with -o raw (got %q)", args[1])
+	case !raw:
+		return nil
+	case len(args) < 2:
+		return fmt.Errorf("-o raw needs an item name: toolkit get %s <name> -o raw", args[0])
+	case !cat.HasRawObject():
+		return fmt.Errorf("%s is not backed by a Kubernetes object; -o raw is available for %s", cat, rawCategoryList())
+	case selectedColumns != "":
+		return errors.New("--columns has no effect with -o raw")
+	}
+	return nil
+}
+
+func rawCategoryList() string {
+	var names []string
+	for _, c := range domain.Categories {
+		if c.HasRawObject() {
+			names = append(names, strings.ToLower(c.String()))
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeRawObject fetches the live object behind the named item and prints
+// it as YAML. Namespaced items may be qualified as <namespace>/<name>; a
+// bare name is looked up across namespaces.
+func writeRawObject(ctx context.Context, w writer, cfg config.Config, env models.Environment, cat domain.Category, ref string) error {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok {
+		namespace, name = "", ref
+	}
+	obj, err := rawObjectFn(ctx, cfg, env, cat, namespace, name)
+	if err != nil {
+		return fmt.Errorf("get raw %s %q: %w", cat, ref, err)
+	}
+	return output.WriteYAML(w, obj, output.Options{})
+}
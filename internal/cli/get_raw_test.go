@@ -0,0 +1,76 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestGetRaw_PrintsObjectAsYAML(t *testing.T) {
+	stageHistoryEnv(t)
+	var gotCat domain.Category
+	var gotNS, gotName string
+	defer swap(&rawObjectFn, func(_ context.Context, _ config.Config, _ models.Environment,
+		cat domain.Category, namespace, name string,
+	) (map[string]any, error) {
+		gotCat, gotNS, gotName = cat, namespace, name
+		return map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"status":     map[string]any{"phase": "Running"},
+		}, nil
+	})()
+
+	out, err := runRootCmd(t, []string{"get", "gpuworkload", "ns1/serv-0", "-o", "raw"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotCat != domain.GPUWorkload || gotNS != "ns1" || gotName != "serv-0" {
+		t.Errorf("unexpected lookup: %v %q %q", gotCat, gotNS, gotName)
+	}
+	for _, want := range []string{"kind: Pod", "namespace: ns1", "phase: Running"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	// A bare name is passed through with no namespace.
+	if _, err := runRootCmd(t, []string{"get", "gn", "gpu-1", "-o", "raw"}, ""); err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotCat != domain.GPUNode || gotNS != "" || gotName != "gpu-1" {
+		t.Errorf("unexpected lookup: %v %q %q", gotCat, gotNS, gotName)
+	}
+}
+
+func TestGetRaw_Rejections(t *testing.T) {
+	stageHistoryEnv(t)
+	defer swap(&rawObjectFn, func(context.Context, config.Config, models.Environment,
+		domain.Category, string, string,
+	) (map[string]any, error) {
+		t.Fatal("must not look up the object")
+		return nil, nil
+	})()
+
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"get", "gpunode", "-o", "raw"}, "-o raw needs an item name"},
+		{[]string{"get", "gpunode", "gpu-1"}, "only accepted with -o raw"},
+		{[]string{"get", "tenant", "acme", "-o", "raw"}, "not backed by a Kubernetes object"},
+		{[]string{"get", "gpunode", "gpu-1", "-o", "raw", "--columns", "name"}, "--columns has no effect"},
+	}
+	for _, tc := range cases {
+		_, err := runRootCmd(t, tc.args, "")
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: err = %v, want containing %q", tc.args, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/configloader"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// itemHistoryFn is the seam tests use to fake the git lookup. Production
+// builds a fresh loader and reads through the optional HistoryReader
+// capability (same path the TUI uses).
+var itemHistoryFn = func(
+	ctx context.Context, cfg config.Config, env models.Environment,
+	cat domain.Category, tenant, name string, limit int, withDiff bool,
+) (gitlog.History, error) {
+	ld := newLoader(ctx, cfg)
+	reader, ok := ld.(loader.HistoryReader)
+	if !ok {
+		return gitlog.History{}, errors.New("loader does not support reading history")
+	}
+	return reader.ItemHistory(ctx, cfg.RepoPath, env, cat, tenant, name, limit, withDiff)
+}
+
+// addHistoryCommand wires the `toolkit history <category> <name>` subcommand.
+func addHistoryCommand(rootCmd *cobra.Command, cfgFile *string) {
+	var (
+		tenant string
+		format string
+		limit  int
+		patch  bool
+	)
+	historyCmd := &cobra.Command{
+		Use:   "history <category> <name>",
+		Short: "Show the git history of the repo file backing a definition or override",
+		Long: `List the commits that touched the JSON file backing a definition or
+override item, newest first, with who made each change and when. --patch
+adds the diff of the file in each commit.
+
+Definitions share one file per kind, so their history covers every
+definition of that kind. Tenancy overrides live under a per-tenant
+directory; pass the tenant directory name with --tenant.
+
+Examples:
+  toolkit history limittenancyoverride gpu-a100-count --tenant acme
+  toolkit history limittenancyoverride gpu-a100-count --tenant acme -p
+  toolkit history propertyregionaloverride some-flag -o json
+  toolkit history limitdefinition gpu-a100-count --limit 5`,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return domain.Aliases, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cat, err := domain.ParseCategory(args[0])
+			if err != nil {
+				return fmt.Errorf("unknown category %q (run `toolkit history -h` for examples)", args[0])
+			}
+			if !configloader.HasSourceFile(cat) {
+				return fmt.Errorf("%s is not backed by a repo file; history is available for definitions and overrides", cat)
+			}
+			if tenant == "" && isTenancyOverride(cat) {
+				return fmt.Errorf("--tenant is required for %s", cat)
+			}
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by history; use table, json, jsonl, or yaml", fmtChoice)
+			}
+			return withHistorySetup(cfgFile, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+				h, err := itemHistoryFn(ctx, cfg, env, cat, tenant, args[1], limit, patch)
+				if err != nil {
+					return fmt.Errorf("load history: %w", err)
+				}
+				return writeHistory(cmd.OutOrStdout(), h, output.Options{Format: fmtChoice, Pretty: true}, patch)
+			})
+		},
+	}
+	historyCmd.Flags().StringVar(&tenant, "tenant", "", "tenant directory name (tenancy overrides only)")
+	historyCmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml")
+	historyCmd.Flags().IntVar(&limit, "limit", gitlog.DefaultLimit, "max commits to show")
+	historyCmd.Flags().BoolVarP(&patch, "patch", "p", false, "include the diff of the file in each commit")
+	rootCmd.AddCommand(historyCmd)
+}
+
+// isTenancyOverride reports whether cat is keyed by a tenant directory.
+func isTenancyOverride(cat domain.Category) bool {
+	switch cat { //nolint:exhaustive
+	case domain.LimitTenancyOverride, domain.ConsolePropertyTenancyOverride, domain.PropertyTenancyOverride:
+		return true
+	default:
+		return false
+	}
+}
+
+// withHistorySetup is the read-only counterpart of withMutationSetup:
+// history needs the repo and the realm (override directories are
+// per-realm) but no cluster access.
+func withHistorySetup(cfgFile *string, fn func(ctx context.Context, cfg config.Config, env models.Environment) error) error {
+	return withCommandSetup(cfgFile, "history", validateHistoryConfig, fn)
+}
+
+// validateHistoryConfig checks the settings `toolkit history` needs.
+func validateHistoryConfig(cfg config.Config) error {
+	var missing []string
+	if cfg.RepoPath == "" {
+		missing = append(missing, "--repo-path")
+	}
+	if cfg.EnvRealm == "" {
+		missing = append(missing, "--env-realm")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"missing required setting(s) for `toolkit history`: %s\n"+
+				"  set them via flags, environment (TOOLKIT_*), or `toolkit init`",
+			strings.Join(missing, ", "),
+		)
+	}
+	return nil
+}
+
+// writeHistory renders a file history. Encoded formats emit the History
+// struct as-is; table prints the source path and last change, then either
+// a commit table or, with patch, git-log style entries with their diffs.
+func writeHistory(w writer, h gitlog.History, opts output.Options, patch bool) error {
+	switch opts.Format {
+	case output.FormatJSON, output.FormatJSONL, output.FormatYAML:
+		return writeEncoded(w, opts, h)
+	case output.FormatTable:
+	default:
+		return fmt.Errorf("-o %s is not supported by history; use table, json, jsonl, or yaml", opts.Format)
+	}
+
+	if _, err := fmt.Fprintf(w, "Source: %s\n", h.Path); err != nil {
+		return err
+	}
+	last := h.LastChange()
+	if last == nil {
+		_, err := fmt.Fprintln(w, "No commits touch this file (new or untracked).")
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Last changed by %s on %s (%s)\n\n",
+		last.Author, last.Date.Local().Format(gitlog.DateLayout), last.ShortHash()); err != nil {
+		return err
+	}
+
+	if !patch {
+		rows := make([][]string, len(h.Commits))
+		for i, c := range h.Commits {
+			rows[i] = []string{c.ShortHash(), c.Date.Local().Format(gitlog.DateLayout), c.Author, c.Subject}
+		}
+		return output.WriteTable(w, []string{"COMMIT", "DATE", "AUTHOR", "SUBJECT"}, rows, opts)
+	}
+	for _, c := range h.Commits {
+		if _, err := fmt.Fprintf(w, "commit %s\nAuthor: %s <%s>\nDate:   %s\n\n    %s\n\n%s\n\n",
+			c.Hash, c.Author, c.Email, c.Date.Local().Format(time.RFC1123Z), c.Subject, c.Diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func stageHistoryEnv(t *testing.T) {
+	t.Helper()
+	stageMutationEnv(t)
+	t.Setenv("TOOLKIT_REPO_PATH", t.TempDir())
+}
+
+func fakeHistory() gitlog.History {
+	return gitlog.History{
+		Path: "shared_modules/limits/limits_tenancy_overrides/regional_values/oc1/acme/gpu.json",
+		Commits: []gitlog.Commit{
+			{
+				Hash: "abcdef1234567890", Author: "Jane Doe", Email: "jane@example.com",
+				Date: time.Date(2025, 3, 4, 5, 6, 0, 0, time.UTC), Subject: "raise gpu limit",
+				Diff: "-  \"max\": 1\n+  \"max\": 8",
+			},
+			{
+				Hash: "1234567abcdef", Author: "John Roe", Email: "john@example.com",
+				Date: time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC), Subject: "add gpu override",
+			},
+		},
+	}
+}
+
+func TestHistory_TableOutput(t *testing.T) {
+	stageHistoryEnv(t)
+	var gotCat domain.Category
+	var gotTenant, gotName string
+	var gotDiff bool
+	defer swap(&itemHistoryFn, func(_ context.Context, _ config.Config, env models.Environment,
+		cat domain.Category, tenant, name string, _ int, withDiff bool,
+	) (gitlog.History, error) {
+		if env.Realm != "oc1" {
+			t.Errorf("realm = %q, want oc1", env.Realm)
+		}
+		gotCat, gotTenant, gotName, gotDiff = cat, tenant, name, withDiff
+		return fakeHistory(), nil
+	})()
+
+	out, err := runRootCmd(t, []string{"history", "limittenancyoverride", "gpu", "--tenant", "acme"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if gotCat != domain.LimitTenancyOverride || gotTenant != "acme" || gotName != "gpu" || gotDiff {
+		t.Errorf("unexpected lookup: %v %q %q diff=%v", gotCat, gotTenant, gotName, gotDiff)
+	}
+	for _, want := range []string{"Source: shared_modules/", "Last changed by Jane Doe", "abcdef1", "raise gpu limit", "John Roe"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestHistory_PatchOutput(t *testing.T) {
+	stageHistoryEnv(t)
+	defer swap(&itemHistoryFn, func(context.Context, config.Config, models.Environment,
+		domain.Category, string, string, int, bool,
+	) (gitlog.History, error) {
+		return fakeHistory(), nil
+	})()
+
+	out, err := runRootCmd(t, []string{"history", "limitdefinition", "gpu", "-p"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if !strings.Contains(out, "commit abcdef1234567890") || !strings.Contains(out, "+  \"max\": 8") {
+		t.Errorf("expected git-log style entries with diff, got:\n%s", out)
+	}
+}
+
+func TestHistory_JSONOutput(t *testing.T) {
+	stageHistoryEnv(t)
+	defer swap(&itemHistoryFn, func(context.Context, config.Config, models.Environment,
+		domain.Category, string, string, int, bool,
+	) (gitlog.History, error) {
+		return fakeHistory(), nil
+	})()
+
+	out, err := runRootCmd(t, []string{"history", "propertyregionaloverride", "flag", "-o", "json"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	var h gitlog.History
+	if err := json.Unmarshal([]byte(out), &h); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(h.Commits) != 2 || h.Commits[0].Author != "Jane Doe" {
+		t.Errorf("unexpected history: %+v", h)
+	}
+}
+
+func TestHistory_Rejections(t *testing.T) {
+	stageHistoryEnv(t)
+	defer swap(&itemHistoryFn, func(context.Context, config.Config, models.Environment,
+		domain.Category, string, string, int, bool,
+	) (gitlog.History, error) {
+		t.Fatal("must not look up history")
+		return gitlog.History{}, nil
+	})()
+
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"history", "gpunode", "n1"}, "not backed by a repo file"},
+		{[]string{"history", "nope", "n1"}, "unknown category"},
+		{[]string{"history", "limittenancyoverride", "gpu"}, "--tenant is required"},
+		{[]string{"history", "limitdefinition", "gpu", "-o", "csv"}, "not supported by history"},
+	}
+	for _, tc := range cases {
+		_, err := runRootCmd(t, tc.args, "")
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: want error containing %q, got %v", tc.args, tc.want, err)
+		}
+	}
+}
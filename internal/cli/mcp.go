@@ -12,7 +12,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/jingle2008/toolkit/internal/config"
-	production "github.com/jingle2008/toolkit/internal/infra/loader/production"
+	"github.com/jingle2008/toolkit/internal/infra/loader/production"
 	"github.com/jingle2008/toolkit/internal/mcp"
 	"github.com/jingle2008/toolkit/pkg/infra/logging"
 )
@@ -67,6 +67,7 @@ func runMCP(cfgFile *string, version string) func(cmd *cobra.Command, args []str
 		if err != nil {
 			return err
 		}
+		configureClients(cfg)
 		logger = logger.WithFields("cmd", "mcp", "version", version)
 		defer func() { _ = logger.Sync() }()
 
@@ -74,7 +75,7 @@ func runMCP(cfgFile *string, version string) func(cmd *cobra.Command, args []str
 		defer stop()
 		ctx = logging.WithContext(ctx, logger)
 
-		ld := production.New(ctx, cfg.MetadataFile)
+		ld := newLoader(ctx, cfg, production.WithInformerCache())
 		srv := mcp.NewServer(cfg, ld, logger, version)
 		logger.Infow(
 			"mcp server starting",
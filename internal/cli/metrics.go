@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/telemetry"
+	"github.com/jingle2008/toolkit/internal/resolve"
+	"github.com/jingle2008/toolkit/internal/ui/tui/actions"
+	"github.com/jingle2008/toolkit/internal/ui/tui/view"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// metricsFn is the seam tests use to fake the cluster and OCI lookups.
+// Production builds a fresh loader, finds the named item, resolves its
+// metrics plan against the model catalogs and summarizes the plan's
+// queries in the GPU nodes' compartment.
+var metricsFn = func(
+	ctx context.Context, cfg config.Config, env models.Environment,
+	cat domain.Category, ref string, start, end time.Time,
+) ([]models.MetricSeries, error) {
+	ld := newLoader(ctx, cfg)
+	item, err := findMetricsItem(ctx, ld, cfg, env, cat, ref)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := loadMetricsCatalogs(ctx, ld, cfg, env, item)
+	if err != nil {
+		return nil, err
+	}
+	plan, ok, reason := telemetry.ResolvePlan(item, env, ds)
+	if !ok {
+		return nil, fmt.Errorf("no metrics for %s %q: %s", cat, ref, reason)
+	}
+	compartmentID, err := resolve.CompartmentID(ctx, cfg.KubeConfig, cfg.KubeContexts, env)
+	if err != nil {
+		return nil, fmt.Errorf("resolve compartment ID: %w", err)
+	}
+	return actions.FetchMetrics(ctx, env, compartmentID, plan, start, end)
+}
+
+// addMetricsCommand wires `toolkit metrics <category> <name>`.
+func addMetricsCommand(rootCmd *cobra.Command, cfgFile *string) {
+	var (
+		format string
+		window time.Duration
+		width  int
+	)
+	cmd := &cobra.Command{
+		Use:   "metrics <category> <name>",
+		Short: "Show a DAC's or GPU workload's service metrics as sparklines",
+		Long: `Fetch the GenerativeAI service metrics of one dedicated AI cluster or
+GPU workload from OCI Monitoring and print one sparkline per metric, with
+its latest value, peak and total over the window. The metric set is the
+one the TUI's <m> dashboard opens, chosen by the model's capability
+(token throughput and latency for chat models, and so on).
+
+<name> is the DAC name, or the workload's pod name, optionally qualified
+as <namespace>/<name>. With -o json|jsonl|yaml the raw datapoints are
+printed instead.
+
+Examples:
+  toolkit metrics dac amaaaaaabcd
+  toolkit metrics gpuworkload team-x/llama-0 --window 24h
+  toolkit metrics dac amaaaaaabcd -o json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cat, err := domain.ParseCategory(args[0])
+			if err != nil {
+				return err
+			}
+			if cat != domain.DedicatedAICluster && cat != domain.GPUWorkload {
+				return fmt.Errorf("metrics are available for dedicatedaicluster and gpuworkload, not %s", cat)
+			}
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by metrics; use table, json, jsonl, or yaml", fmtChoice)
+			}
+			if window <= 0 {
+				return errors.New("--window must be positive")
+			}
+			ref := args[1]
+			return withMutationSetup(cfgFile, "metrics", true, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+				end := time.Now()
+				start := end.Add(-window)
+				series, err := metricsFn(ctx, cfg, env, cat, ref, start, end)
+				if err != nil {
+					return err
+				}
+				out := cmd.OutOrStdout()
+				if fmtChoice != output.FormatTable {
+					return writeEncoded(out, output.Options{Format: fmtChoice, Pretty: true}, series)
+				}
+				if _, err := fmt.Fprintf(out, "%s %s — last %s (%s to %s)\n", cat, ref, window,
+					start.Format(time.DateTime), end.Format(time.DateTime)); err != nil {
+					return err
+				}
+				for _, line := range view.MetricLines(series, width) {
+					if _, err := fmt.Fprintln(out, line); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml")
+	cmd.Flags().DurationVar(&window, "window", 6*time.Hour, "How far back to look")
+	cmd.Flags().IntVar(&width, "width", 100, "Line width the sparklines are fitted to")
+	rootCmd.AddCommand(cmd)
+}
+
+// findMetricsItem loads the category's items and returns the one named
+// ref. A workload ref may be qualified as <namespace>/<name>.
+func findMetricsItem(
+	ctx context.Context, ld loader.Composite, cfg config.Config, env models.Environment,
+	cat domain.Category, ref string,
+) (any, error) {
+	if cat == domain.DedicatedAICluster {
+		grouped, err := ld.LoadDedicatedAIClusters(ctx, cfg.KubeConfig, env)
+		if err != nil {
+			return nil, fmt.Errorf("load dedicated AI clusters: %w", err)
+		}
+		for _, dacs := range grouped {
+			for i := range dacs {
+				if dacs[i].Name == ref {
+					return &dacs[i], nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("dedicated AI cluster %q not found", ref)
+	}
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok {
+		namespace, name = "", ref
+	}
+	grouped, err := ld.LoadGPUWorkloadsByNode(ctx, cfg.KubeConfig, env)
+	if err != nil {
+		return nil, fmt.Errorf("load gpu workloads: %w", err)
+	}
+	for _, workloads := range grouped {
+		for i := range workloads {
+			if workloads[i].Name == name && (namespace == "" || workloads[i].Namespace == namespace) {
+				return &workloads[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("gpu workload %q not found", ref)
+}
+
+// loadMetricsCatalogs loads the model catalogs telemetry.ResolvePlan
+// needs for item: the base catalog always, the imported one only when
+// the item names an imported or fine-tuned model.
+func loadMetricsCatalogs(
+	ctx context.Context, ld loader.Composite, cfg config.Config, env models.Environment, item any,
+) (*models.Dataset, error) {
+	base, err := ld.LoadBaseModels(ctx, cfg.KubeConfig, env)
+	if err != nil {
+		return nil, fmt.Errorf("load base models: %w", err)
+	}
+	ds := &models.Dataset{BaseModels: base}
+	var modelName string
+	switch it := item.(type) {
+	case *models.DedicatedAICluster:
+		modelName = it.ModelName
+	case *models.GPUWorkload:
+		modelName = it.Model
+	}
+	if strings.HasPrefix(modelName, telemetry.ImportedModelPrefix) {
+		if ds.ImportedModelMap, err = ld.LoadImportedModels(ctx, cfg.KubeConfig, env); err != nil {
+			return nil, fmt.Errorf("load imported models: %w", err)
+		}
+	}
+	return ds, nil
+}
@@ -0,0 +1,111 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var testSeries = []models.MetricSeries{
+	{Name: "chat.InputTokenLength", Query: "q1", Points: []models.MetricPoint{
+		{Time: time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC), Value: 1000},
+		{Time: time.Date(2026, 1, 2, 3, 1, 0, 0, time.UTC), Value: 2500},
+	}},
+	{Name: "chat.OutputTokenLength", Query: "q2"},
+}
+
+func TestMetrics_Command(t *testing.T) {
+	stageMutationEnv(t)
+	var (
+		gotEnv   models.Environment
+		gotCat   domain.Category
+		gotRef   string
+		gotRange time.Duration
+	)
+	defer swap(&metricsFn, func(_ context.Context, _ config.Config, env models.Environment, cat domain.Category, ref string, start, end time.Time) ([]models.MetricSeries, error) {
+		gotEnv, gotCat, gotRef, gotRange = env, cat, ref, end.Sub(start)
+		return testSeries, nil
+	})()
+
+	out, err := runRootCmd(t, []string{"metrics", "dac", "dac-a", "--window", "24h"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, models.Environment{Type: "dev", Region: "us-ashburn-1", Realm: "oc1"}, gotEnv)
+	assert.Equal(t, domain.DedicatedAICluster, gotCat)
+	assert.Equal(t, "dac-a", gotRef)
+	assert.Equal(t, 24*time.Hour, gotRange)
+	for _, want := range []string{
+		"last 24h0m0s",
+		"chat.InputTokenLength", "last 2.5k", "peak 2.5k", "total 3.5k",
+		"chat.OutputTokenLength  no data",
+	} {
+		assert.Contains(t, out, want)
+	}
+
+	out, err = runRootCmd(t, []string{"metrics", "gpuworkload", "team-x/wl-a", "-o", "json"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.GPUWorkload, gotCat)
+	assert.Equal(t, "team-x/wl-a", gotRef)
+	var decoded []models.MetricSeries
+	require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+	assert.Equal(t, testSeries, decoded)
+}
+
+func TestMetrics_Rejects(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&metricsFn, func(context.Context, config.Config, models.Environment, domain.Category, string, time.Time, time.Time) ([]models.MetricSeries, error) {
+		return nil, errors.New("boom")
+	})()
+
+	_, err := runRootCmd(t, []string{"metrics", "gpunode", "node-a"}, "")
+	require.ErrorContains(t, err, "metrics are available for dedicatedaicluster and gpuworkload")
+	_, err = runRootCmd(t, []string{"metrics", "dac", "dac-a", "-o", "csv"}, "")
+	require.ErrorContains(t, err, "-o csv is not supported by metrics")
+	_, err = runRootCmd(t, []string{"metrics", "dac", "dac-a", "--window", "0s"}, "")
+	require.ErrorContains(t, err, "--window must be positive")
+	_, err = runRootCmd(t, []string{"metrics", "dac", "dac-a"}, "")
+	require.ErrorContains(t, err, "boom")
+}
+
+func TestFindMetricsItem(t *testing.T) {
+	ctx := context.Background()
+	env := models.Environment{Type: "dev", Region: "us-ashburn-1", Realm: "oc1"}
+
+	item, err := findMetricsItem(ctx, emitLoader{}, config.Config{}, env, domain.DedicatedAICluster, "dac-a")
+	require.NoError(t, err)
+	assert.Equal(t, "dac-a", item.(*models.DedicatedAICluster).Name) //nolint:forcetypeassert // asserted by the lookup
+
+	item, err = findMetricsItem(ctx, emitLoader{}, config.Config{}, env, domain.GPUWorkload, "wl-a")
+	require.NoError(t, err)
+	assert.Equal(t, "wl-a", item.(*models.GPUWorkload).Name) //nolint:forcetypeassert // asserted by the lookup
+
+	_, err = findMetricsItem(ctx, emitLoader{}, config.Config{}, env, domain.GPUWorkload, "team-x/wl-a")
+	require.ErrorContains(t, err, `gpu workload "team-x/wl-a" not found`)
+	_, err = findMetricsItem(ctx, emitLoader{}, config.Config{}, env, domain.DedicatedAICluster, "nope")
+	require.ErrorContains(t, err, `dedicated AI cluster "nope" not found`)
+	_, err = findMetricsItem(ctx, emitLoader{err: errors.New("down")}, config.Config{}, env, domain.DedicatedAICluster, "dac-a")
+	require.ErrorContains(t, err, "load dedicated AI clusters: down")
+}
+
+func TestLoadMetricsCatalogs(t *testing.T) {
+	ctx := context.Background()
+	env := models.Environment{Type: "dev"}
+
+	ds, err := loadMetricsCatalogs(ctx, emitLoader{}, config.Config{}, env, &models.DedicatedAICluster{ModelName: "bm-a"})
+	require.NoError(t, err)
+	assert.NotNil(t, ds.FindBaseModelByName("bm-a"))
+	assert.Nil(t, ds.ImportedModelMap, "base model: imported catalog not loaded")
+
+	ds, err = loadMetricsCatalogs(ctx, emitLoader{}, config.Config{}, env, &models.GPUWorkload{Model: "amaaaaaaimp"})
+	require.NoError(t, err)
+	assert.NotNil(t, ds.ImportedModelMap, "imported model name loads the imported catalog")
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/jingle2008/toolkit/internal/config"
-	production "github.com/jingle2008/toolkit/internal/infra/loader/production"
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
 	"github.com/jingle2008/toolkit/internal/resolve"
 	"github.com/jingle2008/toolkit/pkg/infra/logging"
 	"github.com/jingle2008/toolkit/pkg/models"
@@ -23,7 +23,7 @@ import (
 // In production it constructs a fresh loader per call and delegates
 // to internal/resolve.GPUNode.
 var resolveGPUNodeFn = func(ctx context.Context, cfg config.Config, env models.Environment, name string) (*models.GPUNode, error) {
-	ld := production.New(ctx, cfg.MetadataFile)
+	ld := newLoader(ctx, cfg)
 	return resolve.GPUNode(ctx, ld, cfg.KubeConfig, env, name, "")
 }
 
@@ -39,16 +39,20 @@ func resolveGPUNode(ctx context.Context, cfg config.Config, env models.Environme
 	return resolveGPUNodeFn(ctx, cfg, env, name)
 }
 
+// locateNodeFn is the seam tests use to fake the lookup of which of an
+// environment's clusters has a node.
+var locateNodeFn = k8s.LocateNode
+
 // resolveGPUPoolFn is the seam tests use to fake gpu-pool resolution.
 // In production it constructs a fresh loader and delegates to
 // internal/resolve.GPUPool.
 var resolveGPUPoolFn = func(ctx context.Context, cfg config.Config, env models.Environment, name string) (*models.GPUPool, error) {
-	ld := production.New(ctx, cfg.MetadataFile)
-	return resolve.GPUPool(ctx, ld, cfg.RepoPath, cfg.KubeConfig, env, name)
+	ld := newLoader(ctx, cfg)
+	return resolve.GPUPool(ctx, ld, cfg.RepoPath, cfg.KubeConfig, cfg.KubeContexts, env, name)
 }
 
-// validateMutationConfig checks the minimum settings a mutation
-// subcommand needs.
+// validateMutationConfig checks the minimum settings the mutation
+// subcommand name needs.
 //
 //   - needsKube=true  → cluster-scoped mutations (cordon, drain) and
 //     OCI mutations resolving a node by name without --ocid (reboot,
@@ -58,7 +62,7 @@ var resolveGPUPoolFn = func(ctx context.Context, cfg config.Config, env models.E
 //     flag" case isn't reachable in normal use.
 //   - needsRepo=true  → mutations sourced from Terraform (scale). The
 //     repo path has no default and must be supplied.
-func validateMutationConfig(cfg config.Config, needsKube, needsRepo, needsEnv bool) error {
+func validateMutationConfig(cfg config.Config, name string, needsKube, needsRepo, needsEnv bool) error {
 	var missing []string
 	if needsRepo && cfg.RepoPath == "" {
 		missing = append(missing, "--repo-path")
@@ -76,9 +80,9 @@ func validateMutationConfig(cfg config.Config, needsKube, needsRepo, needsEnv bo
 	}
 	if len(missing) > 0 {
 		return fmt.Errorf(
-			"missing required setting(s): %s\n"+
+			"missing required setting(s) for `toolkit %s`: %s\n"+
 				"  set them via flags, environment (TOOLKIT_*), or `toolkit init`",
-			strings.Join(missing, ", "),
+			name, strings.Join(missing, ", "),
 		)
 	}
 	if needsKube {
@@ -90,16 +94,32 @@ func validateMutationConfig(cfg config.Config, needsKube, needsRepo, needsEnv bo
 }
 
 // withMutationSetup runs the standard prelude every mutation
-// subcommand shares — read the config file, unmarshal, validate per
-// needsKube/needsRepo/needsEnv, init the logger (deferred Sync), wire a
-// signal-cancellable context with the logger attached, and build the
-// Environment triple — then invokes fn with the resolved cfg / env /
-// ctx. Keeps the setup uniform so individual subcommands focus only
-// on flag parsing and their perform closure.
+// subcommand (and reconcile, which reads the same settings) shares:
+// withCommandSetup, validating per needsKube/needsRepo/needsEnv. Keeps
+// the setup uniform so individual subcommands focus only on flag
+// parsing and their perform closure.
 func withMutationSetup(
 	cfgFile *string,
+	name string,
 	needsKube, needsRepo, needsEnv bool,
 	fn func(ctx context.Context, cfg config.Config, env models.Environment) error,
+) error {
+	return withCommandSetup(cfgFile, name, func(cfg config.Config) error {
+		return validateMutationConfig(cfg, name, needsKube, needsRepo, needsEnv)
+	}, fn)
+}
+
+// withCommandSetup is the prelude of the one-shot subcommands that
+// act on an environment — read the config file, unmarshal, validate,
+// init the logger tagged with cmd=name (deferred Sync), configure the
+// OCI clients, wire a signal-cancellable context with the logger
+// attached, and build the Environment triple — then invokes fn with
+// the resolved cfg / env / ctx.
+func withCommandSetup(
+	cfgFile *string,
+	name string,
+	validate func(config.Config) error,
+	fn func(ctx context.Context, cfg config.Config, env models.Environment) error,
 ) error {
 	if err := readConfigFile(cfgFile); err != nil {
 		return err
@@ -108,14 +128,15 @@ func withMutationSetup(
 	if err := viper.Unmarshal(&cfg); err != nil {
 		return fmt.Errorf("unmarshal config: %w", err)
 	}
-	if err := validateMutationConfig(cfg, needsKube, needsRepo, needsEnv); err != nil {
+	if err := validate(cfg); err != nil {
 		return err
 	}
 	logger, err := initLogger(cfg)
 	if err != nil {
 		return err
 	}
-	logger = logger.WithFields("cmd", "mutate")
+	configureClients(cfg)
+	logger = logger.WithFields("cmd", name)
 	defer func() { _ = logger.Sync() }()
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -193,7 +193,7 @@ func TestRunMutation_PerformError(t *testing.T) {
 func TestValidateMutationConfig_NeedsEnvFalse_SkipsEnvTriple(t *testing.T) {
 	// No env type/region/realm set, but needsEnv=false → must pass.
 	cfg := config.Config{}
-	if err := validateMutationConfig(cfg, false, false, false); err != nil {
+	if err := validateMutationConfig(cfg, "set tenant", false, false, false); err != nil {
 		t.Fatalf("needsEnv=false should not require env triple, got: %v", err)
 	}
 }
@@ -201,7 +201,7 @@ func TestValidateMutationConfig_NeedsEnvFalse_SkipsEnvTriple(t *testing.T) {
 func TestValidateMutationConfig_NeedsEnvTrue_RequiresEnvTriple(t *testing.T) {
 	t.Parallel()
 	cfg := config.Config{}
-	err := validateMutationConfig(cfg, false, false, true)
+	err := validateMutationConfig(cfg, "cordon", false, false, true)
 	if err == nil {
 		t.Fatal("needsEnv=true with empty env must error")
 	}
@@ -0,0 +1,267 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// planRow is one pool change joined against the repo and the live
+// cluster. The pointer fields are nil when that side could not be
+// consulted (no repo configured, cluster unreachable, --no-live).
+type planRow struct {
+	terraform.PoolChange
+	RepoSize        *int     `json:"repoSize,omitempty"`
+	LiveNodes       *int     `json:"liveNodes,omitempty"`
+	NodesRemoved    *int     `json:"nodesRemoved,omitempty"`
+	WorkloadsAtRisk *int     `json:"workloadsAtRisk,omitempty"`
+	AtRisk          []string `json:"atRiskWorkloads,omitempty"`
+}
+
+// addPlanCommand wires `toolkit plan <plan.json>`.
+func addPlanCommand(rootCmd *cobra.Command, cfgFile *string) {
+	var (
+		format string
+		noLive bool
+	)
+	cmd := &cobra.Command{
+		Use:   "plan <plan.json>",
+		Short: "Preview GPU pool changes in a Terraform plan and the workloads they affect",
+		Long: `Read the JSON form of a Terraform plan (` + "`terraform show -json tfplan`" + `,
+or "-" for stdin) and list the planned changes to OCI instance pools,
+cluster networks and OKE node pools.
+
+Each change is joined against the GPU pools loaded from --repo-path (the
+REPO column; a mismatch with the planned size means the plan is stale)
+and the live GPU nodes and workloads in the cluster:
+
+  delete / replace   every live node in the pool goes away, so every
+                     workload on them is at risk.
+  shrinking update   OCI picks which instances to terminate, so the
+                     workload count is an upper bound: every workload
+                     on the pool's nodes.
+  unknown size       a size computed at apply shows DELTA "?" and no
+                     removal or risk count.
+
+Use --no-live to skip the cluster lookup.
+
+Examples:
+  terraform show -json tfplan > plan.json && toolkit plan plan.json
+  terraform show -json tfplan | toolkit plan - -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by plan; use table, json, jsonl, or yaml", fmtChoice)
+			}
+			changes, err := readPlanFile(cmd.InOrStdin(), args[0])
+			if err != nil {
+				return err
+			}
+			if err := readConfigFile(cfgFile); err != nil {
+				return err
+			}
+			var cfg config.Config
+			if err := viper.Unmarshal(&cfg); err != nil {
+				return fmt.Errorf("unmarshal config: %w", err)
+			}
+			logger, err := initLogger(cfg)
+			if err != nil {
+				return err
+			}
+			logger = logger.WithFields("cmd", "plan")
+			defer func() { _ = logger.Sync() }()
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			ctx = logging.WithContext(ctx, logger)
+
+			env := models.Environment{Type: cfg.EnvType, Region: cfg.EnvRegion, Realm: cfg.EnvRealm}
+			ld := newLoader(ctx, cfg)
+			rows := joinPlan(ctx, cmd.ErrOrStderr(), ld, cfg, env, changes, !noLive)
+			return writePlan(cmd.OutOrStdout(), rows, output.Options{Format: fmtChoice, Pretty: true})
+		},
+	}
+	cmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml")
+	cmd.Flags().BoolVar(&noLive, "no-live", false, "skip the live GPU node and workload lookup")
+	rootCmd.AddCommand(cmd)
+}
+
+// readPlanFile parses the plan at path, or stdin when path is "-".
+func readPlanFile(stdin io.Reader, path string) ([]terraform.PoolChange, error) {
+	if path == "-" {
+		changes, err := terraform.ParsePlan(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("read plan from stdin: %w", err)
+		}
+		return changes, nil
+	}
+	f, err := os.Open(path) // #nosec G304 -- user-supplied plan path is the point
+	if err != nil {
+		return nil, fmt.Errorf("open plan: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	changes, err := terraform.ParsePlan(f)
+	if err != nil {
+		return nil, fmt.Errorf("read plan %s: %w", path, err)
+	}
+	return changes, nil
+}
+
+// joinPlan joins each change with the repo-declared pool size and, when
+// live is set, the pool's current nodes and the workloads on them. A
+// source that fails to load is reported on stderr and its columns are
+// left blank, so an offline run still shows the plan's own deltas.
+func joinPlan(ctx context.Context, stderr io.Writer, ld loader.Composite, cfg config.Config,
+	env models.Environment, changes []terraform.PoolChange, live bool,
+) []planRow {
+	logger := logging.FromContext(ctx)
+
+	var repoSizes map[string]int
+	if cfg.RepoPath != "" {
+		pools, err := ld.LoadGPUPools(ctx, cfg.RepoPath, env)
+		if err != nil {
+			logger.Warnw("plan: load gpu pools incomplete", "error", err)
+			_, _ = fmt.Fprintf(stderr, "warning: load gpu pools: %s\n", err)
+		}
+		// A partial load still returns the pools that did resolve.
+		if _, partial := errors.AsType[*terraform.PartialLoadError](err); err == nil || partial {
+			repoSizes = make(map[string]int, len(pools))
+			for _, p := range pools {
+				repoSizes[p.Name] = p.Size
+			}
+		}
+	}
+
+	var (
+		nodesByPool     map[string][]models.GPUNode
+		workloadsByNode map[string][]models.GPUWorkload
+	)
+	if live {
+		var err error
+		nodesByPool, err = ld.LoadGPUNodesByPool(ctx, cfg.KubeConfig, env)
+		if err == nil {
+			workloadsByNode, err = ld.LoadGPUWorkloadsByNode(ctx, cfg.KubeConfig, env)
+		}
+		if err != nil {
+			logger.Warnw("plan: live cluster lookup failed", "error", err)
+			_, _ = fmt.Fprintf(stderr, "warning: live cluster lookup: %s\n", err)
+			nodesByPool, workloadsByNode = nil, nil
+		}
+	}
+
+	rows := make([]planRow, len(changes))
+	for i, c := range changes {
+		row := planRow{PoolChange: c}
+		if size, ok := repoSizes[c.Name]; ok {
+			row.RepoSize = &size
+		}
+		if nodesByPool != nil {
+			applyLiveImpact(&row, nodesByPool[c.Name], workloadsByNode)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// applyLiveImpact fills the live columns for one change from the pool's
+// current nodes. An update whose new size is unknown until apply gets
+// only LiveNodes: how many nodes go is not known.
+func applyLiveImpact(row *planRow, nodes []models.GPUNode, workloadsByNode map[string][]models.GPUWorkload) {
+	liveNodes := len(nodes)
+	row.LiveNodes = &liveNodes
+	removed := 0
+	var atRiskNodes []models.GPUNode
+	delta, known := row.SizeDelta()
+	switch {
+	case row.Action == terraform.PlanActionDelete, row.Action == terraform.PlanActionReplace:
+		removed = liveNodes
+		atRiskNodes = nodes
+	case !known:
+		// The new size is only known at apply: leave the impact unset
+		// rather than guess.
+		return
+	case delta < 0 && liveNodes > 0:
+		removed = min(-delta, liveNodes)
+		atRiskNodes = nodes
+	}
+	var atRisk []string
+	for _, n := range atRiskNodes {
+		for _, w := range workloadsByNode[n.Name] {
+			atRisk = append(atRisk, w.Namespace+"/"+w.Name)
+		}
+	}
+	count := len(atRisk)
+	row.NodesRemoved, row.WorkloadsAtRisk, row.AtRisk = &removed, &count, atRisk
+}
+
+// writePlan renders the joined rows. Encoded formats emit planRow as-is.
+func writePlan(w writer, rows []planRow, opts output.Options) error {
+	if opts.Format != output.FormatTable {
+		return writeEncoded(w, opts, rows)
+	}
+	if len(rows) == 0 {
+		_, err := fmt.Fprintln(w, "No changes to GPU pools in this plan.")
+		return err
+	}
+	table := make([][]string, len(rows))
+	for i, r := range rows {
+		table[i] = []string{
+			r.Name,
+			r.Action,
+			planSize(r.BeforeSize) + " → " + planSize(r.AfterSize),
+			planDelta(r.PoolChange),
+			planCount(r.RepoSize),
+			planCount(r.LiveNodes),
+			planCount(r.NodesRemoved),
+			planCount(r.WorkloadsAtRisk),
+		}
+	}
+	return output.WriteTable(w,
+		[]string{"POOL", "ACTION", "SIZE", "DELTA", "REPO", "LIVE NODES", "NODES REMOVED", "WORKLOADS AT RISK"},
+		table, opts)
+}
+
+// planDelta formats a change's size delta, "?" when it is unknown until
+// apply.
+func planDelta(c terraform.PoolChange) string {
+	delta, ok := c.SizeDelta()
+	if !ok {
+		return "?"
+	}
+	return fmt.Sprintf("%+d", delta)
+}
+
+// planSize formats one side of a size change; -1 (absent or unknown
+// until apply) renders as "-".
+func planSize(n int) string {
+	if n < 0 {
+		return "-"
+	}
+	return strconv.Itoa(n)
+}
+
+func planCount(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return strconv.Itoa(*n)
+}
@@ -0,0 +1,136 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+const testPlanJSON = `{
+  "format_version": "1.2",
+  "resource_changes": [
+    {
+      "address": "oci_core_instance_pool.pool[\"pool-a\"]",
+      "mode": "managed",
+      "type": "oci_core_instance_pool",
+      "index": "pool-a",
+      "change": {
+        "actions": ["update"],
+        "before": {"display_name": "pool-a", "size": 3},
+        "after": {"display_name": "pool-a", "size": 1}
+      }
+    }
+  ]
+}`
+
+func TestJoinPlan(t *testing.T) {
+	changes := []terraform.PoolChange{
+		{Name: "pool-a", Action: terraform.PlanActionUpdate, BeforeSize: 1, AfterSize: 0},
+		{Name: "pool-a", Action: terraform.PlanActionUpdate, BeforeSize: 1, AfterSize: 2},
+		{Name: "pool-b", Action: terraform.PlanActionCreate, BeforeSize: -1, AfterSize: 2},
+		{Name: "pool-a", Action: terraform.PlanActionUpdate, BeforeSize: 1, AfterSize: -1},
+	}
+	var stderr bytes.Buffer
+	rows := joinPlan(context.Background(), &stderr, emitLoader{}, config.Config{RepoPath: "/repo"},
+		models.Environment{}, changes, true)
+	require.Len(t, rows, 4)
+	assert.Empty(t, stderr.String())
+
+	shrink := rows[0]
+	require.NotNil(t, shrink.RepoSize)
+	assert.Equal(t, 1, *shrink.RepoSize)
+	assert.Equal(t, 1, *shrink.LiveNodes)
+	assert.Equal(t, 1, *shrink.NodesRemoved)
+	assert.Equal(t, 1, *shrink.WorkloadsAtRisk)
+	assert.Equal(t, []string{"/wl-a"}, shrink.AtRisk)
+
+	grow := rows[1]
+	assert.Equal(t, 0, *grow.NodesRemoved)
+	assert.Equal(t, 0, *grow.WorkloadsAtRisk)
+
+	unknown := rows[2]
+	assert.Nil(t, unknown.RepoSize)
+	assert.Equal(t, 0, *unknown.LiveNodes)
+
+	// An update to a size unknown until apply removes nothing we can count.
+	computed := rows[3]
+	assert.Equal(t, 1, *computed.LiveNodes)
+	assert.Nil(t, computed.NodesRemoved)
+	assert.Nil(t, computed.WorkloadsAtRisk)
+	assert.Empty(t, computed.AtRisk)
+
+	var out bytes.Buffer
+	require.NoError(t, writePlan(&out, rows[3:], output.Options{Format: output.FormatTable}))
+	assert.Contains(t, out.String(), "?")
+	assert.NotContains(t, out.String(), "-1")
+}
+
+func TestJoinPlan_SourcesUnavailable(t *testing.T) {
+	changes := []terraform.PoolChange{{Name: "pool-a", Action: terraform.PlanActionDelete, BeforeSize: 1, AfterSize: -1}}
+	var stderr bytes.Buffer
+	rows := joinPlan(context.Background(), &stderr, emitLoader{err: errors.New("boom")}, config.Config{RepoPath: "/repo"},
+		models.Environment{}, changes, true)
+	require.Len(t, rows, 1)
+	assert.Nil(t, rows[0].RepoSize)
+	assert.Nil(t, rows[0].LiveNodes)
+	assert.Contains(t, stderr.String(), "warning: load gpu pools: boom")
+	assert.Contains(t, stderr.String(), "warning: live cluster lookup: boom")
+
+	// No repo and no live lookup: nothing is consulted.
+	stderr.Reset()
+	rows = joinPlan(context.Background(), &stderr, emitLoader{err: errors.New("boom")}, config.Config{},
+		models.Environment{}, changes, false)
+	assert.Nil(t, rows[0].RepoSize)
+	assert.Nil(t, rows[0].LiveNodes)
+	assert.Empty(t, stderr.String())
+}
+
+func TestPlan_Command(t *testing.T) {
+	stageMutationEnv(t)
+
+	out, err := runRootCmd(t, []string{"plan", "-", "--no-live"}, testPlanJSON)
+	require.NoError(t, err)
+	for _, want := range []string{"pool-a", "update", "3 → 1", "-2"} {
+		assert.Contains(t, out, want)
+	}
+
+	out, err = runRootCmd(t, []string{"plan", "-", "--no-live", "-o", "json"}, testPlanJSON)
+	require.NoError(t, err)
+	var rows []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(out), &rows), out)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "pool-a", rows[0]["name"])
+	assert.InDelta(t, 1, rows[0]["afterSize"], 0)
+	assert.NotContains(t, rows[0], "liveNodes")
+}
+
+func TestPlan_Rejections(t *testing.T) {
+	stageMutationEnv(t)
+	cases := []struct {
+		args  []string
+		stdin string
+		want  string
+	}{
+		{[]string{"plan", "-", "--no-live"}, `{"values": {}}`, "not a terraform plan"},
+		{[]string{"plan", "/no/such/plan.json"}, "", "open plan"},
+		{[]string{"plan", "-", "-o", "csv"}, testPlanJSON, "not supported by plan"},
+	}
+	for _, tc := range cases {
+		_, err := runRootCmd(t, tc.args, tc.stdin)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: want error containing %q, got %v", tc.args, tc.want, err)
+		}
+	}
+}
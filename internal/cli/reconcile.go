@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/reconcile"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// runReconcileFn is the seam tests use to fake the OCI and cluster
+// lookups. In production it builds a fresh loader and delegates to
+// internal/reconcile.Run.
+var runReconcileFn = func(ctx context.Context, cfg config.Config, env models.Environment) (reconcile.Report, error) {
+	ld := newLoader(ctx, cfg)
+	return reconcile.Run(ctx, ld, cfg.RepoPath, cfg.KubeConfig, cfg.KubeContexts, env)
+}
+
+// addReconcileCommand wires `toolkit reconcile`.
+func addReconcileCommand(rootCmd *cobra.Command, cfgFile *string) {
+	var (
+		format   string
+		exitCode bool
+	)
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Find GPU instances that never joined the cluster and nodes whose instance is gone",
+		Long: `List the instances in each GPU instance pool and cluster network and
+match them against the GPU nodes in the cluster by instance OCID.
+
+Reported:
+  not-joined      an instance in a pool with no GPU node, older than
+                  15 minutes (younger ones are still booting).
+  dead-instance   a GPU node whose instance is stopped, terminated or
+                  no longer exists.
+  size-mismatch   a pool whose actual size differs from the number of
+                  its instances that joined or are still booting.
+
+OKE-managed node pools are not listed, but their nodes are still checked
+for dead instances. With --exit-code, exit with status 1 when anything is
+reported.
+
+Examples:
+  toolkit reconcile
+  toolkit reconcile -o json --exit-code`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by reconcile; use table, json, jsonl, or yaml", fmtChoice)
+			}
+			return withMutationSetup(cfgFile, "reconcile", true, true, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+				report, err := runReconcileFn(ctx, cfg, env)
+				if err != nil {
+					return err
+				}
+				for _, w := range report.Warnings {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s\n", w)
+				}
+				if err := writeReconcile(cmd.OutOrStdout(), report, output.Options{Format: fmtChoice, Pretty: true}); err != nil {
+					return err
+				}
+				if exitCode && len(report.Findings) > 0 {
+					return fmt.Errorf("%d finding(s)", len(report.Findings))
+				}
+				return nil
+			})
+		},
+	}
+	cmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml")
+	cmd.Flags().BoolVar(&exitCode, "exit-code", false, "exit with status 1 when anything is reported")
+	rootCmd.AddCommand(cmd)
+}
+
+// writeReconcile renders a report. Encoded formats emit the Report as-is;
+// table prints the pools, then the findings.
+func writeReconcile(w writer, r reconcile.Report, opts output.Options) error {
+	if opts.Format != output.FormatTable {
+		return writeEncoded(w, opts, r)
+	}
+	pools := make([][]string, len(r.Pools))
+	for i, p := range r.Pools {
+		if p.Skipped != "" {
+			pools[i] = []string{p.Name, "-", "-", "-", "skipped: " + p.Skipped}
+			continue
+		}
+		pools[i] = []string{p.Name, strconv.Itoa(p.ActualSize), strconv.Itoa(p.Instances), strconv.Itoa(p.Joined), ""}
+	}
+	if err := output.WriteTable(w, []string{"POOL", "ACTUAL SIZE", "INSTANCES", "JOINED", "NOTE"}, pools, opts); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if len(r.Findings) == 0 {
+		_, err := fmt.Fprintln(w, "No ghost instances or nodes found.")
+		return err
+	}
+	findings := make([][]string, len(r.Findings))
+	for i, f := range r.Findings {
+		findings[i] = []string{f.Kind, f.Pool, orDash(f.InstanceName), orDash(f.Instance), orDash(f.Node), orDash(f.State), f.Detail}
+	}
+	return output.WriteTable(w, []string{"KIND", "POOL", "INSTANCE", "OCID", "NODE", "STATE", "DETAIL"}, findings, opts)
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/reconcile"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var testReport = reconcile.Report{
+	Pools: []reconcile.PoolReport{
+		{Name: "pool-a", ID: "ocid1.instancepool.a", ActualSize: 3, Instances: 3, Joined: 2},
+		{Name: "oke", Skipped: "OKE-managed node pool"},
+	},
+	Findings: []reconcile.Finding{
+		{
+			Kind: reconcile.KindNotJoined, Pool: "pool-a", Instance: "ocid1.instance.x", InstanceName: "inst-x",
+			State: "RUNNING", Detail: "no GPU node for this instance, created 3h ago",
+		},
+		{Kind: reconcile.KindSizeMismatch, Pool: "pool-a", Detail: "actual size 3, 2 node(s) joined"},
+	},
+	Warnings: []string{"cluster networks: boom"},
+}
+
+func TestReconcile_Command(t *testing.T) {
+	stageMutationEnv(t)
+	t.Setenv("TOOLKIT_REPO_PATH", t.TempDir())
+	var gotEnv models.Environment
+	defer swap(&runReconcileFn, func(_ context.Context, _ config.Config, env models.Environment) (reconcile.Report, error) {
+		gotEnv = env
+		return testReport, nil
+	})()
+
+	out, err := runRootCmd(t, []string{"reconcile"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, models.Environment{Type: "dev", Region: "us-ashburn-1", Realm: "oc1"}, gotEnv)
+	for _, want := range []string{
+		"warning: cluster networks: boom",
+		"skipped: OKE-managed node pool",
+		"not-joined", "inst-x", "created 3h ago",
+		"size-mismatch", "actual size 3, 2 node(s) joined",
+	} {
+		assert.Contains(t, out, want)
+	}
+
+	_, err = runRootCmd(t, []string{"reconcile", "--exit-code"}, "")
+	require.ErrorContains(t, err, "2 finding(s)")
+
+	out, err = runRootCmd(t, []string{"reconcile", "-o", "json"}, "")
+	require.NoError(t, err)
+	var got reconcile.Report
+	require.NoError(t, json.Unmarshal([]byte(out[strings.Index(out, "{"):]), &got), out)
+	assert.Equal(t, testReport, got)
+}
+
+func TestReconcile_NothingFound(t *testing.T) {
+	stageMutationEnv(t)
+	t.Setenv("TOOLKIT_REPO_PATH", t.TempDir())
+	defer swap(&runReconcileFn, func(context.Context, config.Config, models.Environment) (reconcile.Report, error) {
+		return reconcile.Report{Pools: []reconcile.PoolReport{{Name: "pool-a", ActualSize: 1, Instances: 1, Joined: 1}}}, nil
+	})()
+
+	out, err := runRootCmd(t, []string{"reconcile", "--exit-code"}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No ghost instances or nodes found.")
+}
+
+func TestReconcile_Rejections(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&runReconcileFn, func(context.Context, config.Config, models.Environment) (reconcile.Report, error) {
+		return reconcile.Report{}, errors.New("enrich gpu pools: boom")
+	})()
+
+	_, err := runRootCmd(t, []string{"reconcile"}, "")
+	require.ErrorContains(t, err, "missing required setting(s) for `toolkit reconcile`: --repo-path")
+
+	t.Setenv("TOOLKIT_REPO_PATH", t.TempDir())
+	_, err = runRootCmd(t, []string{"reconcile", "-o", "csv"}, "")
+	require.ErrorContains(t, err, "not supported by reconcile")
+
+	_, err = runRootCmd(t, []string{"reconcile"}, "")
+	require.ErrorContains(t, err, "enrich gpu pools: boom")
+}
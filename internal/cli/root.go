@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -19,7 +20,12 @@ import (
 
 	"github.com/jingle2008/toolkit/internal/config"
 	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
 	production "github.com/jingle2008/toolkit/internal/infra/loader/production"
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
 	"github.com/jingle2008/toolkit/internal/ui/tui"
 	"github.com/jingle2008/toolkit/pkg/infra/logging"
 	"github.com/jingle2008/toolkit/pkg/models"
@@ -41,6 +47,14 @@ log-level: "" # debug|info|warn|error (empty uses debug flag)
 debug: false
 filter: ""
 metadata-file: "" # Optional path to a YAML or JSON file with additional metadata (e.g. tenants)
+# cache-dir: "/path/to/cache" # Parse cache directory (default: the user cache dir)
+no-cache: false
+# oci-auth: # OCI request signing (default: session token from the realm's profile)
+#   mode: session-token # session-token|api-key|instance-principal|resource-principal
+#   config-file: "~/.oci/config"
+#   profile: "OC1"
+#   environments:
+#     prod-iad: { mode: api-key, profile: "PROD_IAD" }
 `
 
 	home, _ := os.UserHomeDir()
@@ -48,6 +62,7 @@ metadata-file: "" # Optional path to a YAML or JSON file with additional metadat
 	defaultKube := filepath.Join(home, ".kube", "config")
 	defaultConfig := filepath.Join(cfgDir, "toolkit", "config.yaml")
 	defaultMetadata := filepath.Join(cfgDir, "toolkit", "metadata.yaml")
+	defaultCache, _ := parsecache.DefaultDir()
 
 	rootCmd := &cobra.Command{
 		Use:           "toolkit",
@@ -58,7 +73,7 @@ metadata-file: "" # Optional path to a YAML or JSON file with additional metadat
 		RunE:          runRootE(&cfgFile, version),
 	}
 
-	addPersistentFlags(rootCmd, &cfgFile, defaultKube, defaultConfig, defaultMetadata)
+	addPersistentFlags(rootCmd, &cfgFile, defaultKube, defaultConfig, defaultMetadata, defaultCache)
 	addInitCommand(rootCmd, defaultConfig, exampleConfig)
 	addConfigCommand(rootCmd, &cfgFile)
 	addDoctorCommand(rootCmd, &cfgFile)
@@ -73,7 +88,14 @@ metadata-file: "" # Optional path to a YAML or JSON file with additional metadat
 	addScaleCommand(rootCmd, &cfgFile)
 	addDeleteCommand(rootCmd, &cfgFile)
 	addTerminateCommand(rootCmd, &cfgFile)
+	addWaitCommand(rootCmd, &cfgFile)
+	addMetricsCommand(rootCmd, &cfgFile)
 	addSetCommand(rootCmd, &cfgFile)
+	addHistoryCommand(rootCmd, &cfgFile)
+	addTFCommand(rootCmd, &cfgFile)
+	addPlanCommand(rootCmd, &cfgFile)
+	addReconcileCommand(rootCmd, &cfgFile)
+	addCacheCommand(rootCmd, &cfgFile)
 
 	// Bind persistent flags once so Viper can read them.
 	_ = viper.BindPFlags(rootCmd.PersistentFlags())
@@ -105,6 +127,7 @@ func runRootE(cfgFile *string, version string) func(cmd *cobra.Command, _ []stri
 		if err != nil {
 			return err
 		}
+		configureClients(cfg)
 		// Tag every line in the shared log file with the originating
 		// command + build, so concurrent tui/cli/mcp sessions writing the
 		// same file stay distinguishable.
@@ -139,6 +162,50 @@ func readConfigFile(cfgFile *string) error {
 	return nil
 }
 
+// newLoader builds the production loader for cfg: the metadata file plus
+// any configured gpu-pool-sources, gpu-resources and node-health-rules,
+// and the parse cache unless disabled. Long-running sessions add
+// production.WithInformerCache via extra.
+func newLoader(ctx context.Context, cfg config.Config, extra ...production.Option) loader.Composite {
+	opts := []production.Option{
+		production.WithGPUPoolSources(gpuPoolSources(cfg.GPUPoolSources)),
+		production.WithGPUResources(cfg.GPUResources),
+		production.WithNodeHealthRules(healthRules(cfg.NodeHealthRules)),
+		production.WithKubeContexts(cfg.KubeContexts),
+	}
+	if cfg.CacheDir != "" && !cfg.NoCache {
+		opts = append(opts, production.WithParseCache(parsecache.New(cfg.CacheDir)))
+	}
+	return production.New(ctx, cfg.MetadataFile, append(opts, extra...)...)
+}
+
+// gpuPoolSources converts the configured gpu-pool-sources. None yields
+// nil, so the loader reads terraform.DefaultGPUPoolSources.
+func gpuPoolSources(sources []config.GPUPoolSource) []terraform.GPUPoolSource {
+	var result []terraform.GPUPoolSource
+	for _, src := range sources {
+		result = append(result, terraform.GPUPoolSource{Dir: src.Dir, Local: src.Local, IsOkeManaged: src.OkeManaged})
+	}
+	return result
+}
+
+// healthRules converts the configured node-health-rules. Validate rejects
+// an unparsable Within; were one to get through, it would fall back to the
+// default event window.
+func healthRules(rules []config.NodeHealthRule) []k8s.HealthRule {
+	result := make([]k8s.HealthRule, 0, len(rules))
+	for _, r := range rules {
+		within, _ := time.ParseDuration(r.Within)
+		result = append(result, k8s.HealthRule{
+			Name: r.Name, Severity: r.Severity, Message: r.Message,
+			Condition: r.Condition, Status: r.Status, Taint: r.Taint,
+			Label: r.Label, Field: r.Field, Values: r.Values, Mismatch: r.Mismatch,
+			Event: r.Event, Within: within, FailedPods: r.FailedPods,
+		})
+	}
+	return result
+}
+
 // Execute runs the root command.
 func Execute(version string) {
 	cmd := NewRootCmd(version)
@@ -188,14 +255,16 @@ func runToolkit(ctx context.Context, logger logging.Logger, cfg config.Config, v
 	model, err := tui.NewModel(
 		tui.WithRepoPath(repoPath),
 		tui.WithKubeConfig(kubeConfig),
+		tui.WithKubeContexts(cfg.KubeContexts),
 		tui.WithEnvironment(env),
 		tui.WithCategory(category),
 		tui.WithLogger(logger),
 		tui.WithLogStore(ring),
 		tui.WithContext(ctx),
-		tui.WithLoader(production.New(ctx, cfg.MetadataFile)),
+		tui.WithLoader(newLoader(ctx, cfg, production.WithInformerCache())),
 		tui.WithFilter(cfg.Filter),
 		tui.WithVersion(version),
+		tui.WithBreakers(resilience.Default),
 	)
 	if err != nil {
 		logger.Errorw("failed to create toolkit model", "error", err)
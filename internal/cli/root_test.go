@@ -5,8 +5,12 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
 )
 
 func TestRootCmd_HelpOutput(t *testing.T) {
@@ -138,3 +142,20 @@ func TestRootCmd_InvalidConfigFile(t *testing.T) {
 
 // We cannot easily test Execute() since it calls os.Exit on error.
 // Instead, test NewRootCmd and its RunE logic via the above tests.
+
+func TestGPUPoolSources(t *testing.T) {
+	got := gpuPoolSources([]config.GPUPoolSource{
+		{Dir: "pools", Local: "env_pools"},
+		{Dir: "oke", Local: "module.oke.node_pools", OkeManaged: true},
+	})
+	want := []terraform.GPUPoolSource{
+		{Dir: "pools", Local: "env_pools"},
+		{Dir: "oke", Local: "module.oke.node_pools", IsOkeManaged: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("gpuPoolSources = %+v, want %+v", got, want)
+	}
+	if got := gpuPoolSources(nil); got != nil {
+		t.Errorf("no sources must keep the loader's defaults, got %+v", got)
+	}
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/jingle2008/toolkit/internal/config"
 	"github.com/jingle2008/toolkit/internal/infra/loader"
-	production "github.com/jingle2008/toolkit/internal/infra/loader/production"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -22,7 +21,7 @@ const tenancyOCIDPrefix = "ocid1.tenancy."
 // Production builds a fresh loader and upserts via the optional
 // TenantMetadataWriter capability (same path the TUI uses).
 var setTenantFn = func(ctx context.Context, cfg config.Config, entry models.TenantMetadata) error {
-	ld := production.New(ctx, cfg.MetadataFile)
+	ld := newLoader(ctx, cfg)
 	writer, ok := ld.(loader.TenantMetadataWriter)
 	if !ok {
 		return errors.New("loader does not support writing metadata")
@@ -65,7 +64,7 @@ Examples:
 			if !strings.HasPrefix(ocid, tenancyOCIDPrefix) {
 				return fmt.Errorf("invalid tenancy OCID %q: must start with %q", ocid, tenancyOCIDPrefix)
 			}
-			return withMutationSetup(cfgFile, false, false, false, func(ctx context.Context, cfg config.Config, _ models.Environment) error {
+			return withMutationSetup(cfgFile, "set tenant", false, false, false, func(ctx context.Context, cfg config.Config, _ models.Environment) error {
 				return runMutation(ctx, cmd.InOrStdin(), cmd.OutOrStdout(), mutationPlan{
 					Action:  "set",
 					Kind:    "tenant",
@@ -52,8 +52,8 @@ func TestCSVSnapshots(t *testing.T) {
 }
 
 // TestCSVSnapshotsExport pins the export-mode CSV output for the
-// categories that declare RenderForExport closures (DAC, ImportedModel,
-// GPUWorkload today). Drives columns.RenderTableForExport with a fixed realm +
+// categories that declare RenderForExport closures (DAC, Endpoint,
+// ImportedModel, GPUWorkload today). Drives columns.RenderTableForExport with a fixed realm +
 // region so the fully-qualified OCID format is recorded explicitly
 // and any regression in RenderForExport — for either of those columns
 // or any future addition — fails this test at the snapshot level.
@@ -67,6 +67,7 @@ func TestCSVSnapshotsExport(t *testing.T) {
 	)
 	for _, cat := range []domain.Category{
 		domain.DedicatedAICluster,
+		domain.Endpoint,
 		domain.ImportedModel,
 		domain.GPUWorkload,
 	} {
@@ -198,9 +199,21 @@ func fixtureFor(t *testing.T, cat domain.Category) any {
 		return map[string][]models.DedicatedAICluster{
 			"tenant-1": {{Name: "dac-1", TenantID: "tenant-1", Type: "HOSTING", ModelName: "cohere.command", UnitShape: "AI.LARGE", Size: 4, Age: "1d", Status: "ACTIVE"}},
 		}
+	case domain.Endpoint:
+		return map[string][]models.Endpoint{
+			"dac-1": {{Name: "ep-1", DisplayName: "chat-prod", DedicatedAICluster: "dac-1", ModelID: "ocid1.generativeaimodel.oc1.me-dubai-1.model-1", LifecycleState: "ACTIVE", ContentModeration: "BLOCK", Age: "2d"}},
+		}
+	case domain.Event:
+		return map[string][]models.Event{
+			"node-1": {{Name: "node-1.17a0", Namespace: "default", ObjectKind: models.EventObjectNode, Object: "node-1", Type: models.EventTypeWarning, Reason: "Unhealthy", Count: 3, FirstSeen: "2h", LastSeen: "5m", Message: "GPU 3 has fallen off the bus"}},
+		}
+	case domain.WorkRequest:
+		return []models.WorkRequest{{ID: "ocid1.workrequest.oc1.iad.wr-1", Service: models.WorkRequestServiceCompute, Operation: "TerminateInstance", Status: "FAILED", PercentComplete: 40, Errors: []string{"instance is protected"}, Age: "3h"}}
+	case domain.PendingGPUWorkload:
+		return []models.PendingGPUWorkload{{Name: "pod-2", Namespace: "ns1", TenantID: "tenant-1", Model: "gpt-oss-120b", GPUs: 8, Age: "12m", Reason: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", Hint: "pool-a (1 node)"}}
 	case domain.GPUWorkload:
 		return map[string][]models.GPUWorkload{
-			"node-1": {{Name: "pod-1", Node: "node-1", TenantID: "tenant-1", Namespace: "ns1", Model: "gpt-oss-120b", Runtime: "vllm", GPUs: 2, Restarts: 0, Age: "3d", Mode: "RawDeployment"}},
+			"node-1": {{Name: "pod-1", Node: "node-1", TenantID: "tenant-1", Namespace: "ns1", Model: "gpt-oss-120b", Runtime: "vllm", GPUs: 2, Restarts: 1, Age: "3d", Mode: "RawDeployment", LastRestart: "2h", Containers: []models.ContainerState{{Name: "main", Ready: true, State: "Running", Restarts: 1, LastTerminationReason: "OOMKilled", LastExitCode: 137, LastRestart: "2h"}}}},
 		}
 	case domain.Alias:
 		cats := make([]domain.Category, 0, len(domain.Categories))
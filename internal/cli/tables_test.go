@@ -198,8 +198,8 @@ func TestRenderTable_GPUNode(t *testing.T) {
 	}
 	headers, rows, err := columns.RenderTable(domain.GPUNode, grouped, nil)
 	require.NoError(t, err)
-	// All 9 columns Default==true now; name-first (Decision #4).
-	assert.Equal(t, []string{"NAME", "POOL", "TYPE", "TOTAL", "FREE", "HEALTHY", "READY", "AGE", "STATUS"}, headers)
+	// All 10 columns Default==true now; name-first (Decision #4).
+	assert.Equal(t, []string{"NAME", "POOL", "TYPE", "TOTAL", "FREE", "PROFILES", "HEALTHY", "READY", "AGE", "STATUS"}, headers)
 	require.Len(t, rows, 2)
 	// Sorted keys → pool-a first; name-first column ordering
 	assert.Equal(t, "n1", rows[0][0])
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -26,7 +27,9 @@ func addTerminateCommand(rootCmd *cobra.Command, cfgFile *string) {
 		Long: `Submits a TerminateInstance request to OCI for the instance
 backing <node>. PreserveBootVolume=false (same default as the TUI's
 delete-node flow) — the boot volume is destroyed along with the
-instance. Fire-and-forget: the request returns once OCI accepts it.
+instance. Fire-and-forget: the request returns once OCI accepts it and
+prints the work request tracking the termination; follow it with
+` + "`toolkit wait workrequest <id>`" + `.
 
 Destructive: requires explicit --yes; the interactive prompt is
 deliberately disabled to prevent reflex "y" answers.
@@ -38,8 +41,9 @@ already know the instance OCID.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 			needsKube := ocid == ""
-			return withMutationSetup(cfgFile, needsKube, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
-				return runMutation(ctx, cmd.InOrStdin(), cmd.OutOrStdout(), mutationPlan{
+			var workRequest string
+			return withMutationSetup(cfgFile, "terminate", needsKube, false, true, func(ctx context.Context, cfg config.Config, env models.Environment) error {
+				err := runMutation(ctx, cmd.InOrStdin(), cmd.OutOrStdout(), mutationPlan{
 					Action:             "terminate",
 					Kind:               "node",
 					Target:             name,
@@ -52,8 +56,14 @@ already know the instance OCID.`,
 					if err != nil {
 						return err
 					}
-					return terminateInstanceFn(ctx, node, env, logging.FromContext(ctx))
+					workRequest, err = terminateInstanceFn(ctx, node, env, logging.FromContext(ctx))
+					return err
 				})
+				if err != nil || workRequest == "" {
+					return err
+				}
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "work request: %s\n  follow with: toolkit wait workrequest %s\n", workRequest, workRequest)
+				return err
 			})
 		},
 	}
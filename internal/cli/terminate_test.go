@@ -15,9 +15,9 @@ import (
 func TestTerminateCmd_DryRun_DoesNotCallOCI(t *testing.T) {
 	stageMutationEnv(t)
 	called := false
-	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) error {
+	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) (string, error) {
 		called = true
-		return nil
+		return "", nil
 	})()
 
 	// Note: dry-run must work without --yes — it only previews.
@@ -36,9 +36,9 @@ func TestTerminateCmd_DryRun_DoesNotCallOCI(t *testing.T) {
 func TestTerminateCmd_RequiresExplicitYes(t *testing.T) {
 	stageMutationEnv(t)
 	called := false
-	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) error {
+	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) (string, error) {
 		called = true
-		return nil
+		return "", nil
 	})()
 
 	_, err := runRootCmd(t, []string{"terminate", "node-a", "--ocid", "ocid1.instance.fake"}, "y\n") // typing y must NOT be enough
@@ -56,9 +56,9 @@ func TestTerminateCmd_RequiresExplicitYes(t *testing.T) {
 func TestTerminateCmd_YesCallsOCI(t *testing.T) {
 	stageMutationEnv(t)
 	var gotNode *models.GPUNode
-	defer swap(&terminateInstanceFn, func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) error {
+	defer swap(&terminateInstanceFn, func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) (string, error) {
 		gotNode = n
-		return nil
+		return "ocid1.workrequest.fake", nil
 	})()
 
 	out, err := runRootCmd(t, []string{"terminate", "node-a", "--ocid", "ocid1.instance.fake", "--yes"}, "")
@@ -71,6 +71,9 @@ func TestTerminateCmd_YesCallsOCI(t *testing.T) {
 	if !strings.Contains(out, "terminate node/node-a: OK") {
 		t.Errorf("expected OK, got: %q", out)
 	}
+	if !strings.Contains(out, "toolkit wait workrequest ocid1.workrequest.fake") {
+		t.Errorf("expected work request hint, got: %q", out)
+	}
 }
 
 func TestTerminateCmd_NameResolvesViaCluster(t *testing.T) {
@@ -79,9 +82,9 @@ func TestTerminateCmd_NameResolvesViaCluster(t *testing.T) {
 		return &models.GPUNode{Name: name, ID: "ocid1.resolved"}, nil
 	})()
 	var gotNode *models.GPUNode
-	defer swap(&terminateInstanceFn, func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) error {
+	defer swap(&terminateInstanceFn, func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) (string, error) {
 		gotNode = n
-		return nil
+		return "", nil
 	})()
 
 	if _, err := runRootCmd(t, []string{"terminate", "node-a", "--yes"}, ""); err != nil {
@@ -94,8 +97,8 @@ func TestTerminateCmd_NameResolvesViaCluster(t *testing.T) {
 
 func TestTerminateCmd_PerformError(t *testing.T) {
 	stageMutationEnv(t)
-	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) error {
-		return errors.New("instance already terminating")
+	defer swap(&terminateInstanceFn, func(context.Context, *models.GPUNode, models.Environment, logging.Logger) (string, error) {
+		return "", errors.New("instance already terminating")
 	})()
 
 	_, err := runRootCmd(t, []string{"terminate", "node-a", "--ocid", "ocid1.instance.fake", "--yes"}, "")
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/jingle2008/toolkit/internal/cli/output"
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// addTFCommand wires `toolkit tf`, diagnostics for the Terraform modules
+// toolkit reads pools and model artifacts from.
+func addTFCommand(rootCmd *cobra.Command, cfgFile *string) {
+	tfCmd := &cobra.Command{
+		Use:   "tf",
+		Short: "Inspect how toolkit evaluates Terraform modules",
+	}
+
+	var (
+		format     string
+		unresolved bool
+		varFiles   []string
+	)
+	localsCmd := &cobra.Command{
+		Use:   "locals <module-dir>",
+		Short: "Evaluate a module's locals the way the loaders do",
+		Long: `Evaluate the locals and outputs declared in <module-dir> with the same
+synthetic environment the GPU pool and model artifact loaders use:
+env-type/env-region/env-realm from the config, variable defaults,
+terraform.tfvars and *.auto.tfvars from the directory, then any
+--var-file in order.
+
+With --unresolved, list only the locals that did not resolve, with the
+HCL diagnostic and source range of each — the usual reason a pool or
+artifact is missing from ` + "`toolkit get`" + `.
+
+Examples:
+  toolkit tf locals ~/repo/shared_modules/instance_pools_config
+  toolkit tf locals ~/repo/shared_modules/instance_pools_config --unresolved
+  toolkit tf locals ./module --var-file prod.tfvars -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmtChoice, err := output.ParseFormat(format)
+			if err != nil {
+				return err
+			}
+			if isTableLike(fmtChoice) && fmtChoice != output.FormatTable {
+				return fmt.Errorf("-o %s is not supported by tf locals; use table, json, jsonl, or yaml", fmtChoice)
+			}
+			if err := readConfigFile(cfgFile); err != nil {
+				return err
+			}
+			var cfg config.Config
+			if err := viper.Unmarshal(&cfg); err != nil {
+				return fmt.Errorf("unmarshal config: %w", err)
+			}
+			logger, err := initLogger(cfg)
+			if err != nil {
+				return err
+			}
+			logger = logger.WithFields("cmd", "tf locals")
+			defer func() { _ = logger.Sync() }()
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx = logging.WithContext(ctx, logger)
+
+			env := models.Environment{Type: cfg.EnvType, Region: cfg.EnvRegion, Realm: cfg.EnvRealm}
+			locals, err := terraform.ResolveLocals(ctx, args[0], env, varFiles...)
+			if err != nil {
+				return fmt.Errorf("evaluate %s: %w", args[0], err)
+			}
+			opts := output.Options{Format: fmtChoice, Pretty: true}
+			if unresolved {
+				return writeUnresolvedLocals(cmd.OutOrStdout(), locals.Unresolved, opts)
+			}
+			return writeResolvedLocals(cmd.OutOrStdout(), locals, opts)
+		},
+	}
+	localsCmd.Flags().StringVarP(&format, "output", "o", "table", "table|json|jsonl|yaml")
+	localsCmd.Flags().BoolVar(&unresolved, "unresolved", false, "list only locals that did not resolve, with diagnostics")
+	localsCmd.Flags().StringArrayVar(&varFiles, "var-file", nil, "additional .tfvars file, applied after the auto-loaded ones (repeatable)")
+
+	tfCmd.AddCommand(localsCmd)
+	rootCmd.AddCommand(tfCmd)
+}
+
+// writeUnresolvedLocals prints one row per unresolved local. Encoded
+// formats emit the UnresolvedLocal records as-is.
+func writeUnresolvedLocals(w writer, items []terraform.UnresolvedLocal, opts output.Options) error {
+	if opts.Format != output.FormatTable {
+		return writeEncoded(w, opts, items)
+	}
+	if len(items) == 0 {
+		_, err := fmt.Fprintln(w, "All locals resolved.")
+		return err
+	}
+	rows := make([][]string, len(items))
+	for i, u := range items {
+		// Table cells are single-line; HCL diagnostics are not.
+		rows[i] = []string{u.Name, u.Location, strings.Join(strings.Fields(u.Diagnostic), " ")}
+	}
+	return output.WriteTable(w, []string{"LOCAL", "LOCATION", "DIAGNOSTIC"}, rows, opts)
+}
+
+// writeResolvedLocals prints every resolved local with its value as
+// compact JSON. Encoded formats emit a name → value object. The unresolved
+// count is noted in table output so a partial result isn't mistaken for a
+// complete one.
+func writeResolvedLocals(w writer, locals *terraform.Locals, opts output.Options) error {
+	names := slices.Sorted(maps.Keys(locals.Values))
+	values := make(map[string]json.RawMessage, len(names))
+	for _, name := range names {
+		v := locals.Values[name]
+		raw, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			return fmt.Errorf("encode local %s: %w", name, err)
+		}
+		values[name] = raw
+	}
+
+	if opts.Format != output.FormatTable {
+		decoded := make(map[string]any, len(values))
+		for name, raw := range values {
+			var v any
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return fmt.Errorf("decode local %s: %w", name, err)
+			}
+			decoded[name] = v
+		}
+		return writeEncoded(w, opts, decoded)
+	}
+
+	rows := make([][]string, len(names))
+	for i, name := range names {
+		rows[i] = []string{name, string(values[name])}
+	}
+	if err := output.WriteTable(w, []string{"LOCAL", "VALUE"}, rows, opts); err != nil {
+		return err
+	}
+	if n := len(locals.Unresolved); n > 0 {
+		_, err := fmt.Fprintf(w, "\n%d local(s) unresolved; rerun with --unresolved for diagnostics.\n", n)
+		return err
+	}
+	return nil
+}
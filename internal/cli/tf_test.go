@@ -0,0 +1,85 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func stageTFModule(t *testing.T) string {
+	t.Helper()
+	stageMutationEnv(t)
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.tf": `
+variable "pool_size" {
+  default = 1
+}
+locals {
+  region_name = var.region
+  pools       = { gpu = { size = var.pool_size } }
+  broken      = local.does_not_exist
+}
+`,
+		"terraform.tfvars": `pool_size = 4`,
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestTFLocals_Resolved(t *testing.T) {
+	dir := stageTFModule(t)
+	out, err := runRootCmd(t, []string{"tf", "locals", dir}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	for _, want := range []string{`"us-ashburn-1"`, `{"gpu":{"size":4}}`, "1 local(s) unresolved"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTFLocals_Unresolved(t *testing.T) {
+	dir := stageTFModule(t)
+	out, err := runRootCmd(t, []string{"tf", "locals", dir, "--unresolved"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	if !strings.Contains(out, "broken") || !strings.Contains(out, "main.tf:8,") || !strings.Contains(out, "Unsupported attribute") {
+		t.Errorf("expected diagnostic row for broken, got:\n%s", out)
+	}
+	if strings.Contains(out, "region_name") {
+		t.Errorf("resolved local listed as unresolved:\n%s", out)
+	}
+
+	out, err = runRootCmd(t, []string{"tf", "locals", dir, "--unresolved", "-o", "json"}, "")
+	if err != nil {
+		t.Fatalf("execute: %v", err)
+	}
+	var items []map[string]string
+	if err := json.Unmarshal([]byte(out), &items); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(items) != 1 || items[0]["name"] != "broken" || items[0]["diagnostic"] == "" {
+		t.Errorf("unexpected items: %+v", items)
+	}
+}
+
+func TestTFLocals_Errors(t *testing.T) {
+	dir := stageTFModule(t)
+	if _, err := runRootCmd(t, []string{"tf", "locals", dir, "-o", "csv"}, ""); err == nil ||
+		!strings.Contains(err.Error(), "not supported") {
+		t.Errorf("want csv rejection, got %v", err)
+	}
+	if _, err := runRootCmd(t, []string{"tf", "locals", filepath.Join(dir, "missing")}, ""); err == nil {
+		t.Error("want error for missing module dir")
+	}
+}
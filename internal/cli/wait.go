@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jingle2008/toolkit/internal/config"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/ui/tui/actions"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var (
+	// getWorkRequestFn is the seam tests use to fake the OCI call.
+	getWorkRequestFn = actions.GetWorkRequest
+	// waitPollInterval is how often `toolkit wait` polls; tests shorten it.
+	waitPollInterval = 10 * time.Second
+)
+
+// addWaitCommand wires `toolkit wait workrequest`.
+func addWaitCommand(rootCmd *cobra.Command, cfgFile *string) {
+	waitCmd := &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for an asynchronous operation to finish",
+	}
+
+	var timeout time.Duration
+	wrCmd := &cobra.Command{
+		Use:     "workrequest <id>",
+		Aliases: []string{"wr"},
+		Short:   "Wait for an OCI work request to finish",
+		Long: `Polls the work request <id> — a Core Services (compute) or
+GenerativeAI work request OCID, as printed by toolkit terminate or listed
+by toolkit get workrequest — printing a line whenever its status or
+percent complete changes, until it finishes.
+
+Exits non-zero when the work request FAILED or was CANCELED (its error
+messages are included), when it cannot be read at all (not found, not
+authorized), or when --timeout elapses first.
+
+Examples:
+  toolkit wait workrequest ocid1.coreservicesworkrequest.oc1.iad.xxx
+  toolkit wait wr ocid1.generativeaiworkrequest.oc1.iad.xxx --timeout 1h`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+			return withMutationSetup(cfgFile, "wait workrequest", false, false, true, func(ctx context.Context, _ config.Config, env models.Environment) error {
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+				out := cmd.OutOrStdout()
+				wr, err := oci.WaitWorkRequest(ctx, func(ctx context.Context) (models.WorkRequest, error) {
+					return getWorkRequestFn(ctx, id, env)
+				}, waitPollInterval, func(wr models.WorkRequest) {
+					_, _ = fmt.Fprintf(out, "%s %s %.0f%%\n", wr.Operation, wr.Status, wr.PercentComplete)
+				})
+				switch {
+				case err != nil && wr.IsDone():
+					return err // already names the work request and its errors
+				case err != nil:
+					return fmt.Errorf("wait for work request %s: %w", id, err)
+				}
+				_, err = fmt.Fprintf(out, "work request %s: %s\n", wr.ID, wr.Status)
+				return err
+			})
+		},
+	}
+	wrCmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "Give up after this long")
+	waitCmd.AddCommand(wrCmd)
+	rootCmd.AddCommand(waitCmd)
+}
@@ -0,0 +1,100 @@
+//nolint:paralleltest // NewRootCmd uses cobra global state and viper singleton
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// scriptedWorkRequest returns a getWorkRequestFn that replays states,
+// repeating the last one, and records the id and env it was asked for.
+func scriptedWorkRequest(gotID *string, gotEnv *models.Environment, states ...models.WorkRequest) func(context.Context, string, models.Environment) (models.WorkRequest, error) {
+	i := 0
+	return func(_ context.Context, id string, env models.Environment) (models.WorkRequest, error) {
+		*gotID, *gotEnv = id, env
+		wr := states[min(i, len(states)-1)]
+		i++
+		return wr, nil
+	}
+}
+
+func TestWaitWorkRequest_Succeeds(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&waitPollInterval, time.Millisecond)()
+	var (
+		gotID  string
+		gotEnv models.Environment
+	)
+	defer swap(&getWorkRequestFn, scriptedWorkRequest(&gotID, &gotEnv,
+		models.WorkRequest{ID: "ocid1.wr", Operation: "TerminateInstance", Status: "IN_PROGRESS", PercentComplete: 40},
+		models.WorkRequest{ID: "ocid1.wr", Operation: "TerminateInstance", Status: "SUCCEEDED", PercentComplete: 100},
+	))()
+
+	out, err := runRootCmd(t, []string{"wait", "workrequest", "ocid1.wr"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "ocid1.wr", gotID)
+	assert.Equal(t, models.Environment{Type: "dev", Region: "us-ashburn-1", Realm: "oc1"}, gotEnv)
+	assert.Contains(t, out, "TerminateInstance IN_PROGRESS 40%")
+	assert.Contains(t, out, "TerminateInstance SUCCEEDED 100%")
+	assert.Contains(t, out, "work request ocid1.wr: SUCCEEDED")
+}
+
+func TestWaitWorkRequest_Failed(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&waitPollInterval, time.Millisecond)()
+	var (
+		gotID  string
+		gotEnv models.Environment
+	)
+	defer swap(&getWorkRequestFn, scriptedWorkRequest(&gotID, &gotEnv,
+		models.WorkRequest{ID: "ocid1.wr", Status: "FAILED", Errors: []string{"instance is protected"}},
+	))()
+
+	_, err := runRootCmd(t, []string{"wait", "wr", "ocid1.wr"}, "")
+	require.ErrorContains(t, err, "work request ocid1.wr FAILED: instance is protected")
+}
+
+func TestWaitWorkRequest_Timeout(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&waitPollInterval, time.Millisecond)()
+	var (
+		gotID  string
+		gotEnv models.Environment
+	)
+	defer swap(&getWorkRequestFn, scriptedWorkRequest(&gotID, &gotEnv,
+		models.WorkRequest{ID: "ocid1.wr", Status: "IN_PROGRESS"},
+	))()
+
+	_, err := runRootCmd(t, []string{"wait", "workrequest", "ocid1.wr", "--timeout", "20ms"}, "")
+	require.ErrorContains(t, err, "wait for work request ocid1.wr")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitWorkRequest_RequiresEnv(t *testing.T) {
+	stageMutationEnv(t)
+	t.Setenv("TOOLKIT_ENV_REGION", "")
+	_, err := runRootCmd(t, []string{"wait", "workrequest", "ocid1.wr"}, "")
+	require.ErrorContains(t, err, "--env-region")
+}
+
+func TestWaitWorkRequest_PermanentErrorFailsFast(t *testing.T) {
+	stageMutationEnv(t)
+	defer swap(&waitPollInterval, time.Millisecond)()
+	polls := 0
+	defer swap(&getWorkRequestFn, func(context.Context, string, models.Environment) (models.WorkRequest, error) {
+		polls++
+		return models.WorkRequest{}, oci.Permanent(errors.New("NotAuthorizedOrNotFound"))
+	})()
+
+	_, err := runRootCmd(t, []string{"wait", "wr", "ocid1.typo"}, "")
+	require.ErrorContains(t, err, "wait for work request ocid1.typo: NotAuthorizedOrNotFound")
+	assert.Equal(t, 1, polls)
+}
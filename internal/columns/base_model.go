@@ -55,4 +55,5 @@ var BaseModelColumns = Set[models.BaseModel]{Columns: []Column[models.BaseModel]
 		Title: "Status", Key: "status", Ratio: 0.07,
 		Render: func(m models.BaseModel) string { return m.Status },
 	},
+	clusterColumn[models.BaseModel](),
 }}
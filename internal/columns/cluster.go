@@ -0,0 +1,74 @@
+package columns
+
+import (
+	"sync/atomic"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// ClusterKey is the key of the cluster column every k8s-backed category
+// carries.
+const ClusterKey = "cluster"
+
+// multiClusterRatio is the share of the table width a MultiCluster
+// column takes when shown; the other columns are narrowed to make room.
+const multiClusterRatio = 0.1
+
+// multiCluster is whether the environment being rendered spans several
+// kube contexts; see SetMultiCluster.
+var multiCluster atomic.Bool
+
+// SetMultiCluster turns the MultiCluster columns on or off in Defaults.
+// The CLI and the TUI set it from the number of kube contexts configured
+// for the environment they show, so the Cluster column tells rows apart
+// exactly when they can come from more than one cluster.
+func SetMultiCluster(on bool) {
+	multiCluster.Store(on)
+}
+
+// MultiCluster reports whether SetMultiCluster is on.
+func MultiCluster() bool {
+	return multiCluster.Load()
+}
+
+// shareRatio gives each MultiCluster column of cols multiClusterRatio of
+// the width and scales the others down so the ratios still add up.
+// ratio returns a column's Ratio field and whether it is MultiCluster.
+func shareRatio[C any](cols []C, ratio func(*C) (*float64, bool)) {
+	shown := 0
+	for i := range cols {
+		if _, multi := ratio(&cols[i]); multi {
+			shown++
+		}
+	}
+	if shown == 0 {
+		return
+	}
+	scale := 1 - float64(shown)*multiClusterRatio
+	for i := range cols {
+		r, multi := ratio(&cols[i])
+		if multi {
+			*r = multiClusterRatio
+		} else {
+			*r *= scale
+		}
+	}
+}
+
+// clusterColumn is the MultiCluster "cluster" column: the kube context
+// the item was loaded from, which tells rows apart when an environment
+// spans several clusters.
+func clusterColumn[T models.Clustered]() Column[T] {
+	return Column[T]{
+		Title: "Cluster", Key: ClusterKey, MultiCluster: true,
+		Render: func(item T) string { return item.GetCluster() },
+	}
+}
+
+// groupedClusterColumn is clusterColumn for grouped categories.
+func groupedClusterColumn[T models.Clustered]() GroupedColumn[T] {
+	return GroupedColumn[T]{
+		Title: "Cluster", Key: ClusterKey, MultiCluster: true,
+		Render: func(_ string, item T) string { return item.GetCluster() },
+	}
+}
@@ -0,0 +1,82 @@
+package columns
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// Every category read from the cluster offers the opt-in cluster column;
+// Endpoint and WorkRequest are listed from OCI, so their rows have no
+// kube context.
+func TestClusterColumn_ClusterCategories(t *testing.T) {
+	t.Parallel()
+	for _, cat := range domain.Categories {
+		if !cat.NeedsKubeConfig() || cat == domain.Endpoint || cat == domain.WorkRequest {
+			continue
+		}
+		if !slices.Contains(KeysFor(cat), ClusterKey) {
+			t.Errorf("%s: no %q column", cat, ClusterKey)
+		}
+	}
+}
+
+func TestClusterColumn_Renders(t *testing.T) {
+	t.Parallel()
+	nodes := map[string][]models.GPUNode{"pool-a": {{Name: "n1", Cluster: "dp-prod-iad-b"}}}
+
+	headers, _, err := RenderTable(domain.GPUNode, nodes, nil)
+	if err != nil {
+		t.Fatalf("RenderTable: %v", err)
+	}
+	if slices.Contains(headers, "CLUSTER") {
+		t.Errorf("default headers include CLUSTER: %v", headers)
+	}
+
+	_, rows, err := RenderTable(domain.GPUNode, nodes, []string{"name", "cluster"})
+	if err != nil {
+		t.Fatalf("RenderTable selected: %v", err)
+	}
+	if want := [][]string{{"n1", "dp-prod-iad-b"}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows: got %v, want %v", rows, want)
+	}
+}
+
+//nolint:paralleltest // toggles the package-level multi-cluster switch
+func TestClusterColumn_MultiCluster(t *testing.T) {
+	SetMultiCluster(true)
+	defer SetMultiCluster(false)
+	nodes := map[string][]models.GPUNode{"pool-a": {{Name: "n1", Cluster: "dp-prod-iad-b"}}}
+
+	headers, rows, err := RenderTable(domain.GPUNode, nodes, nil)
+	if err != nil {
+		t.Fatalf("RenderTable: %v", err)
+	}
+	i := slices.Index(headers, "CLUSTER")
+	if i < 0 {
+		t.Fatalf("default headers lack CLUSTER: %v", headers)
+	}
+	if rows[0][i] != "dp-prod-iad-b" {
+		t.Errorf("cluster cell: got %q", rows[0][i])
+	}
+
+	defaults := GPUNodeColumns.Defaults()
+	var sum float64
+	for _, c := range defaults {
+		sum += c.Ratio
+	}
+	if sum < 0.98 || sum > 1.02 {
+		t.Errorf("multi-cluster ratio sum = %v, want ~1", sum)
+	}
+	if c := defaults[len(defaults)-1]; c.Key != ClusterKey || c.Ratio != multiClusterRatio {
+		t.Errorf("cluster column: got %q ratio %v", c.Key, c.Ratio)
+	}
+
+	_, help := HelpTable(domain.GPUNode)
+	if last := help[len(help)-1]; last[1] != "Cluster (with several kube contexts or --columns)" {
+		t.Errorf("help row: got %v", last)
+	}
+}
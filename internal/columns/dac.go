@@ -75,4 +75,5 @@ var DACColumns = GroupedSet[models.DedicatedAICluster]{Columns: []GroupedColumn[
 		Title: "Status", Key: "status", Ratio: 0.06,
 		Render: func(_ string, d models.DedicatedAICluster) string { return d.Status },
 	},
+	groupedClusterColumn[models.DedicatedAICluster](),
 }}
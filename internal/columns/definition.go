@@ -19,6 +19,7 @@ func DefinitionColumns[T models.Definition]() Set[T] {
 			Title: "Value", Key: "value", Ratio: 0.12,
 			Render: func(d T) string { return d.GetValue() },
 		},
+		sourceColumn[T](),
 	}}
 }
 
@@ -0,0 +1,50 @@
+package columns
+
+import (
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// EndpointColumns is the canonical column set for domain.Endpoint.
+// 7 columns, ratios sum to 1.00. DAC is the group key and MUST stay at
+// index 1: itemKeyFrom/parentScope derive the scoped key and parent
+// (DedicatedAICluster) from row[1] for grouped categories. Name, DAC and
+// Model show OCID suffixes; export renders the full OCIDs.
+var EndpointColumns = GroupedSet[models.Endpoint]{Columns: []GroupedColumn[models.Endpoint]{
+	{
+		Title: "Name", Key: "name", Ratio: 0.20, TruncateMiddle: true,
+		Render: func(_ string, e models.Endpoint) string { return e.Name },
+		RenderForExport: func(realm, region string, _ string, e models.Endpoint) string {
+			return e.OCID(realm, region)
+		},
+	},
+	{
+		Title: "DAC", Key: "dac", Ratio: 0.20, TruncateMiddle: true,
+		Render: func(k string, _ models.Endpoint) string { return k },
+		RenderForExport: func(realm, region string, _ string, e models.Endpoint) string {
+			return e.DedicatedAIClusterOCID(realm, region)
+		},
+	},
+	{
+		Title: "Display Name", Key: "display-name", Ratio: 0.16, TruncateMiddle: true,
+		Render: func(_ string, e models.Endpoint) string { return e.DisplayName },
+	},
+	{
+		Title: "Model", Key: "model", Ratio: 0.20, TruncateMiddle: true,
+		Render: func(_ string, e models.Endpoint) string { return e.ModelName() },
+		RenderForExport: func(_, _ string, _ string, e models.Endpoint) string {
+			return e.ModelID
+		},
+	},
+	{
+		Title: "State", Key: "state", Ratio: 0.10,
+		Render: func(_ string, e models.Endpoint) string { return e.LifecycleState },
+	},
+	{
+		Title: "Moderation", Key: "moderation", Ratio: 0.08,
+		Render: func(_ string, e models.Endpoint) string { return e.ContentModeration },
+	},
+	{
+		Title: "Age", Key: "age", Ratio: 0.06,
+		Render: func(_ string, e models.Endpoint) string { return e.Age },
+	},
+}}
@@ -0,0 +1,50 @@
+package columns
+
+import (
+	"testing"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestEndpointColumns(t *testing.T) {
+	t.Parallel()
+	// DAC MUST be at index 1 (grouped key invariant).
+	if EndpointColumns.Columns[1].Title != "DAC" {
+		t.Fatalf("col[1] = %q, want DAC", EndpointColumns.Columns[1].Title)
+	}
+	e := models.Endpoint{
+		Name: "amaaaaaaep1", DisplayName: "chat-prod", DedicatedAICluster: "amaaaaaadac1",
+		ModelID:        "ocid1.generativeaimodel.oc1.us-chicago-1.amaaaaaamodel1",
+		LifecycleState: "ACTIVE", ContentModeration: "BLOCK", Age: "2d",
+	}
+	got := map[string]string{}
+	exported := map[string]string{}
+	for _, c := range EndpointColumns.Columns {
+		got[c.Key] = c.Render("amaaaaaadac1", e)
+		if c.RenderForExport != nil {
+			exported[c.Key] = c.RenderForExport("oc1", "us-chicago-1", "amaaaaaadac1", e)
+		}
+	}
+	want := map[string]string{
+		"name": "amaaaaaaep1", "dac": "amaaaaaadac1", "display-name": "chat-prod",
+		"model": "amaaaaaamodel1", "state": "ACTIVE", "moderation": "BLOCK", "age": "2d",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("col %s = %q, want %q", k, got[k], v)
+		}
+	}
+	wantExport := map[string]string{
+		"name":  "ocid1.generativeaiendpoint.oc1.us-chicago-1.amaaaaaaep1",
+		"dac":   "ocid1.generativeaidedicatedaicluster.oc1.us-chicago-1.amaaaaaadac1",
+		"model": e.ModelID,
+	}
+	for k, v := range wantExport {
+		if exported[k] != v {
+			t.Errorf("export col %s = %q, want %q", k, exported[k], v)
+		}
+	}
+	if s := EndpointColumns.RatioSum(); s < 0.98 || s > 1.02 {
+		t.Errorf("ratio sum %.3f", s)
+	}
+}
@@ -0,0 +1,52 @@
+package columns
+
+import (
+	"strconv"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// EventColumns is the canonical column set for domain.Event.
+// 9 columns, ratios sum to 1.00. Object (the involved node's name or the
+// pod's namespace/name, see models.EventObjectKey) is the group key and MUST stay at index 1: itemKeyFrom derives the
+// scoped key from row[1] for grouped categories. Message gets the bulk
+// of the width; the full text is in the detail view.
+var EventColumns = GroupedSet[models.Event]{Columns: []GroupedColumn[models.Event]{
+	{
+		Title: "Name", Key: "name", Ratio: 0.14, TruncateMiddle: true,
+		Render: func(_ string, e models.Event) string { return e.Name },
+	},
+	{
+		Title: "Object", Key: "object", Ratio: 0.14, TruncateMiddle: true,
+		Render: func(k string, _ models.Event) string { return k },
+	},
+	{
+		Title: "Kind", Key: "kind", Ratio: 0.05,
+		Render: func(_ string, e models.Event) string { return e.ObjectKind },
+	},
+	{
+		Title: "Type", Key: "type", Ratio: 0.07,
+		Render: func(_ string, e models.Event) string { return e.Type },
+	},
+	{
+		Title: "Reason", Key: "reason", Ratio: 0.12,
+		Render: func(_ string, e models.Event) string { return e.Reason },
+	},
+	{
+		Title: "Count", Key: "count", Ratio: 0.05,
+		Render: func(_ string, e models.Event) string { return strconv.Itoa(e.Count) },
+	},
+	{
+		Title: "First Seen", Key: "first-seen", Ratio: 0.07,
+		Render: func(_ string, e models.Event) string { return e.FirstSeen },
+	},
+	{
+		Title: "Last Seen", Key: "last-seen", Ratio: 0.07,
+		Render: func(_ string, e models.Event) string { return e.LastSeen },
+	},
+	{
+		Title: "Message", Key: "message", Ratio: 0.29,
+		Render: func(_ string, e models.Event) string { return e.Message },
+	},
+	groupedClusterColumn[models.Event](),
+}}
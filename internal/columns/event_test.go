@@ -0,0 +1,37 @@
+package columns
+
+import (
+	"testing"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestEventColumns(t *testing.T) {
+	t.Parallel()
+	// Object MUST be at index 1 (grouped key invariant).
+	if EventColumns.Columns[1].Title != "Object" {
+		t.Fatalf("col[1] = %q, want Object", EventColumns.Columns[1].Title)
+	}
+	e := models.Event{
+		Name: "gpu-1.17a", ObjectKind: models.EventObjectNode, Object: "gpu-1",
+		Type: models.EventTypeWarning, Reason: "Unhealthy", Count: 3,
+		FirstSeen: "2h", LastSeen: "5m", Message: "GPU fell off the bus",
+	}
+	got := map[string]string{}
+	for _, c := range EventColumns.Columns {
+		got[c.Key] = c.Render("gpu-1", e)
+	}
+	want := map[string]string{
+		"name": "gpu-1.17a", "object": "gpu-1", "kind": "Node", "type": "Warning",
+		"reason": "Unhealthy", "count": "3", "first-seen": "2h", "last-seen": "5m",
+		"message": "GPU fell off the bus",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("col %s = %q, want %q", k, got[k], v)
+		}
+	}
+	if s := EventColumns.RatioSum(); s < 0.98 || s > 1.02 {
+		t.Errorf("ratio sum %.3f", s)
+	}
+}
@@ -14,7 +14,7 @@ var GPUNodeColumns = GroupedSet[models.GPUNode]{Columns: []GroupedColumn[models.
 		Render: func(_ string, n models.GPUNode) string { return n.Name },
 	},
 	{
-		Title: "Pool", Key: "pool", Ratio: 0.22,
+		Title: "Pool", Key: "pool", Ratio: 0.16,
 		Render: func(k string, _ models.GPUNode) string { return k },
 	},
 	{
@@ -29,6 +29,10 @@ var GPUNodeColumns = GroupedSet[models.GPUNode]{Columns: []GroupedColumn[models.
 		Title: "Free", Key: "free", Ratio: 0.06,
 		Render: func(_ string, n models.GPUNode) string { return strconv.Itoa(n.Allocatable - n.Allocated) },
 	},
+	{
+		Title: "Profiles", Key: "profiles", Ratio: 0.12,
+		Render: func(_ string, n models.GPUNode) string { return n.Profiles() },
+	},
 	{
 		Title: "Healthy", Key: "healthy", Ratio: 0.06,
 		Render: func(_ string, n models.GPUNode) string { return strconv.FormatBool(n.IsHealthy()) },
@@ -42,7 +46,8 @@ var GPUNodeColumns = GroupedSet[models.GPUNode]{Columns: []GroupedColumn[models.
 		Render: func(_ string, n models.GPUNode) string { return n.Age },
 	},
 	{
-		Title: "Status", Key: "status", Ratio: 0.18,
+		Title: "Status", Key: "status", Ratio: 0.12,
 		Render: func(_ string, n models.GPUNode) string { return n.GetStatus() },
 	},
+	groupedClusterColumn[models.GPUNode](),
 }}
@@ -16,6 +16,10 @@ func TestGPUNodeColumns(t *testing.T) {
 		Allocated:    3,
 		IsReady:      true,
 		Age:          "1d",
+		Resources: []models.GPUResource{
+			{Name: "nvidia.com/gpu", Allocatable: 1},
+			{Name: "nvidia.com/mig-1g.10gb", Allocatable: 7, Allocated: 3},
+		},
 	}
 
 	got := map[string]string{}
@@ -24,15 +28,16 @@ func TestGPUNodeColumns(t *testing.T) {
 	}
 
 	want := map[string]string{
-		"name":    "node-1",
-		"pool":    "pool-A",
-		"type":    "BM.GPU4.8",
-		"total":   "8",
-		"free":    "5",
-		"healthy": "true",
-		"ready":   "true",
-		"age":     "1d",
-		"status":  n.GetStatus(),
+		"name":     "node-1",
+		"pool":     "pool-A",
+		"type":     "BM.GPU4.8",
+		"total":    "8",
+		"free":     "5",
+		"profiles": "mig-1g.10gb 4/7",
+		"healthy":  "true",
+		"ready":    "true",
+		"age":      "1d",
+		"status":   n.GetStatus(),
 	}
 	for k, v := range want {
 		if got[k] != v {
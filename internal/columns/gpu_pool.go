@@ -45,4 +45,5 @@ var GPUPoolColumns = Set[models.GPUPool]{Columns: []Column[models.GPUPool]{
 		Title: "Status", Key: "status", Ratio: 0.10,
 		Render: func(p models.GPUPool) string { return p.Status },
 	},
+	sourceColumn[models.GPUPool](),
 }}
@@ -16,6 +16,12 @@ func TestGPUWorkloadColumns(t *testing.T) {
 		Name: "p1", Node: "node-a", TenantID: "suffix1", Namespace: "ns1",
 		Model: "gpt", Runtime: "vllm", GPUs: 2, Restarts: 4, Age: "3d", Mode: "RawDeployment",
 		Owner: &models.Tenant{Name: "acme"},
+		Containers: []models.ContainerState{{
+			Name: "main", Restarts: 4, State: models.ContainerStateCrashLoop,
+			LastTerminationReason: "OOMKilled", LastExitCode: 137,
+		}},
+		CrashLoop: true,
+		Resources: []models.GPURequest{{Name: "nvidia.com/mig-3g.40gb", Count: 2}},
 	}
 	got := map[string]string{}
 	for _, c := range GPUWorkloadColumns.Columns {
@@ -24,6 +30,7 @@ func TestGPUWorkloadColumns(t *testing.T) {
 	want := map[string]string{
 		"name": "p1", "node": "node-a", "tenant": "acme", "namespace": "ns1",
 		"model": "gpt", "runtime": "vllm", "gpus": "2", "restarts": "4", "age": "3d",
+		"ready": "0/1", "profiles": "mig-3g.40gb x2", "reason": "CrashLoopBackOff: OOMKilled (exit 137)",
 	}
 	for k, v := range want {
 		if got[k] != v {
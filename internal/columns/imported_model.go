@@ -55,4 +55,5 @@ var ImportedModelColumns = GroupedSet[models.ImportedModel]{Columns: []GroupedCo
 		Title: "Status", Key: "status", Ratio: 0.05,
 		Render: func(_ string, m models.ImportedModel) string { return m.Status },
 	},
+	groupedClusterColumn[models.ImportedModel](),
 }}
@@ -27,4 +27,5 @@ var LimitDefinitionColumns = Set[models.LimitDefinition]{Columns: []Column[model
 		Title: "Max", Key: "max", Ratio: 0.06,
 		Render: func(d models.LimitDefinition) string { return d.DefaultMax },
 	},
+	sourceColumn[models.LimitDefinition](),
 }}
@@ -27,6 +27,7 @@ var LimitRegionalOverrideColumns = Set[models.LimitRegionalOverride]{Columns: []
 		Title: "Max", Key: "max", Ratio: 0.15,
 		Render: func(o models.LimitRegionalOverride) string { return limitOverrideMax(o.Values) },
 	},
+	sourceColumn[models.LimitRegionalOverride](),
 }}
 
 // limitOverrideMin returns Values[0].Min as a string, or "" when
@@ -30,4 +30,5 @@ var LimitTenancyOverrideColumns = GroupedSet[models.LimitTenancyOverride]{Column
 		Title: "Max", Key: "max", Ratio: 0.08,
 		Render: func(_ string, v models.LimitTenancyOverride) string { return limitOverrideMax(v.Values) },
 	},
+	groupedSourceColumn[models.LimitTenancyOverride](),
 }}
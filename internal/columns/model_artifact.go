@@ -25,4 +25,5 @@ var ModelArtifactColumns = GroupedSet[models.ModelArtifact]{Columns: []GroupedCo
 		Title: "TensorRT", Key: "tensorrt", Ratio: 0.10,
 		Render: func(_ string, a models.ModelArtifact) string { return a.TensorRTVersion },
 	},
+	groupedSourceColumn[models.ModelArtifact](),
 }}
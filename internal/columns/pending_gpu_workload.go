@@ -0,0 +1,47 @@
+package columns
+
+import (
+	"strconv"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// PendingGPUWorkloadColumns is the canonical column set for
+// domain.PendingGPUWorkload. 8 columns, ratios sum to 1.00. Reason and
+// Hint get the most room: they are why the category exists. Namespace
+// MUST stay at index 1: itemKeyFrom keys rows by {Namespace, Name}.
+var PendingGPUWorkloadColumns = Set[models.PendingGPUWorkload]{Columns: []Column[models.PendingGPUWorkload]{
+	{
+		Title: "Name", Key: "name", Ratio: 0.15, TruncateMiddle: true,
+		Render: func(w models.PendingGPUWorkload) string { return w.Name },
+	},
+	{
+		Title: "Namespace", Key: "namespace", Ratio: 0.10, TruncateMiddle: true,
+		Render: func(w models.PendingGPUWorkload) string { return w.Namespace },
+	},
+	{
+		Title: "Tenant", Key: "tenant", Ratio: 0.10, TruncateMiddle: true,
+		Render: func(w models.PendingGPUWorkload) string { return w.TenantID },
+	},
+	{
+		Title: "Model", Key: "model", Ratio: 0.10,
+		Render: func(w models.PendingGPUWorkload) string { return w.Model },
+	},
+	{
+		Title: "GPUs", Key: "gpus", Ratio: 0.05,
+		Render: func(w models.PendingGPUWorkload) string { return strconv.Itoa(w.GPUs) },
+	},
+	{
+		Title: "Age", Key: "age", Ratio: 0.05,
+		Render: func(w models.PendingGPUWorkload) string { return w.Age },
+	},
+	{
+		Title: "Reason", Key: "reason", Ratio: 0.27,
+		Render: func(w models.PendingGPUWorkload) string { return w.Reason },
+	},
+	{
+		Title: "Hint", Key: "hint", Ratio: 0.18,
+		Render: func(w models.PendingGPUWorkload) string { return w.Hint },
+	},
+	clusterColumn[models.PendingGPUWorkload](),
+}}
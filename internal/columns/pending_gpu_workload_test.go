@@ -0,0 +1,31 @@
+package columns
+
+import (
+	"testing"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestPendingGPUWorkloadColumns(t *testing.T) {
+	t.Parallel()
+	w := models.PendingGPUWorkload{
+		Name: "serv-0", Namespace: "ns1", TenantID: "t1", Model: "llama", GPUs: 8, Age: "5m",
+		Reason: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", Hint: "pool-a (1 node)",
+	}
+	got := map[string]string{}
+	for _, c := range PendingGPUWorkloadColumns.Columns {
+		got[c.Key] = c.Render(w)
+	}
+	want := map[string]string{
+		"name": "serv-0", "namespace": "ns1", "tenant": "t1", "model": "llama", "gpus": "8", "age": "5m",
+		"reason": "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", "hint": "pool-a (1 node)",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("col %s = %q, want %q", k, got[k], v)
+		}
+	}
+	if s := PendingGPUWorkloadColumns.RatioSum(); s < 0.98 || s > 1.02 {
+		t.Errorf("ratio sum %.3f", s)
+	}
+}
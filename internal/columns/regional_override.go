@@ -23,6 +23,7 @@ func RegionalOverrideColumns[T models.DefinitionOverride]() Set[T] {
 			Title: "Value", Key: "value", Ratio: 0.20,
 			Render: func(o T) string { return o.GetValue() },
 		},
+		sourceColumn[T](),
 	}}
 }
 
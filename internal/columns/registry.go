@@ -16,6 +16,8 @@ import (
 type registryEntry struct {
 	keys            []string
 	titles          []string
+	optional        map[string]bool
+	multiCluster    map[string]bool
 	ratioSum        float64
 	render          func(items any, selected []string) ([]string, [][]string, error)
 	renderForExport func(items any, realm, region string, selected []string) ([]string, [][]string, error)
@@ -26,9 +28,11 @@ type registryEntry struct {
 // closures, so a column reorder lands in every consumer at once.
 func newFlatEntry[T any](s Set[T]) registryEntry {
 	return registryEntry{
-		keys:     s.Keys(),
-		titles:   s.Titles(),
-		ratioSum: s.RatioSum(),
+		keys:         s.Keys(),
+		titles:       s.Titles(),
+		optional:     optionalKeys(s.Columns, func(c Column[T]) (string, bool) { return c.Key, c.Optional }),
+		multiCluster: optionalKeys(s.Columns, func(c Column[T]) (string, bool) { return c.Key, c.MultiCluster }),
+		ratioSum:     s.RatioSum(),
 		render: func(items any, selected []string) ([]string, [][]string, error) {
 			return renderFlat(s, items, selected)
 		},
@@ -41,9 +45,11 @@ func newFlatEntry[T any](s Set[T]) registryEntry {
 // newGroupedEntry is the grouped counterpart to newFlatEntry.
 func newGroupedEntry[T any](g GroupedSet[T]) registryEntry {
 	return registryEntry{
-		keys:     g.Keys(),
-		titles:   g.Titles(),
-		ratioSum: g.RatioSum(),
+		keys:         g.Keys(),
+		titles:       g.Titles(),
+		optional:     optionalKeys(g.Columns, func(c GroupedColumn[T]) (string, bool) { return c.Key, c.Optional }),
+		multiCluster: optionalKeys(g.Columns, func(c GroupedColumn[T]) (string, bool) { return c.Key, c.MultiCluster }),
+		ratioSum:     g.RatioSum(),
 		render: func(items any, selected []string) ([]string, [][]string, error) {
 			return renderGrouped(g, items, selected)
 		},
@@ -53,6 +59,18 @@ func newGroupedEntry[T any](g GroupedSet[T]) registryEntry {
 	}
 }
 
+// optionalKeys collects the keys of the columns in cols that keyOf
+// flags (Optional or MultiCluster).
+func optionalKeys[C any](cols []C, keyOf func(C) (string, bool)) map[string]bool {
+	out := make(map[string]bool)
+	for _, c := range cols {
+		if k, optional := keyOf(c); optional {
+			out[k] = true
+		}
+	}
+	return out
+}
+
 // registry is the single per-category dispatch table the public
 // functions in this file consume. Adding a new list-view category
 // requires exactly one entry here; missing entries surface as
@@ -66,6 +84,8 @@ var registry = map[domain.Category]registryEntry{
 	domain.LimitRegionalOverride:           newFlatEntry(LimitRegionalOverrideColumns),
 	domain.BaseModel:                       newFlatEntry(BaseModelColumns),
 	domain.GPUPool:                         newFlatEntry(GPUPoolColumns),
+	domain.PendingGPUWorkload:              newFlatEntry(PendingGPUWorkloadColumns),
+	domain.WorkRequest:                     newFlatEntry(WorkRequestColumns),
 	domain.ConsolePropertyDefinition:       newFlatEntry(ConsolePropertyDefinitionColumns),
 	domain.PropertyDefinition:              newFlatEntry(PropertyDefinitionColumns),
 	domain.ConsolePropertyRegionalOverride: newFlatEntry(ConsolePropertyRegionalOverrideColumns),
@@ -73,6 +93,8 @@ var registry = map[domain.Category]registryEntry{
 	domain.GPUNode:                         newGroupedEntry(GPUNodeColumns),
 	domain.GPUWorkload:                     newGroupedEntry(GPUWorkloadColumns),
 	domain.DedicatedAICluster:              newGroupedEntry(DACColumns),
+	domain.Endpoint:                        newGroupedEntry(EndpointColumns),
+	domain.Event:                           newGroupedEntry(EventColumns),
 	domain.ImportedModel:                   newGroupedEntry(ImportedModelColumns),
 	domain.ModelArtifact:                   newGroupedEntry(ModelArtifactColumns),
 	domain.LimitTenancyOverride:            newGroupedEntry(LimitTenancyOverrideColumns),
@@ -153,17 +175,26 @@ func RenderTableForExport(cat domain.Category, items any, realm, region string,
 }
 
 // HelpTable returns a (Key, Title) row per column of cat,
-// for the `--columns help` output. Empty if cat is unregistered.
+// for the `--columns help` output. Optional columns are marked, since
+// they only render when selected, as are MultiCluster ones, which also
+// render when the environment spans several kube contexts. Empty if cat
+// is unregistered.
 func HelpTable(cat domain.Category) (headers []string, rows [][]string) {
-	keys := KeysFor(cat)
-	if keys == nil {
+	e, ok := registry[cat]
+	if !ok {
 		return nil, nil
 	}
-	titles := TitlesFor(cat)
 	headers = []string{"KEY", "TITLE"}
-	rows = make([][]string, len(keys))
-	for i, k := range keys {
-		rows[i] = []string{k, titles[i]}
+	rows = make([][]string, len(e.keys))
+	for i, k := range e.keys {
+		title := e.titles[i]
+		switch {
+		case e.optional[k]:
+			title += " (only with --columns)"
+		case e.multiCluster[k]:
+			title += " (with several kube contexts or --columns)"
+		}
+		rows[i] = []string{k, title}
 	}
 	return headers, rows
 }
@@ -208,7 +239,7 @@ func renderFlat[T any](s Set[T], items any, selected []string) ([]string, [][]st
 
 func selectFlat[T any](s Set[T], selected []string) ([]Column[T], error) {
 	if len(selected) == 0 {
-		return s.Columns, nil
+		return s.Defaults(), nil
 	}
 	return s.Select(selected)
 }
@@ -246,7 +277,7 @@ func renderGrouped[T any](g GroupedSet[T], items any, selected []string) ([]stri
 
 func selectGrouped[T any](g GroupedSet[T], selected []string) ([]GroupedColumn[T], error) {
 	if len(selected) == 0 {
-		return g.Columns, nil
+		return g.Defaults(), nil
 	}
 	return g.Select(selected)
 }
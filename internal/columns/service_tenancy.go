@@ -31,4 +31,5 @@ var ServiceTenancyColumns = Set[models.ServiceTenancy]{Columns: []Column[models.
 		Title: "Regions", Key: "regions", Ratio: 0.50,
 		Render: func(s models.ServiceTenancy) string { return strings.Join(s.Regions, ", ") },
 	},
+	sourceColumn[models.ServiceTenancy](),
 }}
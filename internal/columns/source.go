@@ -0,0 +1,31 @@
+package columns
+
+import "github.com/jingle2008/toolkit/pkg/models"
+
+// sourceKey is the key of the opt-in source-location column every
+// repo-backed category carries.
+const sourceKey = "source"
+
+// sourceColumn is the Optional "source" column: where in the repo the
+// item is declared, as file[:line[:column]].
+func sourceColumn[T models.Sourced]() Column[T] {
+	return Column[T]{
+		Title: "Source", Key: sourceKey, Optional: true,
+		Render: func(item T) string { return renderSource(item.GetSource()) },
+	}
+}
+
+// groupedSourceColumn is sourceColumn for grouped categories.
+func groupedSourceColumn[T models.Sourced]() GroupedColumn[T] {
+	return GroupedColumn[T]{
+		Title: "Source", Key: sourceKey, Optional: true,
+		Render: func(_ string, item T) string { return renderSource(item.GetSource()) },
+	}
+}
+
+func renderSource(loc *models.SourceLocation) string {
+	if loc == nil {
+		return ""
+	}
+	return loc.String()
+}
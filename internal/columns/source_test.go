@@ -0,0 +1,60 @@
+package columns
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// The source column is opt-in: absent from the default render and the
+// TUI's Defaults, present when selected, and flagged in --columns help.
+func TestSourceColumn_Optional(t *testing.T) {
+	t.Parallel()
+	pools := []models.GPUPool{
+		{Name: "p1", Source: &models.SourceLocation{File: "pools.tf", Line: 4, Column: 5}},
+		{Name: "p2"},
+	}
+
+	headers, _, err := RenderTable(domain.GPUPool, pools, nil)
+	if err != nil {
+		t.Fatalf("RenderTable: %v", err)
+	}
+	if slices.Contains(headers, "SOURCE") {
+		t.Errorf("default headers include SOURCE: %v", headers)
+	}
+	if n := len(GPUPoolColumns.Defaults()); n != len(GPUPoolColumns.Columns)-1 {
+		t.Errorf("Defaults: got %d columns, want %d", n, len(GPUPoolColumns.Columns)-1)
+	}
+
+	_, rows, err := RenderTable(domain.GPUPool, pools, []string{"name", "source"})
+	if err != nil {
+		t.Fatalf("RenderTable selected: %v", err)
+	}
+	if want := [][]string{{"p1", "pools.tf:4:5"}, {"p2", ""}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows: got %v, want %v", rows, want)
+	}
+
+	_, help := HelpTable(domain.GPUPool)
+	last := help[len(help)-1]
+	if last[0] != "source" || last[1] != "Source (only with --columns)" {
+		t.Errorf("help row: got %v", last)
+	}
+}
+
+// Every repo-backed category offers the source column.
+func TestSourceColumn_RepoCategories(t *testing.T) {
+	t.Parallel()
+	for _, cat := range []domain.Category{
+		domain.LimitDefinition, domain.ConsolePropertyDefinition, domain.PropertyDefinition,
+		domain.LimitRegionalOverride, domain.ConsolePropertyRegionalOverride, domain.PropertyRegionalOverride,
+		domain.LimitTenancyOverride, domain.ConsolePropertyTenancyOverride, domain.PropertyTenancyOverride,
+		domain.ServiceTenancy, domain.GPUPool, domain.ModelArtifact,
+	} {
+		if !slices.Contains(KeysFor(cat), sourceKey) {
+			t.Errorf("%s: no %q column", cat, sourceKey)
+		}
+	}
+}
@@ -30,6 +30,7 @@ func TenancyOverrideColumns[T models.DefinitionOverride]() GroupedSet[T] {
 			Title: "Value", Key: "value", Ratio: 0.10,
 			Render: func(_ string, v T) string { return v.GetValue() },
 		},
+		groupedSourceColumn[T](),
 	}}
 }
 
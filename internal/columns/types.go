@@ -4,7 +4,10 @@
 // surfaces consume them through adapters.
 package columns
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 // Column is a column for a flat (non-grouped) category.
 //
@@ -27,6 +30,16 @@ import "strings"
 // contract symmetric with GroupedColumn.RenderForExport and leaves
 // room for future flat columns whose export form depends on env
 // (e.g. a tenancy OCID column on the Tenant view).
+//
+// Optional columns are rendered only when named in --columns: they are
+// left out of the default CLI table and of the TUI, and carry a zero
+// Ratio. Use it for wide, occasionally useful values such as the
+// repo source location.
+//
+// MultiCluster columns render by default only while SetMultiCluster is
+// on, i.e. when the environment spans several kube contexts; otherwise
+// they behave as Optional ones. They carry a zero Ratio and are given
+// multiClusterRatio of the width when shown.
 type Column[T any] struct {
 	Title           string
 	Key             string
@@ -34,6 +47,8 @@ type Column[T any] struct {
 	Render          func(T) string
 	RenderForExport func(realm, region string, item T) string
 	TruncateMiddle  bool
+	Optional        bool
+	MultiCluster    bool
 }
 
 // GroupedColumn is a column for a grouped category (loader returns
@@ -45,7 +60,8 @@ type Column[T any] struct {
 // Column.TruncateMiddle / Column.RenderForExport; RenderForExport's
 // signature carries the group key alongside realm/region so a
 // column can substitute its display value with an export-mode
-// representation that depends on either.
+// representation that depends on either. Optional and MultiCluster
+// match Column.Optional and Column.MultiCluster.
 type GroupedColumn[T any] struct {
 	Title           string
 	Key             string
@@ -53,6 +69,8 @@ type GroupedColumn[T any] struct {
 	Render          func(key string, item T) string
 	RenderForExport func(realm, region, key string, item T) string
 	TruncateMiddle  bool
+	Optional        bool
+	MultiCluster    bool
 }
 
 // Set is the canonical column list for a flat category.
@@ -116,6 +134,18 @@ func (s Set[T]) Select(keys []string) ([]Column[T], error) {
 	return selectByKey(s.Columns, func(c Column[T]) string { return c.Key }, keys, s.Keys)
 }
 
+// Defaults returns the columns of s that render when none are selected:
+// every column except the Optional ones, and except the MultiCluster
+// ones unless SetMultiCluster is on.
+func (s Set[T]) Defaults() []Column[T] {
+	multi := multiCluster.Load()
+	cols := slices.DeleteFunc(slices.Clone(s.Columns), func(c Column[T]) bool {
+		return c.Optional || (c.MultiCluster && !multi)
+	})
+	shareRatio(cols, func(c *Column[T]) (*float64, bool) { return &c.Ratio, c.MultiCluster })
+	return cols
+}
+
 // Keys returns the keys declared on s in order.
 func (s Set[T]) Keys() []string {
 	return mapColumns(s.Columns, func(c Column[T]) string { return c.Key })
@@ -136,6 +166,16 @@ func (g GroupedSet[T]) Select(keys []string) ([]GroupedColumn[T], error) {
 	return selectByKey(g.Columns, func(c GroupedColumn[T]) string { return c.Key }, keys, g.Keys)
 }
 
+// Defaults is Set.Defaults for g.
+func (g GroupedSet[T]) Defaults() []GroupedColumn[T] {
+	multi := multiCluster.Load()
+	cols := slices.DeleteFunc(slices.Clone(g.Columns), func(c GroupedColumn[T]) bool {
+		return c.Optional || (c.MultiCluster && !multi)
+	})
+	shareRatio(cols, func(c *GroupedColumn[T]) (*float64, bool) { return &c.Ratio, c.MultiCluster })
+	return cols
+}
+
 // Keys returns the keys declared on g in order.
 func (g GroupedSet[T]) Keys() []string {
 	return mapColumns(g.Columns, func(c GroupedColumn[T]) string { return c.Key })
@@ -0,0 +1,45 @@
+package columns
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// WorkRequestColumns is the canonical column set for domain.WorkRequest.
+// 7 columns, ratios sum to 1.00. Name is the full OCID `toolkit wait
+// workrequest` takes, so it gets the most room; Errors is why a failed
+// request is worth opening.
+var WorkRequestColumns = Set[models.WorkRequest]{Columns: []Column[models.WorkRequest]{
+	{
+		Title: "Name", Key: "name", Ratio: 0.30, TruncateMiddle: true,
+		Render: func(w models.WorkRequest) string { return w.ID },
+	},
+	{
+		Title: "Service", Key: "service", Ratio: 0.07,
+		Render: func(w models.WorkRequest) string { return w.Service },
+	},
+	{
+		Title: "Operation", Key: "operation", Ratio: 0.18,
+		Render: func(w models.WorkRequest) string { return w.Operation },
+	},
+	{
+		Title: "Status", Key: "status", Ratio: 0.10,
+		Render: func(w models.WorkRequest) string { return w.Status },
+	},
+	{
+		Title: "%", Key: "percent", Ratio: 0.05,
+		Render: func(w models.WorkRequest) string {
+			return strconv.FormatFloat(float64(w.PercentComplete), 'f', 0, 32)
+		},
+	},
+	{
+		Title: "Age", Key: "age", Ratio: 0.05,
+		Render: func(w models.WorkRequest) string { return w.Age },
+	},
+	{
+		Title: "Errors", Key: "errors", Ratio: 0.25,
+		Render: func(w models.WorkRequest) string { return strings.Join(w.Errors, "; ") },
+	},
+}}
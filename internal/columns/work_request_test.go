@@ -0,0 +1,32 @@
+package columns
+
+import (
+	"testing"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestWorkRequestColumns(t *testing.T) {
+	t.Parallel()
+	w := models.WorkRequest{
+		ID: "ocid1.workrequest.oc1.iad.x", Service: models.WorkRequestServiceCompute,
+		Operation: "TerminateInstance", Status: "FAILED", PercentComplete: 42.6, Age: "5m",
+		Errors: []string{"quota exceeded", "retry later"},
+	}
+	got := map[string]string{}
+	for _, c := range WorkRequestColumns.Columns {
+		got[c.Key] = c.Render(w)
+	}
+	want := map[string]string{
+		"name": "ocid1.workrequest.oc1.iad.x", "service": "compute", "operation": "TerminateInstance",
+		"status": "FAILED", "percent": "43", "age": "5m", "errors": "quota exceeded; retry later",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("col %s = %q, want %q", k, got[k], v)
+		}
+	}
+	if s := WorkRequestColumns.RatioSum(); s < 0.98 || s > 1.02 {
+		t.Errorf("ratio sum %.3f", s)
+	}
+}
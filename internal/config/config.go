@@ -6,8 +6,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	domain "github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
 )
 
 // Config holds configuration for the toolkit CLI application.
@@ -31,6 +35,90 @@ type Config struct {
 	// OCI credentials decide the maximum blast radius — not the
 	// operator's startup-env choice.
 	MutationEnvOverrideAllowed bool `mapstructure:"mutation-env-override-allowed"`
+	// GPUPoolSources lists the Terraform module directories GPU pools are
+	// loaded from. Empty means the built-in instance pool, cluster
+	// network and OKE node pool sources.
+	GPUPoolSources []GPUPoolSource `mapstructure:"gpu-pool-sources"`
+	// GPUResources lists the extended resources counted as GPUs, e.g.
+	// amd.com/gpu or MIG profiles such as nvidia.com/mig-1g.10gb. Empty
+	// means nvidia.com/gpu.
+	GPUResources []string `mapstructure:"gpu-resources"`
+	// NodeHealthRules add GPU node health checks to the built-in ones, or
+	// replace a built-in rule of the same name.
+	NodeHealthRules []NodeHealthRule `mapstructure:"node-health-rules"`
+	// KubeContexts maps an environment name (e.g. "prod-iad") to the kube
+	// contexts its clusters are reached through. Unmapped environments use
+	// the conventional dp-<type>-<region code> context.
+	KubeContexts models.KubeContexts `mapstructure:"kube-contexts"`
+	// CacheDir holds the on-disk parse cache of repo-backed data. Empty,
+	// or NoCache, parses the repo on every load.
+	CacheDir string `mapstructure:"cache-dir"`
+	NoCache  bool   `mapstructure:"no-cache"`
+	// OCIAuth selects how OCI requests are authenticated. Empty means
+	// session tokens from the realm's profile in ~/.oci/config.
+	OCIAuth OCIAuth `mapstructure:"oci-auth"`
+}
+
+// OCIAuthModes are the supported oci-auth modes.
+var OCIAuthModes = []string{"session-token", "api-key", "instance-principal", "resource-principal"}
+
+// OCIAuth is the oci-auth section: a default profile, and overrides per
+// environment name (e.g. "prod-iad") whose empty fields inherit it.
+type OCIAuth struct {
+	OCIAuthProfile `mapstructure:",squash"`
+	Environments   map[string]OCIAuthProfile `mapstructure:"environments"`
+}
+
+// OCIAuthProfile is how one environment authenticates to OCI.
+type OCIAuthProfile struct {
+	// Mode is one of OCIAuthModes; empty means session-token.
+	Mode string `mapstructure:"mode"`
+	// ConfigFile is the OCI CLI config file of the session-token and
+	// api-key modes; empty means ~/.oci/config.
+	ConfigFile string `mapstructure:"config-file"`
+	// Profile names the config file profile; empty means the realm,
+	// uppercased.
+	Profile string `mapstructure:"profile"`
+}
+
+// NodeHealthRule is one entry of node-health-rules. Exactly one of
+// Condition, Taint, Label, Field, Event or FailedPods selects the check,
+// unless Severity is "off".
+type NodeHealthRule struct {
+	Name string `mapstructure:"name"`
+	// Severity is "error" (default), "warning" or "off".
+	Severity string `mapstructure:"severity"`
+	// Message replaces the default description; {node}, {value} and
+	// {expected} are substituted.
+	Message   string `mapstructure:"message"`
+	Condition string `mapstructure:"condition"`
+	// Status is the condition status that matches (default "True").
+	Status string `mapstructure:"status"`
+	Taint  string `mapstructure:"taint"`
+	Label  string `mapstructure:"label"`
+	// Field is a node info field, one of models.NodeInfoFields.
+	Field string `mapstructure:"field"`
+	// Values a taint, label or field value must be one of to match.
+	Values []string `mapstructure:"values"`
+	// Mismatch ("pool" or "cluster") matches a label or field value that
+	// differs from the most common one in that scope.
+	Mismatch string `mapstructure:"mismatch"`
+	// Event is a node event reason; Within (a duration, default 1h)
+	// bounds how recent it must be.
+	Event      string `mapstructure:"event"`
+	Within     string `mapstructure:"within"`
+	FailedPods bool   `mapstructure:"failed-pods"`
+}
+
+// GPUPoolSource is one entry of gpu-pool-sources.
+type GPUPoolSource struct {
+	// Dir is the module directory, relative to repo-path.
+	Dir string `mapstructure:"dir"`
+	// Local is the local or output holding the pool map; it may name a
+	// child module output as module.<name>.<output>.
+	Local string `mapstructure:"local"`
+	// OkeManaged marks OKE node pools.
+	OkeManaged bool `mapstructure:"oke-managed"`
 }
 
 /*
@@ -59,5 +147,93 @@ func (c Config) Validate() error {
 	if err != nil {
 		return fmt.Errorf("config: invalid category: %w", err)
 	}
+	for i, src := range c.GPUPoolSources {
+		if src.Dir == "" || src.Local == "" {
+			return fmt.Errorf("config: gpu-pool-sources[%d]: dir and local are required", i)
+		}
+	}
+	for i, r := range c.NodeHealthRules {
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("config: node-health-rules[%d]: %w", i, err)
+		}
+	}
+	for i, name := range c.GPUResources {
+		if !strings.Contains(name, "/") {
+			return fmt.Errorf("config: gpu-resources[%d]: %q is not an extended resource name (vendor/resource)", i, name)
+		}
+	}
+	for env, contexts := range c.KubeContexts {
+		if len(contexts) == 0 || slices.Contains(contexts, "") {
+			return fmt.Errorf("config: kube-contexts[%s]: expected a non-empty list of context names", env)
+		}
+	}
+	if err := c.OCIAuth.validate(); err != nil {
+		return fmt.Errorf("config: oci-auth%w", err)
+	}
+	return nil
+}
+
+// validate checks the default and per-environment modes. Errors start
+// with the offending key's path below oci-auth.
+func (a OCIAuth) validate() error {
+	if err := a.OCIAuthProfile.validate(); err != nil {
+		return fmt.Errorf(": %w", err)
+	}
+	for env, p := range a.Environments {
+		if err := p.validate(); err != nil {
+			return fmt.Errorf(".environments[%s]: %w", env, err)
+		}
+	}
+	return nil
+}
+
+func (p OCIAuthProfile) validate() error {
+	if p.Mode != "" && !slices.Contains(OCIAuthModes, p.Mode) {
+		return fmt.Errorf("mode %q must be one of %s", p.Mode, strings.Join(OCIAuthModes, ", "))
+	}
+	return nil
+}
+
+func (r NodeHealthRule) validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	switch r.Severity {
+	case "", "error", "warning":
+	case "off":
+		return nil
+	default:
+		return fmt.Errorf("severity %q must be error, warning or off", r.Severity)
+	}
+	if r.Within != "" {
+		if _, err := time.ParseDuration(r.Within); err != nil {
+			return fmt.Errorf("within: %w", err)
+		}
+	}
+	return r.validateCheck()
+}
+
+// validateCheck checks that the rule selects exactly one check and that
+// its options fit it.
+func (r NodeHealthRule) validateCheck() error {
+	kinds := 0
+	for _, set := range []bool{r.Condition != "", r.Taint != "", r.Label != "", r.Field != "", r.Event != "", r.FailedPods} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return errors.New("exactly one of condition, taint, label, field, event or failed-pods is required")
+	}
+	if r.Field != "" && !slices.Contains(models.NodeInfoFields, r.Field) {
+		return fmt.Errorf("field %q must be one of %s", r.Field, strings.Join(models.NodeInfoFields, ", "))
+	}
+	valueRule := r.Label != "" || r.Field != ""
+	if r.Mismatch != "" && (!valueRule || (r.Mismatch != "pool" && r.Mismatch != "cluster")) {
+		return errors.New(`mismatch must be "pool" or "cluster" on a label or field rule`)
+	}
+	if r.Field != "" && r.Mismatch == "" && len(r.Values) == 0 {
+		return errors.New("a field rule needs values or mismatch")
+	}
 	return nil
 }
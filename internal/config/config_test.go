@@ -2,7 +2,12 @@ package config
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/jingle2008/toolkit/pkg/models"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -37,9 +42,117 @@ func TestConfig_Validate(t *testing.T) {
 	if err == nil || !contains(err.Error(), "unknown category") {
 		t.Errorf("expected invalid category error, got: %v", err)
 	}
+
+	// GPU pool sources need both dir and local
+	cfg = valid
+	cfg.GPUPoolSources = []GPUPoolSource{{Dir: "pools", Local: "env_pools"}}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid gpu-pool-sources, got: %v", err)
+	}
+	cfg.GPUPoolSources = append(cfg.GPUPoolSources, GPUPoolSource{Dir: "pools"})
+	err = cfg.Validate()
+	if err == nil || !contains(err.Error(), "gpu-pool-sources[1]: dir and local are required") {
+		t.Errorf("expected gpu-pool-sources error, got: %v", err)
+	}
+
+	// GPU resources must be extended resource names
+	cfg = valid
+	cfg.GPUResources = []string{"amd.com/gpu", "nvidia.com/mig-1g.10gb"}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid gpu-resources, got: %v", err)
+	}
+	cfg.GPUResources = append(cfg.GPUResources, "gpu")
+	err = cfg.Validate()
+	if err == nil || !contains(err.Error(), `gpu-resources[2]: "gpu" is not an extended resource name (vendor/resource)`) {
+		t.Errorf("expected gpu-resources error, got: %v", err)
+	}
+
+	// Kube context mappings list at least one named context
+	cfg = valid
+	cfg.KubeContexts = models.KubeContexts{"prod-iad": {"prod-iad-a", "prod-iad-b"}}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid kube-contexts, got: %v", err)
+	}
+	cfg.KubeContexts["dev-phx"] = nil
+	err = cfg.Validate()
+	if err == nil || !contains(err.Error(), "kube-contexts[dev-phx]: expected a non-empty list of context names") {
+		t.Errorf("expected kube-contexts error, got: %v", err)
+	}
+
+	// OCI auth modes are known, by default and per environment
+	cfg = valid
+	cfg.OCIAuth = OCIAuth{
+		OCIAuthProfile: OCIAuthProfile{Mode: "api-key", Profile: "OPS"},
+		Environments:   map[string]OCIAuthProfile{"prod-iad": {Mode: "instance-principal"}},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid oci-auth, got: %v", err)
+	}
+	cfg.OCIAuth.Mode = "password"
+	err = cfg.Validate()
+	if err == nil || !contains(err.Error(), `oci-auth: mode "password" must be one of session-token, api-key, instance-principal, resource-principal`) {
+		t.Errorf("expected oci-auth error, got: %v", err)
+	}
+	cfg.OCIAuth.Mode = ""
+	cfg.OCIAuth.Environments["dev-phx"] = OCIAuthProfile{Mode: "token"}
+	err = cfg.Validate()
+	if err == nil || !contains(err.Error(), `oci-auth.environments[dev-phx]: mode "token" must be one of session-token, api-key, instance-principal, resource-principal`) {
+		t.Errorf("expected oci-auth environment error, got: %v", err)
+	}
+
+	// Node health rules select exactly one valid check
+	cfg = valid
+	cfg.NodeHealthRules = []NodeHealthRule{
+		{Name: "dcgm", Label: "nvidia.com/gpu.health", Values: []string{"Unhealthy"}},
+		{Name: "kubelet-skew", Field: "kubelet-version", Mismatch: "cluster", Severity: "warning"},
+		{Name: "xid", Event: "GPUXid", Within: "30m"},
+		{Name: "gpu-count", Severity: "off"},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("expected valid node-health-rules, got: %v", err)
+	}
+	for _, tc := range []struct {
+		bad  NodeHealthRule
+		want string
+	}{
+		{NodeHealthRule{Taint: "x"}, "node-health-rules[4]: name is required"},
+		{NodeHealthRule{Name: "x", Taint: "x", Severity: "fatal"}, `node-health-rules[4]: severity "fatal" must be error, warning or off`},
+		{NodeHealthRule{Name: "x", Taint: "x", Label: "y"}, "node-health-rules[4]: exactly one of condition, taint, label, field, event or failed-pods is required"},
+		{NodeHealthRule{Name: "x", Field: "gpu"}, `node-health-rules[4]: field "gpu" must be one of container-runtime, kernel-version, kubelet-version, os-image`},
+		{NodeHealthRule{Name: "x", Field: "os-image"}, "node-health-rules[4]: a field rule needs values or mismatch"},
+		{NodeHealthRule{Name: "x", Taint: "x", Mismatch: "pool"}, `node-health-rules[4]: mismatch must be "pool" or "cluster" on a label or field rule`},
+		{NodeHealthRule{Name: "x", Event: "E", Within: "soon"}, `node-health-rules[4]: within: time: invalid duration "soon"`},
+	} {
+		cfg.NodeHealthRules = append(cfg.NodeHealthRules[:4:4], tc.bad)
+		if err := cfg.Validate(); err == nil || !contains(err.Error(), tc.want) {
+			t.Errorf("expected %q, got: %v", tc.want, err)
+		}
+	}
 }
 
 // contains reports whether substr is in s.
 func contains(s, substr string) bool {
 	return len(substr) == 0 || (len(s) >= len(substr) && (s == substr || contains(s[1:], substr)))
 }
+
+// The gpu-pool-sources keys are GPUPoolSource's mapstructure tags.
+func TestConfig_DecodesGPUPoolSources(t *testing.T) {
+	t.Parallel()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(strings.NewReader(`gpu-pool-sources:
+  - dir: pools
+    local: module.pools.gpu_pools
+    oke-managed: true
+`)); err != nil {
+		t.Fatal(err)
+	}
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	want := []GPUPoolSource{{Dir: "pools", Local: "module.pools.gpu_pools", OkeManaged: true}}
+	if !reflect.DeepEqual(cfg.GPUPoolSources, want) {
+		t.Fatalf("GPUPoolSources = %+v, want %+v", cfg.GPUPoolSources, want)
+	}
+}
@@ -0,0 +1,98 @@
+package configloader
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+// limitsParts are the Dataset parts read from the limits tree.
+const limitsParts = models.RepoDefinitions | models.RepoTenancyOverrides | models.RepoRegionalOverrides
+
+// terraformParts are the Dataset parts evaluated from Terraform modules.
+const terraformParts = models.RepoServiceTenancies | models.RepoModelArtifacts | models.RepoGPUPools
+
+/*
+ChangedParts maps changed working-tree paths (slash-separated and relative
+to the repo root, as fswatch reports them) to the Dataset parts they can
+affect. poolSources are the GPU pool sources in use, or
+terraform.DefaultGPUPoolSources when none are given.
+
+A path inside a part's directory affects that part, and a path above it (a
+created or removed parent directory) affects every part below. Under
+shared_modules/limits the first directory's suffix (_definitions,
+_tenancy_overrides, _regional_overrides) narrows the change to one part. A
+Terraform file, or a directory that may hold one, elsewhere in the tree can
+be a module the Terraform-backed parts call, so it affects all of them.
+Directories are the paths with a trailing slash, as fswatch reports a
+created or removed one. Anything else (docs, scripts, Makefiles, unrelated
+configs) affects nothing.
+*/
+func ChangedParts(paths []string, poolSources ...terraform.GPUPoolSource) models.RepoParts {
+	if len(poolSources) == 0 {
+		poolSources = terraform.DefaultGPUPoolSources
+	}
+	dirs := map[string]models.RepoParts{
+		terraform.ServiceTenanciesDir: models.RepoServiceTenancies,
+		terraform.ModelArtifactsDir:   models.RepoModelArtifacts,
+	}
+	for _, s := range poolSources {
+		dirs[path.Clean(s.Dir)] |= models.RepoGPUPools
+	}
+
+	var parts models.RepoParts
+	for _, p := range paths {
+		parts |= changedPart(path.Clean(p), strings.HasSuffix(p, "/"), dirs)
+	}
+	return parts
+}
+
+// changedPart maps one changed path; see ChangedParts.
+func changedPart(p string, isDir bool, dirs map[string]models.RepoParts) models.RepoParts {
+	var parts models.RepoParts
+	for dir, part := range dirs {
+		if within(p, dir) || within(dir, p) {
+			parts |= part
+		}
+	}
+
+	switch {
+	case within(p, limitsDir) && p != limitsDir:
+		first, _, _ := strings.Cut(strings.TrimPrefix(p, limitsDir+"/"), "/")
+		switch {
+		case strings.HasSuffix(first, definitionSuffix+"s"):
+			parts |= models.RepoDefinitions
+		case strings.HasSuffix(first, tenancyOverridesKey):
+			parts |= models.RepoTenancyOverrides
+		case strings.HasSuffix(first, regionalOverridesKey):
+			parts |= models.RepoRegionalOverrides
+		default:
+			parts |= limitsParts
+		}
+	case within(limitsDir, p):
+		parts |= limitsParts
+	}
+
+	if parts == 0 && (isDir || isTerraformFile(p)) {
+		parts = terraformParts
+	}
+	return parts
+}
+
+// within reports whether p is dir or lies below it. "." is the repo root.
+func within(p, dir string) bool {
+	return dir == "." || p == dir || strings.HasPrefix(p, dir+"/")
+}
+
+// terraformExts are the suffixes of the Terraform source, variables and
+// HCL files a module can read.
+var terraformExts = []string{".tf", ".tfvars", ".tf.json", ".tfvars.json", ".hcl"}
+
+// isTerraformFile reports whether p is a Terraform source, variables or
+// HCL file.
+func isTerraformFile(p string) bool {
+	return slices.ContainsFunc(terraformExts, func(ext string) bool { return strings.HasSuffix(p, ext) })
+}
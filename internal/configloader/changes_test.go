@@ -0,0 +1,53 @@
+package configloader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestChangedParts(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name  string
+		paths []string
+		want  models.RepoParts
+	}{
+		{"definition file", []string{"shared_modules/limits/limit_definitions/oc1_limit_definition.json"}, models.RepoDefinitions},
+		{
+			"tenancy override", []string{"shared_modules/limits/properties_tenancy_overrides/regional_values/oc1/t1/a.json"},
+			models.RepoTenancyOverrides,
+		},
+		{
+			"regional overrides and definitions",
+			[]string{
+				"shared_modules/limits/console_properties_regional_overrides/regional_values/oc1/a.json",
+				"shared_modules/limits/property_definitions/oc1_property_definition.json",
+			},
+			models.RepoRegionalOverrides | models.RepoDefinitions,
+		},
+		{"limits root file", []string{"shared_modules/limits/README.md"}, limitsParts},
+		{"service tenancies", []string{"shared_modules/shep_targets/locals.tf"}, models.RepoServiceTenancies},
+		{"model artifacts", []string{"shared_modules/tensorrt_models_config/models.tf"}, models.RepoModelArtifacts},
+		{"gpu pools", []string{"shared_modules/oci_oke_nodepools_config/pools.tf"}, models.RepoGPUPools},
+		{"removed parent directory", []string{"shared_modules/"}, models.AllRepoParts},
+		{"terraform module elsewhere", []string{"modules/common/main.tf"}, terraformParts},
+		{"hcl file elsewhere", []string{"modules/common/terragrunt.hcl"}, terraformParts},
+		{"directory elsewhere", []string{"modules/common/"}, terraformParts},
+		{"unrelated files", []string{"README.md", "scripts/deploy.sh", "docs/a.png"}, 0},
+		{"extensionless files", []string{"Makefile", "LICENSE", "scripts/Dockerfile"}, 0},
+	} {
+		assert.Equal(t, tc.want, ChangedParts(tc.paths), tc.name)
+	}
+}
+
+func TestChangedParts_ConfiguredPoolSources(t *testing.T) {
+	t.Parallel()
+	src := terraform.GPUPoolSource{Dir: "regions/pools/", Local: "pools"}
+	assert.Equal(t, models.RepoGPUPools, ChangedParts([]string{"regions/pools/a.tfvars"}, src))
+	assert.Equal(t, terraformParts, ChangedParts([]string{"shared_modules/instance_pools_config/a.tf"}, src),
+		"a default pool dir is just another module once pool sources are configured")
+}
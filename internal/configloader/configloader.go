@@ -9,10 +9,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/jingle2008/toolkit/internal/encoding/jsonutil"
 	"github.com/jingle2008/toolkit/internal/fileutil"
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
 	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
 	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -24,6 +27,8 @@ const (
 	tenancyOverridesKey  = "_tenancy_overrides"
 	regionalOverridesKey = "_regional_overrides"
 	regionalValuesDir    = "regional_values"
+	definitionValuesKey  = "values"
+	limitsDir            = "shared_modules/limits"
 )
 
 func getConfigPath(root, configName string) string {
@@ -66,12 +71,32 @@ func loadOverridesWith[T models.NamedItem](
 		if err != nil {
 			return nil, err
 		}
+		// Each override is one record per file, so the file is the location.
+		if s, ok := any(override).(sourceSetter); ok {
+			s.SetSource(&models.SourceLocation{File: file})
+		}
 		overrides = append(overrides, *override)
 	}
 
 	return overrides, nil
 }
 
+// sourceSetter is implemented (on the pointer) by the override types, so
+// loadOverridesWith can stamp each record with its file.
+type sourceSetter interface {
+	SetSource(loc *models.SourceLocation)
+}
+
+// sourceAt locates the i-th entry of a definitions file from the element
+// lines jsonutil.LoadFileWithLines reported, falling back to the file alone.
+func sourceAt(path string, lines []int, i int) *models.SourceLocation {
+	loc := &models.SourceLocation{File: path}
+	if i < len(lines) {
+		loc.Line = lines[i]
+	}
+	return loc
+}
+
 func loadOverrides[T models.NamedItem](ctx context.Context, dirPath string) ([]T, error) {
 	return loadOverridesWith(ctx, dirPath, fileutil.ListFiles, jsonutil.LoadFile[T])
 }
@@ -91,6 +116,9 @@ func loadTenancyOverridesWith[T models.NamedItem](
 	}
 
 	for _, tenant := range tenants {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		overrides, err := loadOverridesFunc(ctx, filepath.Join(realmDir, tenant))
 		if err != nil {
 			return nil, err
@@ -101,20 +129,37 @@ func loadTenancyOverridesWith[T models.NamedItem](
 	return results, nil
 }
 
+// loadTenancyOverrides loads a realm's per-tenant overrides of one kind
+// through the parse cache on ctx; the realm directory and every tenant
+// directory are its inputs.
 func loadTenancyOverrides[T models.NamedItem](ctx context.Context, root, realm, name string) (map[string][]T, error) {
-	return loadTenancyOverridesWith(ctx, root, realm, name, listSubDirs, loadOverrides[T])
+	realmDir := filepath.Join(root, name, regionalValuesDir, realm)
+	return parsecache.Load(ctx, "tenancy-overrides", realmDir, func(in *parsecache.Inputs) (map[string][]T, error) {
+		in.AddDirs(realmDir)
+		return loadTenancyOverridesWith(ctx, root, realm, name, listSubDirs,
+			func(ctx context.Context, dir string) ([]T, error) {
+				in.AddDirs(dir)
+				return loadOverrides[T](ctx, dir)
+			})
+	})
 }
 
+// loadRegionalOverrides loads a realm's regional overrides of one kind
+// through the parse cache on ctx. A missing realm directory yields no
+// overrides, and is cached as such until it appears.
 func loadRegionalOverrides[T models.NamedItem](ctx context.Context, root, realm, name string) ([]T, error) {
 	realmDir := filepath.Join(root, name, regionalValuesDir, realm)
-	overrides, err := loadOverrides[T](ctx, realmDir)
-	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return []T{}, nil
+	return parsecache.Load(ctx, "regional-overrides", realmDir, func(in *parsecache.Inputs) ([]T, error) {
+		in.AddDirs(realmDir)
+		overrides, err := loadOverrides[T](ctx, realmDir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return []T{}, nil
+			}
+			return nil, err
 		}
-		return nil, err
-	}
-	return overrides, nil
+		return overrides, nil
+	})
 }
 
 type idSet map[string]struct{}
@@ -192,15 +237,13 @@ type tenancyOverrideRecord interface {
 }
 
 // loadAndStamp loads the tenancy override file set under key for the
-// given realm, stamps each record with its source-directory name as
+// given realm and stamps each record with its source-directory name as
 // TenantName (via setTenantName, since SetTenantName lives on the
-// value pointer), and updates the running tenantMap with this
-// batch's tenant IDs. The three concrete override types call this
-// from LoadTenancyOverrideGroup.
+// value pointer). The three concrete override types call this from
+// tenancyOverrideTasks.
 func loadAndStamp[T tenancyOverrideRecord](
 	ctx context.Context,
 	limitsRoot, realm, key string,
-	tenantMap map[string]idSet,
 	setTenantName func(*T, string),
 ) (map[string][]T, error) {
 	m, err := loadTenancyOverrides[T](ctx, limitsRoot, realm, key)
@@ -212,7 +255,6 @@ func loadAndStamp[T tenancyOverrideRecord](
 			setTenantName(&ovs[i], name)
 		}
 	}
-	updateTenants(tenantMap, m)
 	return m, nil
 }
 
@@ -254,65 +296,104 @@ func isValidEnvironment(env models.Environment, allEnvs []models.Environment) bo
 
 // LoadDataset loads a Dataset from the given repository path and environment.
 func LoadDataset(ctx context.Context, repoPath string, env models.Environment, metadata *models.Metadata) (*models.Dataset, error) {
-	serviceTenancies, err := terraform.LoadServiceTenancies(ctx, repoPath)
-	if err != nil {
-		return nil, err
-	}
-
-	environments := getEnvironments(serviceTenancies)
-	if err := validateEnvironment(env, environments); err != nil {
-		return nil, err
-	}
+	return LoadDatasetParts(ctx, repoPath, env, metadata, models.AllRepoParts)
+}
 
+/*
+LoadDatasetParts loads only the given parts of a Dataset; the fields of the
+other parts are left zero. It is the partial counterpart of LoadDataset used
+when a working-tree change affects only some of the repo (see ChangedParts),
+and its result is merged with Dataset.MergeRepoParts. RepoGPUPools is
+ignored: GPU pools are loaded by terraform.LoadGPUPools.
+
+Reloading RepoServiceTenancies re-validates env against the environments
+they define.
+
+The parts, and the kinds of override within them, load concurrently (see
+runLoadTasks), so the load takes about as long as its slowest part. The
+first failure cancels the rest and is returned.
+*/
+func LoadDatasetParts(ctx context.Context, repoPath string, env models.Environment, metadata *models.Metadata,
+	parts models.RepoParts,
+) (*models.Dataset, error) {
+	ds := &models.Dataset{}
 	realm := env.Realm
+	var tasks []loadTask
 
-	limitGroup, consolePropertyDefinitionGroup, propertyDefinitionGroup, err := loadDefinitionGroups(repoPath)
-	if err != nil {
-		return nil, err
+	if parts.Has(models.RepoServiceTenancies) {
+		tasks = append(tasks, loadTask{name: "service tenancies", load: func(ctx context.Context) error {
+			serviceTenancies, err := terraform.LoadServiceTenancies(ctx, repoPath)
+			if err != nil {
+				return err
+			}
+			environments := getEnvironments(serviceTenancies)
+			if err := validateEnvironment(env, environments); err != nil {
+				return err
+			}
+			ds.ServiceTenancies = serviceTenancies
+			ds.Environments = environments
+			return nil
+		}})
 	}
 
-	tenancyOverrideGroup, err := LoadTenancyOverrideGroup(ctx, repoPath, realm, metadata)
-	if err != nil {
-		return nil, err
+	if parts.Has(models.RepoDefinitions) {
+		tasks = append(tasks, loadTask{name: "definitions", load: func(ctx context.Context) error {
+			groups, err := loadDefinitionGroupsCached(ctx, repoPath)
+			if err != nil {
+				return err
+			}
+			ds.LimitDefinitionGroup = groups.Limit
+			ds.ConsolePropertyDefinitionGroup = groups.ConsoleProperty
+			ds.PropertyDefinitionGroup = groups.Property
+			return nil
+		}})
 	}
 
-	limitRegionalOverrides, err := LoadLimitRegionalOverrides(ctx, repoPath, realm)
-	if err != nil {
-		return nil, err
+	var tenancyOverrides models.TenancyOverrideGroup
+	if parts.Has(models.RepoTenancyOverrides) {
+		tasks = append(tasks, tenancyOverrideTasks(repoPath, realm, &tenancyOverrides)...)
 	}
-	consolePropertyRegionalOverrides, err := LoadConsolePropertyRegionalOverrides(ctx, repoPath, realm)
-	if err != nil {
-		return nil, err
+
+	if parts.Has(models.RepoRegionalOverrides) {
+		tasks = append(tasks,
+			loadTask{name: "limit regional overrides", load: func(ctx context.Context) (err error) {
+				ds.LimitRegionalOverrides, err = LoadLimitRegionalOverrides(ctx, repoPath, realm)
+				return err
+			}},
+			loadTask{name: "console property regional overrides", load: func(ctx context.Context) (err error) {
+				ds.ConsolePropertyRegionalOverrides, err = LoadConsolePropertyRegionalOverrides(ctx, repoPath, realm)
+				return err
+			}},
+			loadTask{name: "property regional overrides", load: func(ctx context.Context) (err error) {
+				ds.PropertyRegionalOverrides, err = LoadPropertyRegionalOverrides(ctx, repoPath, realm)
+				return err
+			}},
+		)
 	}
-	propertyRegionalOverrides, err := LoadPropertyRegionalOverrides(ctx, repoPath, realm)
-	if err != nil {
-		return nil, err
+
+	if parts.Has(models.RepoModelArtifacts) {
+		tasks = append(tasks, loadTask{name: "model artifacts", load: func(ctx context.Context) (err error) {
+			ds.ModelArtifactMap, err = terraform.LoadModelArtifacts(ctx, repoPath, env)
+			return err
+		}})
 	}
 
-	modelArtifactMap, err := terraform.LoadModelArtifacts(ctx, repoPath, env)
-	if err != nil {
+	start := time.Now()
+	if err := runLoadTasks(ctx, tasks); err != nil {
 		return nil, err
 	}
-
-	return &models.Dataset{
-		LimitDefinitionGroup:              *limitGroup,
-		ConsolePropertyDefinitionGroup:    *consolePropertyDefinitionGroup,
-		PropertyDefinitionGroup:           *propertyDefinitionGroup,
-		LimitTenancyOverrideMap:           tenancyOverrideGroup.LimitTenancyOverrideMap,
-		ConsolePropertyTenancyOverrideMap: tenancyOverrideGroup.ConsolePropertyTenancyOverrideMap,
-		PropertyTenancyOverrideMap:        tenancyOverrideGroup.PropertyTenancyOverrideMap,
-		ConsolePropertyRegionalOverrides:  consolePropertyRegionalOverrides,
-		LimitRegionalOverrides:            limitRegionalOverrides,
-		PropertyRegionalOverrides:         propertyRegionalOverrides,
-		Tenants:                           tenancyOverrideGroup.Tenants,
-		Environments:                      environments,
-		ServiceTenancies:                  serviceTenancies,
-		ModelArtifactMap:                  modelArtifactMap,
-	}, nil
+	if parts.Has(models.RepoTenancyOverrides) {
+		ds.LimitTenancyOverrideMap = tenancyOverrides.LimitTenancyOverrideMap
+		ds.ConsolePropertyTenancyOverrideMap = tenancyOverrides.ConsolePropertyTenancyOverrideMap
+		ds.PropertyTenancyOverrideMap = tenancyOverrides.PropertyTenancyOverrideMap
+		ds.Tenants = tenantsOf(tenancyOverrides, realm, metadata)
+	}
+	logging.FromContext(ctx).Debugw("loaded dataset", "parts", parts.String(), "elapsed", time.Since(start))
+	return ds, nil
 }
 
 func getLimitsRoot(repoPath string) string {
-	return filepath.Join(repoPath, "shared_modules/limits")
+	return filepath.Join(repoPath, limitsDir)
 }
 
 // validateEnvironment checks if the provided environment is valid.
@@ -323,6 +404,31 @@ func validateEnvironment(env models.Environment, allEnvs []models.Environment) e
 	return nil
 }
 
+// definitionGroups holds the three definition groups as one cached value.
+type definitionGroups struct {
+	Limit           models.LimitDefinitionGroup
+	ConsoleProperty models.ConsolePropertyDefinitionGroup
+	Property        models.PropertyDefinitionGroup
+}
+
+// loadDefinitionGroupsCached loads the definition groups through the parse
+// cache on ctx; the three definition files are its inputs.
+func loadDefinitionGroupsCached(ctx context.Context, repoPath string) (definitionGroups, error) {
+	limitsRoot := getLimitsRoot(repoPath)
+	return parsecache.Load(ctx, "definitions", limitsRoot, func(in *parsecache.Inputs) (definitionGroups, error) {
+		in.AddFiles(
+			getConfigPath(limitsRoot, limitsKey+definitionSuffix),
+			getConfigPath(limitsRoot, consolePropertiesKey+definitionSuffix),
+			getConfigPath(limitsRoot, propertiesKey+definitionSuffix),
+		)
+		limit, consoleProperty, property, err := loadDefinitionGroups(repoPath)
+		if err != nil {
+			return definitionGroups{}, err
+		}
+		return definitionGroups{Limit: *limit, ConsoleProperty: *consoleProperty, Property: *property}, nil
+	})
+}
+
 // loadDefinitionGroups loads the definition groups.
 // NOTE: Definitions are always loaded from the "oc1" realm, regardless of the user's selected realm.
 func loadDefinitionGroups(repoPath string) (
@@ -333,64 +439,80 @@ func loadDefinitionGroups(repoPath string) (
 ) {
 	limitsRoot := getLimitsRoot(repoPath)
 	limitDefinitionPath := getConfigPath(limitsRoot, limitsKey+definitionSuffix)
-	limitGroup, err := jsonutil.LoadFile[models.LimitDefinitionGroup](limitDefinitionPath)
+	limitGroup, lines, err := jsonutil.LoadFileWithLines[models.LimitDefinitionGroup](limitDefinitionPath, definitionValuesKey)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	for i := range limitGroup.Values {
+		limitGroup.Values[i].Source = sourceAt(limitDefinitionPath, lines, i)
+	}
 
 	consolePropertyDefinitionPath := getConfigPath(limitsRoot, consolePropertiesKey+definitionSuffix)
-	consolePropertyDefinitionGroup, err := jsonutil.LoadFile[models.ConsolePropertyDefinitionGroup](consolePropertyDefinitionPath)
+	consolePropertyDefinitionGroup, lines, err := jsonutil.LoadFileWithLines[models.ConsolePropertyDefinitionGroup](consolePropertyDefinitionPath, definitionValuesKey)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	for i := range consolePropertyDefinitionGroup.Values {
+		consolePropertyDefinitionGroup.Values[i].Source = sourceAt(consolePropertyDefinitionPath, lines, i)
+	}
 
 	propertyDefinitionPath := getConfigPath(limitsRoot, propertiesKey+definitionSuffix)
-	propertyDefinitionGroup, err := jsonutil.LoadFile[models.PropertyDefinitionGroup](propertyDefinitionPath)
+	propertyDefinitionGroup, lines, err := jsonutil.LoadFileWithLines[models.PropertyDefinitionGroup](propertyDefinitionPath, definitionValuesKey)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	for i := range propertyDefinitionGroup.Values {
+		propertyDefinitionGroup.Values[i].Source = sourceAt(propertyDefinitionPath, lines, i)
+	}
 
 	return limitGroup, consolePropertyDefinitionGroup, propertyDefinitionGroup, nil
 }
 
 /*
 LoadTenancyOverrideGroup loads tenants and all tenancy override maps for a given realm.
+The three maps load concurrently.
 */
 func LoadTenancyOverrideGroup(ctx context.Context, repoPath, realm string, metadata *models.Metadata) (models.TenancyOverrideGroup, error) {
-	tenantMap := make(map[string]idSet)
-	limitsRoot := getLimitsRoot(repoPath)
-
-	limitTenancyOverrideMap, err := loadAndStamp(
-		ctx, limitsRoot, realm, limitsKey+tenancyOverridesKey, tenantMap,
-		func(v *models.LimitTenancyOverride, name string) { v.SetTenantName(name) },
-	)
-	if err != nil {
-		return models.TenancyOverrideGroup{}, err
-	}
-
-	consolePropertyTenancyOverrideMap, err := loadAndStamp(
-		ctx, limitsRoot, realm, consolePropertiesKey+tenancyOverridesKey, tenantMap,
-		func(v *models.ConsolePropertyTenancyOverride, name string) { v.SetTenantName(name) },
-	)
-	if err != nil {
+	var group models.TenancyOverrideGroup
+	if err := runLoadTasks(ctx, tenancyOverrideTasks(repoPath, realm, &group)); err != nil {
 		return models.TenancyOverrideGroup{}, err
 	}
+	group.Tenants = tenantsOf(group, realm, metadata)
+	return group, nil
+}
 
-	propertyTenancyOverrideMap, err := loadAndStamp(
-		ctx, limitsRoot, realm, propertiesKey+tenancyOverridesKey, tenantMap,
-		func(v *models.PropertyTenancyOverride, name string) { v.SetTenantName(name) },
-	)
-	if err != nil {
-		return models.TenancyOverrideGroup{}, err
+// tenancyOverrideTasks returns the tasks loading the realm's three
+// tenancy override maps into group. Tenants are derived from all three,
+// so tenantsOf fills them in once the tasks are done.
+func tenancyOverrideTasks(repoPath, realm string, group *models.TenancyOverrideGroup) []loadTask {
+	limitsRoot := getLimitsRoot(repoPath)
+	return []loadTask{
+		{name: "limit tenancy overrides", load: func(ctx context.Context) (err error) {
+			group.LimitTenancyOverrideMap, err = loadAndStamp(ctx, limitsRoot, realm, limitsKey+tenancyOverridesKey,
+				func(v *models.LimitTenancyOverride, name string) { v.SetTenantName(name) })
+			return err
+		}},
+		{name: "console property tenancy overrides", load: func(ctx context.Context) (err error) {
+			group.ConsolePropertyTenancyOverrideMap, err = loadAndStamp(ctx, limitsRoot, realm, consolePropertiesKey+tenancyOverridesKey,
+				func(v *models.ConsolePropertyTenancyOverride, name string) { v.SetTenantName(name) })
+			return err
+		}},
+		{name: "property tenancy overrides", load: func(ctx context.Context) (err error) {
+			group.PropertyTenancyOverrideMap, err = loadAndStamp(ctx, limitsRoot, realm, propertiesKey+tenancyOverridesKey,
+				func(v *models.PropertyTenancyOverride, name string) { v.SetTenantName(name) })
+			return err
+		}},
 	}
+}
 
-	tenants := getTenants(tenantMap, metadata.GetTenants(realm))
-	return models.TenancyOverrideGroup{
-		Tenants:                           tenants,
-		LimitTenancyOverrideMap:           limitTenancyOverrideMap,
-		ConsolePropertyTenancyOverrideMap: consolePropertyTenancyOverrideMap,
-		PropertyTenancyOverrideMap:        propertyTenancyOverrideMap,
-	}, nil
+// tenantsOf returns the tenants named by group's override maps, merged
+// with the realm's tenant metadata.
+func tenantsOf(group models.TenancyOverrideGroup, realm string, metadata *models.Metadata) []models.Tenant {
+	tenantMap := make(map[string]idSet)
+	updateTenants(tenantMap, group.LimitTenancyOverrideMap)
+	updateTenants(tenantMap, group.ConsolePropertyTenancyOverrideMap)
+	updateTenants(tenantMap, group.PropertyTenancyOverrideMap)
+	return getTenants(tenantMap, metadata.GetTenants(realm))
 }
 
 // LoadLimitRegionalOverrides loads limit regional overrides for the given repo path and realm.
@@ -3,15 +3,18 @@ package configloader
 import (
 	"context"
 	"encoding/json"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -326,8 +329,11 @@ func TestLoadDataset_ContextCanceled(t *testing.T) {
 	require.Error(t, err)
 }
 
-func TestLoadDataset_Success(t *testing.T) {
-	t.Parallel()
+// writeDatasetRepo lays out a minimal repo LoadDataset can read: one of
+// each definition, tenancy override and regional override, a service
+// tenancy and an empty model artifact map.
+func writeDatasetRepo(t *testing.T) string {
+	t.Helper()
 	tmp := t.TempDir()
 	realm := "oc1"
 	// Create minimal limit definition group
@@ -414,9 +420,6 @@ locals {
 	// Add a dummy file for limits_regional_overrides to avoid directory read error
 	_ = os.WriteFile(filepath.Join(limitRegOverrideDir, "dummy.json"), []byte("{}"), 0o600)
 
-	// Environment
-	env := models.Environment{Type: "dev", Region: "us-phx-1", Realm: realm}
-
 	// Create minimal .tf file for ServiceTenancy in shep_targets
 	shepTargetsDir := filepath.Join(tmp, "shared_modules/shep_targets")
 	_ = os.MkdirAll(shepTargetsDir, 0o750)
@@ -433,6 +436,19 @@ locals {
 	tfPath := filepath.Join(shepTargetsDir, "tenancy.tf")
 	_ = os.WriteFile(tfPath, []byte(tfContent), 0o600)
 
+	return tmp
+}
+
+func TestLoadDataset_Success(t *testing.T) {
+	t.Parallel()
+	tmp := writeDatasetRepo(t)
+	env := models.Environment{Type: "dev", Region: "us-phx-1", Realm: "oc1"}
+	limitsDir := filepath.Join(tmp, "shared_modules/limits")
+	limitDefPath := filepath.Join(limitsDir, "limits_definitions", "oc1_limits_definition.json")
+	propRegOverridePath := filepath.Join(limitsDir, "properties_regional_overrides", "regional_values", "oc1", "properties_regional_overrides.json")
+	limitOverridePath := filepath.Join(limitsDir, "limits_tenancy_overrides", "regional_values", "oc1", "tenant1", "limits_tenancy_overrides.json")
+	tfPath := filepath.Join(tmp, "shared_modules/shep_targets", "tenancy.tf")
+
 	ds, err := LoadDataset(context.Background(), tmp, env, &models.Metadata{})
 	require.NoError(t, err)
 	assert.Equal(t, "foo", ds.LimitDefinitionGroup.Values[0].Name)
@@ -441,6 +457,50 @@ locals {
 	assert.Equal(t, "tenant1", ds.Tenants[0].Name)
 	assert.Equal(t, "cpr", ds.ConsolePropertyRegionalOverrides[0].Name)
 	assert.Equal(t, "pr", ds.PropertyRegionalOverrides[0].Name)
+
+	// Every repo-backed item records the file it came from; definitions
+	// share a file, so they also carry the line their entry starts on.
+	assert.Equal(t, &models.SourceLocation{File: limitDefPath, Line: 1}, ds.LimitDefinitionGroup.Values[0].Source)
+	assert.Equal(t, &models.SourceLocation{File: propRegOverridePath}, ds.PropertyRegionalOverrides[0].Source)
+	assert.Equal(t, &models.SourceLocation{File: limitOverridePath}, ds.LimitTenancyOverrideMap["tenant1"][0].Source)
+	require.NotNil(t, ds.ServiceTenancies[0].Source)
+	assert.Equal(t, models.SourceLocation{File: tfPath, Line: 3, Column: 3}, *ds.ServiceTenancies[0].Source)
+}
+
+func TestLoadDataset_ParseCache(t *testing.T) {
+	t.Parallel()
+	tmp := writeDatasetRepo(t)
+	// Inputs written moments before a load are not cached; backdate them.
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, filepath.WalkDir(tmp, func(path string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(path, past, past)
+	}))
+	env := models.Environment{Type: "dev", Region: "us-phx-1", Realm: "oc1"}
+	cache := parsecache.New(t.TempDir())
+	ctx := parsecache.WithContext(context.Background(), cache)
+
+	cold, err := LoadDataset(ctx, tmp, env, &models.Metadata{})
+	require.NoError(t, err)
+	st, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		"definitions": 1, "tenancy-overrides": 3, "regional-overrides": 3, "service-tenancies": 1, "model-artifacts": 1,
+	}, st.Kinds)
+
+	warm, err := LoadDataset(ctx, tmp, env, &models.Metadata{})
+	require.NoError(t, err)
+	assert.Equal(t, cold, warm, "a warm load returns what the cold one parsed")
+
+	// Editing one override re-parses its subtree.
+	override := filepath.Join(tmp, "shared_modules/limits/properties_regional_overrides/regional_values/oc1/properties_regional_overrides.json")
+	require.NoError(t, os.WriteFile(override, []byte(`{"name":"pr2"}`), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	edited, err := LoadDataset(ctx, tmp, env, &models.Metadata{})
+	require.NoError(t, err)
+	assert.Equal(t, "pr2", edited.PropertyRegionalOverrides[0].Name)
+	assert.Equal(t, cold.LimitDefinitionGroup, edited.LimitDefinitionGroup)
 }
 
 func TestValidateEnvironment_Success(t *testing.T) {
@@ -0,0 +1,51 @@
+package configloader
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+)
+
+// maxParallelLoads bounds how many parts of the repo load at once. The
+// parts are a mix of file I/O and JSON/HCL decoding, and there are fewer
+// than a dozen of them, so this mostly guards against oversubscribing
+// small machines.
+const maxParallelLoads = 8
+
+// loadTask is one independently loadable part of the repo. Tasks run
+// concurrently, so each must write only to state no other task touches.
+type loadTask struct {
+	name string
+	load func(ctx context.Context) error
+}
+
+/*
+runLoadTasks runs tasks concurrently, at most maxParallelLoads at a time,
+and waits for them. The first failure cancels the context passed to the
+others, and tasks not yet started are skipped; that failure is returned.
+Each task's duration is logged at debug, so a slow part of the repo stands
+out in the log.
+*/
+func runLoadTasks(ctx context.Context, tasks []loadTask) error {
+	logger := logging.FromContext(ctx)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallelLoads)
+	for _, task := range tasks {
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			start := time.Now()
+			if err := task.load(gctx); err != nil {
+				logger.Debugw("failed to load repo part", "part", task.name, "elapsed", time.Since(start), "error", err)
+				return err
+			}
+			logger.Debugw("loaded repo part", "part", task.name, "elapsed", time.Since(start))
+			return nil
+		})
+	}
+	return g.Wait()
+}
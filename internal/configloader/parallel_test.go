@@ -0,0 +1,95 @@
+package configloader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestRunLoadTasks_RunsConcurrently(t *testing.T) {
+	t.Parallel()
+	// Each task waits for the other: sequential execution would time out.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	wait := func(ctx context.Context) error {
+		wg.Done()
+		done := make(chan struct{})
+		go func() { wg.Wait(); close(done) }()
+		select {
+		case <-done:
+			return nil
+		case <-time.After(2 * time.Second):
+			return errors.New("tasks did not overlap")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	err := runLoadTasks(context.Background(), []loadTask{{name: "a", load: wait}, {name: "b", load: wait}})
+	require.NoError(t, err)
+}
+
+func TestRunLoadTasks_BoundsParallelism(t *testing.T) {
+	t.Parallel()
+	var running, peak atomic.Int32
+	task := func(context.Context) error {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	}
+	tasks := make([]loadTask, 3*maxParallelLoads)
+	for i := range tasks {
+		tasks[i] = loadTask{name: "t", load: task}
+	}
+	require.NoError(t, runLoadTasks(context.Background(), tasks))
+	assert.LessOrEqual(t, int(peak.Load()), maxParallelLoads)
+	assert.Greater(t, int(peak.Load()), 1)
+}
+
+func TestRunLoadTasks_FailureCancelsOthers(t *testing.T) {
+	t.Parallel()
+	boom := errors.New("boom")
+	var cancelled atomic.Bool
+	started := make(chan struct{})
+	err := runLoadTasks(context.Background(), []loadTask{
+		{name: "slow", load: func(ctx context.Context) error {
+			close(started)
+			select {
+			case <-ctx.Done():
+				cancelled.Store(true)
+				return ctx.Err()
+			case <-time.After(2 * time.Second):
+				return nil
+			}
+		}},
+		{name: "failing", load: func(context.Context) error {
+			<-started
+			return boom
+		}},
+	})
+	require.ErrorIs(t, err, boom)
+	assert.True(t, cancelled.Load(), "the failure cancels the other tasks")
+}
+
+func TestLoadDataset_Cancelled(t *testing.T) {
+	t.Parallel()
+	repo := writeDatasetRepo(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := LoadDataset(ctx, repo, models.Environment{Type: "dev", Region: "us-ashburn-1", Realm: "oc1"}, &models.Metadata{})
+	require.ErrorIs(t, err, context.Canceled)
+}
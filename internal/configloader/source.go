@@ -0,0 +1,94 @@
+package configloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/encoding/jsonutil"
+	"github.com/jingle2008/toolkit/internal/fileutil"
+)
+
+// ErrNoSourceFile is returned by SourceFile for categories that are not
+// backed by a single JSON file under shared_modules/limits (k8s-derived
+// categories, Terraform-derived ones, tenants).
+var ErrNoSourceFile = errors.New("category is not backed by a repo file")
+
+// sourceKeys maps each file-backed category to its directory key under
+// shared_modules/limits — the same keys LoadDataset reads them from.
+var sourceKeys = map[domain.Category]string{
+	domain.LimitDefinition:                 limitsKey + definitionSuffix,
+	domain.ConsolePropertyDefinition:       consolePropertiesKey + definitionSuffix,
+	domain.PropertyDefinition:              propertiesKey + definitionSuffix,
+	domain.LimitTenancyOverride:            limitsKey + tenancyOverridesKey,
+	domain.ConsolePropertyTenancyOverride:  consolePropertiesKey + tenancyOverridesKey,
+	domain.PropertyTenancyOverride:         propertiesKey + tenancyOverridesKey,
+	domain.LimitRegionalOverride:           limitsKey + regionalOverridesKey,
+	domain.ConsolePropertyRegionalOverride: consolePropertiesKey + regionalOverridesKey,
+	domain.PropertyRegionalOverride:        propertiesKey + regionalOverridesKey,
+}
+
+// HasSourceFile reports whether items of cat are backed by a repo file
+// that SourceFile can locate.
+func HasSourceFile(cat domain.Category) bool {
+	_, ok := sourceKeys[cat]
+	return ok
+}
+
+/*
+SourceFile returns the absolute path of the repo file that backs the named
+item, mirroring the layout LoadDataset reads:
+
+  - definitions live in one shared file per kind (always the oc1 realm), so
+    every definition of a kind maps to the same file;
+  - regional overrides are one file per record under regional_values/<realm>;
+  - tenancy overrides are one file per record under
+    regional_values/<realm>/<tenant>, so tenant (the directory name) is
+    required.
+
+Override files are named freely, so the directory is scanned and the first
+file (in the loader's order) whose "name" matches is returned — the same
+record findItem resolves to in the TUI.
+*/
+func SourceFile(ctx context.Context, repoPath, realm string, cat domain.Category, tenant, name string) (string, error) {
+	key, ok := sourceKeys[cat]
+	if !ok {
+		return "", fmt.Errorf("%s: %w", cat, ErrNoSourceFile)
+	}
+	limitsRoot := getLimitsRoot(repoPath)
+
+	var dir string
+	switch cat { //nolint:exhaustive // only file-backed categories reach here (sourceKeys)
+	case domain.LimitDefinition, domain.ConsolePropertyDefinition, domain.PropertyDefinition:
+		return getConfigPath(limitsRoot, key), nil
+	case domain.LimitTenancyOverride, domain.ConsolePropertyTenancyOverride, domain.PropertyTenancyOverride:
+		if tenant == "" {
+			return "", fmt.Errorf("%s %q: tenant is required", cat, name)
+		}
+		dir = filepath.Join(limitsRoot, key, regionalValuesDir, realm, tenant)
+	default:
+		dir = filepath.Join(limitsRoot, key, regionalValuesDir, realm)
+	}
+
+	files, err := fileutil.ListFiles(ctx, dir, ".json")
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		rec, err := jsonutil.LoadFile[namedRecord](file)
+		if err != nil {
+			return "", err
+		}
+		if rec.Name == name {
+			return file, nil
+		}
+	}
+	return "", fmt.Errorf("%s %q not found under %s", cat, name, dir)
+}
+
+// namedRecord decodes just the "name" field shared by every override record.
+type namedRecord struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,67 @@
+package configloader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+)
+
+func TestSourceFile(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	realm := "oc1"
+	limitsRoot := filepath.Join(root, "shared_modules/limits")
+	write := func(rel, body string) string {
+		t.Helper()
+		path := filepath.Join(limitsRoot, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750)) // #nosec G301
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+		return path
+	}
+	tenancyA := write("limits_tenancy_overrides/regional_values/oc1/acme/a.json", `{"name":"other"}`)
+	tenancyB := write("limits_tenancy_overrides/regional_values/oc1/acme/b.json", `{"name":"gpu-count"}`)
+	regional := write("properties_regional_overrides/regional_values/oc1/x.json", `{"name":"flag"}`)
+	ctx := context.Background()
+
+	got, err := SourceFile(ctx, root, realm, domain.LimitTenancyOverride, "acme", "gpu-count")
+	require.NoError(t, err)
+	assert.Equal(t, tenancyB, got)
+
+	got, err = SourceFile(ctx, root, realm, domain.LimitTenancyOverride, "acme", "other")
+	require.NoError(t, err)
+	assert.Equal(t, tenancyA, got)
+
+	got, err = SourceFile(ctx, root, realm, domain.PropertyRegionalOverride, "", "flag")
+	require.NoError(t, err)
+	assert.Equal(t, regional, got)
+
+	// Definitions share one file per kind; the name is not consulted.
+	got, err = SourceFile(ctx, root, realm, domain.LimitDefinition, "", "anything")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(limitsRoot, "limits_definitions", "oc1_limits_definition.json"), got)
+}
+
+func TestSourceFile_Errors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	_, err := SourceFile(ctx, "/repo", "oc1", domain.GPUNode, "", "n")
+	require.ErrorIs(t, err, ErrNoSourceFile)
+	assert.False(t, HasSourceFile(domain.GPUNode))
+	assert.True(t, HasSourceFile(domain.LimitTenancyOverride))
+
+	_, err = SourceFile(ctx, "/repo", "oc1", domain.LimitTenancyOverride, "", "n")
+	require.ErrorContains(t, err, "tenant is required")
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "shared_modules/limits/limits_regional_overrides/regional_values/oc1")
+	require.NoError(t, os.MkdirAll(dir, 0o750)) // #nosec G301
+	_, err = SourceFile(ctx, root, "oc1", domain.LimitRegionalOverride, "", "missing")
+	require.ErrorContains(t, err, "not found")
+}
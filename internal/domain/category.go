@@ -60,8 +60,24 @@ const (
 	// requests nvidia.com/gpu). Scoped by GPUNode: GPUPool → GPUNode →
 	// GPUWorkload.
 	GPUWorkload
+	// PendingGPUWorkload is a category for GPU-requesting pods the
+	// scheduler has not placed on a node yet. Top-level: without a node
+	// there is no GPUNode to scope them by.
+	PendingGPUWorkload
 	// DedicatedAICluster is a category for dedicated AI clusters.
 	DedicatedAICluster
+	// Endpoint is a category for GenAI endpoints, listed from the
+	// GenerativeAI service. Scoped by DedicatedAICluster: Tenant →
+	// DedicatedAICluster → Endpoint.
+	Endpoint
+	// Event is a category for Kubernetes events about GPU nodes and
+	// GPU workload pods, grouped by the involved object's name. Scoped
+	// by both GPUNode and GPUWorkload.
+	Event
+	// WorkRequest is a category for recent asynchronous OCI operations
+	// (compute, compute management and GenerativeAI work requests) in
+	// the compartments of the cluster's GPU nodes and DACs.
+	WorkRequest
 	// Alias is a category for reporting all aliases.
 	Alias
 )
@@ -114,7 +130,11 @@ func (e Category) ScopedCategories() []Category {
 	case GPUPool:
 		return []Category{GPUNode}
 	case GPUNode:
-		return []Category{GPUWorkload}
+		return []Category{GPUWorkload, Event}
+	case GPUWorkload:
+		return []Category{Event}
+	case DedicatedAICluster:
+		return []Category{Endpoint}
 	default:
 		return nil
 	}
@@ -140,6 +160,11 @@ Aliases returns a list of aliases for the Category.
 func (e Category) Aliases() []string {
 	cat := e.String()
 	short := uppercaseLetters(cat)
+	switch e { //nolint:exhaustive
+	case Endpoint, Event:
+		// "E" alone would collide with Environment's short alias.
+		short = cat[:2]
+	}
 	aliases := []string{strings.ToLower(short), strings.ToLower(cat)}
 
 	// Preserve short aliases that users typed before the GPU/DAC
@@ -155,6 +180,8 @@ func (e Category) Aliases() []string {
 		aliases = append(aliases, "dac")
 	case GPUWorkload:
 		aliases = append(aliases, "gw")
+	case PendingGPUWorkload:
+		aliases = append(aliases, "pgw")
 	}
 	return aliases
 }
@@ -183,10 +210,23 @@ func (e Category) IsFaulty() bool {
 
 // NeedsKubeConfig reports whether loading this category requires a
 // kubeconfig. These categories are sourced from a live cluster; the
-// rest come from the on-disk repo.
+// rest come from the on-disk repo. Endpoint and WorkRequest are listed
+// from OCI but need the cluster's DACs (and, for WorkRequest, GPU nodes)
+// to know which compartments to list.
 func (e Category) NeedsKubeConfig() bool {
 	switch e { //nolint:exhaustive
-	case BaseModel, ImportedModel, GPUNode, DedicatedAICluster, GPUWorkload:
+	case BaseModel, ImportedModel, GPUNode, DedicatedAICluster, GPUWorkload, PendingGPUWorkload, Endpoint, Event, WorkRequest:
+		return true
+	}
+	return false
+}
+
+// HasRawObject reports whether items of this category are flattened from
+// a single live Kubernetes object (a CR, Node or Pod) that can be fetched
+// and shown as-is.
+func (e Category) HasRawObject() bool {
+	switch e { //nolint:exhaustive
+	case BaseModel, ImportedModel, DedicatedAICluster, GPUNode, GPUWorkload:
 		return true
 	}
 	return false
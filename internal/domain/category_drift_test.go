@@ -29,7 +29,11 @@ func TestNeedsKubeConfig_EveryCategoryAccountedFor(t *testing.T) {
 		GPUPool:                         false,
 		GPUNode:                         true,
 		GPUWorkload:                     true,
+		PendingGPUWorkload:              true,
 		DedicatedAICluster:              true,
+		Endpoint:                        true,
+		Event:                           true,
+		WorkRequest:                     true,
 		Alias:                           false,
 	}
 	require.Len(t, want, len(Categories), "every category must have an expected NeedsKubeConfig value")
@@ -27,13 +27,17 @@ func _() {
 	_ = x[GPUPool-16]
 	_ = x[GPUNode-17]
 	_ = x[GPUWorkload-18]
-	_ = x[DedicatedAICluster-19]
-	_ = x[Alias-20]
+	_ = x[PendingGPUWorkload-19]
+	_ = x[DedicatedAICluster-20]
+	_ = x[Endpoint-21]
+	_ = x[Event-22]
+	_ = x[WorkRequest-23]
+	_ = x[Alias-24]
 }
 
-const _Category_name = "CategoryUnknownTenantLimitDefinitionConsolePropertyDefinitionPropertyDefinitionLimitTenancyOverrideConsolePropertyTenancyOverridePropertyTenancyOverrideLimitRegionalOverrideConsolePropertyRegionalOverridePropertyRegionalOverrideBaseModelImportedModelModelArtifactEnvironmentServiceTenancyGPUPoolGPUNodeGPUWorkloadDedicatedAIClusterAlias"
+const _Category_name = "CategoryUnknownTenantLimitDefinitionConsolePropertyDefinitionPropertyDefinitionLimitTenancyOverrideConsolePropertyTenancyOverridePropertyTenancyOverrideLimitRegionalOverrideConsolePropertyRegionalOverridePropertyRegionalOverrideBaseModelImportedModelModelArtifactEnvironmentServiceTenancyGPUPoolGPUNodeGPUWorkloadPendingGPUWorkloadDedicatedAIClusterEndpointEventWorkRequestAlias"
 
-var _Category_index = [...]uint16{0, 15, 21, 36, 61, 79, 99, 129, 152, 173, 204, 228, 237, 250, 263, 274, 288, 295, 302, 313, 331, 336}
+var _Category_index = [...]uint16{0, 15, 21, 36, 61, 79, 99, 129, 152, 173, 204, 228, 237, 250, 263, 274, 288, 295, 302, 313, 331, 349, 357, 362, 373, 378}
 
 func (i Category) String() string {
 	if i < 0 || i >= Category(len(_Category_index)-1) {
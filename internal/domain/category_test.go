@@ -31,7 +31,11 @@ func TestCategory_String(t *testing.T) {
 		{GPUPool, "GPUPool"},
 		{GPUNode, "GPUNode"},
 		{GPUWorkload, "GPUWorkload"},
+		{PendingGPUWorkload, "PendingGPUWorkload"},
 		{DedicatedAICluster, "DedicatedAICluster"},
+		{Endpoint, "Endpoint"},
+		{Event, "Event"},
+		{WorkRequest, "WorkRequest"},
 		{Category(99), "Category(99)"},
 	}
 	for _, tt := range tests {
@@ -46,11 +50,12 @@ func TestCategory_IsScope(t *testing.T) {
 	t.Parallel()
 	scopeCases := []Category{
 		Tenant, LimitDefinition, ConsolePropertyDefinition, PropertyDefinition, GPUPool, GPUNode,
+		GPUWorkload, DedicatedAICluster,
 	}
 	nonScopeCases := []Category{
 		LimitTenancyOverride, ConsolePropertyTenancyOverride, PropertyTenancyOverride,
 		ConsolePropertyRegionalOverride, PropertyRegionalOverride, ModelArtifact,
-		Environment, ServiceTenancy, DedicatedAICluster,
+		Environment, ServiceTenancy, PendingGPUWorkload, Endpoint, Event, WorkRequest,
 	}
 	for _, c := range scopeCases {
 		t.Run("scope_"+c.String(), func(t *testing.T) {
@@ -78,7 +83,9 @@ func TestCategory_ScopedCategories(t *testing.T) {
 		{ConsolePropertyDefinition, []Category{ConsolePropertyTenancyOverride, ConsolePropertyRegionalOverride}},
 		{PropertyDefinition, []Category{PropertyTenancyOverride, PropertyRegionalOverride}},
 		{GPUPool, []Category{GPUNode}},
-		{GPUNode, []Category{GPUWorkload}},
+		{GPUNode, []Category{GPUWorkload, Event}},
+		{GPUWorkload, []Category{Event}},
+		{DedicatedAICluster, []Category{Endpoint}},
 	}
 	for _, tc := range cases {
 		t.Run(tc.scope.String(), func(t *testing.T) {
@@ -102,10 +109,13 @@ func TestCategory_Parents(t *testing.T) {
 		{ImportedModel, []Category{Tenant}},
 		{GPUNode, []Category{GPUPool}},
 		{GPUWorkload, []Category{GPUNode}},
+		{Endpoint, []Category{DedicatedAICluster}},
 		{LimitRegionalOverride, []Category{LimitDefinition}},
 		{ConsolePropertyRegionalOverride, []Category{ConsolePropertyDefinition}},
 		{PropertyRegionalOverride, []Category{PropertyDefinition}},
-		// Dual-parent children: scoped by both a Tenant and a Definition.
+		// Dual-parent children: scoped by both a Tenant and a Definition,
+		// or (Event) by both a node and a workload.
+		{Event, []Category{GPUNode, GPUWorkload}},
 		{LimitTenancyOverride, []Category{Tenant, LimitDefinition}},
 		{ConsolePropertyTenancyOverride, []Category{Tenant, ConsolePropertyDefinition}},
 		{PropertyTenancyOverride, []Category{Tenant, PropertyDefinition}},
@@ -194,6 +204,9 @@ func TestCategory_NeedsKubeConfig(t *testing.T) {
 		{GPUNode, true},
 		{DedicatedAICluster, true},
 		{GPUWorkload, true},
+		{PendingGPUWorkload, true},
+		{Event, true},
+		{WorkRequest, true},
 		{Tenant, false},
 	}
 	for _, tt := range tests {
@@ -213,7 +226,7 @@ func TestParseCategory_Unknown(t *testing.T) {
 
 func TestCategory_GPUWorkload(t *testing.T) {
 	t.Parallel()
-	assert.Equal(t, []Category{GPUWorkload}, GPUNode.ScopedCategories())
+	assert.Equal(t, GPUWorkload, GPUNode.ScopedCategories()[0], "Enter on a node drills into its workloads")
 	assert.True(t, GPUNode.IsScope())
 	assert.Equal(t, []Category{GPUNode}, GPUWorkload.Parents())
 	assert.True(t, GPUWorkload.NeedsKubeConfig())
@@ -221,3 +234,39 @@ func TestCategory_GPUWorkload(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, GPUWorkload, c)
 }
+
+func TestCategory_Event(t *testing.T) {
+	t.Parallel()
+	assert.ElementsMatch(t, []Category{GPUNode, GPUWorkload}, Event.Parents())
+	assert.Equal(t, []Category{Event}, GPUWorkload.ScopedCategories())
+	assert.True(t, Event.NeedsKubeConfig())
+	assert.Equal(t, []string{"ev", "event"}, Event.Aliases())
+	c, err := ParseCategory("ev")
+	require.NoError(t, err)
+	assert.Equal(t, Event, c)
+	c, err = ParseCategory("e")
+	require.NoError(t, err)
+	assert.Equal(t, Environment, c, "the lone short alias stays with Environment")
+}
+
+func TestCategory_PendingGPUWorkload(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, PendingGPUWorkload.Parents(), "unscheduled pods have no node to be scoped by")
+	assert.True(t, PendingGPUWorkload.NeedsKubeConfig())
+	assert.False(t, PendingGPUWorkload.HasRawObject())
+	assert.Equal(t, []string{"pgpuw", "pendinggpuworkload", "pgw"}, PendingGPUWorkload.Aliases())
+	c, err := ParseCategory("pgw")
+	require.NoError(t, err)
+	assert.Equal(t, PendingGPUWorkload, c)
+}
+
+func TestCategory_WorkRequest(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, WorkRequest.Parents())
+	assert.True(t, WorkRequest.NeedsKubeConfig())
+	assert.False(t, WorkRequest.HasRawObject())
+	assert.Equal(t, []string{"wr", "workrequest"}, WorkRequest.Aliases())
+	c, err := ParseCategory("wr")
+	require.NoError(t, err)
+	assert.Equal(t, WorkRequest, c)
+}
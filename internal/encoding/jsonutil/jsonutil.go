@@ -5,9 +5,12 @@ including secure file loading and pretty-printing of JSON data.
 package jsonutil
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/jingle2008/toolkit/internal/fileutil"
 )
@@ -56,3 +59,73 @@ func Pretty[T any](object T) (string, error) {
 	}
 	return string(data), nil
 }
+
+/*
+LoadFileWithLines is LoadFile that also returns, for each element of the
+array held by the top-level object field key, the 1-based line on which
+that element starts. It is how loaders attribute entries of a shared
+definitions file to their position in it.
+
+Returns:
+  - *T: pointer to the decoded object
+  - []int: one line per array element, in order; nil if key is absent
+  - error: if the file cannot be read or decoded
+*/
+func LoadFileWithLines[T any](path, key string) (*T, []int, error) {
+	allowedExt := map[string]struct{}{".json": {}}
+	jsonData, err := fileutil.SafeReadFile(path, filepath.Dir(path), allowedExt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	var data T
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	lines, err := elementLines(jsonData, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to locate %q elements: %w", key, err)
+	}
+	return &data, lines, nil
+}
+
+// elementLines walks the top-level object in data and returns the start
+// line of each element of the array under key.
+func elementLines(data []byte, key string) ([]int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("top level is not an object")
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if name, _ := tok.(string); name != key {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+			return nil, fmt.Errorf("%q is not an array", key)
+		}
+		var lines []int
+		for dec.More() {
+			// InputOffset is the end of the previous token; the element
+			// starts after any whitespace and the separating comma.
+			start := int(dec.InputOffset())
+			for start < len(data) && strings.IndexByte(" \t\r\n,", data[start]) >= 0 {
+				start++
+			}
+			lines = append(lines, bytes.Count(data[:start], []byte("\n"))+1)
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+		return lines, nil
+	}
+	return nil, nil
+}
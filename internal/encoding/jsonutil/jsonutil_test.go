@@ -132,3 +132,37 @@ func TestLoadFile_FromTestdata(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 123, val.A)
 }
+
+func TestLoadFileWithLines(t *testing.T) {
+	t.Parallel()
+	type group struct {
+		Name   string `json:"group"`
+		Values []struct {
+			Name string `json:"name"`
+		} `json:"values"`
+	}
+	path := t.TempDir() + "/defs.json"
+	body := `{
+  "meta": {"values": [1, 2]},
+  "group": "g",
+  "values": [
+    {"name": "a"},
+    {
+      "name": "b"
+    }, {"name": "c"}
+  ]
+}`
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o600)) // #nosec G306
+
+	g, lines, err := LoadFileWithLines[group](path, "values")
+	require.NoError(t, err)
+	require.Len(t, g.Values, 3)
+	assert.Equal(t, []int{5, 6, 8}, lines)
+
+	_, lines, err = LoadFileWithLines[group](path, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, lines)
+
+	_, _, err = LoadFileWithLines[group](path, "group")
+	require.Error(t, err)
+}
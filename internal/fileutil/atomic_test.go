@@ -0,0 +1,35 @@
+package fileutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "state.json")
+
+	require.NoError(t, WriteFileAtomic(path, []byte("one"), 0o600))
+	require.NoError(t, WriteFileAtomic(path, []byte("two"), 0o600))
+	got, err := os.ReadFile(path) //nolint:gosec // test reads its own temp file
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(got))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temp file is left behind")
+
+	// A path whose parent is a file cannot be written, and leaves it intact.
+	require.Error(t, WriteFileAtomic(filepath.Join(path, "child"), []byte("x"), 0o600))
+	got, err = os.ReadFile(path) //nolint:gosec // test reads its own temp file
+	require.NoError(t, err)
+	assert.Equal(t, "two", string(got))
+}
@@ -1,7 +1,7 @@
 // Package fswatch provides a recursive, debounced filesystem watcher that
-// emits a coalesced "something changed" trigger — the same channel shape the
-// k8s watch feeds into the TUI reducer. It is used to make repo-backed
-// categories live by reloading the dataset when the working tree changes.
+// emits the coalesced set of paths changed in each debounce window. It is
+// used to make repo-backed categories live by reloading the parts of the
+// dataset those paths back when the working tree changes.
 package fswatch
 
 import (
@@ -9,6 +9,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,23 +19,29 @@ import (
 )
 
 // Watch establishes a recursive filesystem watch rooted at root and returns a
-// coalesced trigger channel: one value per debounce window in which any
-// non-hidden file under root changed. Dot-directories (.git, .idea, …) are
-// excluded. The caller owns ctx; cancelling it stops the watcher and closes
-// the channel. The channel also closes if the watcher backend dies, which the
-// caller treats as a fallback signal.
-func Watch(ctx context.Context, root string, window time.Duration) (<-chan struct{}, error) {
+// coalesced change channel: one value per debounce window in which any
+// non-hidden, non-ignored path under root changed, carrying those paths
+// (slash-separated, relative to root, sorted and de-duplicated). A created or
+// removed directory is reported as the directory itself, not its contents,
+// with a trailing slash so consumers can tell it from an extensionless file.
+// Dot-directories (.git, .idea, …) and paths matched by the tree's
+// .gitignore files are excluded. The caller owns ctx; cancelling it stops the
+// watcher and closes the channel. The channel also closes if the watcher
+// backend dies, which the caller treats as a fallback signal.
+func Watch(ctx context.Context, root string, window time.Duration) (<-chan []string, error) {
 	w, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
 	}
-	if err := addRecursive(w, root); err != nil {
+	ig := newIgnorer(root)
+	dirs := make(map[string]struct{})
+	if err := addRecursive(w, ig, dirs, root); err != nil {
 		_ = w.Close()
 		return nil, err
 	}
 
-	out := make(chan struct{})
-	go run(ctx, w, root, window, out)
+	out := make(chan []string)
+	go run(ctx, w, ig, dirs, root, window, out)
 	return out, nil
 }
 
@@ -45,32 +52,52 @@ func isHidden(path string) bool {
 	return base != "." && base != ".." && strings.HasPrefix(base, ".")
 }
 
-// addRecursive adds root and every non-hidden subdirectory to w.
-func addRecursive(w *fsnotify.Watcher, root string) error {
-	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+// relPath returns path relative to root, slash-separated.
+func relPath(root, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// addRecursive adds dir and every non-hidden, non-ignored subdirectory to w
+// and to dirs, loading each directory's .gitignore into ig on the way down.
+func addRecursive(w *fsnotify.Watcher, ig *ignorer, dirs map[string]struct{}, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 		if !d.IsDir() {
 			return nil
 		}
-		if path != root && isHidden(path) {
+		rel := relPath(ig.root, path)
+		if path != dir && (isHidden(path) || ig.ignored(rel, true)) {
 			return fs.SkipDir
 		}
+		if rel == "." {
+			rel = ""
+		}
+		ig.load(rel)
+		dirs[path] = struct{}{}
 		return w.Add(path)
 	})
 }
 
 // run consumes fsnotify events, adds newly created directories on the fly,
-// ignores hidden paths, debounces into out, and tears everything down on ctx
-// cancel or backend error.
-func run(ctx context.Context, w *fsnotify.Watcher, root string, window time.Duration, out chan<- struct{}) { //nolint:cyclop // event-select loop; splitting it would obscure the debounce/watch lifecycle without reducing real complexity
+// ignores hidden and .gitignored paths (re-reading a .gitignore when it
+// changes, and adding the directories it stops ignoring), collects changed paths per debounce window into out, and tears
+// everything down on ctx cancel or backend error. dirs holds the watched
+// directories, which is how a removed path (no longer stat-able) is known
+// to have been a directory.
+func run(ctx context.Context, w *fsnotify.Watcher, ig *ignorer, dirs map[string]struct{}, root string, window time.Duration, out chan<- []string) { //nolint:cyclop // event-select loop; splitting it would obscure the debounce/watch lifecycle without reducing real complexity
 	defer close(out)
 	defer func() { _ = w.Close() }()
 
 	logging.FromContext(ctx).Infow("fs watch established", "root", root)
 
 	var timerC <-chan time.Time
+	changed := make(map[string]struct{})
 	for {
 		select {
 		case <-ctx.Done():
@@ -80,16 +107,35 @@ func run(ctx context.Context, w *fsnotify.Watcher, root string, window time.Dura
 			if !ok {
 				return
 			}
+			rel := relPath(root, ev.Name)
+			if filepath.Base(ev.Name) == gitignoreFile {
+				ig.load(strings.TrimSuffix(strings.TrimSuffix(rel, gitignoreFile), "/"))
+				// Directories the edit no longer ignores are not watched
+				// yet; watching the rest again is a no-op.
+				_ = addRecursive(w, ig, dirs, filepath.Dir(ev.Name))
+				continue
+			}
 			if isHidden(ev.Name) {
 				continue // ignore events under dot-dirs (e.g. .git churn)
 			}
+			info, statErr := os.Stat(ev.Name)
+			isDir := statErr == nil && info.IsDir()
+			if _, watched := dirs[ev.Name]; watched && statErr != nil {
+				isDir = true
+				delete(dirs, ev.Name)
+			}
+			if ig.ignored(rel, isDir) {
+				continue
+			}
 			// A newly created directory must be watched so its contents
 			// trigger too.
-			if ev.Op.Has(fsnotify.Create) {
-				if info, statErr := os.Stat(ev.Name); statErr == nil && info.IsDir() {
-					_ = addRecursive(w, ev.Name)
-				}
+			if isDir && ev.Op.Has(fsnotify.Create) {
+				_ = addRecursive(w, ig, dirs, ev.Name)
 			}
+			if isDir {
+				rel += "/"
+			}
+			changed[rel] = struct{}{}
 			if timerC == nil {
 				timerC = time.After(window)
 			}
@@ -101,8 +147,14 @@ func run(ctx context.Context, w *fsnotify.Watcher, root string, window time.Dura
 			return
 		case <-timerC:
 			timerC = nil
+			paths := make([]string, 0, len(changed))
+			for p := range changed {
+				paths = append(paths, p)
+			}
+			slices.Sort(paths)
+			clear(changed)
 			select {
-			case out <- struct{}{}:
+			case out <- paths:
 			case <-ctx.Done():
 				return
 			}
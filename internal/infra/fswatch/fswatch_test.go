@@ -51,6 +51,39 @@ func TestWatch_RecursiveNewSubdir(t *testing.T) {
 	}
 }
 
+// A created or removed directory is reported with a trailing slash, so
+// ChangedParts can tell it from an extensionless file.
+func TestWatch_DirectoriesCarryTrailingSlash(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "old"), 0o750)) //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	trig, err := Watch(ctx, dir, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "new"), 0o750))               //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Makefile"), nil, 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.Remove(filepath.Join(dir, "old")))
+
+	seen := map[string]bool{}
+	deadline := time.After(2 * time.Second)
+	for len(seen) < 3 {
+		select {
+		case paths := <-trig:
+			for _, p := range paths {
+				seen[p] = true
+			}
+		case <-deadline:
+			t.Fatalf("paths seen: %v", seen)
+		}
+	}
+	assert.True(t, seen["new/"], "created directory")
+	assert.True(t, seen["old/"], "removed directory")
+	assert.True(t, seen["Makefile"], "extensionless file")
+}
+
 func TestWatch_IgnoresDotGit(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
@@ -70,6 +103,88 @@ func TestWatch_IgnoresDotGit(t *testing.T) {
 	}
 }
 
+func TestWatch_ReportsChangedPaths(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o750)) //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	trig, err := Watch(ctx, dir, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.json"), []byte("x"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.tf"), []byte("x"), 0o600))          //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.tf"), []byte("y"), 0o600))          //nolint:gosec // test helper; 0o600 is fine for temp files
+
+	select {
+	case paths := <-trig:
+		assert.Equal(t, []string{"a.tf", "sub/b.json"}, paths)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the changed paths after writing files")
+	}
+}
+
+func TestWatch_HonorsGitignore(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("*.log\nbuild/\n"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "build"), 0o750))                                     //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	trig, err := Watch(ctx, dir, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "debug.log"), []byte("x"), 0o600))         //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "out.json"), []byte("x"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	select {
+	case paths := <-trig:
+		t.Fatalf("ignored paths must not trigger a reload, got %v", paths)
+	case <-time.After(400 * time.Millisecond):
+	}
+
+	// Editing .gitignore takes effect immediately without triggering itself.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("build/\n"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "debug.log"), []byte("y"), 0o600))         //nolint:gosec // test helper; 0o600 is fine for temp files
+	select {
+	case paths := <-trig:
+		assert.Equal(t, []string{"debug.log"}, paths)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a trigger for a path no longer ignored")
+	}
+}
+
+func TestWatch_WatchesDirectoryNoLongerIgnored(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("build/\n"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "build", "nested"), 0o750))                 //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	trig, err := Watch(ctx, dir, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), nil, 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	// Events arrive in order, so once this write triggers, the .gitignore
+	// edit has been applied.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "marker"), []byte("x"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	select {
+	case <-trig:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a trigger for the marker file")
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build", "nested", "out.tf"), []byte("x"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	select {
+	case paths := <-trig:
+		assert.Equal(t, []string{"build/nested/out.tf"}, paths)
+	case <-time.After(2 * time.Second):
+		t.Fatal("a directory no longer ignored must be watched")
+	}
+}
+
 func TestWatch_NonexistentRootErrors(t *testing.T) {
 	t.Parallel()
 	ctx, cancel := context.WithCancel(context.Background())
@@ -100,8 +215,8 @@ func TestRun_ClosedWatcherClosesOutput(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, w.Add(dir))
 
-	out := make(chan struct{})
-	go run(context.Background(), w, dir, 50*time.Millisecond, out)
+	out := make(chan []string)
+	go run(context.Background(), w, newIgnorer(dir), map[string]struct{}{}, dir, 50*time.Millisecond, out)
 
 	// Closing the backend closes both Events and Errors; run treats
 	// either as "watcher died" and shuts down, which the caller sees as a
@@ -125,8 +240,8 @@ func TestRun_CancelWhileTriggerPending(t *testing.T) {
 	require.NoError(t, w.Add(dir))
 
 	ctx, cancel := context.WithCancel(context.Background())
-	out := make(chan struct{})
-	go run(ctx, w, dir, 20*time.Millisecond, out)
+	out := make(chan []string)
+	go run(ctx, w, newIgnorer(dir), map[string]struct{}{}, dir, 20*time.Millisecond, out)
 
 	// Write a file but never read `out`, so once the debounce window
 	// elapses run is parked on the send.
@@ -0,0 +1,153 @@
+package fswatch
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// gitignoreFile is the per-directory ignore file honored by the watcher.
+const gitignoreFile = ".gitignore"
+
+// ignoreRule is one pattern line of a .gitignore file.
+type ignoreRule struct {
+	segments []string // pattern split on "/", leading and trailing "/" removed
+	negate   bool     // "!pattern" re-includes a path
+	dirOnly  bool     // "pattern/" matches directories only
+	anchored bool     // a "/" before the end anchors the pattern to its directory
+}
+
+/*
+ignorer evaluates the .gitignore files found under a watch root, with git's
+precedence: rules in deeper files override shallower ones, later lines
+override earlier ones, and nothing below an ignored directory can be
+re-included. Paths are slash-separated and relative to the root. It
+supports the pattern syntax found in practice (globs, "**", "!", anchoring
+and directory-only patterns) but not $GIT_DIR/info/exclude or the user's
+global excludes file.
+
+An ignorer is owned by a single goroutine; it is not safe for concurrent
+use.
+*/
+type ignorer struct {
+	root  string
+	rules map[string][]ignoreRule // by directory, relative to root ("" for root)
+}
+
+func newIgnorer(root string) *ignorer {
+	return &ignorer{root: root, rules: make(map[string][]ignoreRule)}
+}
+
+// load (re)reads the .gitignore in dir, a slash-separated path relative to
+// the root. A missing or unreadable file clears the directory's rules.
+func (ig *ignorer) load(dir string) {
+	delete(ig.rules, dir)
+	f, err := os.Open(filepath.Join(ig.root, filepath.FromSlash(dir), gitignoreFile))
+	if err != nil {
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	var rules []ignoreRule
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if r, ok := parseIgnoreRule(sc.Text()); ok {
+			rules = append(rules, r)
+		}
+	}
+	if len(rules) > 0 {
+		ig.rules[dir] = rules
+	}
+}
+
+// parseIgnoreRule parses one .gitignore line; ok is false for blank lines
+// and comments.
+func parseIgnoreRule(line string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+	var r ignoreRule
+	if strings.HasPrefix(line, "!") {
+		r.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, `\`) // "\#" and "\!" escape a literal first character
+	if strings.HasSuffix(line, "/") {
+		r.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	r.anchored = strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return ignoreRule{}, false
+	}
+	r.segments = strings.Split(line, "/")
+	return r, true
+}
+
+// ignored reports whether rel (slash-separated, relative to the root) is
+// ignored, either itself or through an ignored parent directory.
+func (ig *ignorer) ignored(rel string, isDir bool) bool {
+	if len(ig.rules) == 0 || rel == "" || rel == "." {
+		return false
+	}
+	parts := strings.Split(rel, "/")
+	for i := 1; i < len(parts); i++ {
+		if ig.match(parts[:i], true) {
+			return true
+		}
+	}
+	return ig.match(parts, isDir)
+}
+
+// match applies the rules of every .gitignore from the root down to the
+// path's parent; the last matching rule decides.
+func (ig *ignorer) match(parts []string, isDir bool) bool {
+	ignored := false
+	for depth := range parts {
+		for _, r := range ig.rules[strings.Join(parts[:depth], "/")] {
+			if r.matches(parts[depth:], isDir) {
+				ignored = !r.negate
+			}
+		}
+	}
+	return ignored
+}
+
+// matches reports whether rel, relative to the rule's directory, matches.
+// An unanchored pattern matches the base name at any depth.
+func (r ignoreRule) matches(rel []string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+	if !r.anchored {
+		return globSegments(r.segments, rel[len(rel)-1:])
+	}
+	return globSegments(r.segments, rel)
+}
+
+// globSegments matches path segments against pattern segments, where a
+// "**" segment matches zero or more path segments.
+func globSegments(pattern, segs []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(segs); i++ {
+				if globSegments(pattern[1:], segs[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segs) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], segs[0]); !ok {
+			return false
+		}
+		pattern, segs = pattern[1:], segs[1:]
+	}
+	return len(segs) == 0
+}
@@ -0,0 +1,67 @@
+package fswatch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnorer_Patterns(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte(`# build output
+*.tfstate
+!keep.tfstate
+/out
+.terraform/
+docs/**/*.png
+\#notes
+`), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o750))                                           //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", ".gitignore"), []byte("!b.tfstate\n"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+
+	ig := newIgnorer(dir)
+	ig.load("")
+	ig.load("sub")
+
+	for _, tc := range []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"a.tfstate", false, true},
+		{"x/y/a.tfstate", false, true},  // unanchored: any depth
+		{"keep.tfstate", false, false},  // re-included by a later line
+		{"sub/b.tfstate", false, false}, // deeper file overrides
+		{"out", true, true},
+		{"x/out", true, false}, // anchored to the root
+		{".terraform", true, true},
+		{".terraform", false, false}, // directory-only
+		{"m/.terraform/plugins/p", false, true},
+		{"docs/a.png", false, true}, // "**" matches zero segments
+		{"docs/x/y/a.png", false, true},
+		{"img/a.png", false, false},
+		{"#notes", false, true},
+		{"main.tf", false, false},
+	} {
+		assert.Equal(t, tc.want, ig.ignored(tc.path, tc.isDir), tc.path)
+	}
+}
+
+func TestIgnorer_ExcludedParentCannotBeReincluded(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitignore"), []byte("vendor/\n!vendor/keep.go\n"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+
+	ig := newIgnorer(dir)
+	ig.load("")
+	assert.True(t, ig.ignored("vendor/keep.go", false))
+
+	// Removing the file drops its rules.
+	require.NoError(t, os.Remove(filepath.Join(dir, ".gitignore")))
+	ig.load("")
+	assert.False(t, ig.ignored("vendor/keep.go", false))
+}
@@ -0,0 +1,206 @@
+// Package gitlog reads the change history of individual files in the
+// config repo by shelling out to the git CLI. It backs the "who changed
+// this override and when" views: the TUI detail-view history toggle and
+// `toolkit history`. Only read-only git plumbing is used (log/show), so
+// it is safe to run against a developer's working tree.
+package gitlog
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultLimit caps how many commits a history lookup returns when the
+// caller passes a non-positive limit. Long-lived override files can have
+// hundreds of commits; the newest few answer the audit question.
+const DefaultLimit = 20
+
+// DateLayout is the commit timestamp format history is shown with, in
+// both the CLI table and the TUI history view.
+const DateLayout = "2006-01-02 15:04"
+
+// ErrNotRepository is returned when the repo path is not inside a git
+// work tree (e.g. an exported tarball of the config repo).
+var ErrNotRepository = errors.New("not a git repository")
+
+// Commit is one commit that touched the file. Path is the file's path in
+// that commit, which differs from History.Path before a rename. Diff is
+// the unified diff of the file in that commit and is only populated when
+// requested.
+type Commit struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+	Path    string    `json:"path,omitempty"`
+	Diff    string    `json:"diff,omitempty"`
+
+	// fromPath is the path the commit renamed the file from, if any.
+	fromPath string
+}
+
+// ShortHash returns the abbreviated (7-char) commit hash for display.
+func (c Commit) ShortHash() string {
+	if len(c.Hash) > 7 {
+		return c.Hash[:7]
+	}
+	return c.Hash
+}
+
+// History is the change history of a single repo file, newest first.
+// Path is relative to the repo root.
+type History struct {
+	Path    string   `json:"path"`
+	Commits []Commit `json:"commits"`
+}
+
+// LastChange returns the newest commit in the history, or nil when the
+// file has never been committed (new, untracked file).
+func (h History) LastChange() *Commit {
+	if len(h.Commits) == 0 {
+		return nil
+	}
+	return &h.Commits[0]
+}
+
+// runGit is the seam tests use to fake the git binary. It runs git with
+// args in dir and returns stdout; stderr is folded into the error.
+var runGit = func(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...) // #nosec G204 -- fixed binary, args built by this package
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "not a git repository") {
+			return nil, ErrNotRepository
+		}
+		if msg != "" {
+			return nil, fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+		}
+		return nil, fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// Field and record separators for the --format string. ASCII unit and
+// record separators never appear in names or subjects, so splitting on
+// them is unambiguous.
+const (
+	fieldSep  = "\x1f"
+	recordSep = "\x1e"
+	logFormat = "--format=" + recordSep + "%H" + fieldSep + "%an" + fieldSep + "%ae" + fieldSep + "%aI" + fieldSep + "%s"
+)
+
+// FileHistory returns up to limit commits that touched file (absolute,
+// or relative to repoPath), newest first, following renames. When
+// withDiff is set each commit carries the file's diff in that commit,
+// taken at the path the file had then.
+func FileHistory(ctx context.Context, repoPath, file string, limit int, withDiff bool) (History, error) {
+	rel, err := relPath(repoPath, file)
+	if err != nil {
+		return History{}, err
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	out, err := runGit(ctx, repoPath, "log", "--follow", "--no-color", "--name-status",
+		fmt.Sprintf("--max-count=%d", limit), logFormat, "--", rel)
+	if err != nil {
+		return History{}, err
+	}
+	commits, err := parseLog(out)
+	if err != nil {
+		return History{}, err
+	}
+
+	if withDiff {
+		for i := range commits {
+			c := &commits[i]
+			args := []string{"show", "--no-color", "--format=", "-M", c.Hash, "--", cmp.Or(c.Path, rel)}
+			if c.fromPath != "" {
+				args = append(args, c.fromPath)
+			}
+			diff, err := runGit(ctx, repoPath, args...)
+			if err != nil {
+				return History{}, err
+			}
+			commits[i].Diff = strings.TrimRight(string(diff), "\n")
+		}
+	}
+	return History{Path: rel, Commits: commits}, nil
+}
+
+// parseLog splits `git log` output produced with logFormat (and
+// --name-status) into commits. Each record's subject is followed by the
+// file's status line: "M\tpath", or "R100\told\tnew" for a rename.
+func parseLog(out []byte) ([]Commit, error) {
+	records := strings.Split(string(out), recordSep)
+	commits := make([]Commit, 0, len(records))
+	for _, rec := range records {
+		rec = strings.TrimSpace(rec)
+		if rec == "" {
+			continue
+		}
+		fields := strings.SplitN(rec, fieldSep, 5)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected git log record %q", rec)
+		}
+		date, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("parse commit date %q: %w", fields[3], err)
+		}
+		subject, status, _ := strings.Cut(fields[4], "\n")
+		c := Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Date:    date,
+			Subject: subject,
+		}
+		c.Path, c.fromPath = statusPaths(status)
+		commits = append(commits, c)
+	}
+	return commits, nil
+}
+
+// statusPaths returns the path in the last --name-status line of lines,
+// and the path it was renamed or copied from, if any.
+func statusPaths(lines string) (path, from string) {
+	for line := range strings.Lines(lines) {
+		parts := strings.Split(strings.TrimRight(line, "\n"), "\t")
+		switch {
+		case len(parts) == 3:
+			path, from = parts[2], parts[1]
+		case len(parts) == 2:
+			path, from = parts[1], ""
+		}
+	}
+	return path, from
+}
+
+// relPath returns file relative to repoPath in slash form, rejecting
+// paths that escape the repo.
+func relPath(repoPath, file string) (string, error) {
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(repoPath, file)
+	}
+	rel, err := filepath.Rel(repoPath, file)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside repo %s", file, repoPath)
+	}
+	return filepath.ToSlash(rel), nil
+}
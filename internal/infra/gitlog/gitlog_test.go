@@ -0,0 +1,141 @@
+package gitlog
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initRepo creates a throwaway repo with a deterministic identity so the
+// test does not depend on the developer's global git config.
+func initRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	git(t, dir, "init", "-q")
+	git(t, dir, "config", "user.name", "Jane Doe")
+	git(t, dir, "config", "user.email", "jane@example.com")
+	git(t, dir, "config", "commit.gpgsign", "false")
+	return dir
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func commitFile(t *testing.T, dir, rel, content, msg string) {
+	t.Helper()
+	path := filepath.Join(dir, rel)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	git(t, dir, "add", rel)
+	git(t, dir, "commit", "-q", "-m", msg)
+}
+
+func TestFileHistory(t *testing.T) {
+	t.Parallel()
+	dir := initRepo(t)
+	commitFile(t, dir, "limits/a.json", "{\"max\": 1}\n", "add a")
+	commitFile(t, dir, "limits/b.json", "{}\n", "add b")
+	commitFile(t, dir, "limits/a.json", "{\"max\": 5}\n", "raise a")
+
+	h, err := FileHistory(context.Background(), dir, filepath.Join(dir, "limits/a.json"), 0, true)
+	require.NoError(t, err)
+	assert.Equal(t, "limits/a.json", h.Path)
+	require.Len(t, h.Commits, 2)
+	assert.Equal(t, "raise a", h.Commits[0].Subject)
+	assert.Equal(t, "add a", h.Commits[1].Subject)
+	assert.Equal(t, "Jane Doe", h.Commits[0].Author)
+	assert.Equal(t, "jane@example.com", h.Commits[0].Email)
+	assert.Len(t, h.Commits[0].ShortHash(), 7)
+	assert.Contains(t, h.Commits[0].Diff, "+{\"max\": 5}")
+	assert.Contains(t, h.Commits[0].Diff, "-{\"max\": 1}")
+	require.NotNil(t, h.LastChange())
+	assert.Equal(t, h.Commits[0].Hash, h.LastChange().Hash)
+}
+
+// Commits before a rename are diffed at the path the file had then, so
+// their diffs are not empty.
+func TestFileHistory_FollowsRenames(t *testing.T) {
+	t.Parallel()
+	dir := initRepo(t)
+	commitFile(t, dir, "old/a.json", "{\"max\": 1,\n \"min\": 0}\n", "add a")
+	commitFile(t, dir, "old/a.json", "{\"max\": 2,\n \"min\": 0}\n", "raise a")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "new"), 0o750))
+	git(t, dir, "mv", "old/a.json", "new/a.json")
+	git(t, dir, "commit", "-q", "-m", "move a")
+	commitFile(t, dir, "new/a.json", "{\"max\": 3,\n \"min\": 0}\n", "raise a again")
+
+	h, err := FileHistory(context.Background(), dir, "new/a.json", 0, true)
+	require.NoError(t, err)
+	require.Len(t, h.Commits, 4)
+	assert.Equal(t, "new/a.json", h.Commits[0].Path)
+	assert.Equal(t, "move a", h.Commits[1].Subject)
+	assert.Equal(t, "new/a.json", h.Commits[1].Path)
+	assert.Equal(t, "old/a.json", h.Commits[2].Path)
+	assert.Contains(t, h.Commits[1].Diff, "rename from old/a.json")
+	assert.Contains(t, h.Commits[2].Diff, "+{\"max\": 2,")
+	assert.Contains(t, h.Commits[3].Diff, "+{\"max\": 1,")
+}
+
+func TestFileHistory_LimitAndNoDiff(t *testing.T) {
+	t.Parallel()
+	dir := initRepo(t)
+	commitFile(t, dir, "a.json", "1\n", "one")
+	commitFile(t, dir, "a.json", "2\n", "two")
+
+	h, err := FileHistory(context.Background(), dir, "a.json", 1, false)
+	require.NoError(t, err)
+	require.Len(t, h.Commits, 1)
+	assert.Equal(t, "two", h.Commits[0].Subject)
+	assert.Empty(t, h.Commits[0].Diff)
+}
+
+func TestFileHistory_Untracked(t *testing.T) {
+	t.Parallel()
+	dir := initRepo(t)
+	commitFile(t, dir, "a.json", "1\n", "one")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.json"), []byte("{}"), 0o600))
+
+	h, err := FileHistory(context.Background(), dir, "new.json", 0, false)
+	require.NoError(t, err)
+	assert.Empty(t, h.Commits)
+	assert.Nil(t, h.LastChange())
+}
+
+func TestFileHistory_NotRepository(t *testing.T) {
+	t.Parallel()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	_, err := FileHistory(context.Background(), dir, "a.json", 0, false)
+	require.ErrorIs(t, err, ErrNotRepository)
+}
+
+func TestFileHistory_OutsideRepo(t *testing.T) {
+	t.Parallel()
+	_, err := FileHistory(context.Background(), "/repo", "/elsewhere/a.json", 0, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "outside repo")
+}
+
+func TestParseLog_Malformed(t *testing.T) {
+	t.Parallel()
+	_, err := parseLog([]byte(recordSep + "abc" + fieldSep + "only-two"))
+	require.Error(t, err)
+
+	_, err = parseLog([]byte(recordSep + "h" + fieldSep + "a" + fieldSep + "e" + fieldSep + "not-a-date" + fieldSep + "s"))
+	require.Error(t, err)
+}
@@ -0,0 +1,601 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+)
+
+// cachedGVRs are the resources a Cache serves from informers. Everything
+// else — events, pod logs, writes — goes to the API server.
+var cachedGVRs = map[schema.GroupVersionResource]bool{
+	nodeGVR:             true,
+	podGVR:              true,
+	clusterBaseModelGVR: true,
+	baseModelGVR:        true,
+	dacV1GVR:            true,
+	dacV2GVR:            true,
+}
+
+/*
+Cache is a per-cluster store of nodes, pods and ome.io CRs kept current by
+shared informers, so that every k8s category reads one in-memory copy
+instead of re-listing on each reload. A reload still reads the whole
+copy: informer events only trigger it, they are not applied as deltas.
+
+Clientset and Dynamic return clients whose List calls for those resources
+are answered from the stores (label and field selectors are applied in
+memory); all other calls pass through to the API server. An informer is
+started on the first list of its resource, after a one-item probe list
+confirms the resource is served and readable — so a missing CRD or a
+forbidden resource fails the caller as a direct list would, instead of
+leaving an informer retrying in the background. Informers run until the
+context given to NewCache is done.
+
+The Watch methods return the same coalesced trigger channels as the
+package-level Watch functions, but fed by informer events rather than
+separate watch streams.
+*/
+type Cache struct {
+	ctx        context.Context
+	clientset  kubernetes.Interface
+	dynamic    dynamic.Interface
+	factory    informers.SharedInformerFactory
+	dynFactory dynamicinformer.DynamicSharedInformerFactory
+
+	mu      sync.Mutex
+	started map[schema.GroupVersionResource]bool
+}
+
+// NewCache returns a Cache over the given clients whose informers run
+// until ctx is done. Informers list and watch through informerClientset
+// and informerDynamic (when nil, clientset and client) — production
+// passes clients without a request timeout so that long-lived watch
+// streams are not cut by it.
+func NewCache(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	client dynamic.Interface,
+	informerClientset kubernetes.Interface,
+	informerDynamic dynamic.Interface,
+) *Cache {
+	if informerClientset == nil {
+		informerClientset = clientset
+	}
+	if informerDynamic == nil {
+		informerDynamic = client
+	}
+	return &Cache{
+		ctx:        ctx,
+		clientset:  clientset,
+		dynamic:    client,
+		factory:    informers.NewSharedInformerFactoryWithOptions(informerClientset, 0, informers.WithTransform(stripManagedFields)),
+		dynFactory: dynamicinformer.NewDynamicSharedInformerFactory(informerDynamic, 0),
+		started:    make(map[schema.GroupVersionResource]bool),
+	}
+}
+
+// NewCacheFromKubeConfig returns a Cache for one kubeconfig context.
+func NewCacheFromKubeConfig(ctx context.Context, kubeconfig, kubeContext string) (*Cache, error) {
+	config, err := NewConfig(kubeconfig, kubeContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config from kubeconfig: %w", err)
+	}
+	clientset, err := NewClientsetFromRestConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := NewDynamicClient(config)
+	if err != nil {
+		return nil, err
+	}
+	streaming := *config
+	streaming.Timeout = 0
+	informerClientset, err := NewClientsetFromRestConfig(&streaming)
+	if err != nil {
+		return nil, err
+	}
+	informerDynamic, err := NewDynamicClient(&streaming)
+	if err != nil {
+		return nil, err
+	}
+	return NewCache(ctx, clientset, client, informerClientset, informerDynamic), nil
+}
+
+// Clientset returns a typed client that lists nodes and pods from the
+// cache.
+func (c *Cache) Clientset() kubernetes.Interface {
+	return cachedClientset{Interface: c.clientset, cache: c}
+}
+
+// Dynamic returns a dynamic client that lists the cached resources from
+// the cache.
+func (c *Cache) Dynamic() dynamic.Interface {
+	return cachedDynamic{Interface: c.dynamic, cache: c}
+}
+
+// informer returns the shared informer of a cached resource.
+func (c *Cache) informer(gvr schema.GroupVersionResource) toolscache.SharedIndexInformer {
+	switch gvr {
+	case nodeGVR:
+		return c.factory.Core().V1().Nodes().Informer()
+	case podGVR:
+		return c.factory.Core().V1().Pods().Informer()
+	}
+	return c.dynFactory.ForResource(gvr).Informer()
+}
+
+// ensure starts the informer of gvr on first use and waits for its store
+// to sync. The probe runs without the lock, so a slow cluster API does
+// not hold up the other resources; callers racing on first use may each
+// probe, but only one starts the informer.
+func (c *Cache) ensure(ctx context.Context, gvr schema.GroupVersionResource) (toolscache.SharedIndexInformer, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	started := c.started[gvr]
+	c.mu.Unlock()
+	if !started {
+		if err := c.probe(ctx, gvr); err != nil {
+			return nil, err
+		}
+	}
+	c.mu.Lock()
+	if !c.started[gvr] {
+		inf := c.informer(gvr)
+		if gvr != nodeGVR && gvr != podGVR {
+			_ = inf.SetTransform(stripManagedFields)
+		}
+		c.factory.Start(c.ctx.Done())
+		c.dynFactory.Start(c.ctx.Done())
+		c.started[gvr] = true
+		logging.FromContext(ctx).Infow("informer started", "resource", gvr.String())
+	}
+	inf := c.informer(gvr)
+	c.mu.Unlock()
+	if !toolscache.WaitForCacheSync(ctx.Done(), inf.HasSynced) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, c.ctx.Err()
+	}
+	return inf, nil
+}
+
+// probe lists one item of gvr straight from the API server.
+func (c *Cache) probe(ctx context.Context, gvr schema.GroupVersionResource) error {
+	opts := v1.ListOptions{Limit: 1}
+	var err error
+	switch gvr {
+	case nodeGVR:
+		_, err = c.clientset.CoreV1().Nodes().List(ctx, opts)
+	case podGVR:
+		_, err = c.clientset.CoreV1().Pods("").List(ctx, opts)
+	default:
+		_, err = c.dynamic.Resource(gvr).List(ctx, opts)
+	}
+	return err
+}
+
+// list returns the cached objects of gvr in namespace ("" for all) that
+// match opts' selectors, sorted by namespace and name, one page of
+// opts.Limit at a time. The Continue token of a page that is not the
+// last is the offset of the next one, so paging callers walk the whole
+// store. The objects are the store's own; callers copy them.
+func (c *Cache) list(ctx context.Context, gvr schema.GroupVersionResource, namespace string, opts v1.ListOptions) ([]any, v1.ListMeta, error) {
+	match, err := matcher(opts.LabelSelector, opts.FieldSelector)
+	if err != nil {
+		return nil, v1.ListMeta{}, err
+	}
+	offset := 0
+	if opts.Continue != "" {
+		if offset, err = strconv.Atoi(opts.Continue); err != nil || offset < 0 {
+			return nil, v1.ListMeta{}, fmt.Errorf("invalid continue token %q", opts.Continue)
+		}
+	}
+	inf, err := c.ensure(ctx, gvr)
+	if err != nil {
+		return nil, v1.ListMeta{}, err
+	}
+	var result []any
+	for _, obj := range inf.GetStore().List() {
+		m, err := meta.Accessor(obj)
+		if err != nil || (namespace != "" && m.GetNamespace() != namespace) || !match(obj) {
+			continue
+		}
+		result = append(result, obj)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, _ := meta.Accessor(result[i])
+		b, _ := meta.Accessor(result[j])
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	listMeta := v1.ListMeta{ResourceVersion: inf.LastSyncResourceVersion()}
+	result = result[min(offset, len(result)):]
+	if opts.Limit > 0 && int64(len(result)) > opts.Limit {
+		result = result[:opts.Limit]
+		listMeta.Continue = strconv.Itoa(offset + int(opts.Limit))
+	}
+	return result, listMeta, nil
+}
+
+// listUnstructured is list for the dynamic client: typed nodes and pods
+// are converted.
+func (c *Cache) listUnstructured(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	namespace string,
+	opts v1.ListOptions,
+) (*unstructured.UnstructuredList, error) {
+	objs, listMeta, err := c.list(ctx, gvr, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &unstructured.UnstructuredList{Items: make([]unstructured.Unstructured, 0, len(objs))}
+	list.SetResourceVersion(listMeta.ResourceVersion)
+	list.SetContinue(listMeta.Continue)
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			list.Items = append(list.Items, *u.DeepCopy())
+			continue
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		u := unstructured.Unstructured{Object: content}
+		u.SetAPIVersion("v1")
+		if gvr == nodeGVR {
+			u.SetKind("Node")
+		} else {
+			u.SetKind("Pod")
+		}
+		list.Items = append(list.Items, u)
+	}
+	return list, nil
+}
+
+// matcher returns a predicate for objects matching a label and a field
+// selector (see objectFields for the fields a selector may use).
+func matcher(labelSelector, fieldSelector string) (func(obj any) bool, error) {
+	ls, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+	fs, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector %q: %w", fieldSelector, err)
+	}
+	return func(obj any) bool {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return false
+		}
+		return ls.Matches(labels.Set(m.GetLabels())) && fs.Matches(objectFields(obj, m))
+	}, nil
+}
+
+// objectFields returns the selectable fields of a cached object: the
+// metadata fields of any object, and the pod and node fields the
+// API server supports that the loaders select on.
+func objectFields(obj any, m v1.Object) fields.Set {
+	set := fields.Set{
+		"metadata.name":      m.GetName(),
+		"metadata.namespace": m.GetNamespace(),
+	}
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		set["spec.nodeName"] = o.Spec.NodeName
+		set["spec.schedulerName"] = o.Spec.SchedulerName
+		set["spec.serviceAccountName"] = o.Spec.ServiceAccountName
+		set["spec.restartPolicy"] = string(o.Spec.RestartPolicy)
+		set["status.phase"] = string(o.Status.Phase)
+		set["status.podIP"] = o.Status.PodIP
+	case *corev1.Node:
+		set["spec.unschedulable"] = strconv.FormatBool(o.Spec.Unschedulable)
+	}
+	return set
+}
+
+// stripManagedFields drops managedFields from cached objects; nothing
+// reads them and they are a large share of every object's size.
+func stripManagedFields(obj any) (any, error) {
+	if m, err := meta.Accessor(obj); err == nil {
+		m.SetManagedFields(nil)
+	}
+	return obj, nil
+}
+
+// cachedClientset serves node and pod lists from a Cache.
+type cachedClientset struct {
+	kubernetes.Interface
+	cache *Cache
+}
+
+func (c cachedClientset) CoreV1() corev1client.CoreV1Interface {
+	return cachedCoreV1{CoreV1Interface: c.Interface.CoreV1(), cache: c.cache}
+}
+
+type cachedCoreV1 struct {
+	corev1client.CoreV1Interface
+	cache *Cache
+}
+
+func (c cachedCoreV1) Nodes() corev1client.NodeInterface {
+	return cachedNodes{NodeInterface: c.CoreV1Interface.Nodes(), cache: c.cache}
+}
+
+func (c cachedCoreV1) Pods(namespace string) corev1client.PodInterface {
+	return cachedPods{PodInterface: c.CoreV1Interface.Pods(namespace), namespace: namespace, cache: c.cache}
+}
+
+type cachedNodes struct {
+	corev1client.NodeInterface
+	cache *Cache
+}
+
+func (n cachedNodes) List(ctx context.Context, opts v1.ListOptions) (*corev1.NodeList, error) {
+	objs, listMeta, err := n.cache.list(ctx, nodeGVR, "", opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &corev1.NodeList{ListMeta: listMeta, Items: make([]corev1.Node, 0, len(objs))}
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*corev1.Node).DeepCopy())
+	}
+	return list, nil
+}
+
+type cachedPods struct {
+	corev1client.PodInterface
+	namespace string
+	cache     *Cache
+}
+
+func (p cachedPods) List(ctx context.Context, opts v1.ListOptions) (*corev1.PodList, error) {
+	objs, listMeta, err := p.cache.list(ctx, podGVR, p.namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &corev1.PodList{ListMeta: listMeta, Items: make([]corev1.Pod, 0, len(objs))}
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*corev1.Pod).DeepCopy())
+	}
+	return list, nil
+}
+
+// cachedDynamic serves lists of the cached resources from a Cache.
+type cachedDynamic struct {
+	dynamic.Interface
+	cache *Cache
+}
+
+func (d cachedDynamic) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	r := d.Interface.Resource(gvr)
+	if !cachedGVRs[gvr] {
+		return r
+	}
+	return cachedResource{NamespaceableResourceInterface: r, gvr: gvr, cache: d.cache}
+}
+
+type cachedResource struct {
+	dynamic.NamespaceableResourceInterface
+	gvr   schema.GroupVersionResource
+	cache *Cache
+}
+
+func (r cachedResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return cachedNamespacedResource{
+		ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace),
+		gvr:               r.gvr,
+		namespace:         namespace,
+		cache:             r.cache,
+	}
+}
+
+func (r cachedResource) List(ctx context.Context, opts v1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return r.cache.listUnstructured(ctx, r.gvr, "", opts)
+}
+
+type cachedNamespacedResource struct {
+	dynamic.ResourceInterface
+	gvr       schema.GroupVersionResource
+	namespace string
+	cache     *Cache
+}
+
+func (r cachedNamespacedResource) List(ctx context.Context, opts v1.ListOptions) (*unstructured.UnstructuredList, error) {
+	return r.cache.listUnstructured(ctx, r.gvr, r.namespace, opts)
+}
+
+// triggerSource is one informer a cache trigger listens to, and which of
+// its objects count.
+type triggerSource struct {
+	gvr   schema.GroupVersionResource
+	match func(obj any) bool
+}
+
+// selectedSource returns a triggerSource for the objects of gvr matching
+// the selectors.
+func selectedSource(gvr schema.GroupVersionResource, labelSelector, fieldSelector string) (triggerSource, error) {
+	match, err := matcher(labelSelector, fieldSelector)
+	if err != nil {
+		return triggerSource{}, err
+	}
+	return triggerSource{gvr: gvr, match: match}, nil
+}
+
+// gpuPodSources returns one source per GPU pod label selector, scoped to
+// Running pods (see gpuPodWatchOpeners).
+func gpuPodSources() ([]triggerSource, error) {
+	sources := make([]triggerSource, 0, len(gpuPodSelectors))
+	for _, sel := range gpuPodSelectors {
+		s, err := selectedSource(podGVR, sel, runningPodSelector)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, s)
+	}
+	return sources, nil
+}
+
+/*
+trigger is watchTrigger over informers: each add, update or delete of a
+matching object signals, and the signals are coalesced into the returned
+channel. An update signals when the object matches before or after it, so
+objects leaving a selection count as they do for a selector watch. The
+informers' initial lists do not signal. The channel closes when ctx or the
+cache's context is done.
+*/
+func (c *Cache) trigger(ctx context.Context, sources ...triggerSource) (<-chan struct{}, error) {
+	raw := make(chan struct{}, 1)
+	signal := func() {
+		select {
+		case raw <- struct{}{}:
+		default: // a signal is already pending; coalesce
+		}
+	}
+	type registration struct {
+		inf toolscache.SharedIndexInformer
+		reg toolscache.ResourceEventHandlerRegistration
+	}
+	regs := make([]registration, 0, len(sources))
+	unregister := func() {
+		for _, r := range regs {
+			_ = r.inf.RemoveEventHandler(r.reg)
+		}
+	}
+	for _, s := range sources {
+		inf, err := c.ensure(ctx, s.gvr)
+		if err != nil {
+			unregister()
+			return nil, err
+		}
+		reg, err := inf.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+			AddFunc: func(obj any, isInInitialList bool) {
+				if !isInInitialList && s.match(obj) {
+					signal()
+				}
+			},
+			UpdateFunc: func(oldObj, newObj any) {
+				if s.match(oldObj) || s.match(newObj) {
+					signal()
+				}
+			},
+			DeleteFunc: func(obj any) {
+				if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if s.match(obj) {
+					signal()
+				}
+			},
+		})
+		if err != nil {
+			unregister()
+			return nil, err
+		}
+		regs = append(regs, registration{inf: inf, reg: reg})
+	}
+
+	logging.FromContext(ctx).Infow("watch established", "informers", len(regs))
+
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-c.ctx.Done():
+		}
+		unregister()
+		close(stopped)
+	}()
+	out := make(chan struct{})
+	go coalesce(ctx, DebounceWindow, raw, c.ctx.Done(), stopped, out)
+	return out, nil
+}
+
+// WatchBaseModels is WatchBaseModels fed by the cache.
+func (c *Cache) WatchBaseModels(ctx context.Context) (<-chan struct{}, error) {
+	return c.trigger(ctx, triggerSource{gvr: clusterBaseModelGVR, match: matchAll})
+}
+
+// WatchImportedModels is WatchImportedModels fed by the cache.
+func (c *Cache) WatchImportedModels(ctx context.Context) (<-chan struct{}, error) {
+	return c.trigger(ctx,
+		triggerSource{gvr: baseModelGVR, match: matchAll},
+		triggerSource{gvr: clusterBaseModelGVR, match: matchAll},
+	)
+}
+
+// WatchGPUNodes is WatchGPUNodes fed by the cache.
+func (c *Cache) WatchGPUNodes(ctx context.Context, resources ...string) (<-chan struct{}, error) {
+	nodes, err := selectedSource(nodeGVR, newGPUResources(resources).nodeSelector(), "")
+	if err != nil {
+		return nil, err
+	}
+	pods, err := gpuPodSources()
+	if err != nil {
+		return nil, err
+	}
+	return c.trigger(ctx, append([]triggerSource{nodes}, pods...)...)
+}
+
+// WatchGPUWorkloads is WatchGPUWorkloads fed by the cache.
+func (c *Cache) WatchGPUWorkloads(ctx context.Context) (<-chan struct{}, error) {
+	pods, err := gpuPodSources()
+	if err != nil {
+		return nil, err
+	}
+	return c.trigger(ctx, pods...)
+}
+
+// WatchPendingGPUWorkloads is WatchPendingGPUWorkloads fed by the cache.
+func (c *Cache) WatchPendingGPUWorkloads(ctx context.Context) (<-chan struct{}, error) {
+	pending, err := selectedSource(podGVR, "", pendingPodSelector)
+	if err != nil {
+		return nil, err
+	}
+	return c.trigger(ctx, pending)
+}
+
+// WatchDedicatedAIClusters is WatchDedicatedAIClusters fed by the cache.
+func (c *Cache) WatchDedicatedAIClusters(ctx context.Context) (<-chan struct{}, error) {
+	pods, err := gpuPodSources()
+	if err != nil {
+		return nil, err
+	}
+	return c.trigger(ctx, append([]triggerSource{
+		{gvr: dacV1GVR, match: matchAll},
+		{gvr: dacV2GVR, match: matchAll},
+	}, pods...)...)
+}
+
+// WatchEvents watches events directly: they are not cached.
+func (c *Cache) WatchEvents(ctx context.Context) (<-chan struct{}, error) {
+	return WatchEvents(ctx, c.clientset)
+}
+
+func matchAll(any) bool { return true }
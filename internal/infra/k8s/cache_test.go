@@ -0,0 +1,191 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func newFakeDynamic(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			clusterBaseModelGVR: "ClusterBaseModelList",
+			baseModelGVR:        "BaseModelList",
+			dacV1GVR:            "DedicatedAIClusterList",
+			dacV2GVR:            "DedicatedAIClusterList",
+			podGVR:              "PodList",
+		}, objs...)
+}
+
+func TestCache_ListsPodsFromStore(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serving := gpuPod("serv", "node-a", 2, map[string]string{servingLabelV2: "isvc"}, nil)
+	pending := gpuPod("pend", "", 1, map[string]string{servingLabelV2: "isvc"}, nil)
+	pending.Status.Phase = corev1.PodPending
+	other := gpuPod("other", "node-a", 0, nil, nil)
+	cs := fake.NewSimpleClientset(serving, pending, other)
+	c := NewCache(ctx, cs, newFakeDynamic(), nil, nil)
+
+	// Unlike the fake clientset, the cache honors field selectors.
+	pods, err := c.Clientset().CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		LabelSelector: servingLabelV2,
+		FieldSelector: runningPodSelector,
+	})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, "serv", pods.Items[0].Name)
+
+	// Served from the store: a later list does not reach the API server.
+	var lists int
+	cs.PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		lists++
+		return false, nil, nil
+	})
+	pods, err = c.Clientset().CoreV1().Pods("ns1").List(ctx, metav1.ListOptions{FieldSelector: pendingPodSelector})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+	assert.Equal(t, "pend", pods.Items[0].Name)
+	assert.Zero(t, lists)
+
+	// The store follows the cluster.
+	_, err = cs.CoreV1().Pods("ns1").Create(ctx, gpuPod("new", "node-b", 1, nil, nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		pods, err := c.Clientset().CoreV1().Pods("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=node-b"})
+		return err == nil && len(pods.Items) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestCache_LoadersReadThroughCache(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs := fake.NewSimpleClientset(
+		makeNode("gpu-1", map[string]string{"nvidia.com/gpu.present": "true"}, 8, false, nil),
+		makeNode("cpu-1", nil, 0, false, nil),
+		gpuPod("serv", "gpu-1", 2, map[string]string{servingLabelV2: "isvc"}, nil),
+	)
+	c := NewCache(ctx, cs, newFakeDynamic(newCBM("m1", nil, nil, nil, nil)), nil, nil)
+
+	nodes, err := ListGPUNodes(ctx, c.Clientset(), 0)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "gpu-1", nodes[0].Name)
+	assert.Equal(t, 2, nodes[0].Allocated)
+
+	baseModels, err := LoadBaseModels(ctx, c.Dynamic())
+	require.NoError(t, err)
+	require.Len(t, baseModels, 1)
+	assert.Equal(t, "m1", baseModels[0].Name)
+
+	// Pods listed through the dynamic client come from the typed store.
+	pods, err := listPodsWithSelectors(ctx, c.Dynamic(), servingLabelV2, runningPodSelector)
+	require.NoError(t, err)
+	require.Len(t, pods["ns1"], 1)
+	assert.Equal(t, "Pod", pods["ns1"][0].GetKind())
+}
+
+func TestCache_ListPagesWithContinue(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const n = 1234 // more than two gpuWorkloadPageSize pages
+	objs := make([]runtime.Object, n)
+	for i := range objs {
+		objs[i] = gpuPod(fmt.Sprintf("pod-%04d", i), "node-a", 1, nil, nil)
+	}
+	c := NewCache(ctx, fake.NewSimpleClientset(objs...), newFakeDynamic(), nil, nil)
+
+	page, err := c.Clientset().CoreV1().Pods("").List(ctx, metav1.ListOptions{Limit: 500})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 500)
+	assert.Equal(t, "500", page.Continue)
+	page, err = c.Clientset().CoreV1().Pods("").List(ctx, metav1.ListOptions{Limit: 500, Continue: "1000"})
+	require.NoError(t, err)
+	require.Len(t, page.Items, n-1000)
+	assert.Empty(t, page.Continue, "last page")
+	assert.Equal(t, "pod-1000", page.Items[0].Name)
+
+	_, err = c.Clientset().CoreV1().Pods("").List(ctx, metav1.ListOptions{Limit: 500, Continue: "bogus"})
+	require.ErrorContains(t, err, "invalid continue token")
+
+	// The paging loaders see every pod, not just the first page.
+	workloads, err := LoadGPUWorkloadsByNode(ctx, c.Clientset())
+	require.NoError(t, err)
+	assert.Len(t, workloads["node-a"], n)
+}
+
+func TestCache_ProbeErrorFailsFast(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dyn := newFakeDynamic()
+	dyn.PrependReactor("list", "dedicatedaiclusters", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(dacV1GVR.GroupResource(), "")
+	})
+	c := NewCache(ctx, fake.NewSimpleClientset(), dyn, nil, nil)
+
+	_, err := c.Dynamic().Resource(dacV1GVR).List(ctx, metav1.ListOptions{})
+	require.Error(t, err)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+//nolint:paralleltest // mutates package-global DebounceWindow; must not run in parallel
+func TestCache_WatchGPUWorkloads_FiresOnMatchingPods(t *testing.T) {
+	old := DebounceWindow
+	DebounceWindow = 50 * time.Millisecond
+	defer func() { DebounceWindow = old }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs := fake.NewSimpleClientset(gpuPod("existing", "node-a", 1, map[string]string{servingLabelV2: "isvc"}, nil))
+	c := NewCache(ctx, cs, newFakeDynamic(), nil, nil)
+	trig, err := c.WatchGPUWorkloads(ctx)
+	require.NoError(t, err)
+
+	// The informer's initial list does not trigger, nor does a pod outside
+	// the GPU selectors.
+	_, err = cs.CoreV1().Pods("ns1").Create(ctx, gpuPod("plain", "node-a", 0, nil, nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-trig:
+		t.Fatal("unexpected trigger")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	_, err = cs.CoreV1().Pods("ns1").Create(ctx,
+		gpuPod("serv", "node-a", 1, map[string]string{servingLabelV2: "isvc"}, nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-trig:
+	case <-time.After(time.Second):
+		t.Fatal("expected a trigger tick after GPU pod create")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-trig:
+		assert.False(t, ok, "trigger closes when ctx is done")
+	case <-time.After(time.Second):
+		t.Fatal("trigger did not close")
+	}
+}
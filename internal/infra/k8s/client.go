@@ -6,6 +6,7 @@ package k8s
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"k8s.io/client-go/dynamic"
@@ -13,6 +14,8 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 )
 
 // DefaultRequestTimeout caps every client-go call so a broken or
@@ -37,9 +40,8 @@ var RequestTimeout = DefaultRequestTimeout
 func NewConfig(kubeconfig, ctx string) (*rest.Config, error) {
 	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
 	overrides := &clientcmd.ConfigOverrides{CurrentContext: ctx}
-	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		loadingRules, overrides,
-	).ClientConfig()
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+	config, err := clientConfig.ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
 	}
@@ -67,6 +69,18 @@ func NewConfig(kubeconfig, ctx string) (*rest.Config, error) {
 	if config.ExecProvider != nil {
 		config.ExecProvider.InteractiveMode = clientcmdapi.NeverExecInteractiveMode
 	}
+	// Retry transient reads and fail fast while the cluster keeps
+	// failing, with a breaker per context (see package resilience).
+	name := ctx
+	if name == "" {
+		if raw, err := clientConfig.RawConfig(); err == nil {
+			name = raw.CurrentContext
+		}
+	}
+	service := "k8s/" + name
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return resilience.Default.Transport(service, rt)
+	})
 	// Identify this client in user agent.
 	rest.AddUserAgent(config, "toolkit")
 	return config, nil
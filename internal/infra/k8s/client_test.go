@@ -1,13 +1,18 @@
 package k8s
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 )
 
 func writeTempKubeconfig(t *testing.T, config *api.Config) string {
@@ -96,6 +101,39 @@ func TestNewConfig_AppliesRequestTimeout(t *testing.T) { //nolint:paralleltest /
 	}
 }
 
+func TestNewConfig_RoutesCallsThroughContextBreaker(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major":"1","minor":"30","gitVersion":"v1.30.0"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	kc := api.NewConfig()
+	kc.Clusters["c"] = &api.Cluster{Server: srv.URL}
+	kc.Contexts["breaker-test"] = &api.Context{Cluster: "c", AuthInfo: "user"}
+	kc.AuthInfos["user"] = &api.AuthInfo{}
+	kc.CurrentContext = "breaker-test"
+
+	// An empty context resolves to the kubeconfig's current one.
+	cfg, err := NewConfig(writeTempKubeconfig(t, kc), "")
+	if err != nil {
+		t.Fatalf("NewConfig: %v", err)
+	}
+	cs, err := NewClientsetFromRestConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewClientsetFromRestConfig: %v", err)
+	}
+	if _, err := cs.Discovery().ServerVersion(); err != nil {
+		t.Fatalf("ServerVersion: %v", err)
+	}
+	if !slices.ContainsFunc(resilience.Default.Snapshot(), func(s resilience.Status) bool {
+		return s.Service == "k8s/breaker-test"
+	}) {
+		t.Errorf("no breaker registered for k8s/breaker-test: %+v", resilience.Default.Snapshot())
+	}
+}
+
 func TestNewClientsetFromRestConfig(t *testing.T) {
 	t.Parallel()
 	// Use a minimal valid rest.Config for fake client
@@ -2,8 +2,10 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/drain"
@@ -48,6 +50,51 @@ func DrainNode(ctx context.Context, kubeconfig, contextName, nodeName string) er
 	return drainNode(ctx, clientset, nodeName)
 }
 
+/*
+LocateNode returns the one of contexts whose cluster has a node named
+nodeName, so a mutation given only a node name reaches the right cluster
+of a multi-cluster environment. A single context is returned without a
+lookup; a node found in no context, or in several, is an error.
+*/
+func LocateNode(ctx context.Context, kubeconfig string, contexts []string, nodeName string) (string, error) {
+	return locateNode(ctx, contexts, nodeName, func(kubeCtx string) (kubernetes.Interface, error) {
+		return NewClientsetFromKubeConfig(kubeconfig, kubeCtx)
+	})
+}
+
+func locateNode(
+	ctx context.Context,
+	contexts []string,
+	nodeName string,
+	clientsetFor func(string) (kubernetes.Interface, error),
+) (string, error) {
+	if len(contexts) == 1 {
+		return contexts[0], nil
+	}
+	var found []string
+	for _, kubeCtx := range contexts {
+		clientset, err := clientsetFor(kubeCtx)
+		if err != nil {
+			return "", err
+		}
+		_, err = clientset.CoreV1().Nodes().Get(ctx, nodeName, v1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return "", fmt.Errorf("failed to look up node %s in %s: %w", nodeName, kubeCtx, err)
+		}
+		found = append(found, kubeCtx)
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("node %s not found in any of %s", nodeName, strings.Join(contexts, ", "))
+	case 1:
+		return found[0], nil
+	}
+	return "", fmt.Errorf("node %s exists in several clusters (%s); specify the cluster", nodeName, strings.Join(found, ", "))
+}
+
 type logWriter struct{ logger logging.Logger }
 
 func (w logWriter) Write(p []byte) (int, error) {
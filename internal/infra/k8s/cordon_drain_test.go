@@ -4,8 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/kubectl/pkg/drain"
 )
@@ -178,3 +181,29 @@ func TestDrainNode_Error(t *testing.T) { //nolint:paralleltest // paralleltest i
 		t.Error("expected error from runNodeDrain")
 	}
 }
+
+func TestLocateNode(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	node := func(name string) *corev1.Node { return &corev1.Node{ObjectMeta: v1.ObjectMeta{Name: name}} }
+	clusters := map[string]*fake.Clientset{
+		"a": fake.NewSimpleClientset(node("n1"), node("shared")),
+		"b": fake.NewSimpleClientset(node("n2"), node("shared")),
+	}
+	clientsetFor := func(c string) (kubernetes.Interface, error) { return clusters[c], nil }
+	contexts := []string{"a", "b"}
+
+	got, err := locateNode(ctx, contexts, "n2", clientsetFor)
+	require.NoError(t, err)
+	assert.Equal(t, "b", got)
+
+	got, err = locateNode(ctx, []string{"only"}, "anything", clientsetFor)
+	require.NoError(t, err)
+	assert.Equal(t, "only", got, "a single context needs no lookup")
+
+	_, err = locateNode(ctx, contexts, "missing", clientsetFor)
+	require.EqualError(t, err, "node missing not found in any of a, b")
+
+	_, err = locateNode(ctx, contexts, "shared", clientsetFor)
+	require.EqualError(t, err, "node shared exists in several clusters (a, b); specify the cluster")
+}
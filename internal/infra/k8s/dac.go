@@ -13,8 +13,8 @@ import (
 )
 
 // listDedicatedAIClusters returns all DedicatedAICluster resources from both v1alpha1 and v1beta1 CRDs.
-func listDedicatedAIClusters(ctx context.Context, client dynamic.Interface) ([]models.DedicatedAICluster, error) {
-	cache, err := buildPodCache(ctx, client)
+func listDedicatedAIClusters(ctx context.Context, client dynamic.Interface, resources []string) ([]models.DedicatedAICluster, error) {
+	cache, err := buildPodCache(ctx, client, resources)
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +131,11 @@ func listDedicatedAIClustersV2(ctx context.Context, client dynamic.Interface, ca
 
 /*
 LoadDedicatedAIClusters loads DedicatedAICluster information using the provided DedicatedAIClusterLister.
+Replica counts include only pods requesting one of resources (or
+DefaultGPUResources).
 */
-func LoadDedicatedAIClusters(ctx context.Context, client dynamic.Interface) (map[string][]models.DedicatedAICluster, error) {
-	dacs, err := listDedicatedAIClusters(ctx, client)
+func LoadDedicatedAIClusters(ctx context.Context, client dynamic.Interface, resources ...string) (map[string][]models.DedicatedAICluster, error) {
+	dacs, err := listDedicatedAIClusters(ctx, client, resources)
 	if err != nil {
 		return nil, err
 	}
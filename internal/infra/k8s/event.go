@@ -0,0 +1,187 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+// eventPageSize bounds each events List call; pages are followed via the
+// Continue token, as in LoadGPUWorkloadsByNode.
+const eventPageSize = 500
+
+// eventKinds are the involved-object kinds the Event category reports on;
+// each gets its own field-selected list/watch.
+var eventKinds = []string{models.EventObjectNode, models.EventObjectPod}
+
+func eventKindSelector(kind string) string {
+	return "involvedObject.kind=" + kind
+}
+
+/*
+LoadEvents lists the Kubernetes events whose involved object is a GPU node
+or a GPU workload pod, grouped by models.EventObjectKey: the node name, or
+<namespace>/<pod name>, so same-named pods in two namespaces keep their
+events apart. Within a group, events are ordered most recent first.
+
+GPU nodes and workloads are those ListGPUNodes and LoadGPUWorkloadsByNode
+report for resources; pods are matched by namespace and name so a
+same-named pod in another namespace is not attributed to the workload.
+*/
+func LoadEvents(ctx context.Context, clientset kubernetes.Interface, resources ...string) (map[string][]models.Event, error) {
+	nodes, err := newGPUResources(resources).listNodes(ctx, clientset, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gpu nodes: %w", err)
+	}
+	gpuNodes := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		gpuNodes[n.Name] = struct{}{}
+	}
+
+	workloads, err := LoadGPUWorkloadsByNode(ctx, clientset, resources...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gpu workloads: %w", err)
+	}
+	gpuPods := make(map[string]struct{})
+	for _, ws := range workloads {
+		for _, w := range ws {
+			gpuPods[models.EventObjectKey(models.EventObjectPod, w.Namespace, w.Name)] = struct{}{}
+		}
+	}
+
+	keep := func(obj corev1.ObjectReference) bool {
+		switch obj.Kind {
+		case models.EventObjectNode:
+			_, ok := gpuNodes[obj.Name]
+			return ok
+		case models.EventObjectPod:
+			_, ok := gpuPods[models.EventObjectKey(obj.Kind, obj.Namespace, obj.Name)]
+			return ok
+		}
+		return false
+	}
+
+	result := make(map[string][]models.Event)
+	lastSeen := make(map[string]time.Time)
+	now := time.Now()
+	for _, kind := range eventKinds {
+		sel := eventKindSelector(kind)
+		cont := ""
+		for {
+			page, err := clientset.CoreV1().Events("").List(ctx, v1.ListOptions{
+				FieldSelector: sel,
+				Limit:         eventPageSize,
+				Continue:      cont,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list events (%s): %w", sel, err)
+			}
+			for i := range page.Items {
+				ev := &page.Items[i]
+				// Re-check the kind: the field selector is advisory for
+				// some clients (the fake clientset ignores it).
+				if ev.InvolvedObject.Kind != kind || !keep(ev.InvolvedObject) {
+					continue
+				}
+				first, last := eventTimes(ev)
+				key := models.EventObjectKey(kind, ev.InvolvedObject.Namespace, ev.InvolvedObject.Name)
+				result[key] = append(result[key], models.Event{
+					Name:       ev.Name,
+					Namespace:  ev.Namespace,
+					ObjectKind: ev.InvolvedObject.Kind,
+					Object:     key,
+					Type:       ev.Type,
+					Reason:     ev.Reason,
+					Count:      eventCount(ev),
+					FirstSeen:  FormatAge(now.Sub(first)),
+					LastSeen:   FormatAge(now.Sub(last)),
+					Message:    ev.Message,
+				})
+				lastSeen[ev.Namespace+"/"+ev.Name] = last
+			}
+			cont = page.Continue
+			if cont == "" {
+				break
+			}
+		}
+	}
+
+	for _, evs := range result {
+		sort.SliceStable(evs, func(i, j int) bool {
+			return lastSeen[evs[i].Namespace+"/"+evs[i].Name].After(lastSeen[evs[j].Namespace+"/"+evs[j].Name])
+		})
+	}
+	logging.FromContext(ctx).Debugw("loaded events", "objects", len(result))
+	return result, nil
+}
+
+// eventTimes returns when an event was first and last observed. Events
+// written through the events.k8s.io API leave the legacy timestamps
+// zero and carry EventTime (plus Series for repeats) instead; the
+// creation timestamp is the last resort.
+func eventTimes(ev *corev1.Event) (first, last time.Time) {
+	first, last = ev.FirstTimestamp.Time, ev.LastTimestamp.Time
+	if first.IsZero() {
+		first = ev.EventTime.Time
+	}
+	if first.IsZero() {
+		first = ev.CreationTimestamp.Time
+	}
+	if last.IsZero() && ev.Series != nil {
+		last = ev.Series.LastObservedTime.Time
+	}
+	if last.IsZero() {
+		last = first
+	}
+	return first, last
+}
+
+// eventCount returns how many times the event occurred, preferring the
+// legacy Count and falling back to Series.Count; a single occurrence has
+// neither set.
+func eventCount(ev *corev1.Event) int {
+	switch {
+	case ev.Count > 0:
+		return int(ev.Count)
+	case ev.Series != nil && ev.Series.Count > 0:
+		return int(ev.Series.Count)
+	default:
+		return 1
+	}
+}
+
+// WatchEvents triggers on Node and Pod events. The involved objects are
+// not narrowed to GPU nodes/pods here (a field selector can't express
+// that); the debounce keeps the resulting reload rate bounded.
+func WatchEvents(ctx context.Context, clientset kubernetes.Interface) (<-chan struct{}, error) {
+	openers := make([]func(context.Context) (watch.Interface, error), 0, len(eventKinds))
+	for _, kind := range eventKinds {
+		sel := eventKindSelector(kind)
+		openers = append(openers, durableOpener(
+			func(ctx context.Context) (string, error) {
+				l, err := clientset.CoreV1().Events("").List(ctx, v1.ListOptions{
+					FieldSelector: sel,
+					Limit:         1,
+				})
+				if err != nil {
+					return "", err
+				}
+				return l.ResourceVersion, nil
+			},
+			func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = sel
+				return clientset.CoreV1().Events("").Watch(ctx, opts)
+			},
+		))
+	}
+	return watchTrigger(ctx, DebounceWindow, openers...)
+}
@@ -0,0 +1,113 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+func k8sEvent(name, kind, ns, obj, typ, reason string, count int32, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: kind, Namespace: ns, Name: obj,
+		},
+		Type:           typ,
+		Reason:         reason,
+		Message:        reason + " happened",
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(last.Add(-time.Hour)),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+func TestLoadEvents(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "gpu-1",
+		Labels: map[string]string{"nvidia.com/gpu.present": "true"},
+	}}
+	cpuNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cpu-1"}}
+	pod := gpuPod("serv", "gpu-1", 1, nil, nil)
+
+	cs := fake.NewSimpleClientset(node, cpuNode, pod,
+		k8sEvent("n-old", models.EventObjectNode, "default", "gpu-1", "Normal", "Ready", 1, now.Add(-2*time.Hour)),
+		k8sEvent("n-new", models.EventObjectNode, "default", "gpu-1", "Warning", "Unhealthy", 4, now.Add(-time.Minute)),
+		k8sEvent("cpu", models.EventObjectNode, "default", "cpu-1", "Warning", "Unhealthy", 1, now),
+		k8sEvent("p1", models.EventObjectPod, "ns1", "serv", "Warning", "BackOff", 0, now),
+		k8sEvent("other-ns", models.EventObjectPod, "ns2", "serv", "Normal", "Pulled", 1, now),
+		k8sEvent("svc", "Service", "ns1", "serv", "Normal", "Synced", 1, now),
+	)
+
+	got, err := LoadEvents(context.Background(), cs)
+	require.NoError(t, err)
+	require.Len(t, got, 2, "only GPU node and GPU pod events are kept: %v", got)
+
+	nodeEvs := got["gpu-1"]
+	require.Len(t, nodeEvs, 2)
+	assert.Equal(t, "n-new", nodeEvs[0].Name, "most recent event first")
+	assert.Equal(t, "n-old", nodeEvs[1].Name)
+	assert.Equal(t, 4, nodeEvs[0].Count)
+	assert.Equal(t, models.EventObjectNode, nodeEvs[0].ObjectKind)
+	assert.Equal(t, "gpu-1", nodeEvs[0].Object)
+	assert.True(t, nodeEvs[0].IsFaulty())
+	assert.NotEmpty(t, nodeEvs[0].FirstSeen)
+	assert.NotEmpty(t, nodeEvs[0].LastSeen)
+
+	podEvs := got["ns1/serv"]
+	require.Len(t, podEvs, 1, "same-named pod in another namespace and non-pod kinds are excluded")
+	assert.Equal(t, "p1", podEvs[0].Name)
+	assert.Equal(t, "ns1/serv", podEvs[0].Object, "pod events are grouped by namespace/name")
+	assert.Equal(t, 1, podEvs[0].Count, "zero count falls back to a single occurrence")
+	assert.Equal(t, "BackOff happened", podEvs[0].Message)
+}
+
+func TestEventTimes_FallsBackToEventTime(t *testing.T) {
+	t.Parallel()
+	at := time.Now().Add(-time.Hour)
+	ev := &corev1.Event{EventTime: metav1.NewMicroTime(at)}
+	first, last := eventTimes(ev)
+	assert.True(t, first.Equal(at))
+	assert.True(t, last.Equal(at))
+
+	later := at.Add(30 * time.Minute)
+	ev.Series = &corev1.EventSeries{Count: 3, LastObservedTime: metav1.NewMicroTime(later)}
+	_, last = eventTimes(ev)
+	assert.True(t, last.Equal(later))
+	assert.Equal(t, 3, eventCount(ev))
+}
+
+//nolint:paralleltest // mutates package-global DebounceWindow; must not run in parallel
+func TestWatchEvents_FiresOnEvent(t *testing.T) {
+	// Not parallel: this test writes the package-level DebounceWindow global.
+	old := DebounceWindow
+	DebounceWindow = 50 * time.Millisecond
+	defer func() { DebounceWindow = old }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs := fake.NewSimpleClientset()
+	trig, err := WatchEvents(ctx, cs)
+	require.NoError(t, err)
+
+	_, err = cs.CoreV1().Events("default").Create(ctx,
+		k8sEvent("e1", models.EventObjectNode, "default", "gpu-1", "Warning", "Unhealthy", 1, time.Now()),
+		metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case <-trig:
+	case <-time.After(time.Second):
+		t.Fatal("expected a trigger tick after event create")
+	}
+}
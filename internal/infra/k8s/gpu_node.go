@@ -13,105 +13,131 @@ import (
 	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
-// gpuProperty is the Kubernetes resource name for GPU.
-const gpuProperty corev1.ResourceName = "nvidia.com/gpu"
-
 const (
 	nodeCondGPUBus   corev1.NodeConditionType = "GPUBus"
 	nodeCondGPUCount corev1.NodeConditionType = "GPUCount"
 )
 
-// ListGPUNodes lists a list of gpu nodes up to the limit.
-func ListGPUNodes(ctx context.Context, clientset kubernetes.Interface, limit int) ([]models.GPUNode, error) {
-	nodes, err := clientset.CoreV1().Nodes().List(ctx, v1.ListOptions{
-		LabelSelector: "nvidia.com/gpu.present=true",
-		Limit:         int64(limit),
-	})
+// NodeOption configures ListGPUNodes and LoadGPUNodesByPool.
+type NodeOption func(*nodeOptions)
+
+type nodeOptions struct {
+	resources []string
+	rules     []HealthRule
+}
+
+// WithResources sets the accelerator resources counted as GPUs; none
+// means DefaultGPUResources.
+func WithResources(resources ...string) NodeOption {
+	return func(o *nodeOptions) { o.resources = resources }
+}
+
+// WithHealthRules adds rules to (or overrides by name) DefaultHealthRules.
+func WithHealthRules(rules []HealthRule) NodeOption {
+	return func(o *nodeOptions) { o.rules = rules }
+}
+
+/*
+ListGPUNodes lists a list of gpu nodes up to the limit. Allocatable and
+allocated GPUs are accounted per accelerator resource (WithResources, or
+DefaultGPUResources) and summed into the node's totals. Each node's
+Findings (and Issues) are the health rules it matches.
+*/
+func ListGPUNodes(ctx context.Context, clientset kubernetes.Interface, limit int, opts ...NodeOption) ([]models.GPUNode, error) {
+	var o nodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	res := newGPUResources(o.resources)
+	nodes, err := res.listNodes(ctx, clientset, limit)
 	if err != nil {
 		return nil, err
 	}
 
 	// 1. GPU allocation: as before, using processPodQueries (4 label selectors)
-	gpuAllocationMap := make(map[string]int64)
-	for _, node := range nodes.Items {
-		gpuAllocationMap[node.Name] = 0
-	}
+	gpuAllocationMap := make(map[string]map[corev1.ResourceName]int64)
 	err = processPodQueries(ctx, clientset, gpuPodSelectors, runningPodSelector,
-		getGPUAllocations,
-		func(node string, usage int64) {
-			gpuAllocationMap[node] += usage
+		gpuAllocations(res),
+		func(node string, usage map[corev1.ResourceName]int64) {
+			if gpuAllocationMap[node] == nil {
+				gpuAllocationMap[node] = make(map[corev1.ResourceName]int64)
+			}
+			for name, v := range usage {
+				gpuAllocationMap[node][name] += v
+			}
 		})
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. Pod issues: one extra query for all pods not Running/Succeeded
-	badPhaseSelector := "status.phase!=Running,status.phase!=Succeeded"
-	badPods, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
-		FieldSelector: badPhaseSelector,
-	})
+	// 2. Pod issues and health rules
+	podIssueMap, err := listPodIssues(ctx, clientset)
 	if err != nil {
 		return nil, err
 	}
-	podIssueMap := make(map[string][]string)
-	for _, p := range badPods.Items {
-		if p.Spec.NodeName == "" {
-			continue
-		}
-		// Defensive: fake clientset does not filter by phase, so skip healthy pods here.
-		if p.Status.Phase == corev1.PodRunning || p.Status.Phase == corev1.PodSucceeded {
-			continue
-		}
-		podIssueMap[p.Spec.NodeName] = append(
-			podIssueMap[p.Spec.NodeName],
-			fmt.Sprintf("pod %s: %s", p.Name, getPodReason(&p)),
-		)
+	health, err := newHealthEvaluator(ctx, clientset, mergeHealthRules(o.rules), nodes, podIssueMap)
+	if err != nil {
+		return nil, err
 	}
 
-	gpuNodes := make([]models.GPUNode, 0, len(nodes.Items))
-	for _, node := range nodes.Items {
-		allocQty := node.Status.Allocatable[gpuProperty]
-		allocatable, _ := allocQty.AsInt64()
-		age := FormatAge(time.Since(node.CreationTimestamp.Time))
-		issues := getNodeIssues(node.Status.Conditions)
-		// Add pod issues for this node
-		issues = append(issues, podIssueMap[node.Name]...)
+	gpuNodes := make([]models.GPUNode, 0, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		resources := nodeResources(res.allocatable(node), gpuAllocationMap[node.Name])
+		allocatable, allocated := 0, 0
+		for _, r := range resources {
+			allocatable += r.Allocatable
+			allocated += r.Allocated
+		}
+		findings := health.evaluate(node)
+		issues := make([]string, 0, len(findings))
+		for _, f := range findings {
+			issues = append(issues, f.Message)
+		}
 		gpuNodes = append(gpuNodes, models.GPUNode{
 			Name:                 node.Name,
 			InstanceType:         node.Labels["beta.kubernetes.io/instance-type"],
 			NodePool:             node.Labels["instance-pool.name"],
 			CompartmentID:        node.Annotations["oci.oraclecloud.com/compartment-id"],
 			ID:                   node.Spec.ProviderID,
-			Allocatable:          int(allocatable),
-			Allocated:            int(gpuAllocationMap[node.Name]),
+			Allocatable:          allocatable,
+			Allocated:            allocated,
 			IsReady:              isNodeReady(node.Status.Conditions),
 			IsSchedulingDisabled: node.Spec.Unschedulable,
-			Age:                  age,
+			Age:                  FormatAge(time.Since(node.CreationTimestamp.Time)),
 			Issues:               issues,
+			Findings:             findings,
+			Resources:            resources,
 		})
 	}
 	return gpuNodes, nil
 }
 
-// getNodeIssues returns a list of messages for alarming node conditions.
-func getNodeIssues(conditions []corev1.NodeCondition) []string {
-	issues := make([]string, 0)
-	for _, c := range conditions {
-		switch c.Type {
-		case corev1.NodeMemoryPressure,
-			corev1.NodeDiskPressure,
-			corev1.NodePIDPressure,
-			corev1.NodeNetworkUnavailable,
-			nodeCondGPUBus,
-			nodeCondGPUCount:
-			if c.Status == corev1.ConditionTrue {
-				issues = append(issues, c.Message)
-			}
-		case corev1.NodeReady:
-			// exhaustive check
+// listPodIssues returns "pod <name>: <reason>" for every scheduled pod
+// that is neither Running nor Succeeded, keyed by node name.
+func listPodIssues(ctx context.Context, clientset kubernetes.Interface) (map[string][]string, error) {
+	badPhaseSelector := "status.phase!=Running,status.phase!=Succeeded"
+	badPods, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
+		FieldSelector: badPhaseSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	podIssueMap := make(map[string][]string)
+	for _, p := range badPods.Items {
+		if p.Spec.NodeName == "" {
+			continue
+		}
+		// Defensive: fake clientset does not filter by phase, so skip healthy pods here.
+		if p.Status.Phase == corev1.PodRunning || p.Status.Phase == corev1.PodSucceeded {
+			continue
 		}
+		podIssueMap[p.Spec.NodeName] = append(
+			podIssueMap[p.Spec.NodeName],
+			fmt.Sprintf("pod %s: %s", p.Name, getPodReason(&p)),
+		)
 	}
-	return issues
+	return podIssueMap, nil
 }
 
 func getPodReason(p *corev1.Pod) string {
@@ -140,10 +166,11 @@ func isNodeReady(conditions []corev1.NodeCondition) bool {
 
 /*
 LoadGPUNodesByPool returns a map of node pool names to slices of GPUNode.
-It fetches all GPU nodes and groups them by their node pool label.
+It fetches all GPU nodes (see ListGPUNodes) and groups them by their node
+pool label.
 */
-func LoadGPUNodesByPool(ctx context.Context, clientset kubernetes.Interface) (map[string][]models.GPUNode, error) {
-	nodes, err := ListGPUNodes(ctx, clientset, 0)
+func LoadGPUNodesByPool(ctx context.Context, clientset kubernetes.Interface, opts ...NodeOption) (map[string][]models.GPUNode, error) {
+	nodes, err := ListGPUNodes(ctx, clientset, 0, opts...)
 	if err != nil {
 		return nil, err
 	}
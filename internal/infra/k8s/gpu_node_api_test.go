@@ -12,6 +12,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	cgotesting "k8s.io/client-go/testing"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
 func makeNode(name string, labels map[string]string, allocatable int64, unschedulable bool, conditions []corev1.NodeCondition) *corev1.Node {
@@ -156,3 +158,66 @@ func TestLoadGPUNodes_Empty(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, result)
 }
+
+func makeAcceleratorNode(name string, labels map[string]string, resources corev1.ResourceList) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Capacity:    resources,
+			Allocatable: resources,
+			Conditions:  []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func TestListGPUNodes_AcceleratorResources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const (
+		amd   corev1.ResourceName = "amd.com/gpu"
+		mig1g corev1.ResourceName = "nvidia.com/mig-1g.10gb"
+		mig3g corev1.ResourceName = "nvidia.com/mig-3g.40gb"
+	)
+	qty := func(n int64) resource.Quantity { return *resource.NewQuantity(n, resource.DecimalSI) }
+	amdNode := makeAcceleratorNode("amd", map[string]string{
+		"beta.kubernetes.io/instance-type": "BM.GPU.MI300X.8",
+	}, corev1.ResourceList{amd: qty(8)})
+	migNode := makeAcceleratorNode("mig", map[string]string{
+		"nvidia.com/gpu.present":           "true",
+		"beta.kubernetes.io/instance-type": "BM.GPU.A100-v2.8",
+	}, corev1.ResourceList{mig1g: qty(7), mig3g: qty(2)})
+	cpuNode := makeAcceleratorNode("cpu", nil, corev1.ResourceList{corev1.ResourceCPU: qty(64)})
+	amdPod := makePod("amd-pod", "amd", 0, servingLabelV1)
+	amdPod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{amd: qty(2)}
+	migPod := makePod("mig-pod", "mig", 0, servingLabelV1)
+	migPod.Spec.Containers[0].Resources.Requests = corev1.ResourceList{mig1g: qty(3)}
+	client := fake.NewSimpleClientset(amdNode, migNode, cpuNode, amdPod, migPod)
+
+	nodes, err := ListGPUNodes(ctx, client, 0)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1, "the default resources select labeled NVIDIA nodes only")
+	assert.Equal(t, "mig", nodes[0].Name)
+	assert.Zero(t, nodes[0].Allocatable)
+
+	nodes, err = ListGPUNodes(ctx, client, 0, WithResources(string(gpuProperty), string(amd), string(mig1g), string(mig3g)))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2, "the CPU node is skipped")
+	byName := map[string]models.GPUNode{nodes[0].Name: nodes[0], nodes[1].Name: nodes[1]}
+
+	a := byName["amd"]
+	assert.Equal(t, 8, a.Allocatable)
+	assert.Equal(t, 2, a.Allocated)
+	assert.Equal(t, []models.GPUResource{{Name: string(amd), Allocatable: 8, Allocated: 2}}, a.Resources)
+	assert.Empty(t, a.Profiles())
+	assert.Equal(t, "OK", a.GetStatus())
+
+	m := byName["mig"]
+	assert.Equal(t, 9, m.Allocatable)
+	assert.Equal(t, 3, m.Allocated)
+	assert.Equal(t, "mig-1g.10gb 4/7, mig-3g.40gb 2/2", m.Profiles())
+	assert.Equal(t, "OK", m.GetStatus(), "MIG slices are not checked against the shape's GPU count")
+
+	nodes, err = ListGPUNodes(ctx, client, 1, WithResources(string(amd)))
+	require.NoError(t, err)
+	assert.Len(t, nodes, 1)
+}
@@ -1,12 +1,17 @@
 package k8s
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/fake"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
 func TestIsNodeReady(t *testing.T) {
@@ -44,7 +49,7 @@ func TestIsNodeReady(t *testing.T) {
 	}
 }
 
-func TestCalculatePodGPUs(t *testing.T) {
+func TestGPUResources_PodGPUs(t *testing.T) {
 	t.Parallel()
 	pod := corev1.Pod{
 		Spec: corev1.PodSpec{
@@ -57,7 +62,8 @@ func TestCalculatePodGPUs(t *testing.T) {
 			},
 		},
 	}
-	assert.Equal(t, int64(3), calculatePodGPUs(&pod))
+	assert.Equal(t, int64(3), newGPUResources(nil).podGPUs(&pod))
+	assert.Zero(t, newGPUResources([]string{"amd.com/gpu"}).podGPUs(&pod))
 }
 
 func TestFormatAge(t *testing.T) {
@@ -85,7 +91,7 @@ func TestFormatAge(t *testing.T) {
 	}
 }
 
-func TestGetNodeIssues(t *testing.T) {
+func TestDefaultHealthRules_Conditions(t *testing.T) {
 	t.Parallel()
 	conds := []corev1.NodeCondition{
 		{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionTrue, Message: "memory pressure!"},
@@ -103,7 +109,14 @@ func TestGetNodeIssues(t *testing.T) {
 		"gpu bus error!",
 		"gpu count mismatch!",
 	}
-	got := getNodeIssues(conds)
+	node := &corev1.Node{Status: corev1.NodeStatus{Conditions: conds}}
+	e, err := newHealthEvaluator(context.Background(), fake.NewSimpleClientset(), mergeHealthRules(nil), nil, nil)
+	require.NoError(t, err)
+	got := make([]string, 0, len(want))
+	for _, f := range e.evaluate(node) {
+		assert.Equal(t, models.SeverityError, f.Severity)
+		got = append(got, f.Message)
+	}
 	assert.ElementsMatch(t, want, got)
 }
 
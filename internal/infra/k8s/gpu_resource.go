@@ -0,0 +1,186 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+// gpuProperty is the Kubernetes resource name for a whole NVIDIA GPU.
+const gpuProperty corev1.ResourceName = "nvidia.com/gpu"
+
+// nodePageSize bounds each Nodes List call when GPU nodes cannot be
+// pre-selected by label (see gpuResources.nodeSelector).
+const nodePageSize = 500
+
+// DefaultGPUResources are the accelerator resources counted as GPUs
+// when none are configured.
+var DefaultGPUResources = []string{string(gpuProperty)}
+
+/*
+gpuResources is the set of extended resources counted as GPUs, e.g.
+nvidia.com/gpu, amd.com/gpu or a MIG profile such as
+nvidia.com/mig-1g.10gb. Every loader that counts GPUs resolves its
+variadic resources argument through newGPUResources so node allocation,
+workload requests and scheduling hints agree on what a GPU is.
+*/
+type gpuResources []corev1.ResourceName
+
+// newGPUResources returns names as resources, or DefaultGPUResources
+// when names is empty.
+func newGPUResources(names []string) gpuResources {
+	if len(names) == 0 {
+		names = DefaultGPUResources
+	}
+	res := make(gpuResources, 0, len(names))
+	for _, n := range names {
+		res = append(res, corev1.ResourceName(n))
+	}
+	return res
+}
+
+// requests returns the pod's non-zero requests per resource. Only
+// Spec.Containers count (not init/sidecars) — GPU workloads request the
+// device on their main container. Requests equal limits for extended
+// resources, which Kubernetes forbids overcommitting.
+func (r gpuResources) requests(pod *corev1.Pod) map[corev1.ResourceName]int64 {
+	result := make(map[corev1.ResourceName]int64)
+	for _, container := range pod.Spec.Containers {
+		for _, name := range r {
+			if val, ok := container.Resources.Requests[name]; ok && val.Value() > 0 {
+				result[name] += val.Value()
+			}
+		}
+	}
+	return result
+}
+
+// podGPUs returns the pod's total request across all resources.
+func (r gpuResources) podGPUs(pod *corev1.Pod) int64 {
+	var total int64
+	for _, v := range r.requests(pod) {
+		total += v
+	}
+	return total
+}
+
+// allocatable returns the node's non-zero allocatable count per resource.
+func (r gpuResources) allocatable(node *corev1.Node) map[corev1.ResourceName]int64 {
+	result := make(map[corev1.ResourceName]int64)
+	for _, name := range r {
+		if q, ok := node.Status.Allocatable[name]; ok && q.Value() > 0 {
+			result[name] = q.Value()
+		}
+	}
+	return result
+}
+
+// nodeSelector returns gpuNodeSelector when every resource is an NVIDIA
+// one — GPU feature discovery labels those nodes, MIG-partitioned ones
+// included — and "" otherwise, in which case nodes are matched by
+// isGPUNode instead.
+func (r gpuResources) nodeSelector() string {
+	for _, name := range r {
+		if !strings.HasPrefix(string(name), "nvidia.com/") {
+			return ""
+		}
+	}
+	return gpuNodeSelector
+}
+
+// isGPUNode reports whether node carries the NVIDIA GPU label or has
+// capacity for any of the resources. Capacity rather than allocatable
+// keeps a node whose devices dropped off the bus listed (as "Missing
+// GPUs") instead of hiding it.
+func (r gpuResources) isGPUNode(node *corev1.Node) bool {
+	if node.Labels["nvidia.com/gpu.present"] == "true" {
+		return true
+	}
+	for _, name := range r {
+		if q, ok := node.Status.Capacity[name]; ok && q.Value() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// listNodes returns up to limit (<= 0 for all) GPU nodes. With an NVIDIA
+// only resource set the label selector narrows the list server-side;
+// otherwise all nodes are paged through and filtered by isGPUNode.
+func (r gpuResources) listNodes(ctx context.Context, clientset kubernetes.Interface, limit int) ([]corev1.Node, error) {
+	if sel := r.nodeSelector(); sel != "" {
+		opts := v1.ListOptions{LabelSelector: sel}
+		if limit > 0 {
+			opts.Limit = int64(limit)
+		}
+		nodes, err := clientset.CoreV1().Nodes().List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return nodes.Items, nil
+	}
+
+	var result []corev1.Node
+	cont := ""
+	for {
+		page, err := clientset.CoreV1().Nodes().List(ctx, v1.ListOptions{
+			Limit:    nodePageSize,
+			Continue: cont,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Items {
+			if !r.isGPUNode(&page.Items[i]) {
+				continue
+			}
+			result = append(result, page.Items[i])
+			if limit > 0 && len(result) == limit {
+				return result, nil
+			}
+		}
+		cont = page.Continue
+		if cont == "" {
+			return result, nil
+		}
+	}
+}
+
+// nodeResources returns the node's per-resource breakdown sorted by
+// name, covering every resource that is allocatable or allocated.
+func nodeResources(allocatable, allocated map[corev1.ResourceName]int64) []models.GPUResource {
+	names := make(map[corev1.ResourceName]struct{}, len(allocatable))
+	for n := range allocatable {
+		names[n] = struct{}{}
+	}
+	for n := range allocated {
+		names[n] = struct{}{}
+	}
+	result := make([]models.GPUResource, 0, len(names))
+	for n := range names {
+		result = append(result, models.GPUResource{
+			Name:        string(n),
+			Allocatable: int(allocatable[n]),
+			Allocated:   int(allocated[n]),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// workloadResources returns the pod's per-resource requests sorted by
+// name.
+func workloadResources(requests map[corev1.ResourceName]int64) []models.GPURequest {
+	result := make([]models.GPURequest, 0, len(requests))
+	for n, v := range requests {
+		result = append(result, models.GPURequest{Name: string(n), Count: int(v)})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -18,15 +19,17 @@ import (
 const gpuWorkloadPageSize = 500
 
 // LoadGPUWorkloadsByNode lists running pods that consume GPU and groups
-// them by spec.nodeName (== GPUNode.Name). A pod qualifies when it limits
-// nvidia.com/gpu > 0 and is scheduled to a node.
+// them by spec.nodeName (== GPUNode.Name). A pod qualifies when it
+// requests any of resources (or DefaultGPUResources) and is scheduled to a
+// node; GPUs sums the requests and Resources breaks them down.
 //
 // Detection is intentionally broad (any GPU-consuming pod, not just
 // serving workloads), so it cannot pre-narrow with a label selector the
 // way the GPUNode allocation path does. To keep that broad scan bounded
 // on large clusters, pods are listed in pages of gpuWorkloadPageSize and
 // accumulated across the Continue token rather than in a single response.
-func LoadGPUWorkloadsByNode(ctx context.Context, clientset kubernetes.Interface) (map[string][]models.GPUWorkload, error) {
+func LoadGPUWorkloadsByNode(ctx context.Context, clientset kubernetes.Interface, resources ...string) (map[string][]models.GPUWorkload, error) {
+	res := newGPUResources(resources)
 	result := make(map[string][]models.GPUWorkload)
 	total := 0
 	cont := ""
@@ -41,27 +44,14 @@ func LoadGPUWorkloadsByNode(ctx context.Context, clientset kubernetes.Interface)
 		}
 		for i := range page.Items {
 			pod := &page.Items[i]
-			// calculatePodGPUs (requests) is the same accounting the
-			// GPUNode "Allocated" sum uses. For nvidia.com/gpu requests
-			// equal limits (Kubernetes forbids overcommitting extended
-			// resources), so this matches the spec's "limits" intent while
-			// keeping a single source of truth. Only Spec.Containers count
-			// (not init/sidecars) — GPU workloads request the device on
-			// their main container.
-			gpus := int(calculatePodGPUs(pod))
-			if gpus <= 0 || pod.Spec.NodeName == "" {
+			// res.requests is the same accounting the GPUNode "Allocated"
+			// sum uses, so the two categories agree per resource.
+			requests := res.requests(pod)
+			if len(requests) == 0 || pod.Spec.NodeName == "" {
 				continue
 			}
 			labels := pod.Labels
 			annos := pod.Annotations
-			// Only ContainerStatuses: init containers run once at startup,
-			// so their restarts don't signal an ongoing serving failure.
-			// Restart/faulty visibility is bounded by the status.phase=Running
-			// selector above — a fully-down crash-looper won't appear here.
-			var restarts int
-			for _, cs := range pod.Status.ContainerStatuses {
-				restarts += int(cs.RestartCount)
-			}
 			age := ""
 			if ts := pod.CreationTimestamp; !ts.IsZero() {
 				age = FormatAge(time.Since(ts.Time))
@@ -73,11 +63,12 @@ func LoadGPUWorkloadsByNode(ctx context.Context, clientset kubernetes.Interface)
 				Namespace: pod.Namespace,
 				Model:     labels["base-model-name"],
 				Runtime:   labels["serving-runtime"],
-				GPUs:      gpus,
-				Restarts:  restarts,
+				GPUs:      int(res.podGPUs(pod)),
 				Age:       age,
 				Mode:      annos["ome.io/deploymentMode"],
+				Resources: workloadResources(requests),
 			}
+			setContainerStates(&w, pod.Status.ContainerStatuses)
 			result[pod.Spec.NodeName] = append(result[pod.Spec.NodeName], w)
 			total++
 		}
@@ -90,3 +81,63 @@ func LoadGPUWorkloadsByNode(ctx context.Context, clientset kubernetes.Interface)
 		"workloads", total, "nodes", len(result))
 	return result, nil
 }
+
+// setContainerStates fills w's restart count and crash-loop triage fields
+// from the pod's container statuses. Only ContainerStatuses count: init
+// containers run once at startup, so their restarts don't signal an
+// ongoing serving failure. A crash-looping pod stays in phase Running, so
+// the status.phase=Running selector still lists it.
+func setContainerStates(w *models.GPUWorkload, statuses []corev1.ContainerStatus) {
+	now := time.Now()
+	var lastRestart time.Time
+	w.Containers = make([]models.ContainerState, 0, len(statuses))
+	for _, cs := range statuses {
+		c := models.ContainerState{
+			Name:     cs.Name,
+			Ready:    cs.Ready,
+			Restarts: int(cs.RestartCount),
+			State:    containerState(cs.State),
+		}
+		if t := cs.LastTerminationState.Terminated; t != nil {
+			c.LastTerminationReason = t.Reason
+			if c.LastTerminationReason == "" {
+				c.LastTerminationReason = "Terminated"
+			}
+			c.LastExitCode = int(t.ExitCode)
+			if at := t.FinishedAt.Time; !at.IsZero() {
+				c.LastRestart = FormatAge(now.Sub(at))
+				if at.After(lastRestart) {
+					lastRestart = at
+				}
+			}
+		}
+		if c.State == models.ContainerStateCrashLoop {
+			w.CrashLoop = true
+		}
+		w.Restarts += c.Restarts
+		w.Containers = append(w.Containers, c)
+	}
+	if !lastRestart.IsZero() {
+		w.LastRestart = FormatAge(now.Sub(lastRestart))
+	}
+}
+
+// containerState returns "Running" or the reason the container is
+// waiting or terminated, falling back to the bare state name.
+func containerState(s corev1.ContainerState) string {
+	switch {
+	case s.Waiting != nil:
+		if s.Waiting.Reason != "" {
+			return s.Waiting.Reason
+		}
+		return "Waiting"
+	case s.Terminated != nil:
+		if s.Terminated.Reason != "" {
+			return s.Terminated.Reason
+		}
+		return "Terminated"
+	case s.Running != nil:
+		return "Running"
+	}
+	return ""
+}
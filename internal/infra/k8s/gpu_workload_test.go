@@ -3,13 +3,18 @@ package k8s
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
 func gpuPod(name, node string, gpus int64, labels, annos map[string]string) *corev1.Pod {
@@ -17,7 +22,7 @@ func gpuPod(name, node string, gpus int64, labels, annos map[string]string) *cor
 	if gpus > 0 {
 		// Set both requests and limits — Kubernetes requires them equal
 		// for nvidia.com/gpu, and the loader counts requests via
-		// calculatePodGPUs.
+		// gpuResources.requests.
 		q := corev1.ResourceList{gpuProperty: *resource.NewQuantity(gpus, resource.DecimalSI)}
 		c.Resources = corev1.ResourceRequirements{Requests: q, Limits: q}
 	}
@@ -75,6 +80,76 @@ func TestLoadGPUWorkloadsByNode(t *testing.T) {
 	}
 }
 
+func TestLoadGPUWorkloadsByNode_Resources(t *testing.T) {
+	t.Parallel()
+	const mig corev1.ResourceName = "nvidia.com/mig-1g.10gb"
+	sliced := gpuPod("sliced", "node-a", 0, nil, nil)
+	q := corev1.ResourceList{mig: *resource.NewQuantity(2, resource.DecimalSI)}
+	sliced.Spec.Containers[0].Resources = corev1.ResourceRequirements{Requests: q, Limits: q}
+	cs := fake.NewSimpleClientset(sliced, gpuPod("whole", "node-b", 4, nil, nil))
+
+	got, err := LoadGPUWorkloadsByNode(context.Background(), cs)
+	require.NoError(t, err)
+	assert.NotContains(t, got, "node-a", "MIG requests are not counted unless configured")
+
+	got, err = LoadGPUWorkloadsByNode(context.Background(), cs, string(gpuProperty), string(mig))
+	require.NoError(t, err)
+	require.Len(t, got["node-a"], 1)
+	w := got["node-a"][0]
+	assert.Equal(t, 2, w.GPUs)
+	assert.Equal(t, []models.GPURequest{{Name: string(mig), Count: 2}}, w.Resources)
+	assert.Equal(t, "mig-1g.10gb x2", w.Profiles())
+	require.Len(t, got["node-b"], 1)
+	assert.Equal(t, 4, got["node-b"][0].GPUs)
+	assert.Empty(t, got["node-b"][0].Profiles())
+}
+
+func TestLoadGPUWorkloadsByNode_ContainerStates(t *testing.T) {
+	t.Parallel()
+	finished := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	looping := gpuPod("loop", "node-a", 1, nil, nil)
+	looping.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name: "main", RestartCount: 5,
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				Reason: "OOMKilled", ExitCode: 137, FinishedAt: finished,
+			}},
+		},
+		{
+			Name: "proxy", Ready: true,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		},
+	}
+
+	got, err := LoadGPUWorkloadsByNode(context.Background(), fake.NewSimpleClientset(looping))
+	require.NoError(t, err)
+	require.Len(t, got["node-a"], 1)
+	w := got["node-a"][0]
+	assert.True(t, w.CrashLoop)
+	assert.Equal(t, 5, w.Restarts)
+	assert.Equal(t, "10m", w.LastRestart)
+	assert.Equal(t, []models.ContainerState{
+		{Name: "main", Restarts: 5, State: "CrashLoopBackOff", LastTerminationReason: "OOMKilled", LastExitCode: 137, LastRestart: "10m"},
+		{Name: "proxy", Ready: true, State: "Running"},
+	}, w.Containers)
+	assert.Equal(t, "1/2", w.Ready())
+	assert.Equal(t, "CrashLoopBackOff: OOMKilled (exit 137) [main]", w.FaultyReason())
+}
+
+func TestContainerState(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Running", containerState(corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}))
+	assert.Equal(t, "ContainerCreating", containerState(corev1.ContainerState{
+		Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"},
+	}))
+	assert.Equal(t, "Waiting", containerState(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}))
+	assert.Equal(t, "Error", containerState(corev1.ContainerState{
+		Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+	}))
+	assert.Empty(t, containerState(corev1.ContainerState{}))
+}
+
 // TestLoadGPUWorkloadsByNode_Paginates verifies the loader follows the
 // Continue token and accumulates workloads across pages. The fake
 // clientset ignores Limit/Continue, so a reactor simulates a paged
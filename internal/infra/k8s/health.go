@@ -0,0 +1,395 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+// Health rule severities beyond models.SeverityError/SeverityWarning, and
+// the scopes a mismatch rule compares within.
+const (
+	SeverityOff     = "off"
+	MismatchPool    = "pool"
+	MismatchCluster = "cluster"
+)
+
+// defaultEventWindow is how far back an event rule looks when Within is
+// unset.
+const defaultEventWindow = time.Hour
+
+/*
+HealthRule is a declarative GPU node health check. Exactly one of
+Condition, Taint, Label, Field, Event or FailedPods selects what it
+inspects:
+
+  - Condition matches a node condition of that type whose status is Status
+    (default "True").
+  - Taint matches a taint with that key, and one of Values if set.
+  - Label and Field (a NodeInfo field, one of models.NodeInfoFields) match when the
+    value is one of Values, when it differs from the most common value in
+    the node's pool or the cluster (Mismatch), or — for a label with
+    neither — when the label is present.
+  - Event matches a node event with that reason last seen within Within
+    (default one hour).
+  - FailedPods matches pods on the node that are neither Running nor
+    Succeeded.
+
+A match becomes a models.HealthFinding with Severity (default
+models.SeverityError) and Message, in which {node}, {value} and
+{expected} are substituted; an empty Message describes the match.
+*/
+type HealthRule struct {
+	Name       string
+	Severity   string
+	Message    string
+	Condition  string
+	Status     string
+	Taint      string
+	Label      string
+	Field      string
+	Values     []string
+	Mismatch   string
+	Event      string
+	Within     time.Duration
+	FailedPods bool
+}
+
+// DefaultHealthRules are the checks every node gets. Configured rules
+// with the same name replace them; SeverityOff disables one.
+var DefaultHealthRules = []HealthRule{
+	{Name: "memory-pressure", Condition: string(corev1.NodeMemoryPressure)},
+	{Name: "disk-pressure", Condition: string(corev1.NodeDiskPressure)},
+	{Name: "pid-pressure", Condition: string(corev1.NodePIDPressure)},
+	{Name: "network-unavailable", Condition: string(corev1.NodeNetworkUnavailable)},
+	{Name: "gpu-bus", Condition: string(nodeCondGPUBus)},
+	{Name: "gpu-count", Condition: string(nodeCondGPUCount)},
+	{Name: "pod-phase", FailedPods: true},
+}
+
+// nodeInfoFields reads each of models.NodeInfoFields, the fields a Field
+// rule may name, from a node's NodeInfo.
+var nodeInfoFields = map[string]func(corev1.NodeSystemInfo) string{
+	models.NodeInfoKubeletVersion:   func(i corev1.NodeSystemInfo) string { return i.KubeletVersion },
+	models.NodeInfoKernelVersion:    func(i corev1.NodeSystemInfo) string { return i.KernelVersion },
+	models.NodeInfoOSImage:          func(i corev1.NodeSystemInfo) string { return i.OSImage },
+	models.NodeInfoContainerRuntime: func(i corev1.NodeSystemInfo) string { return i.ContainerRuntimeVersion },
+}
+
+// mergeHealthRules returns DefaultHealthRules with custom applied: a
+// custom rule replaces the default of the same name or is appended, and
+// SeverityOff rules are dropped.
+func mergeHealthRules(custom []HealthRule) []HealthRule {
+	merged := append([]HealthRule(nil), DefaultHealthRules...)
+	for _, r := range custom {
+		replaced := false
+		for i := range merged {
+			if merged[i].Name == r.Name {
+				merged[i], replaced = r, true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, r)
+		}
+	}
+	result := merged[:0]
+	for _, r := range merged {
+		if r.Severity != SeverityOff {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// healthEvaluator evaluates rules against one listing of nodes. Mismatch
+// rules need every node's value up front and event rules the node
+// events, so both are gathered once in newHealthEvaluator.
+type healthEvaluator struct {
+	rules []HealthRule
+	// expected[i] maps a scope (pool name, or "" for the cluster) to the
+	// most common value of mismatch rule i.
+	expected  []map[string]string
+	events    map[string][]*corev1.Event
+	podIssues map[string][]string
+	now       time.Time
+}
+
+func newHealthEvaluator(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	rules []HealthRule,
+	nodes []corev1.Node,
+	podIssues map[string][]string,
+) (*healthEvaluator, error) {
+	e := &healthEvaluator{
+		rules:     rules,
+		expected:  make([]map[string]string, len(rules)),
+		podIssues: podIssues,
+		now:       time.Now(),
+	}
+	reasons := make(map[string]struct{})
+	for i, r := range rules {
+		if r.Mismatch != "" {
+			e.expected[i] = majorities(nodes, r)
+		}
+		if r.Event != "" {
+			reasons[r.Event] = struct{}{}
+		}
+	}
+	if len(reasons) > 0 {
+		events, err := nodeEvents(ctx, clientset, reasons)
+		if err != nil {
+			return nil, err
+		}
+		e.events = events
+	}
+	return e, nil
+}
+
+// evaluate returns the node's findings in rule order.
+func (e *healthEvaluator) evaluate(node *corev1.Node) []models.HealthFinding {
+	findings := make([]models.HealthFinding, 0)
+	for i, r := range e.rules {
+		for _, m := range e.match(i, node) {
+			severity := r.Severity
+			if severity == "" {
+				severity = models.SeverityError
+			}
+			findings = append(findings, models.HealthFinding{
+				Rule:     r.Name,
+				Severity: severity,
+				Message:  m,
+			})
+		}
+	}
+	return findings
+}
+
+// match returns one message per match of rule i on node.
+func (e *healthEvaluator) match(i int, node *corev1.Node) []string {
+	r := e.rules[i]
+	switch {
+	case r.Condition != "":
+		return matchCondition(r, node)
+	case r.Taint != "":
+		return matchTaint(r, node)
+	case r.Label != "" || r.Field != "":
+		return e.matchValue(i, node)
+	case r.Event != "":
+		return e.matchEvent(r, node)
+	case r.FailedPods:
+		msgs := make([]string, 0, len(e.podIssues[node.Name]))
+		for _, m := range e.podIssues[node.Name] {
+			msgs = append(msgs, r.render(m, node, m, ""))
+		}
+		return msgs
+	}
+	return nil
+}
+
+func matchCondition(r HealthRule, node *corev1.Node) []string {
+	status := r.Status
+	if status == "" {
+		status = string(corev1.ConditionTrue)
+	}
+	for _, c := range node.Status.Conditions {
+		if string(c.Type) != r.Condition || string(c.Status) != status {
+			continue
+		}
+		msg := c.Message
+		if msg == "" {
+			msg = fmt.Sprintf("%s is %s", c.Type, c.Status)
+		}
+		return []string{r.render(msg, node, string(c.Status), "")}
+	}
+	return nil
+}
+
+func matchTaint(r HealthRule, node *corev1.Node) []string {
+	for _, t := range node.Spec.Taints {
+		if t.Key != r.Taint || (len(r.Values) > 0 && !slices.Contains(r.Values, t.Value)) {
+			continue
+		}
+		return []string{r.render("taint "+t.ToString(), node, t.Value, "")}
+	}
+	return nil
+}
+
+func (e *healthEvaluator) matchValue(i int, node *corev1.Node) []string {
+	r := e.rules[i]
+	v, ok := ruleValue(r, node)
+	if !ok {
+		return nil
+	}
+	what := r.Label
+	if r.Field != "" {
+		what = r.Field
+	}
+	switch {
+	case r.Mismatch != "":
+		exp := e.expected[i][mismatchScope(r, node)]
+		if exp == "" || v == exp {
+			return nil
+		}
+		msg := fmt.Sprintf("%s %s differs from %s majority %s", what, v, r.Mismatch, exp)
+		return []string{r.render(msg, node, v, exp)}
+	case len(r.Values) > 0 && !slices.Contains(r.Values, v):
+		return nil
+	}
+	return []string{r.render(fmt.Sprintf("%s is %s", what, v), node, v, "")}
+}
+
+func (e *healthEvaluator) matchEvent(r HealthRule, node *corev1.Node) []string {
+	within := r.Within
+	if within <= 0 {
+		within = defaultEventWindow
+	}
+	var latest *corev1.Event
+	var latestAt time.Time
+	for _, ev := range e.events[node.Name] {
+		if ev.Reason != r.Event {
+			continue
+		}
+		if _, last := eventTimes(ev); e.now.Sub(last) <= within && last.After(latestAt) {
+			latest, latestAt = ev, last
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+	msg := fmt.Sprintf("%s x%d, last %s ago: %s", latest.Reason, eventCount(latest),
+		FormatAge(e.now.Sub(latestAt)), latest.Message)
+	return []string{r.render(msg, node, latest.Message, "")}
+}
+
+// render returns r.Message with its placeholders substituted, or def
+// when the rule has no message.
+func (r HealthRule) render(def string, node *corev1.Node, value, expected string) string {
+	if r.Message == "" {
+		return def
+	}
+	return strings.NewReplacer(
+		"{node}", node.Name,
+		"{value}", value,
+		"{expected}", expected,
+	).Replace(r.Message)
+}
+
+// ruleValue returns the label or NodeInfo field a value rule reads, and
+// whether the node has one.
+func ruleValue(r HealthRule, node *corev1.Node) (string, bool) {
+	if r.Label != "" {
+		v, ok := node.Labels[r.Label]
+		return v, ok
+	}
+	get, ok := nodeInfoFields[r.Field]
+	if !ok {
+		return "", false
+	}
+	v := get(node.Status.NodeInfo)
+	return v, v != ""
+}
+
+func mismatchScope(r HealthRule, node *corev1.Node) string {
+	if r.Mismatch == MismatchPool {
+		return node.Labels[instancePoolLabel]
+	}
+	return ""
+}
+
+// majorities returns the most common value of rule r per scope. Ties go
+// to the newer value (see newerValue), so the newer of two equally common
+// versions is the expected one.
+func majorities(nodes []corev1.Node, r HealthRule) map[string]string {
+	counts := make(map[string]map[string]int)
+	for i := range nodes {
+		v, ok := ruleValue(r, &nodes[i])
+		if !ok || v == "" {
+			continue
+		}
+		scope := mismatchScope(r, &nodes[i])
+		if counts[scope] == nil {
+			counts[scope] = make(map[string]int)
+		}
+		counts[scope][v]++
+	}
+	result := make(map[string]string, len(counts))
+	for scope, byValue := range counts {
+		values := make([]string, 0, len(byValue))
+		for v := range byValue {
+			values = append(values, v)
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if byValue[values[i]] != byValue[values[j]] {
+				return byValue[values[i]] > byValue[values[j]]
+			}
+			return newerValue(values[i], values[j])
+		})
+		result[scope] = values[0]
+	}
+	return result
+}
+
+// newerValue reports whether node info value a is newer than b. Values
+// that parse as versions, optionally behind a runtime scheme such as
+// "containerd://", compare numerically, so v1.30.10 is newer than v1.30.9;
+// anything else (an OS image name, or equal versions) compares as text.
+func newerValue(a, b string) bool {
+	va, errA := version.ParseGeneric(afterScheme(a))
+	vb, errB := version.ParseGeneric(afterScheme(b))
+	if errA == nil && errB == nil && !va.EqualTo(vb) {
+		return va.GreaterThan(vb)
+	}
+	return a > b
+}
+
+// afterScheme strips a "<scheme>://" prefix from s.
+func afterScheme(s string) string {
+	if _, rest, ok := strings.Cut(s, "://"); ok {
+		return rest
+	}
+	return s
+}
+
+// nodeEvents returns the node events with one of reasons, keyed by node
+// name.
+func nodeEvents(ctx context.Context, clientset kubernetes.Interface, reasons map[string]struct{}) (map[string][]*corev1.Event, error) {
+	sel := eventKindSelector(models.EventObjectNode)
+	result := make(map[string][]*corev1.Event)
+	cont := ""
+	for {
+		page, err := clientset.CoreV1().Events("").List(ctx, v1.ListOptions{
+			FieldSelector: sel,
+			Limit:         eventPageSize,
+			Continue:      cont,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events (%s): %w", sel, err)
+		}
+		for i := range page.Items {
+			ev := &page.Items[i]
+			// Re-check the kind: the fake clientset ignores the selector.
+			if ev.InvolvedObject.Kind != models.EventObjectNode {
+				continue
+			}
+			if _, ok := reasons[ev.Reason]; ok {
+				result[ev.InvolvedObject.Name] = append(result[ev.InvolvedObject.Name], ev)
+			}
+		}
+		cont = page.Continue
+		if cont == "" {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+func healthNode(name, pool, kubelet string, labels map[string]string) *corev1.Node {
+	n := makeNode(name, map[string]string{
+		"nvidia.com/gpu.present":           "true",
+		"beta.kubernetes.io/instance-type": "BM.GPU.H100.8",
+		"instance-pool.name":               pool,
+	}, 8, false, []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}})
+	for k, v := range labels {
+		n.Labels[k] = v
+	}
+	n.Status.NodeInfo.KubeletVersion = kubelet
+	return n
+}
+
+//nolint:cyclop // end-to-end rule test; one node per rule kind reads better in one body
+func TestListGPUNodes_HealthRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tainted := healthNode("tainted", "a", "v1.30.1", nil)
+	tainted.Spec.Taints = []corev1.Taint{{Key: "nvidia.com/gpu-unhealthy", Value: "xid79", Effect: corev1.TaintEffectNoSchedule}}
+	dcgm := healthNode("dcgm", "a", "v1.30.1", map[string]string{"nvidia.com/gpu.health": "Unhealthy"})
+	driver := healthNode("driver", "b", "v1.30.1", map[string]string{"nvidia.com/cuda.driver-version.full": "535.104"})
+	skew := healthNode("skew", "b", "v1.29.4", map[string]string{"nvidia.com/cuda.driver-version.full": "550.54"})
+	xid := healthNode("xid", "b", "v1.30.1", map[string]string{"nvidia.com/cuda.driver-version.full": "550.54"})
+	ok := healthNode("ok", "b", "v1.30.1", map[string]string{"nvidia.com/cuda.driver-version.full": "550.54"})
+	ok.Status.Conditions = append(ok.Status.Conditions,
+		corev1.NodeCondition{Type: nodeCondGPUBus, Status: corev1.ConditionTrue, Message: "GPU 3 fell off the bus"})
+	now := time.Now()
+	cs := fake.NewSimpleClientset(tainted, dcgm, driver, skew, xid, ok,
+		k8sEvent("x1", models.EventObjectNode, "default", "xid", "Warning", "GPUXid", 3, now.Add(-10*time.Minute)),
+		k8sEvent("x2", models.EventObjectNode, "default", "ok", "Warning", "GPUXid", 1, now.Add(-3*time.Hour)),
+	)
+
+	nodes, err := ListGPUNodes(ctx, cs, 0, WithHealthRules([]HealthRule{
+		{Name: "gpu-bus", Condition: string(nodeCondGPUBus), Severity: SeverityOff},
+		{Name: "gpu-taint", Taint: "nvidia.com/gpu-unhealthy"},
+		{Name: "dcgm", Label: "nvidia.com/gpu.health", Values: []string{"Unhealthy"}, Message: "DCGM reports {value} on {node}"},
+		{Name: "driver-skew", Label: "nvidia.com/cuda.driver-version.full", Mismatch: MismatchPool, Severity: models.SeverityWarning},
+		{Name: "kubelet-skew", Field: "kubelet-version", Mismatch: MismatchCluster, Severity: models.SeverityWarning},
+		{Name: "xid", Event: "GPUXid", Within: time.Hour},
+	}))
+	require.NoError(t, err)
+	byName := make(map[string]models.GPUNode, len(nodes))
+	for _, n := range nodes {
+		byName[n.Name] = n
+	}
+
+	assert.Equal(t, "ERROR: gpu-taint", byName["tainted"].GetStatus())
+	assert.Equal(t, []string{"taint nvidia.com/gpu-unhealthy=xid79:NoSchedule"}, byName["tainted"].Issues)
+	assert.Equal(t, []string{"DCGM reports Unhealthy on dcgm"}, byName["dcgm"].Issues)
+	assert.Equal(t, "WARN: driver-skew", byName["driver"].GetStatus())
+	assert.Equal(t, []string{"nvidia.com/cuda.driver-version.full 535.104 differs from pool majority 550.54"},
+		byName["driver"].Issues)
+	assert.True(t, byName["driver"].IsHealthy(), "warnings do not make a node unhealthy")
+	assert.True(t, byName["driver"].IsFaulty())
+	assert.Equal(t, []models.HealthFinding{{
+		Rule: "kubelet-skew", Severity: models.SeverityWarning,
+		Message: "kubelet-version v1.29.4 differs from cluster majority v1.30.1",
+	}}, byName["skew"].Findings)
+	assert.Equal(t, "ERROR: xid", byName["xid"].GetStatus())
+	assert.Equal(t, []string{"GPUXid x3, last 10m ago: GPUXid happened"}, byName["xid"].Issues)
+	assert.Equal(t, "OK", byName["ok"].GetStatus(), "the disabled gpu-bus default and a stale event don't match")
+	assert.Empty(t, byName["ok"].Findings)
+}
+
+func TestMergeHealthRules(t *testing.T) {
+	t.Parallel()
+	merged := mergeHealthRules([]HealthRule{
+		{Name: "pod-phase", FailedPods: true, Severity: models.SeverityWarning},
+		{Name: "gpu-count", Severity: SeverityOff},
+		{Name: "extra", Taint: "x"},
+	})
+	names := make([]string, 0, len(merged))
+	for _, r := range merged {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"memory-pressure", "disk-pressure", "pid-pressure", "network-unavailable", "gpu-bus", "pod-phase", "extra"}, names)
+	assert.Equal(t, models.SeverityWarning, merged[5].Severity)
+}
+
+func TestNodeInfoFields_MatchModels(t *testing.T) {
+	t.Parallel()
+	keys := make([]string, 0, len(nodeInfoFields))
+	for k := range nodeInfoFields {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, models.NodeInfoFields, keys)
+	assert.IsIncreasing(t, models.NodeInfoFields, "listed sorted, as config errors print them")
+}
+
+func TestMajorities_TieGoesToNewerVersion(t *testing.T) {
+	t.Parallel()
+	node := func(kubelet, runtime string) corev1.Node {
+		return corev1.Node{Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{
+			KubeletVersion: kubelet, ContainerRuntimeVersion: runtime,
+		}}}
+	}
+	nodes := []corev1.Node{
+		node("v1.30.9", "containerd://1.7.9"),
+		node("v1.30.10", "containerd://1.7.10"),
+	}
+	assert.Equal(t, map[string]string{"": "v1.30.10"},
+		majorities(nodes, HealthRule{Field: "kubelet-version", Mismatch: MismatchCluster}))
+	assert.Equal(t, map[string]string{"": "containerd://1.7.10"},
+		majorities(nodes, HealthRule{Field: "container-runtime", Mismatch: MismatchCluster}))
+
+	assert.True(t, newerValue("Oracle Linux 9", "Oracle Linux 8"), "non-versions compare as text")
+	assert.True(t, newerValue("5.15.0-1045-oracle", "5.4.17-2136-oracle"))
+}
@@ -0,0 +1,271 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+const (
+	// pendingPodSelector matches pods the scheduler has not bound to a
+	// node yet. Pods pending on a node (e.g. pulling images) are excluded.
+	pendingPodSelector       = "status.phase=Pending,spec.nodeName="
+	failedSchedulingReason   = "FailedScheduling"
+	failedSchedulingSelector = "involvedObject.kind=Pod,reason=" + failedSchedulingReason
+	// pendingHintPoolLimit caps how many pools a hint names.
+	pendingHintPoolLimit  = 3
+	instanceTypeLabel     = "node.kubernetes.io/instance-type"
+	instanceTypeLabelBeta = "beta.kubernetes.io/instance-type"
+	instancePoolLabel     = "instance-pool.name"
+)
+
+/*
+LoadPendingGPUWorkloads lists the GPU-requesting pods that are Pending
+without a node, most GPU-hungry first. Each carries the message of its
+latest FailedScheduling event (falling back to the PodScheduled
+condition) and a hint naming the pools that have a Ready, uncordoned node
+with enough free GPUs right now — "free" being the same per-resource
+allocatable minus allocated accounting the GPUNode category shows, over
+resources (or DefaultGPUResources).
+*/
+func LoadPendingGPUWorkloads(ctx context.Context, clientset kubernetes.Interface, resources ...string) ([]models.PendingGPUWorkload, error) {
+	res := newGPUResources(resources)
+	var pods []*corev1.Pod
+	cont := ""
+	for {
+		page, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
+			FieldSelector: pendingPodSelector,
+			Limit:         gpuWorkloadPageSize,
+			Continue:      cont,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pending pods: %w", err)
+		}
+		for i := range page.Items {
+			pod := &page.Items[i]
+			// Re-check the selector: the fake clientset ignores it.
+			if pod.Status.Phase != corev1.PodPending || pod.Spec.NodeName != "" || res.podGPUs(pod) <= 0 {
+				continue
+			}
+			pods = append(pods, pod)
+		}
+		cont = page.Continue
+		if cont == "" {
+			break
+		}
+	}
+	if len(pods) == 0 {
+		return []models.PendingGPUWorkload{}, nil
+	}
+
+	reasons, err := latestFailedScheduling(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := ListGPUNodes(ctx, clientset, 0, WithResources(resources...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gpu nodes: %w", err)
+	}
+
+	now := time.Now()
+	result := make([]models.PendingGPUWorkload, 0, len(pods))
+	for _, pod := range pods {
+		requests := res.requests(pod)
+		gpus := int(res.podGPUs(pod))
+		reason, ok := reasons[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			reason = unschedulableMessage(pod)
+		}
+		age := ""
+		if ts := pod.CreationTimestamp; !ts.IsZero() {
+			age = FormatAge(now.Sub(ts.Time))
+		}
+		result = append(result, models.PendingGPUWorkload{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			TenantID:  pod.Labels["tenancy-id"],
+			Model:     pod.Labels[baseModelLabelV1],
+			GPUs:      gpus,
+			Age:       age,
+			Reason:    reason,
+			Hint:      schedulingHint(nodes, pod.Spec.NodeSelector, requests),
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].GPUs != result[j].GPUs {
+			return result[i].GPUs > result[j].GPUs
+		}
+		return result[i].Namespace+"/"+result[i].Name < result[j].Namespace+"/"+result[j].Name
+	})
+	logging.FromContext(ctx).Debugw("loaded pending gpu workloads", "workloads", len(result))
+	return result, nil
+}
+
+// latestFailedScheduling returns the message of the most recent
+// FailedScheduling event per pod, keyed by namespace/name.
+func latestFailedScheduling(ctx context.Context, clientset kubernetes.Interface) (map[string]string, error) {
+	messages := make(map[string]string)
+	seen := make(map[string]time.Time)
+	cont := ""
+	for {
+		page, err := clientset.CoreV1().Events("").List(ctx, v1.ListOptions{
+			FieldSelector: failedSchedulingSelector,
+			Limit:         eventPageSize,
+			Continue:      cont,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events (%s): %w", failedSchedulingSelector, err)
+		}
+		for i := range page.Items {
+			ev := &page.Items[i]
+			if ev.InvolvedObject.Kind != models.EventObjectPod || ev.Reason != failedSchedulingReason {
+				continue
+			}
+			key := ev.InvolvedObject.Namespace + "/" + ev.InvolvedObject.Name
+			_, last := eventTimes(ev)
+			if prev, ok := seen[key]; ok && !last.After(prev) {
+				continue
+			}
+			seen[key] = last
+			messages[key] = ev.Message
+		}
+		cont = page.Continue
+		if cont == "" {
+			break
+		}
+	}
+	return messages, nil
+}
+
+// unschedulableMessage returns the scheduler's message from the pod's
+// PodScheduled=False condition, used when the FailedScheduling event has
+// already expired.
+func unschedulableMessage(pod *corev1.Pod) string {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse &&
+			c.Reason == corev1.PodReasonUnschedulable {
+			return c.Message
+		}
+	}
+	return ""
+}
+
+// schedulingHint names the pools with at least one Ready, uncordoned node
+// that has each requested resource free and matches the pod's
+// instance-type or pool node selector. Other selector keys are not
+// visible on GPUNode and are ignored, so the hint is a lead rather than a
+// promise.
+func schedulingHint(nodes []models.GPUNode, selector map[string]string, requests map[corev1.ResourceName]int64) string {
+	fits := make(map[string]int)
+	for _, n := range nodes {
+		if !n.IsReady || n.IsSchedulingDisabled || !hasFree(n, requests) {
+			continue
+		}
+		if !matchesNodeSelector(n, selector) {
+			continue
+		}
+		fits[n.NodePool]++
+	}
+	if len(fits) == 0 {
+		return "no schedulable node has " + describeRequests(requests)
+	}
+
+	pools := make([]string, 0, len(fits))
+	for p := range fits {
+		pools = append(pools, p)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		if fits[pools[i]] != fits[pools[j]] {
+			return fits[pools[i]] > fits[pools[j]]
+		}
+		return pools[i] < pools[j]
+	})
+	parts := make([]string, 0, pendingHintPoolLimit+1)
+	for i, p := range pools {
+		if i == pendingHintPoolLimit {
+			parts = append(parts, fmt.Sprintf("+%d more", len(pools)-i))
+			break
+		}
+		unit := "nodes"
+		if fits[p] == 1 {
+			unit = "node"
+		}
+		parts = append(parts, fmt.Sprintf("%s (%d %s)", p, fits[p], unit))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func hasFree(n models.GPUNode, requests map[corev1.ResourceName]int64) bool {
+	for name, v := range requests {
+		if int64(n.Free(string(name))) < v {
+			return false
+		}
+	}
+	return true
+}
+
+// describeRequests renders requests as "4 free GPUs", naming MIG
+// profiles instead, e.g. "2 free mig-1g.10gb".
+func describeRequests(requests map[corev1.ResourceName]int64) string {
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		unit := "GPUs"
+		if models.IsMIGResource(name) {
+			unit = models.MIGProfile(name)
+		}
+		parts = append(parts, fmt.Sprintf("%d free %s", requests[corev1.ResourceName(name)], unit))
+	}
+	return strings.Join(parts, " + ")
+}
+
+func matchesNodeSelector(n models.GPUNode, selector map[string]string) bool {
+	for k, v := range selector {
+		switch k {
+		case instanceTypeLabel, instanceTypeLabelBeta:
+			if n.InstanceType != v {
+				return false
+			}
+		case instancePoolLabel:
+			if n.NodePool != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// WatchPendingGPUWorkloads triggers on changes to unscheduled pods; a pod
+// leaving the selector (bound or deleted) is reported as a delete.
+func WatchPendingGPUWorkloads(ctx context.Context, clientset kubernetes.Interface) (<-chan struct{}, error) {
+	return watchTrigger(ctx, DebounceWindow, durableOpener(
+		func(ctx context.Context) (string, error) {
+			l, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
+				FieldSelector: pendingPodSelector,
+				Limit:         1,
+			})
+			if err != nil {
+				return "", err
+			}
+			return l.ResourceVersion, nil
+		},
+		func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = pendingPodSelector
+			return clientset.CoreV1().Pods("").Watch(ctx, opts)
+		},
+	))
+}
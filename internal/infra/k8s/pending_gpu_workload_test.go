@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	models "github.com/jingle2008/toolkit/pkg/models"
+)
+
+func pendingPod(name string, gpus int64, selector map[string]string) *corev1.Pod {
+	p := gpuPod(name, "", gpus, map[string]string{"tenancy-id": "t1", "base-model-name": "llama"}, nil)
+	p.Status.Phase = corev1.PodPending
+	p.Spec.NodeSelector = selector
+	return p
+}
+
+func poolNode(name, pool string, allocatable int64, ready bool) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return makeNode(name, map[string]string{
+		"nvidia.com/gpu.present":           "true",
+		"instance-pool.name":               pool,
+		"beta.kubernetes.io/instance-type": "BM.GPU.H100.8",
+	}, allocatable, false, []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}})
+}
+
+func TestLoadPendingGPUWorkloads(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	waiting := pendingPod("waiting", 2, nil)
+	waiting.Status.Conditions = []corev1.PodCondition{{
+		Type: corev1.PodScheduled, Status: corev1.ConditionFalse,
+		Reason: corev1.PodReasonUnschedulable, Message: "0/3 nodes are available",
+	}}
+	onNode := pendingPod("pulling", 1, nil)
+	onNode.Spec.NodeName = "gpu-a1"
+	cpuOnly := pendingPod("cpu", 0, nil)
+
+	ev := func(name, pod, msg string, last time.Time) *corev1.Event {
+		e := k8sEvent(name, models.EventObjectPod, "ns1", pod, "Warning", failedSchedulingReason, 1, last)
+		e.Message = msg
+		return e
+	}
+	cs := fake.NewSimpleClientset(
+		poolNode("gpu-a1", "pool-a", 8, true),
+		poolNode("gpu-a2", "pool-a", 8, true),
+		poolNode("gpu-b1", "pool-b", 8, true),
+		poolNode("gpu-c1", "pool-c", 8, false),
+		pendingPod("big", 8, map[string]string{"instance-pool.name": "pool-b"}),
+		waiting, onNode, cpuOnly,
+		ev("old", "big", "stale", now.Add(-time.Hour)),
+		ev("new", "big", "0/3 nodes are available: 3 Insufficient nvidia.com/gpu.", now),
+	)
+
+	got, err := LoadPendingGPUWorkloads(context.Background(), cs)
+	require.NoError(t, err)
+	require.Len(t, got, 2, "scheduled and non-GPU pods are excluded: %v", got)
+
+	assert.Equal(t, "big", got[0].Name, "most GPUs first")
+	assert.Equal(t, 8, got[0].GPUs)
+	assert.Equal(t, "0/3 nodes are available: 3 Insufficient nvidia.com/gpu.", got[0].Reason, "latest event wins")
+	assert.Equal(t, "pool-b (1 node)", got[0].Hint, "the pool node selector narrows the hint")
+	assert.Equal(t, "t1", got[0].TenantID)
+	assert.Equal(t, "llama", got[0].Model)
+	assert.NotEmpty(t, got[0].Age)
+
+	assert.Equal(t, "waiting", got[1].Name)
+	assert.Equal(t, "0/3 nodes are available", got[1].Reason, "falls back to the PodScheduled condition")
+	assert.Equal(t, "pool-a (2 nodes), pool-b (1 node)", got[1].Hint, "not-ready pool-c is skipped")
+}
+
+func TestSchedulingHint(t *testing.T) {
+	t.Parallel()
+	nodes := []models.GPUNode{
+		{NodePool: "a", InstanceType: "BM.GPU.A10.4", Allocatable: 4, IsReady: true},
+		{NodePool: "b", InstanceType: "BM.GPU.H100.8", Allocatable: 8, IsReady: true},
+		{NodePool: "c", InstanceType: "BM.GPU.H100.8", Allocatable: 8, IsReady: true},
+		{NodePool: "d", InstanceType: "BM.GPU.H100.8", Allocatable: 8, IsReady: true},
+		{NodePool: "e", InstanceType: "BM.GPU.H100.8", Allocatable: 8, IsReady: true},
+		{NodePool: "f", InstanceType: "BM.GPU.H100.8", Allocatable: 8, Allocated: 8, IsReady: true},
+		{NodePool: "g", InstanceType: "BM.GPU.H100.8", Allocatable: 8, IsReady: true, IsSchedulingDisabled: true},
+	}
+	gpus := func(n int64) map[corev1.ResourceName]int64 { return map[corev1.ResourceName]int64{gpuProperty: n} }
+	assert.Equal(t, "a (1 node), b (1 node), c (1 node), +2 more", schedulingHint(nodes, nil, gpus(1)))
+	assert.Equal(t, "b (1 node), c (1 node), d (1 node), +1 more", schedulingHint(nodes, nil, gpus(8)))
+	assert.Equal(t, "a (1 node)",
+		schedulingHint(nodes, map[string]string{"node.kubernetes.io/instance-type": "BM.GPU.A10.4", "zone": "x"}, gpus(4)))
+	assert.Equal(t, "no schedulable node has 16 free GPUs", schedulingHint(nodes, nil, gpus(16)))
+}
+
+func TestSchedulingHint_PerResource(t *testing.T) {
+	t.Parallel()
+	const mig = "nvidia.com/mig-1g.10gb"
+	nodes := []models.GPUNode{
+		{NodePool: "mig", IsReady: true, Resources: []models.GPUResource{
+			{Name: mig, Allocatable: 7, Allocated: 6},
+		}},
+		{NodePool: "whole", IsReady: true, Resources: []models.GPUResource{
+			{Name: string(gpuProperty), Allocatable: 8},
+		}},
+	}
+	assert.Equal(t, "mig (1 node)", schedulingHint(nodes, nil, map[corev1.ResourceName]int64{mig: 1}))
+	assert.Equal(t, "no schedulable node has 2 free mig-1g.10gb",
+		schedulingHint(nodes, nil, map[corev1.ResourceName]int64{mig: 2}))
+	assert.Equal(t, "whole (1 node)", schedulingHint(nodes, nil, map[corev1.ResourceName]int64{gpuProperty: 8}))
+}
+
+func TestLoadPendingGPUWorkloads_NoneSkipsLookups(t *testing.T) {
+	t.Parallel()
+	cs := fake.NewSimpleClientset(gpuPod("running", "gpu-1", 1, nil, nil))
+	got, err := LoadPendingGPUWorkloads(context.Background(), cs)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	for _, a := range cs.Actions() {
+		assert.Equal(t, "pods", a.GetResource().Resource, "no events or nodes are listed without pending pods")
+	}
+}
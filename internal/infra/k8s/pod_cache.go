@@ -22,6 +22,9 @@ type PodStats struct {
 // PodCache maps namespace name to pods.
 type PodCache struct {
 	byNS map[string][]*unstructured.Unstructured
+	// resources are the accelerator resources runsOnGPU counts; nil
+	// means DefaultGPUResources.
+	resources []string
 }
 
 //nolint:cyclop // single-pass pod classification; the label/annotation branches are clearer inline than split across helpers
@@ -32,12 +35,13 @@ func (c PodCache) getPodStats(ctx context.Context, namespace string) PodStats {
 	modelNameMap := make(map[string]struct{})
 
 	logger := logging.FromContext(ctx)
+	res := newGPUResources(c.resources)
 	for _, item := range pods {
 		// Only count pods actually scheduled onto a GPU node. The label
 		// selectors that populate the cache match workload intent, but a
 		// pod without the `nvidia.com/gpu: "true"` nodeSelector does not
 		// consume GPU and must not inflate the replica counts.
-		if !runsOnGPU(item) {
+		if !runsOnGPU(item, res) {
 			continue
 		}
 		totalPods++
@@ -106,17 +110,16 @@ func getUniqeKey(logger logging.Logger, m map[string]struct{}, namespace string)
 	return keys[0]
 }
 
-// runsOnGPU reports whether any of the pod's containers requests an
-// nvidia.com/gpu resource, reusing the same accounting as
-// getGPUAllocations (calculatePodGPUs). Pods that request no GPU are
-// excluded from replica stats so TotalReplicas reflects only
+// runsOnGPU reports whether any of the pod's containers requests one of
+// res, reusing the same accounting as gpuAllocations. Pods that request
+// no GPU are excluded from replica stats so TotalReplicas reflects only
 // GPU-consuming pods.
-func runsOnGPU(item *unstructured.Unstructured) bool {
+func runsOnGPU(item *unstructured.Unstructured, res gpuResources) bool {
 	var pod corev1.Pod
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
 		return false
 	}
-	return calculatePodGPUs(&pod) > 0
+	return res.podGPUs(&pod) > 0
 }
 
 // getLabels safely extracts labels from an unstructured pod.
@@ -149,8 +152,8 @@ func getAnnotations(item *unstructured.Unstructured) map[string]string {
 	return annos
 }
 
-func buildPodCache(ctx context.Context, client dynamic.Interface) (PodCache, error) {
-	cache := PodCache{byNS: make(map[string][]*unstructured.Unstructured)}
+func buildPodCache(ctx context.Context, client dynamic.Interface, resources []string) (PodCache, error) {
+	cache := PodCache{byNS: make(map[string][]*unstructured.Unstructured), resources: resources}
 
 	err := processPodQueries(ctx, client, gpuPodSelectors, runningPodSelector,
 		listPodsWithSelectors,
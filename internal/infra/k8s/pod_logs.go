@@ -0,0 +1,90 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// LogOptions selects which log StreamPodLogs opens.
+type LogOptions struct {
+	// Container names the container; empty selects the pod's only
+	// container (the API server rejects it for multi-container pods).
+	Container string
+	// Follow keeps the stream open and delivers new lines as they are
+	// written.
+	Follow bool
+	// Previous returns the log of the container's last terminated
+	// instance (a crashed or restarted container). Mutually exclusive
+	// with Follow in practice: a terminated instance writes nothing new.
+	Previous bool
+	// TailLines bounds the number of lines returned from the end of
+	// the log; 0 returns the whole log.
+	TailLines int64
+}
+
+/*
+PodContainers returns the names of the pod's containers in spec order.
+Init and ephemeral containers are not included.
+*/
+func PodContainers(ctx context.Context, kubeconfig, contextName, namespace, pod string) ([]string, error) {
+	clientset, err := NewClientsetFromKubeConfig(kubeconfig, contextName)
+	if err != nil {
+		return nil, err
+	}
+	return podContainers(ctx, clientset, namespace, pod)
+}
+
+func podContainers(ctx context.Context, clientset kubernetes.Interface, namespace, pod string) ([]string, error) {
+	p, err := clientset.CoreV1().Pods(namespace).Get(ctx, pod, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, pod, err)
+	}
+	names := make([]string, 0, len(p.Spec.Containers))
+	for _, c := range p.Spec.Containers {
+		names = append(names, c.Name)
+	}
+	return names, nil
+}
+
+/*
+StreamPodLogs opens the pods/log stream for a container. The caller owns
+the returned reader and must close it; cancelling ctx also ends the stream.
+
+The per-request RequestTimeout is dropped for this client: a followed log
+is open-ended and would otherwise be cut off mid-stream. ctx alone bounds
+the stream's lifetime.
+*/
+func StreamPodLogs(ctx context.Context, kubeconfig, contextName, namespace, pod string, opts LogOptions) (io.ReadCloser, error) {
+	config, err := NewConfig(kubeconfig, contextName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create config from kubeconfig: %w", err)
+	}
+	config.Timeout = 0
+	clientset, err := NewClientsetFromRestConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return streamPodLogs(ctx, clientset, namespace, pod, opts)
+}
+
+func streamPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace, pod string, opts LogOptions) (io.ReadCloser, error) {
+	logOpts := &corev1.PodLogOptions{
+		Container: opts.Container,
+		Follow:    opts.Follow,
+		Previous:  opts.Previous,
+	}
+	if opts.TailLines > 0 {
+		tail := opts.TailLines
+		logOpts.TailLines = &tail
+	}
+	rc, err := clientset.CoreV1().Pods(namespace).GetLogs(pod, logOpts).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for %s/%s: %w", namespace, pod, err)
+	}
+	return rc, nil
+}
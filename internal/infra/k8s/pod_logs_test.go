@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestPodContainers(t *testing.T) {
+	t.Parallel()
+	pod := gpuPod("serv", "node-a", 1, nil, nil)
+	pod.Spec.InitContainers = []corev1.Container{{Name: "init"}}
+	pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: "sidecar"})
+	cs := fake.NewSimpleClientset(pod)
+
+	got, err := podContainers(context.Background(), cs, "ns1", "serv")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "sidecar"}, got, "spec order, init containers excluded")
+
+	_, err = podContainers(context.Background(), cs, "ns1", "missing")
+	require.Error(t, err)
+}
+
+func TestStreamPodLogs_PassesOptions(t *testing.T) {
+	t.Parallel()
+	cs := fake.NewSimpleClientset(gpuPod("serv", "node-a", 1, nil, nil))
+	var got *corev1.PodLogOptions
+	cs.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		ga, ok := action.(k8stesting.GenericAction)
+		if !ok || action.GetSubresource() != "log" {
+			return false, nil, nil
+		}
+		got, _ = ga.GetValue().(*corev1.PodLogOptions)
+		return true, &runtime.Unknown{Raw: []byte("line 1\nline 2\n")}, nil
+	})
+
+	rc, err := streamPodLogs(context.Background(), cs, "ns1", "serv",
+		LogOptions{Container: "main", Follow: true, TailLines: 100})
+	require.NoError(t, err)
+	defer rc.Close()
+	body, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "line 1\nline 2\n", string(body))
+
+	require.NotNil(t, got, "log request should reach the reactor")
+	assert.Equal(t, "main", got.Container)
+	assert.True(t, got.Follow)
+	assert.False(t, got.Previous)
+	require.NotNil(t, got.TailLines)
+	assert.Equal(t, int64(100), *got.TailLines)
+}
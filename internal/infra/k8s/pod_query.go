@@ -34,36 +34,36 @@ var gpuPodSelectors = []string{
 	trainingLabelV2,
 }
 
-func getGPUAllocations(
-	ctx context.Context,
-	clientset kubernetes.Interface,
-	labelSelector string,
-	fieldSelector string,
-) (map[string]int64, error) {
-	pods, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
-		LabelSelector: labelSelector,
-		FieldSelector: fieldSelector,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list pods for selector %q in getGPUAllocations: %w", labelSelector, err)
-	}
-
-	usageMap := make(map[string]int64)
-	for _, pod := range pods.Items {
-		usageMap[pod.Spec.NodeName] += calculatePodGPUs(&pod)
-	}
-
-	return usageMap, nil
-}
+// gpuAllocations returns a podsMapper that sums the pods' requests of
+// res per node and resource.
+func gpuAllocations(res gpuResources) func(context.Context, kubernetes.Interface, string, string) (map[string]map[corev1.ResourceName]int64, error) {
+	return func(
+		ctx context.Context,
+		clientset kubernetes.Interface,
+		labelSelector string,
+		fieldSelector string,
+	) (map[string]map[corev1.ResourceName]int64, error) {
+		pods, err := clientset.CoreV1().Pods("").List(ctx, v1.ListOptions{
+			LabelSelector: labelSelector,
+			FieldSelector: fieldSelector,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods for selector %q in gpuAllocations: %w", labelSelector, err)
+		}
 
-func calculatePodGPUs(pod *corev1.Pod) int64 {
-	var total int64
-	for _, container := range pod.Spec.Containers {
-		if val, ok := container.Resources.Requests[gpuProperty]; ok {
-			total += val.Value()
+		usageMap := make(map[string]map[corev1.ResourceName]int64)
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			for name, v := range res.requests(pod) {
+				if usageMap[pod.Spec.NodeName] == nil {
+					usageMap[pod.Spec.NodeName] = make(map[corev1.ResourceName]int64)
+				}
+				usageMap[pod.Spec.NodeName][name] += v
+			}
 		}
+
+		return usageMap, nil
 	}
-	return total
 }
 
 // listPodsWithSelectors lists pods (as unstructured) using label and field selectors with a dynamic client.
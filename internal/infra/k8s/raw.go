@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// RawKind names the kind of live object behind a toolkit item.
+type RawKind int
+
+// Kinds GetRawObject can fetch.
+const (
+	RawBaseModel RawKind = iota
+	RawImportedModel
+	RawDedicatedAICluster
+	RawNode
+	RawPod
+)
+
+var (
+	nodeGVR = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	podGVR  = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+)
+
+// rawResources lists, in lookup order, the resources an item of kind may
+// be stored as. DACs exist under both CRD versions and imported models
+// as either namespaced BaseModels or tenant-labeled ClusterBaseModels,
+// mirroring the sources their loaders merge.
+func rawResources(kind RawKind) ([]schema.GroupVersionResource, error) {
+	switch kind {
+	case RawBaseModel:
+		return []schema.GroupVersionResource{clusterBaseModelGVR}, nil
+	case RawImportedModel:
+		return []schema.GroupVersionResource{baseModelGVR, clusterBaseModelGVR}, nil
+	case RawDedicatedAICluster:
+		return []schema.GroupVersionResource{dacV2GVR, dacV1GVR}, nil
+	case RawNode:
+		return []schema.GroupVersionResource{nodeGVR}, nil
+	case RawPod:
+		return []schema.GroupVersionResource{podGVR}, nil
+	default:
+		return nil, fmt.Errorf("unsupported raw object kind %d", kind)
+	}
+}
+
+// ErrObjectNotFound is wrapped by GetRawObject when no object has the
+// name, so callers searching several clusters can move on to the next.
+var ErrObjectNotFound = errors.New("not found in the cluster")
+
+/*
+GetRawObject fetches the live object named name for kind and returns it
+with metadata.managedFields removed — the server-side-apply bookkeeping
+is noise for a human reading the object.
+
+namespace narrows the lookup for namespaced kinds; when it is empty the
+object is searched for across all namespaces and an ambiguous name is an
+error naming the candidates. Resources whose CRD is not installed are
+skipped, so a cluster that only serves one DAC version still resolves.
+*/
+func GetRawObject(ctx context.Context, client dynamic.Interface, kind RawKind, namespace, name string) (map[string]any, error) {
+	gvrs, err := rawResources(kind)
+	if err != nil {
+		return nil, err
+	}
+	for _, gvr := range gvrs {
+		matches, err := findByName(ctx, client, gvr, namespace, name)
+		if apierrors.IsNotFound(err) {
+			continue // CRD version not served by this cluster
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", gvr.Resource, err)
+		}
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			obj := matches[0].Object
+			unstructured.RemoveNestedField(obj, "metadata", "managedFields")
+			return obj, nil
+		default:
+			return nil, ambiguousNameError(name, matches)
+		}
+	}
+	return nil, fmt.Errorf("%s %q %w", gvrs[0].Resource, name, ErrObjectNotFound)
+}
+
+// findByName lists gvr with a metadata.name field selector. The name (and
+// namespace, when set) is re-checked in code: the selector is an
+// optimization the fake clients ignore.
+func findByName(ctx context.Context, client dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string) ([]unstructured.Unstructured, error) {
+	list, err := client.Resource(gvr).Namespace(namespace).List(ctx, v1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var matches []unstructured.Unstructured
+	for _, item := range list.Items {
+		if item.GetName() != name || (namespace != "" && item.GetNamespace() != namespace) {
+			continue
+		}
+		matches = append(matches, item)
+	}
+	return matches, nil
+}
+
+func ambiguousNameError(name string, matches []unstructured.Unstructured) error {
+	namespaces := make([]string, 0, len(matches))
+	for _, m := range matches {
+		namespaces = append(namespaces, m.GetNamespace())
+	}
+	sort.Strings(namespaces)
+	return fmt.Errorf("%q exists in several namespaces (%s); qualify it as <namespace>/%s",
+		name, strings.Join(namespaces, ", "), name)
+}
@@ -0,0 +1,102 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	cgotesting "k8s.io/client-go/testing"
+)
+
+var rawListKinds = map[schema.GroupVersionResource]string{
+	nodeGVR:             "NodeList",
+	podGVR:              "PodList",
+	clusterBaseModelGVR: "ClusterBaseModelList",
+	baseModelGVR:        "BaseModelList",
+	dacV1GVR:            "DedicatedAIClusterList",
+	dacV2GVR:            "DedicatedAIClusterList",
+}
+
+func rawPod(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]any{
+			"name":          name,
+			"namespace":     namespace,
+			"annotations":   map[string]any{"team": "genai"},
+			"managedFields": []any{map[string]any{"manager": "kubelet"}},
+		},
+		"status": map[string]any{"phase": "Running"},
+	}}
+}
+
+func TestGetRawObject_StripsManagedFields(t *testing.T) {
+	t.Parallel()
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), rawListKinds,
+		rawPod("ns1", "serv-0"), rawPod("ns1", "serv-1"))
+
+	obj, err := GetRawObject(context.Background(), client, RawPod, "ns1", "serv-0")
+	require.NoError(t, err)
+
+	u := unstructured.Unstructured{Object: obj}
+	assert.Equal(t, "serv-0", u.GetName())
+	assert.Equal(t, map[string]string{"team": "genai"}, u.GetAnnotations())
+	assert.Empty(t, u.GetManagedFields())
+	_, found, _ := unstructured.NestedFieldNoCopy(obj, "metadata", "managedFields")
+	assert.False(t, found)
+}
+
+func TestGetRawObject_NamespaceOptional(t *testing.T) {
+	t.Parallel()
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), rawListKinds,
+		rawPod("ns1", "serv-0"), rawPod("ns2", "serv-0"), rawPod("ns2", "solo"))
+	ctx := context.Background()
+
+	obj, err := GetRawObject(ctx, client, RawPod, "", "solo")
+	require.NoError(t, err)
+	assert.Equal(t, "ns2", (&unstructured.Unstructured{Object: obj}).GetNamespace())
+
+	_, err = GetRawObject(ctx, client, RawPod, "", "serv-0")
+	require.ErrorContains(t, err, "several namespaces (ns1, ns2)")
+
+	obj, err = GetRawObject(ctx, client, RawPod, "ns2", "serv-0")
+	require.NoError(t, err)
+	assert.Equal(t, "ns2", (&unstructured.Unstructured{Object: obj}).GetNamespace())
+}
+
+func TestGetRawObject_NotFound(t *testing.T) {
+	t.Parallel()
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), rawListKinds)
+
+	_, err := GetRawObject(context.Background(), client, RawNode, "", "gpu-1")
+	require.ErrorContains(t, err, `nodes "gpu-1" not found`)
+}
+
+func TestGetRawObject_SkipsUnservedCRDVersion(t *testing.T) {
+	t.Parallel()
+	dac := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "ome.oracle.com/v1alpha1",
+		"kind":       "DedicatedAICluster",
+		"metadata":   map[string]any{"name": "dac1"},
+		"spec":       map[string]any{"unitShape": "BM.GPU.H100.8"},
+	}}
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), rawListKinds, dac)
+	client.PrependReactor("list", "dedicatedaiclusters", func(a cgotesting.Action) (bool, runtime.Object, error) {
+		if a.GetResource() == dacV2GVR {
+			return true, nil, apierrors.NewNotFound(dacV2GVR.GroupResource(), "")
+		}
+		return false, nil, nil
+	})
+
+	obj, err := GetRawObject(context.Background(), client, RawDedicatedAICluster, "", "dac1")
+	require.NoError(t, err)
+	shape, _, _ := unstructured.NestedString(obj, "spec", "unitShape")
+	assert.Equal(t, "BM.GPU.H100.8", shape)
+}
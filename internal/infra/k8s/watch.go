@@ -28,8 +28,8 @@ var DebounceWindow = 5 * time.Second
 // The watch is a TRIGGER, not a data source — event bodies are
 // discarded. The caller owns ctx; cancelling it stops all watchers and
 // closes the returned channel. The channel also closes if any
-// underlying stream dies (the API server closing the connection), which
-// the caller treats as a fallback signal.
+// underlying stream dies (the API server closing the connection); the
+// TUI then reloads and re-opens the watch with backoff.
 //
 // If any opener returns an error, all already-opened watchers are
 // stopped and the error is returned with no channel.
@@ -109,8 +109,8 @@ func fanInWatcher(
 				// openers this no longer happens on routine server-side closes
 				// (those auto-reconnect); reaching here means RetryWatcher gave
 				// up on an unrecoverable error (e.g. 410 Expired) or was
-				// stopped. Treat it as a fallback signal: the live indicator
-				// drops and the caller does a final one-shot reload.
+				// stopped. Close the trigger: the caller reloads and
+				// re-establishes the watch (a fresh list and resourceVersion).
 				logging.FromContext(ctx).Warnw("watch ended (retry exhausted or stopped); live watch will drop")
 				closeDone()
 				return
@@ -289,12 +289,14 @@ func WatchImportedModels(ctx context.Context, client dynamic.Interface) (<-chan
 }
 
 // WatchGPUNodes triggers on Node changes plus GPU pod changes (pods
-// drive allocation and node issues).
-func WatchGPUNodes(ctx context.Context, clientset kubernetes.Interface) (<-chan struct{}, error) {
+// drive allocation and node issues). With non-NVIDIA resources configured
+// GPU nodes cannot be told apart by label, so every Node change triggers.
+func WatchGPUNodes(ctx context.Context, clientset kubernetes.Interface, resources ...string) (<-chan struct{}, error) {
+	selector := newGPUResources(resources).nodeSelector()
 	nodeOpener := durableOpener(
 		func(ctx context.Context) (string, error) {
 			l, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{
-				LabelSelector: gpuNodeSelector,
+				LabelSelector: selector,
 				Limit:         1,
 			})
 			if err != nil {
@@ -303,7 +305,7 @@ func WatchGPUNodes(ctx context.Context, clientset kubernetes.Interface) (<-chan
 			return l.ResourceVersion, nil
 		},
 		func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
-			opts.LabelSelector = gpuNodeSelector
+			opts.LabelSelector = selector
 			return clientset.CoreV1().Nodes().Watch(ctx, opts)
 		},
 	)
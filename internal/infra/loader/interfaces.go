@@ -4,6 +4,8 @@ package loader
 import (
 	"context"
 
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -56,6 +58,11 @@ type GPUWorkloadLoader interface {
 	LoadGPUWorkloadsByNode(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.GPUWorkload, error)
 }
 
+// PendingGPUWorkloadLoader loads GPU-requesting pods not yet scheduled to a node.
+type PendingGPUWorkloadLoader interface {
+	LoadPendingGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) ([]models.PendingGPUWorkload, error)
+}
+
 /*
 DedicatedAIClusterLoader defines an interface for loading dedicated AI clusters.
 */
@@ -64,6 +71,27 @@ type DedicatedAIClusterLoader interface {
 	LoadDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.DedicatedAICluster, error)
 }
 
+/*
+EndpointLoader defines an interface for loading GenAI endpoints.
+*/
+type EndpointLoader interface {
+	// LoadEndpoints loads the endpoints hosted on the cluster's dedicated AI clusters,
+	// keyed by DAC name.
+	LoadEndpoints(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.Endpoint, error)
+}
+
+// WorkRequestLoader loads the recent compute and GenerativeAI work requests
+// in the compartments of the cluster's GPU nodes and DACs, newest first.
+type WorkRequestLoader interface {
+	LoadWorkRequests(ctx context.Context, kubeCfg string, env models.Environment) ([]models.WorkRequest, error)
+}
+
+// EventLoader loads Kubernetes events for GPU nodes and GPU workload pods,
+// keyed by the involved object's name.
+type EventLoader interface {
+	LoadEvents(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.Event, error)
+}
+
 /*
 TenancyOverrideLoader defines methods for loading tenancy override maps.
 */
@@ -90,7 +118,11 @@ type Composite interface {
 	GPUPoolLoader
 	GPUNodeLoader
 	GPUWorkloadLoader
+	PendingGPUWorkloadLoader
 	DedicatedAIClusterLoader
+	EndpointLoader
+	EventLoader
+	WorkRequestLoader
 	TenancyOverrideLoader
 	RegionalOverrideLoader
 }
@@ -120,30 +152,72 @@ assertion fails or a method returns an error.
 Each method returns a channel that yields one value whenever the
 category's underlying resources change (debounced). The caller owns ctx;
 cancelling it stops the watch and closes the channel. The channel also
-closes if the stream dies, which the caller treats as a fallback signal.
+closes if the stream dies; the caller then reloads and calls the Watch
+method again, with backoff, to re-establish it.
 */
 type Watcher interface {
 	WatchBaseModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
 	WatchImportedModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
 	WatchGPUNodes(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
 	WatchGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
+	WatchPendingGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
 	WatchDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
+	WatchEvents(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error)
 }
 
 /*
 RepoWatcher is an OPTIONAL capability: establishing a filesystem watch on the
-repo working tree that emits a coalesced "reload now" signal, making
+repo working tree that emits the coalesced set of changed paths, making
 repo-backed categories live the way Watcher makes k8s-backed categories live.
 Like Watcher and TenantMetadataWriter it is deliberately kept out of Composite
 so the many fake loaders used in tests need not implement it. Callers
 type-assert a Composite to this interface and fall back to a static load when
 the assertion fails or the method returns an error.
 
-The returned channel yields one value whenever any non-hidden file under
-repoPath changes (debounced). The caller owns ctx; cancelling it stops the
-watch and closes the channel. The channel also closes if the watcher dies,
-which the caller treats as a fallback signal.
+The channel returned by WatchRepo yields the changed paths (slash-separated,
+relative to repoPath, directories with a trailing slash) whenever non-hidden
+files not excluded by .gitignore change (debounced). The caller owns ctx; cancelling it stops the watch and
+closes the channel. The channel also closes if the watcher dies, which the
+caller treats as a fallback signal. ChangedParts maps those paths to the
+dataset parts they can affect, and LoadDatasetParts reloads just those parts
+so a change re-parses only what it touched.
 */
 type RepoWatcher interface {
-	WatchRepo(ctx context.Context, repoPath string) (<-chan struct{}, error)
+	WatchRepo(ctx context.Context, repoPath string) (<-chan []string, error)
+	ChangedParts(paths []string) models.RepoParts
+	LoadDatasetParts(ctx context.Context, repo string, env models.Environment, parts models.RepoParts) (*models.Dataset, error)
+}
+
+/*
+HistoryReader is an OPTIONAL capability: reading the git change history of
+the repo file that backs a definition or override item. Like RepoWatcher it
+is deliberately kept out of Composite so the many fake loaders used in tests
+need not implement it. Callers type-assert a Composite to this interface and
+report history as unavailable when the assertion fails.
+
+tenant is the tenant directory name and is only consulted for the tenancy
+override categories. limit caps the number of commits (non-positive means
+gitlog.DefaultLimit); withDiff populates each commit's diff of the file.
+*/
+type HistoryReader interface {
+	ItemHistory(ctx context.Context, repo string, env models.Environment, cat domain.Category,
+		tenant, name string, limit int, withDiff bool) (gitlog.History, error)
+}
+
+/*
+RawObjectReader is an OPTIONAL capability: fetching the live Kubernetes
+object behind a cluster-derived item (the CR, Node or Pod toolkit flattens
+into its model). Like HistoryReader it is deliberately kept out of
+Composite so the many fake loaders used in tests need not implement it.
+Callers type-assert a Composite to this interface and report the raw view
+as unavailable when the assertion fails.
+
+cluster is the kube context the item was loaded from (its GetCluster);
+when empty every cluster of env is searched. namespace may be empty, in
+which case the object is searched for across namespaces. The returned
+object has metadata.managedFields removed.
+*/
+type RawObjectReader interface {
+	RawObject(ctx context.Context, kubeCfg string, env models.Environment, cat domain.Category,
+		cluster, namespace, name string) (map[string]any, error)
 }
@@ -0,0 +1,314 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// clusterLoadSource labels the *terraform.PartialLoadError returned when
+// some, but not all, kube contexts fail to load.
+const clusterLoadSource = "kube contexts"
+
+// forEachCluster runs load against every kube context concurrently and
+// returns the results in context order. With several contexts, one that
+// fails does not stop the others: the results of those that loaded are
+// returned with a *terraform.PartialLoadError naming each failed context
+// (its slot holds the zero T). Only when every context fails is the
+// result nil.
+func forEachCluster[T any](
+	ctx context.Context,
+	contexts []string,
+	load func(ctx context.Context, kubeCtx string) (T, error),
+) ([]T, error) {
+	if len(contexts) == 1 {
+		r, err := load(ctx, contexts[0])
+		if err != nil {
+			return nil, err
+		}
+		return []T{r}, nil
+	}
+	results := make([]T, len(contexts))
+	errs := make([]error, len(contexts))
+	var wg sync.WaitGroup
+	for i, kubeCtx := range contexts {
+		wg.Go(func() {
+			r, err := load(ctx, kubeCtx)
+			if err != nil {
+				errs[i] = fmt.Errorf("cluster %s: %w", kubeCtx, err)
+				return
+			}
+			results[i] = r
+		})
+	}
+	wg.Wait()
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	switch {
+	case len(failed) == 0:
+		return results, nil
+	case len(failed) == len(contexts):
+		return nil, errors.Join(failed...)
+	default:
+		return results, &terraform.PartialLoadError{Source: clusterLoadSource, Errs: failed}
+	}
+}
+
+// isPartialLoad reports whether err is the partial-load error of
+// forEachCluster (or of terraform.LoadGPUPools), whose result is usable.
+func isPartialLoad(err error) bool {
+	_, ok := errors.AsType[*terraform.PartialLoadError](err)
+	return ok
+}
+
+// loadFlat loads a flat k8s category from every context and concatenates
+// the results, tagging each item with the context it came from. A
+// partial load returns what loaded along with the error.
+func loadFlat[V any](
+	ctx context.Context,
+	contexts []string,
+	load func(ctx context.Context, kubeCtx string) ([]V, error),
+	tag func(item *V, cluster string),
+) ([]V, error) {
+	parts, err := forEachCluster(ctx, contexts, load)
+	if err != nil && !isPartialLoad(err) {
+		return nil, err
+	}
+	var result []V
+	for i, part := range parts {
+		for j := range part {
+			tag(&part[j], contexts[i])
+		}
+		result = append(result, part...)
+	}
+	return result, err
+}
+
+// loadGrouped is loadFlat for grouped categories: groups with the same key
+// in several clusters are merged.
+func loadGrouped[V any](
+	ctx context.Context,
+	contexts []string,
+	load func(ctx context.Context, kubeCtx string) (map[string][]V, error),
+	tag func(item *V, cluster string),
+) (map[string][]V, error) {
+	parts, err := forEachCluster(ctx, contexts, load)
+	if err != nil && !isPartialLoad(err) {
+		return nil, err
+	}
+	if len(parts) == 1 {
+		for _, items := range parts[0] {
+			for j := range items {
+				tag(&items[j], contexts[0])
+			}
+		}
+		return parts[0], nil
+	}
+	result := make(map[string][]V)
+	for i, part := range parts {
+		for key, items := range part {
+			for j := range items {
+				tag(&items[j], contexts[i])
+			}
+			result[key] = append(result[key], items...)
+		}
+	}
+	return result, err
+}
+
+// Cluster rewatch backoff: a cluster whose watch dropped is reopened
+// after clusterRewatchBase, doubling per failed reopen up to
+// clusterRewatchMax. Variables so tests can shorten them.
+var (
+	clusterRewatchBase = time.Second
+	clusterRewatchMax  = 30 * time.Second
+)
+
+// watchClusters opens watch against every context and merges the
+// triggers. With several contexts, a watch that fails to open is retried
+// in the background, with backoff, while the others run; only when every
+// watch fails to open is the error returned. Once running, a watch that
+// drops on one cluster is reopened the same way. A drop and a reopen each
+// fire a trigger, since changes may have been missed while the watch was
+// down. The merged trigger closes only when ctx is done.
+func watchClusters(
+	ctx context.Context,
+	contexts []string,
+	watch func(ctx context.Context, kubeCtx string) (<-chan struct{}, error),
+) (<-chan struct{}, error) {
+	if len(contexts) == 1 {
+		return watch(ctx, contexts[0])
+	}
+	wctx, cancel := context.WithCancel(ctx)
+	triggers := make([]<-chan struct{}, len(contexts))
+	var errs []error
+	for i, kubeCtx := range contexts {
+		t, err := watch(wctx, kubeCtx)
+		if err != nil {
+			err = fmt.Errorf("cluster %s: %w", kubeCtx, err)
+			logging.FromContext(ctx).Warnw("cluster watch failed; retrying", "cluster", kubeCtx, "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		triggers[i] = t
+	}
+	if len(errs) == len(contexts) {
+		cancel()
+		return nil, errors.Join(errs...)
+	}
+	out := make(chan struct{}, 1)
+	var wg sync.WaitGroup
+	for i, kubeCtx := range contexts {
+		wg.Go(func() { rewatchCluster(wctx, kubeCtx, triggers[i], watch, out) })
+	}
+	go func() {
+		wg.Wait()
+		cancel()
+		close(out)
+	}()
+	return out, nil
+}
+
+// rewatchCluster forwards the ticks of one cluster's watch to out,
+// coalescing them, and reopens the watch each time it drops until ctx is
+// done. A nil trigger is a watch that failed to open: it is reopened
+// straight away. The merged trigger stays open throughout, so the TUI's
+// reconnect status (◌ RECONNECTING, ↻n and its backoff, which tracks the
+// merged watch) does not see these reopens; they are logged instead.
+func rewatchCluster(
+	ctx context.Context,
+	kubeCtx string,
+	trigger <-chan struct{},
+	watch func(ctx context.Context, kubeCtx string) (<-chan struct{}, error),
+	out chan<- struct{},
+) {
+	notify := func() {
+		select {
+		case out <- struct{}{}:
+		default:
+		}
+	}
+	for {
+		if trigger != nil {
+			for range trigger {
+				notify()
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			logging.FromContext(ctx).Warnw("cluster watch dropped; reconnecting", "cluster", kubeCtx)
+			notify()
+		}
+		delay := clusterRewatchBase
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			t, err := watch(ctx, kubeCtx)
+			if err == nil {
+				trigger = t
+				break
+			}
+			delay = min(2*delay, clusterRewatchMax)
+			logging.FromContext(ctx).Warnw("cluster rewatch failed", "cluster", kubeCtx, "retryIn", delay.String(), "error", err)
+		}
+		notify()
+	}
+}
+
+// clusterCaches holds the informer cache of each kube context, created on
+// first use and running until ctx is done.
+type clusterCaches struct {
+	ctx context.Context
+	mu  sync.Mutex
+	// byContext is keyed by kubeconfig path and context name.
+	byContext map[[2]string]*k8s.Cache
+	// newCache is a seam for tests.
+	newCache func(ctx context.Context, kubeCfg, kubeCtx string) (*k8s.Cache, error)
+}
+
+func newClusterCaches(ctx context.Context) *clusterCaches {
+	return &clusterCaches{
+		ctx:       ctx,
+		byContext: make(map[[2]string]*k8s.Cache),
+		newCache:  k8s.NewCacheFromKubeConfig,
+	}
+}
+
+func (c *clusterCaches) get(kubeCfg, kubeCtx string) (*k8s.Cache, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := [2]string{kubeCfg, kubeCtx}
+	if cache, ok := c.byContext[key]; ok {
+		return cache, nil
+	}
+	cache, err := c.newCache(c.ctx, kubeCfg, kubeCtx)
+	if err != nil {
+		return nil, err
+	}
+	c.byContext[key] = cache
+	return cache, nil
+}
+
+// clientset returns the typed client for kubeCtx, reading from its
+// informer cache when the cache is enabled.
+func (l Client) clientset(kubeCfg, kubeCtx string) (kubernetes.Interface, error) {
+	if l.caches == nil {
+		return k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+	}
+	cache, err := l.caches.get(kubeCfg, kubeCtx)
+	if err != nil {
+		return nil, err
+	}
+	return cache.Clientset(), nil
+}
+
+// dynamicClient is clientset for the dynamic client.
+func (l Client) dynamicClient(kubeCfg, kubeCtx string) (dynamic.Interface, error) {
+	if l.caches == nil {
+		return k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
+	}
+	cache, err := l.caches.get(kubeCfg, kubeCtx)
+	if err != nil {
+		return nil, err
+	}
+	return cache.Dynamic(), nil
+}
+
+// watchK8s opens a watch on every context of env (see watchClusters):
+// from the context's informer cache when the cache is enabled, else with
+// direct watch streams.
+func (l Client) watchK8s(
+	ctx context.Context,
+	kubeCfg string,
+	env models.Environment,
+	cached func(c *k8s.Cache, ctx context.Context) (<-chan struct{}, error),
+	direct func(ctx context.Context, kubeCtx string) (<-chan struct{}, error),
+) (<-chan struct{}, error) {
+	return watchClusters(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+		if l.caches == nil {
+			return direct(ctx, kubeCtx)
+		}
+		cache, err := l.caches.get(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return cached(cache, ctx)
+	})
+}
@@ -0,0 +1,240 @@
+package production
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestLoadGrouped_MergesAndTagsClusters(t *testing.T) {
+	t.Parallel()
+	byCluster := map[string]map[string][]models.GPUNode{
+		"a": {"pool-1": {{Name: "n1"}}},
+		"b": {"pool-1": {{Name: "n2"}}, "pool-2": {{Name: "n3"}}},
+	}
+	got, err := loadGrouped(context.Background(), []string{"a", "b"},
+		func(_ context.Context, kubeCtx string) (map[string][]models.GPUNode, error) {
+			return byCluster[kubeCtx], nil
+		},
+		func(n *models.GPUNode, cluster string) { n.Cluster = cluster })
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]models.GPUNode{
+		"pool-1": {{Name: "n1", Cluster: "a"}, {Name: "n2", Cluster: "b"}},
+		"pool-2": {{Name: "n3", Cluster: "b"}},
+	}, got)
+}
+
+func TestLoadFlat_PartialLoadNamesCluster(t *testing.T) {
+	t.Parallel()
+	got, err := loadFlat(context.Background(), []string{"a", "b"},
+		func(_ context.Context, kubeCtx string) ([]models.BaseModel, error) {
+			if kubeCtx == "b" {
+				return nil, errors.New("connection refused")
+			}
+			return []models.BaseModel{{Name: "m"}}, nil
+		},
+		func(m *models.BaseModel, cluster string) { m.Cluster = cluster })
+	partial, ok := errors.AsType[*terraform.PartialLoadError](err)
+	require.True(t, ok, "one unreachable cluster must not fail the others: %v", err)
+	require.Len(t, partial.Errs, 1)
+	require.EqualError(t, partial.Errs[0], "cluster b: connection refused")
+	assert.Equal(t, []models.BaseModel{{Name: "m", Cluster: "a"}}, got)
+
+	_, err = loadGrouped(context.Background(), []string{"a", "b"},
+		func(_ context.Context, kubeCtx string) (map[string][]models.GPUNode, error) {
+			return nil, errors.New(kubeCtx + " unreachable")
+		},
+		func(n *models.GPUNode, cluster string) { n.Cluster = cluster })
+	require.EqualError(t, err, "cluster a: a unreachable\ncluster b: b unreachable")
+	assert.False(t, isPartialLoad(err), "a load with no cluster answering is not partial")
+
+	got, err = loadFlat(context.Background(), []string{"a"},
+		func(context.Context, string) ([]models.BaseModel, error) { return []models.BaseModel{{Name: "m"}}, nil },
+		func(m *models.BaseModel, cluster string) { m.Cluster = cluster })
+	require.NoError(t, err)
+	assert.Equal(t, []models.BaseModel{{Name: "m", Cluster: "a"}}, got)
+}
+
+// fakeWatch is a watch trigger that closes when its context is done or
+// when the test drops it, whichever comes first.
+type fakeWatch struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+func (f *fakeWatch) drop() { f.once.Do(func() { close(f.ch) }) }
+
+//nolint:paralleltest // shortens the package-level rewatch backoff
+func TestWatchClusters_ReconnectsDroppedCluster(t *testing.T) {
+	defer func(base time.Duration) { clusterRewatchBase = base }(clusterRewatchBase)
+	clusterRewatchBase = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		opens   = map[string]int{}
+		watches = map[string]*fakeWatch{}
+	)
+	reopened := make(chan *fakeWatch, 1)
+	trigger, err := watchClusters(ctx, []string{"a", "b"}, func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		opens[kubeCtx]++
+		if kubeCtx == "b" && opens[kubeCtx] == 2 {
+			return nil, errors.New("connection refused") // the first reopen fails
+		}
+		w := &fakeWatch{ch: make(chan struct{})}
+		context.AfterFunc(ctx, w.drop)
+		watches[kubeCtx] = w
+		if opens[kubeCtx] > 1 {
+			reopened <- w
+		}
+		return w.ch, nil
+	})
+	require.NoError(t, err)
+	next := func(msg string) {
+		t.Helper()
+		select {
+		case _, ok := <-trigger:
+			require.True(t, ok, msg)
+		case <-time.After(time.Second):
+			t.Fatal(msg)
+		}
+	}
+
+	mu.Lock()
+	a, b := watches["a"], watches["b"]
+	mu.Unlock()
+	a.ch <- struct{}{}
+	next("a tick from one cluster must trigger a reload")
+
+	b.drop()
+	next("a dropped watch triggers a reload")
+	var b2 *fakeWatch
+	select {
+	case b2 = <-reopened:
+	case <-time.After(time.Second):
+		t.Fatal("the dropped cluster's watch must be reopened")
+	}
+	b2.ch <- struct{}{}
+	next("the reopened watch triggers reloads")
+	a.ch <- struct{}{}
+	next("the healthy cluster keeps running")
+	mu.Lock()
+	assert.Equal(t, map[string]int{"a": 1, "b": 3}, opens)
+	mu.Unlock()
+
+	cancel()
+	for range trigger { //nolint:revive // drain until the merged trigger closes
+	}
+
+	_, err = watchClusters(context.Background(), []string{"a", "b"}, func(_ context.Context, kubeCtx string) (<-chan struct{}, error) {
+		return nil, errors.New(kubeCtx + " forbidden")
+	})
+	require.EqualError(t, err, "cluster a: a forbidden\ncluster b: b forbidden")
+}
+
+//nolint:paralleltest // shortens the package-level rewatch backoff
+func TestWatchClusters_RetriesClusterThatFailedToOpen(t *testing.T) {
+	defer func(base time.Duration) { clusterRewatchBase = base }(clusterRewatchBase)
+	clusterRewatchBase = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		mu    sync.Mutex
+		opens = map[string]int{}
+	)
+	opened := make(chan *fakeWatch, 1)
+	trigger, err := watchClusters(ctx, []string{"a", "b"}, func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		opens[kubeCtx]++
+		if kubeCtx == "b" && opens[kubeCtx] <= 2 {
+			return nil, errors.New("connection refused")
+		}
+		w := &fakeWatch{ch: make(chan struct{})}
+		context.AfterFunc(ctx, w.drop)
+		if kubeCtx == "b" {
+			opened <- w
+		}
+		return w.ch, nil
+	})
+	require.NoError(t, err, "the reachable cluster is watched")
+
+	var b *fakeWatch
+	select {
+	case b = <-opened:
+	case <-time.After(time.Second):
+		t.Fatal("the cluster that failed to open must be retried")
+	}
+	select {
+	case _, ok := <-trigger:
+		require.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("opening the retried watch triggers a reload")
+	}
+	b.ch <- struct{}{}
+	select {
+	case _, ok := <-trigger:
+		require.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("the retried watch triggers reloads")
+	}
+	mu.Lock()
+	assert.Equal(t, map[string]int{"a": 1, "b": 3}, opens)
+	mu.Unlock()
+}
+
+func TestClusterCaches_OneCachePerContext(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gpus := corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}
+	pods := map[string]*corev1.Pod{}
+	for _, kubeCtx := range []string{"a", "b"} {
+		pods[kubeCtx] = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "p-" + kubeCtx, Namespace: "ns"},
+			Spec: corev1.PodSpec{NodeName: "node-" + kubeCtx, Containers: []corev1.Container{
+				{Name: "main", Resources: corev1.ResourceRequirements{Requests: gpus, Limits: gpus}},
+			}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	var created []string
+	caches := newClusterCaches(ctx)
+	caches.newCache = func(ctx context.Context, _, kubeCtx string) (*k8s.Cache, error) {
+		created = append(created, kubeCtx)
+		return k8s.NewCache(ctx, fake.NewSimpleClientset(pods[kubeCtx]),
+			dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), nil, nil), nil
+	}
+	l := Client{
+		kubeContexts: models.KubeContexts{"dev-phx": {"a", "b"}},
+		caches:       caches,
+	}
+	env := models.Environment{Type: "dev", Region: "us-phoenix-1", Realm: "oc1"}
+
+	for range 2 {
+		got, err := l.LoadGPUWorkloadsByNode(ctx, "kubeconfig", env)
+		require.NoError(t, err)
+		assert.Len(t, got["node-a"], 1)
+		assert.Len(t, got["node-b"], 1)
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, created, "caches are reused across loads")
+}
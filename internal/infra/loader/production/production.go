@@ -4,15 +4,23 @@ Package production provides the production Client implementation for the toolkit
 package production
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"time"
+
+	"k8s.io/client-go/dynamic"
 
 	"github.com/jingle2008/toolkit/internal/configloader"
+	"github.com/jingle2008/toolkit/internal/domain"
 	"github.com/jingle2008/toolkit/internal/infra/fswatch"
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
 	"github.com/jingle2008/toolkit/internal/infra/k8s"
 	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
 	"github.com/jingle2008/toolkit/internal/infra/terraform"
 	"github.com/jingle2008/toolkit/pkg/infra/logging"
 	"github.com/jingle2008/toolkit/pkg/models"
@@ -29,16 +37,75 @@ Client implements all loader interfaces using the production utils package.
 */
 type Client struct {
 	metadataFile    string
+	gpuPoolSources  []terraform.GPUPoolSource // nil means terraform.DefaultGPUPoolSources
+	gpuResources    []string                  // nil means k8s.DefaultGPUResources
+	healthRules     []k8s.HealthRule          // added to k8s.DefaultHealthRules
+	kubeContexts    models.KubeContexts       // unmapped environments use Environment.KubeContext
+	informerCache   bool
+	caches          *clusterCaches    // per-context informer caches; nil unless informerCache
+	parseCache      *parsecache.Cache // nil disables the on-disk parse cache
 	metadata        *models.Metadata
 	metadataLoadErr error // non-nil when an EXISTING metadata file failed to parse; blocks writes to avoid clobbering it
 }
 
+// Option configures a Client built by New.
+type Option func(*Client)
+
+// WithGPUPoolSources overrides the Terraform module directories GPU pools
+// are loaded from. An empty list keeps the defaults.
+func WithGPUPoolSources(sources []terraform.GPUPoolSource) Option {
+	return func(l *Client) { l.gpuPoolSources = sources }
+}
+
+// WithGPUResources overrides the accelerator resources counted as GPUs
+// (e.g. amd.com/gpu, nvidia.com/mig-1g.10gb). An empty list keeps the
+// default nvidia.com/gpu.
+func WithGPUResources(resources []string) Option {
+	return func(l *Client) { l.gpuResources = resources }
+}
+
+// WithNodeHealthRules adds GPU node health rules to the defaults, or
+// replaces a default of the same name.
+func WithNodeHealthRules(rules []k8s.HealthRule) Option {
+	return func(l *Client) { l.healthRules = rules }
+}
+
+// WithKubeContexts maps environments to the kube contexts of their
+// clusters. k8s-backed categories are loaded from every context of the
+// environment and tagged with the one each item came from.
+func WithKubeContexts(contexts models.KubeContexts) Option {
+	return func(l *Client) { l.kubeContexts = contexts }
+}
+
+// WithInformerCache serves nodes, pods and the ome.io CRs from shared
+// informers (see k8s.Cache), one set per kube context, started on first
+// use and kept until New's ctx is done. Reloads then read memory instead
+// of re-listing, and watches are fed by the informers. It suits
+// long-running sessions; one-shot commands are better off listing
+// directly.
+func WithInformerCache() Option {
+	return func(l *Client) { l.informerCache = true }
+}
+
+// WithParseCache serves repo-backed data from the on-disk parse cache c,
+// re-parsing only the parts of the repo whose files changed since they
+// were cached. A nil c disables the cache.
+func WithParseCache(c *parsecache.Cache) Option {
+	return func(l *Client) { l.parseCache = c }
+}
+
 // New returns a Client implementation for production use.
-func New(ctx context.Context, metadataFile string) loader.Composite {
+func New(ctx context.Context, metadataFile string, opts ...Option) loader.Composite {
 	l := &Client{
 		metadataFile: metadataFile,
 		metadata:     &models.Metadata{},
 	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.informerCache {
+		l.caches = newClusterCaches(ctx)
+	}
 
 	if metadataFile != "" {
 		if _, statErr := os.Stat(metadataFile); statErr == nil {
@@ -60,88 +127,192 @@ func New(ctx context.Context, metadataFile string) loader.Composite {
 // MetadataPath returns the configured metadata file path (for display).
 func (l *Client) MetadataPath() string { return l.metadataFile }
 
+// repoCtx returns ctx carrying the parse cache, if any, for repo loads.
+func (l Client) repoCtx(ctx context.Context) context.Context {
+	if l.parseCache == nil {
+		return ctx
+	}
+	return parsecache.WithContext(ctx, l.parseCache)
+}
+
 /*
 LoadDataset loads a dataset from the given repo and environment.
 */
 func (l Client) LoadDataset(ctx context.Context, repo string, env models.Environment) (*models.Dataset, error) {
-	return configloader.LoadDataset(ctx, repo, env, l.metadata)
+	return configloader.LoadDataset(l.repoCtx(ctx), repo, env, l.metadata)
 }
 
 /*
 LoadBaseModels loads base models from the cluster using the provided kubeconfig and environment.
 */
-func (Client) LoadBaseModels(ctx context.Context, kubeCfg string, env models.Environment) ([]models.BaseModel, error) {
-	client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.LoadBaseModels(ctx, client)
+func (l Client) LoadBaseModels(ctx context.Context, kubeCfg string, env models.Environment) ([]models.BaseModel, error) {
+	return loadFlat(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) ([]models.BaseModel, error) {
+		client, err := l.dynamicClient(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadBaseModels(ctx, client)
+	}, func(m *models.BaseModel, cluster string) { m.Cluster = cluster })
 }
 
 // LoadImportedModels loads tenant-imported models from the cluster
 // (namespaced BaseModel CRs + ClusterBaseModel CRs with a
 // `tenancy-id` label) grouped by raw TenantID, using the provided
 // kubeconfig and environment.
-func (Client) LoadImportedModels(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.ImportedModel, error) {
-	client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.LoadImportedModels(ctx, client)
+func (l Client) LoadImportedModels(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.ImportedModel, error) {
+	return loadGrouped(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (map[string][]models.ImportedModel, error) {
+		client, err := l.dynamicClient(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadImportedModels(ctx, client)
+	}, func(m *models.ImportedModel, cluster string) { m.Cluster = cluster })
 }
 
 // LoadGPUPools loads GPU pools from the given repo and environment.
-func (Client) LoadGPUPools(ctx context.Context, repo string, env models.Environment) ([]models.GPUPool, error) {
-	return terraform.LoadGPUPools(ctx, repo, env)
+func (l Client) LoadGPUPools(ctx context.Context, repo string, env models.Environment) ([]models.GPUPool, error) {
+	return terraform.LoadGPUPools(l.repoCtx(ctx), repo, env, l.gpuPoolSources...)
 }
 
 // LoadGPUNodesByPool loads GPU nodes from the given kube config and environment.
-func (Client) LoadGPUNodesByPool(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.GPUNode, error) {
-	client, err := k8s.NewClientsetFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.LoadGPUNodesByPool(ctx, client)
+func (l Client) LoadGPUNodesByPool(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.GPUNode, error) {
+	return loadGrouped(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (map[string][]models.GPUNode, error) {
+		client, err := l.clientset(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadGPUNodesByPool(ctx, client,
+			k8s.WithResources(l.gpuResources...), k8s.WithHealthRules(l.healthRules))
+	}, func(n *models.GPUNode, cluster string) { n.Cluster = cluster })
 }
 
 // LoadGPUWorkloadsByNode lists GPU-consuming pods grouped by node.
-func (Client) LoadGPUWorkloadsByNode(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.GPUWorkload, error) {
-	client, err := k8s.NewClientsetFromKubeConfig(kubeCfg, env.KubeContext())
+func (l Client) LoadGPUWorkloadsByNode(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.GPUWorkload, error) {
+	return loadGrouped(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (map[string][]models.GPUWorkload, error) {
+		client, err := l.clientset(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadGPUWorkloadsByNode(ctx, client, l.gpuResources...)
+	}, func(w *models.GPUWorkload, cluster string) { w.Cluster = cluster })
+}
+
+// LoadPendingGPUWorkloads lists GPU-requesting pods not yet scheduled to a node.
+func (l Client) LoadPendingGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) ([]models.PendingGPUWorkload, error) {
+	return loadFlat(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) ([]models.PendingGPUWorkload, error) {
+		client, err := l.clientset(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadPendingGPUWorkloads(ctx, client, l.gpuResources...)
+	}, func(w *models.PendingGPUWorkload, cluster string) { w.Cluster = cluster })
+}
+
+// LoadDedicatedAIClusters loads dedicated AI clusters from the given kube config and environment.
+func (l Client) LoadDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.DedicatedAICluster, error) {
+	return loadGrouped(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (map[string][]models.DedicatedAICluster, error) {
+		client, err := l.dynamicClient(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadDedicatedAIClusters(ctx, client, l.gpuResources...)
+	}, func(d *models.DedicatedAICluster, cluster string) { d.Cluster = cluster })
+}
+
+// LoadEndpoints lists, via the GenerativeAI service, the endpoints hosted
+// on the dedicated AI clusters read from the given kube config. When only
+// some clusters load, the endpoints of their DACs are returned with the
+// partial-load error.
+func (l Client) LoadEndpoints(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.Endpoint, error) {
+	grouped, loadErr := l.LoadDedicatedAIClusters(ctx, kubeCfg, env)
+	if loadErr != nil && !isPartialLoad(loadErr) {
+		return nil, loadErr
+	}
+	var dacs []models.DedicatedAICluster
+	for _, items := range grouped {
+		dacs = append(dacs, items...)
+	}
+	client, err := oci.NewGenAIClient(env)
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := oci.LoadEndpoints(ctx, client, env, dacs)
 	if err != nil {
 		return nil, err
 	}
-	return k8s.LoadGPUWorkloadsByNode(ctx, client)
+	return endpoints, loadErr
 }
 
-// LoadDedicatedAIClusters loads dedicated AI clusters from the given kube config and environment.
-func (Client) LoadDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.DedicatedAICluster, error) {
-	client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, env.KubeContext())
+// LoadWorkRequests lists the recent work requests of the compartments the
+// cluster's GPU nodes (compute) and dedicated AI clusters (GenerativeAI)
+// live in. When only some clusters load, the work requests of their
+// compartments are returned with the partial-load error.
+func (l Client) LoadWorkRequests(ctx context.Context, kubeCfg string, env models.Environment) ([]models.WorkRequest, error) {
+	nodes, nodesErr := l.LoadGPUNodesByPool(ctx, kubeCfg, env)
+	if nodesErr != nil && !isPartialLoad(nodesErr) {
+		return nil, nodesErr
+	}
+	var compartments []string
+	for _, items := range nodes {
+		for _, n := range items {
+			compartments = append(compartments, n.CompartmentID)
+		}
+	}
+	grouped, dacsErr := l.LoadDedicatedAIClusters(ctx, kubeCfg, env)
+	if dacsErr != nil && !isPartialLoad(dacsErr) {
+		return nil, dacsErr
+	}
+	var dacs []models.DedicatedAICluster
+	for _, items := range grouped {
+		dacs = append(dacs, items...)
+	}
+	compute, err := oci.NewWorkRequestClient(env)
 	if err != nil {
 		return nil, err
 	}
-	return k8s.LoadDedicatedAIClusters(ctx, client)
+	genai, err := oci.NewGenAIClient(env)
+	if err != nil {
+		return nil, err
+	}
+	items, err := oci.LoadWorkRequests(ctx, compute, genai, env, compartments, dacs, time.Now().Add(-oci.RecentWorkRequests))
+	if err != nil {
+		return nil, err
+	}
+	return items, cmp.Or(nodesErr, dacsErr)
+}
+
+// LoadEvents lists the events for GPU nodes and GPU workload pods,
+// grouped by the involved object's name.
+func (l Client) LoadEvents(ctx context.Context, kubeCfg string, env models.Environment) (map[string][]models.Event, error) {
+	return loadGrouped(ctx, l.kubeContexts.For(env), func(ctx context.Context, kubeCtx string) (map[string][]models.Event, error) {
+		client, err := l.clientset(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		return k8s.LoadEvents(ctx, client, l.gpuResources...)
+	}, func(e *models.Event, cluster string) { e.Cluster = cluster })
 }
 
 // LoadTenancyOverrideGroup loads tenants and all tenancy override maps for a given realm.
 func (l Client) LoadTenancyOverrideGroup(ctx context.Context, repo string, env models.Environment) (models.TenancyOverrideGroup, error) {
-	return configloader.LoadTenancyOverrideGroup(ctx, repo, env.Realm, l.metadata)
+	return configloader.LoadTenancyOverrideGroup(l.repoCtx(ctx), repo, env.Realm, l.metadata)
 }
 
 /*
 LoadLimitRegionalOverrides ...
 */
-func (Client) LoadLimitRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.LimitRegionalOverride, error) {
-	return configloader.LoadLimitRegionalOverrides(ctx, repo, env.Realm)
+func (l Client) LoadLimitRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.LimitRegionalOverride, error) {
+	return configloader.LoadLimitRegionalOverrides(l.repoCtx(ctx), repo, env.Realm)
 }
 
 // LoadConsolePropertyRegionalOverrides loads console property regional overrides for the given repo and environment.
-func (Client) LoadConsolePropertyRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.ConsolePropertyRegionalOverride, error) {
-	return configloader.LoadConsolePropertyRegionalOverrides(ctx, repo, env.Realm)
+func (l Client) LoadConsolePropertyRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.ConsolePropertyRegionalOverride, error) {
+	return configloader.LoadConsolePropertyRegionalOverrides(l.repoCtx(ctx), repo, env.Realm)
 }
 
 // LoadPropertyRegionalOverrides loads property regional overrides for the given repo and environment.
-func (Client) LoadPropertyRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.PropertyRegionalOverride, error) {
-	return configloader.LoadPropertyRegionalOverrides(ctx, repo, env.Realm)
+func (l Client) LoadPropertyRegionalOverrides(ctx context.Context, repo string, env models.Environment) ([]models.PropertyRegionalOverride, error) {
+	return configloader.LoadPropertyRegionalOverrides(l.repoCtx(ctx), repo, env.Realm)
 }
 
 // UpsertTenantMetadata merges entry into the metadata file (replacing
@@ -184,53 +355,94 @@ func (l *Client) UpsertTenantMetadata(entry models.TenantMetadata) error {
 var _ loader.Watcher = (*Client)(nil)
 
 // WatchBaseModels establishes a watch on ClusterBaseModel CRs.
-func (Client) WatchBaseModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
-	client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.WatchBaseModels(ctx, client)
+func (l Client) WatchBaseModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchBaseModels,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchBaseModels(ctx, client)
+		})
 }
 
 // WatchImportedModels establishes a watch on the imported-model sources.
-func (Client) WatchImportedModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
-	client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.WatchImportedModels(ctx, client)
+func (l Client) WatchImportedModels(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchImportedModels,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			client, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchImportedModels(ctx, client)
+		})
 }
 
 // WatchGPUNodes establishes a watch on GPU nodes and GPU pods.
-func (Client) WatchGPUNodes(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
-	cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.WatchGPUNodes(ctx, cs)
+func (l Client) WatchGPUNodes(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env,
+		func(c *k8s.Cache, ctx context.Context) (<-chan struct{}, error) {
+			return c.WatchGPUNodes(ctx, l.gpuResources...)
+		},
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchGPUNodes(ctx, cs, l.gpuResources...)
+		})
 }
 
 // WatchGPUWorkloads establishes a watch on GPU pods.
-func (Client) WatchGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
-	cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, env.KubeContext())
-	if err != nil {
-		return nil, err
-	}
-	return k8s.WatchGPUWorkloads(ctx, cs)
+func (l Client) WatchGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchGPUWorkloads,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchGPUWorkloads(ctx, cs)
+		})
+}
+
+// WatchPendingGPUWorkloads establishes a watch on unscheduled pods.
+func (l Client) WatchPendingGPUWorkloads(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchPendingGPUWorkloads,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchPendingGPUWorkloads(ctx, cs)
+		})
 }
 
 // WatchDedicatedAIClusters establishes a watch on DAC CRs and GPU pods.
-func (Client) WatchDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
-	kubeCtx := env.KubeContext()
-	dyn, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
-	if err != nil {
-		return nil, err
-	}
-	cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
-	if err != nil {
-		return nil, err
-	}
-	return k8s.WatchDedicatedAIClusters(ctx, dyn, cs)
+func (l Client) WatchDedicatedAIClusters(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchDedicatedAIClusters,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			dyn, err := k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchDedicatedAIClusters(ctx, dyn, cs)
+		})
+}
+
+// WatchEvents establishes a watch on Node and Pod events.
+func (l Client) WatchEvents(ctx context.Context, kubeCfg string, env models.Environment) (<-chan struct{}, error) {
+	return l.watchK8s(ctx, kubeCfg, env, (*k8s.Cache).WatchEvents,
+		func(ctx context.Context, kubeCtx string) (<-chan struct{}, error) {
+			cs, err := k8s.NewClientsetFromKubeConfig(kubeCfg, kubeCtx)
+			if err != nil {
+				return nil, err
+			}
+			return k8s.WatchEvents(ctx, cs)
+		})
 }
 
 // Compile-time guard: *Client must satisfy the optional RepoWatcher
@@ -240,6 +452,83 @@ var _ loader.RepoWatcher = (*Client)(nil)
 // WatchRepo establishes a debounced filesystem watch on the repo working tree.
 // It reuses k8s.DebounceWindow so repo and k8s watches coalesce on the same
 // cadence.
-func (Client) WatchRepo(ctx context.Context, repoPath string) (<-chan struct{}, error) {
+func (Client) WatchRepo(ctx context.Context, repoPath string) (<-chan []string, error) {
 	return fswatch.Watch(ctx, repoPath, k8s.DebounceWindow)
 }
+
+// ChangedParts maps changed repo paths to the dataset parts they can affect,
+// using the client's GPU pool sources.
+func (l Client) ChangedParts(paths []string) models.RepoParts {
+	return configloader.ChangedParts(paths, l.gpuPoolSources...)
+}
+
+// LoadDatasetParts reloads only the given parts of the dataset.
+func (l Client) LoadDatasetParts(ctx context.Context, repo string, env models.Environment, parts models.RepoParts) (*models.Dataset, error) {
+	return configloader.LoadDatasetParts(l.repoCtx(ctx), repo, env, l.metadata, parts)
+}
+
+// Compile-time guard: *Client must satisfy the optional HistoryReader
+// interface, kept out of Composite (see loader.HistoryReader docs).
+var _ loader.HistoryReader = (*Client)(nil)
+
+// ItemHistory locates the repo file backing the item and returns the git
+// history of that file.
+func (Client) ItemHistory(ctx context.Context, repo string, env models.Environment, cat domain.Category,
+	tenant, name string, limit int, withDiff bool,
+) (gitlog.History, error) {
+	file, err := configloader.SourceFile(ctx, repo, env.Realm, cat, tenant, name)
+	if err != nil {
+		return gitlog.History{}, err
+	}
+	return gitlog.FileHistory(ctx, repo, file, limit, withDiff)
+}
+
+// Compile-time guard: *Client must satisfy the optional RawObjectReader
+// interface, kept out of Composite (see loader.RawObjectReader docs).
+var _ loader.RawObjectReader = (*Client)(nil)
+
+// rawKinds maps each category whose HasRawObject is true to the kind
+// k8s.GetRawObject fetches. domain.Category.HasRawObject decides which
+// categories have a raw object; this only translates, and
+// TestRawKinds_MatchHasRawObject fails when a category is added there
+// without a kind here.
+var rawKinds = map[domain.Category]k8s.RawKind{
+	domain.BaseModel:          k8s.RawBaseModel,
+	domain.ImportedModel:      k8s.RawImportedModel,
+	domain.DedicatedAICluster: k8s.RawDedicatedAICluster,
+	domain.GPUNode:            k8s.RawNode,
+	domain.GPUWorkload:        k8s.RawPod,
+}
+
+// RawObject fetches the live Kubernetes object behind the named item from
+// cluster, or from the first of env's clusters that has it when cluster
+// is empty.
+func (l Client) RawObject(ctx context.Context, kubeCfg string, env models.Environment, cat domain.Category,
+	cluster, namespace, name string,
+) (map[string]any, error) {
+	if !cat.HasRawObject() {
+		return nil, fmt.Errorf("%s is not backed by a single Kubernetes object", cat)
+	}
+	kind, ok := rawKinds[cat]
+	if !ok {
+		return nil, fmt.Errorf("no raw object kind registered for %s", cat)
+	}
+	contexts := l.kubeContexts.For(env)
+	if cluster != "" {
+		contexts = []string{cluster}
+	}
+	var err error
+	for _, kubeCtx := range contexts {
+		var client dynamic.Interface
+		client, err = k8s.NewDynamicClientFromKubeConfig(kubeCfg, kubeCtx)
+		if err != nil {
+			return nil, err
+		}
+		var obj map[string]any
+		obj, err = k8s.GetRawObject(ctx, client, kind, namespace, name)
+		if !errors.Is(err, k8s.ErrObjectNotFound) {
+			return obj, err
+		}
+	}
+	return nil, err
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jingle2008/toolkit/internal/configloader"
+	"github.com/jingle2008/toolkit/internal/domain"
 	"github.com/jingle2008/toolkit/internal/infra/loader"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
@@ -118,6 +119,22 @@ func TestLoadGPUWorkloadsByNode_Error(t *testing.T) {
 	assert.Nil(t, got)
 }
 
+func TestLoadPendingGPUWorkloads_Error(t *testing.T) {
+	t.Parallel()
+	ldr := New(context.Background(), "")
+	got, err := ldr.LoadPendingGPUWorkloads(context.Background(), "/nonexistent/kubeconfig", models.Environment{})
+	require.Error(t, err, "LoadPendingGPUWorkloads with bad kubeconfig: want error")
+	assert.Nil(t, got)
+}
+
+func TestLoadWorkRequests_Error(t *testing.T) {
+	t.Parallel()
+	ldr := New(context.Background(), "")
+	got, err := ldr.LoadWorkRequests(context.Background(), "/nonexistent/kubeconfig", models.Environment{})
+	require.Error(t, err, "LoadWorkRequests with bad kubeconfig: want error")
+	assert.Nil(t, got)
+}
+
 func TestMetadataPath(t *testing.T) {
 	t.Parallel()
 	// New returns loader.Composite, so reach MetadataPath through the
@@ -340,3 +357,27 @@ func TestUpsertTenantMetadata_NoPathErrors(t *testing.T) {
 		t.Fatal("expected error when no metadata file is configured")
 	}
 }
+
+// rawKinds must cover exactly the categories that advertise a raw object:
+// RawObject gates on HasRawObject, so a category missing here would
+// offer the TUI toggle and `get -o raw` a lookup that errors, and an
+// extra entry would be dead.
+func TestRawKinds_MatchHasRawObject(t *testing.T) {
+	t.Parallel()
+	for _, c := range domain.Categories {
+		_, ok := rawKinds[c]
+		assert.Equalf(t, c.HasRawObject(), ok, "rawKinds/HasRawObject disagree for %s", c)
+	}
+}
+
+func TestRawObject_Errors(t *testing.T) {
+	t.Parallel()
+	reader, ok := New(context.Background(), "").(loader.RawObjectReader)
+	require.True(t, ok)
+
+	_, err := reader.RawObject(context.Background(), "/nonexistent/kubeconfig", models.Environment{}, domain.Tenant, "", "", "acme")
+	require.ErrorContains(t, err, "not backed by a single Kubernetes object")
+
+	_, err = reader.RawObject(context.Background(), "/nonexistent/kubeconfig", models.Environment{}, domain.GPUNode, "", "", "gpu-1")
+	require.Error(t, err)
+}
@@ -35,7 +35,9 @@ func TestWatch_BadKubeConfigErrors(t *testing.T) {
 		{"WatchImportedModels", Client{}.WatchImportedModels},
 		{"WatchGPUNodes", Client{}.WatchGPUNodes},
 		{"WatchGPUWorkloads", Client{}.WatchGPUWorkloads},
+		{"WatchPendingGPUWorkloads", Client{}.WatchPendingGPUWorkloads},
 		{"WatchDedicatedAIClusters", Client{}.WatchDedicatedAIClusters},
+		{"WatchEvents", Client{}.WatchEvents},
 	}
 
 	for _, tc := range cases {
@@ -27,7 +27,8 @@ func TestClient_WatchRepo_TriggersOnChange(t *testing.T) {
 
 	require.NoError(t, os.WriteFile(filepath.Join(dir, "x.yaml"), []byte("v"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
 	select {
-	case <-trig:
+	case paths := <-trig:
+		require.Equal(t, []string{"x.yaml"}, paths)
 	case <-time.After(7 * time.Second): // > DebounceWindow (5s)
 		t.Fatal("expected a trigger from WatchRepo after a file change")
 	}
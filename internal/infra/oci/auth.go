@@ -0,0 +1,249 @@
+package oci
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// AuthMode selects how OCI requests are signed.
+type AuthMode string
+
+const (
+	// AuthSessionToken signs with a session token from an OCI CLI
+	// profile (`oci session authenticate`). The default.
+	AuthSessionToken AuthMode = "session-token"
+	// AuthAPIKey signs with the API key of an OCI CLI profile.
+	AuthAPIKey AuthMode = "api-key"
+	// AuthInstancePrincipal signs as the compute instance toolkit runs on.
+	AuthInstancePrincipal AuthMode = "instance-principal"
+	// AuthResourcePrincipal signs as the resource (e.g. a function)
+	// toolkit runs in.
+	AuthResourcePrincipal AuthMode = "resource-principal"
+)
+
+// AuthProfile is how one environment's OCI clients authenticate.
+type AuthProfile struct {
+	// Mode is the signing mode; empty means AuthSessionToken.
+	Mode AuthMode
+	// ConfigFile is the OCI CLI config file read by the session-token
+	// and api-key modes; empty means OciConfigPath.
+	ConfigFile string
+	// Profile is the config file profile; empty means the
+	// environment's realm, uppercased.
+	Profile string
+}
+
+// usesConfigFile reports whether the mode reads an OCI CLI profile.
+func (p AuthProfile) usesConfigFile() bool {
+	return p.Mode == AuthSessionToken || p.Mode == AuthAPIKey
+}
+
+// String describes the profile for errors and doctor, e.g.
+// "session-token (profile OC1 in ~/.oci/config)".
+func (p AuthProfile) String() string {
+	if !p.usesConfigFile() {
+		return string(p.Mode)
+	}
+	return fmt.Sprintf("%s (profile %s in %s)", p.Mode, p.Profile, p.ConfigFile)
+}
+
+// AuthConfig is the auth profile of every environment.
+type AuthConfig struct {
+	Default AuthProfile
+	// Environments overrides Default per environment name
+	// (Environment.GetName); empty fields inherit Default's.
+	Environments map[string]AuthProfile
+}
+
+// Auth is the configuration every OCI client is built with. The zero
+// value signs with session tokens from the realm's profile in
+// OciConfigPath. Set it at startup, before any client is built.
+var Auth AuthConfig
+
+// For returns env's profile, defaults filled in.
+func (a AuthConfig) For(env models.Environment) AuthProfile {
+	p := a.Default
+	if o, ok := a.Environments[env.GetName()]; ok {
+		if o.Mode != "" {
+			p.Mode = o.Mode
+		}
+		if o.ConfigFile != "" {
+			p.ConfigFile = o.ConfigFile
+		}
+		if o.Profile != "" {
+			p.Profile = o.Profile
+		}
+	}
+	if p.Mode == "" {
+		p.Mode = AuthSessionToken
+	}
+	if p.ConfigFile == "" {
+		p.ConfigFile = OciConfigPath
+	}
+	if p.Profile == "" {
+		p.Profile = strings.ToUpper(env.Realm)
+	}
+	return p
+}
+
+var (
+	apiKeyProviderFunc            configProviderFunc = common.ConfigurationProviderFromFileWithProfile
+	instancePrincipalProviderFunc                    = auth.InstancePrincipalConfigurationProvider
+	resourcePrincipalProviderFunc                    = func() (common.ConfigurationProvider, error) {
+		return auth.ResourcePrincipalConfigurationProvider()
+	}
+)
+
+// newConfigProvider returns the configuration provider Auth selects for
+// env. sessionToken builds the session-token provider, so each client
+// factory keeps its own test seam for it.
+func newConfigProvider(env models.Environment, sessionToken configProviderFunc) (common.ConfigurationProvider, error) {
+	p := Auth.For(env)
+	var (
+		provider common.ConfigurationProvider
+		err      error
+	)
+	switch p.Mode {
+	case AuthSessionToken:
+		provider, err = sessionToken(p.ConfigFile, p.Profile, "")
+	case AuthAPIKey:
+		provider, err = apiKeyProviderFunc(p.ConfigFile, p.Profile, "")
+	case AuthInstancePrincipal:
+		provider, err = instancePrincipalProviderFunc()
+	case AuthResourcePrincipal:
+		provider, err = resourcePrincipalProviderFunc()
+	default:
+		return nil, fmt.Errorf("unknown OCI auth mode %q", p.Mode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	return provider, nil
+}
+
+// ErrNoSessionToken is returned by SessionTokenExpiry for a profile that
+// names no security_token_file.
+var ErrNoSessionToken = errors.New("profile has no security_token_file")
+
+// SessionTokenExpiry returns when the session token of p's profile
+// expires, read from the token's exp claim without verifying it. A
+// config file that does not exist is an error matching os.ErrNotExist.
+func SessionTokenExpiry(p AuthProfile) (time.Time, error) {
+	values, err := readProfile(p.ConfigFile, p.Profile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tokenFile := values["security_token_file"]
+	if tokenFile == "" {
+		return time.Time{}, fmt.Errorf("%s: %w", p, ErrNoSessionToken)
+	}
+	token, err := os.ReadFile(expandHome(tokenFile))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read session token: %w", err)
+	}
+	return tokenExpiry(strings.TrimSpace(string(token)))
+}
+
+// CheckProfile checks that p's profile exists in its config file and
+// names a key_file that exists, without signing anything.
+func CheckProfile(p AuthProfile) error {
+	values, err := readProfile(p.ConfigFile, p.Profile)
+	if err != nil {
+		return err
+	}
+	keyFile := values["key_file"]
+	if keyFile == "" {
+		return fmt.Errorf("%s: profile has no key_file", p)
+	}
+	if _, err := os.Stat(expandHome(keyFile)); err != nil {
+		return fmt.Errorf("%s: key_file: %w", p, err)
+	}
+	return nil
+}
+
+// tokenExpiry decodes the exp claim of a JWT.
+func tokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("session token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode session token: %w", err)
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("decode session token: %w", err)
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, errors.New("session token has no exp claim")
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
+
+// readProfile returns the keys of profile in the OCI CLI config file at
+// path, falling back to its DEFAULT section for keys the profile does
+// not set, as the OCI CLI does.
+func readProfile(path, profile string) (map[string]string, error) {
+	f, err := os.Open(expandHome(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	sections := map[string]map[string]string{}
+	var cur map[string]string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			cur = map[string]string{}
+			sections[name] = cur
+		case cur != nil:
+			if k, v, ok := strings.Cut(line, "="); ok {
+				cur[strings.ToLower(strings.TrimSpace(k))] = strings.TrimSpace(v)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	values, ok := sections[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in %s", profile, path)
+	}
+	for k, v := range sections["DEFAULT"] {
+		if _, set := values[k]; !set {
+			values[k] = v
+		}
+	}
+	return values, nil
+}
+
+// expandHome expands a leading "~/" to the user's home directory, as the
+// OCI SDK does for config and key file paths.
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
@@ -0,0 +1,156 @@
+//nolint:paralleltest // tests use global state
+package oci
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestAuthConfigFor(t *testing.T) {
+	env := models.Environment{Type: "prod", Region: "us-ashburn-1", Realm: "oc1"}
+
+	assert.Equal(t, AuthProfile{Mode: AuthSessionToken, ConfigFile: OciConfigPath, Profile: "OC1"},
+		AuthConfig{}.For(env), "the zero value keeps the historical behaviour")
+
+	a := AuthConfig{
+		Default: AuthProfile{Mode: AuthAPIKey, ConfigFile: "/etc/oci/config"},
+		Environments: map[string]AuthProfile{
+			env.GetName(): {Profile: "PROD_IAD"},
+			"dev-phx":     {Mode: AuthInstancePrincipal},
+		},
+	}
+	assert.Equal(t, AuthProfile{Mode: AuthAPIKey, ConfigFile: "/etc/oci/config", Profile: "PROD_IAD"}, a.For(env))
+	dev := models.Environment{Type: "dev", Region: "us-phoenix-1", Realm: "oc1"}
+	assert.Equal(t, AuthInstancePrincipal, a.For(dev).Mode)
+}
+
+// stubProviders replaces the non-session-token provider seams, recording
+// which one was called.
+func stubProviders(t *testing.T) *string {
+	t.Helper()
+	origAPIKey, origInstance, origResource := apiKeyProviderFunc, instancePrincipalProviderFunc, resourcePrincipalProviderFunc
+	t.Cleanup(func() {
+		apiKeyProviderFunc, instancePrincipalProviderFunc, resourcePrincipalProviderFunc = origAPIKey, origInstance, origResource
+	})
+	var called string
+	apiKeyProviderFunc = func(path, profile, _ string) (common.ConfigurationProvider, error) {
+		called = "api-key " + path + " " + profile
+		return &fakeProvider{}, nil
+	}
+	instancePrincipalProviderFunc = func() (common.ConfigurationProvider, error) {
+		called = "instance-principal"
+		return &fakeProvider{}, nil
+	}
+	resourcePrincipalProviderFunc = func() (common.ConfigurationProvider, error) {
+		called = "resource-principal"
+		return nil, errors.New("not in a function")
+	}
+	return &called
+}
+
+func setAuth(t *testing.T, a AuthConfig) {
+	t.Helper()
+	orig := Auth
+	t.Cleanup(func() { Auth = orig })
+	Auth = a
+}
+
+func TestNewConfigProvider_Modes(t *testing.T) {
+	called := stubProviders(t)
+	session := func(path, profile, _ string) (common.ConfigurationProvider, error) {
+		*called = "session-token " + path + " " + profile
+		return &fakeProvider{}, nil
+	}
+	env := makeEnv()
+
+	for mode, want := range map[AuthMode]string{
+		"":                    "session-token ~/.oci/config OC1",
+		AuthAPIKey:            "api-key ~/.oci/config OC1",
+		AuthInstancePrincipal: "instance-principal",
+	} {
+		setAuth(t, AuthConfig{Default: AuthProfile{Mode: mode}})
+		_, err := newConfigProvider(env, session)
+		require.NoError(t, err, mode)
+		assert.Equal(t, want, *called)
+	}
+
+	setAuth(t, AuthConfig{Default: AuthProfile{Mode: AuthResourcePrincipal}})
+	_, err := newConfigProvider(env, session)
+	require.ErrorContains(t, err, "resource-principal: not in a function")
+
+	setAuth(t, AuthConfig{Default: AuthProfile{Mode: "password"}})
+	_, err = newConfigProvider(env, session)
+	require.ErrorContains(t, err, `unknown OCI auth mode "password"`)
+}
+
+func TestNewComputeClient_UsesConfiguredProfile(t *testing.T) {
+	called := stubProviders(t)
+	origFactory := computeClientFactory
+	defer func() { computeClientFactory = origFactory }()
+	computeClientFactory = func(common.ConfigurationProvider) (core.ComputeClient, error) {
+		return core.ComputeClient{}, nil
+	}
+	setAuth(t, AuthConfig{Environments: map[string]AuthProfile{
+		makeEnv().GetName(): {Mode: AuthAPIKey, ConfigFile: "/etc/oci/config", Profile: "OPS"},
+	}})
+
+	_, err := NewComputeClient(makeEnv())
+	require.NoError(t, err)
+	assert.Equal(t, "api-key /etc/oci/config OPS", *called)
+}
+
+// jwt returns an unsigned JWT whose payload is claims.
+func jwt(claims string) string {
+	enc := base64.RawURLEncoding.EncodeToString
+	return enc([]byte(`{"alg":"none"}`)) + "." + enc([]byte(claims)) + ".sig"
+}
+
+func TestSessionTokenExpiry(t *testing.T) {
+	dir := t.TempDir()
+	token := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(token, []byte(jwt(`{"exp":1767225600}`)+"\n"), 0o600))
+	cfg := filepath.Join(dir, "config")
+	require.NoError(t, os.WriteFile(cfg, []byte(`[DEFAULT]
+region = us-ashburn-1
+security_token_file = `+token+`
+
+# a profile inheriting the token file
+[OC1]
+fingerprint = aa:bb
+
+[APIKEY]
+security_token_file =
+key_file = ~/.oci/key.pem
+`), 0o600))
+
+	exp, err := SessionTokenExpiry(AuthProfile{Mode: AuthSessionToken, ConfigFile: cfg, Profile: "OC1"})
+	require.NoError(t, err)
+	assert.True(t, exp.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)), exp)
+
+	_, err = SessionTokenExpiry(AuthProfile{Mode: AuthSessionToken, ConfigFile: cfg, Profile: "APIKEY"})
+	require.ErrorIs(t, err, ErrNoSessionToken)
+
+	_, err = SessionTokenExpiry(AuthProfile{Mode: AuthSessionToken, ConfigFile: cfg, Profile: "OC2"})
+	require.ErrorContains(t, err, "profile OC2 not found")
+
+	_, err = SessionTokenExpiry(AuthProfile{Mode: AuthSessionToken, ConfigFile: filepath.Join(dir, "nope"), Profile: "OC1"})
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestTokenExpiry_Malformed(t *testing.T) {
+	for _, tok := range []string{"opaque", "a.!!.c", jwt(`[]`), jwt(`{"sub":"x"}`)} {
+		_, err := tokenExpiry(tok)
+		assert.Error(t, err, tok)
+	}
+}
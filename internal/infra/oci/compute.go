@@ -2,11 +2,12 @@ package oci
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -14,22 +15,24 @@ var (
 	computeConfigProviderFunc = common.ConfigurationProviderForSessionTokenWithProfile
 	computeClientFactory      = core.NewComputeClientWithConfigurationProvider
 	computeMgmtClientFactory  = core.NewComputeManagementClientWithConfigurationProvider
+	workRequestClientFactory  = workrequests.NewWorkRequestClientWithConfigurationProvider
 )
 
 // newOCIClient is the shared scaffold for OCI client factories: build
-// the realm-scoped session-token config provider, hand it to the
-// typed factory, then call SetRegion on the freshly-built client.
-// setRegion is the typed method expression (e.g.
-// (*core.ComputeClient).SetRegion) so the helper stays generic over
-// the concrete client type.
+// the config provider Auth selects for env, hand it to the
+// typed factory, call SetRegion on the freshly-built client, then route
+// its calls through service's breaker for env's region. setRegion is the typed method
+// expression (e.g. (*core.ComputeClient).SetRegion) and base returns
+// the embedded BaseClient, so the helper stays generic over the
+// concrete client type.
 func newOCIClient[T any](
 	env models.Environment,
 	factory func(common.ConfigurationProvider) (T, error),
 	setRegion func(*T, string),
-	label string,
+	base func(*T) *common.BaseClient,
+	service, label string,
 ) (*T, error) {
-	profile := strings.ToUpper(env.Realm)
-	provider, err := computeConfigProviderFunc(OciConfigPath, profile, "")
+	provider, err := newConfigProvider(env, computeConfigProviderFunc)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get OCI config provider: %w", err)
 	}
@@ -38,15 +41,45 @@ func newOCIClient[T any](
 		return nil, fmt.Errorf("failed to create %s: %w", label, err)
 	}
 	setRegion(&client, env.Region)
+	withResilience(base(&client), service, env.Region)
 	return &client, nil
 }
 
+// withResilience sends c's calls through the shared retry layer and the
+// circuit breaker for service in region (see package resilience). The
+// breaker is per region, e.g. "oci/compute/us-ashburn-1": an outage in
+// one region must not fail calls fast in another.
+func withResilience(c *common.BaseClient, service, region string) {
+	c.HTTPClient = resilience.Default.Dispatcher(breakerName(service, region), c.HTTPClient)
+}
+
+// breakerName is the breaker key for service in region; service alone
+// when the region is unknown.
+func breakerName(service, region string) string {
+	if region == "" {
+		return service
+	}
+	return service + "/" + region
+}
+
 // NewComputeClient creates a new OCI ComputeClient for the given region.
 func NewComputeClient(env models.Environment) (*core.ComputeClient, error) {
-	return newOCIClient(env, computeClientFactory, (*core.ComputeClient).SetRegion, "compute client")
+	return newOCIClient(env, computeClientFactory, (*core.ComputeClient).SetRegion,
+		func(c *core.ComputeClient) *common.BaseClient { return &c.BaseClient },
+		"oci/compute", "compute client")
 }
 
 // NewComputeManagementClient creates a new OCI ComputeManagementClient for the given region.
 func NewComputeManagementClient(env models.Environment) (*core.ComputeManagementClient, error) {
-	return newOCIClient(env, computeMgmtClientFactory, (*core.ComputeManagementClient).SetRegion, "compute management client")
+	return newOCIClient(env, computeMgmtClientFactory, (*core.ComputeManagementClient).SetRegion,
+		func(c *core.ComputeManagementClient) *common.BaseClient { return &c.BaseClient },
+		"oci/compute-management", "compute management client")
+}
+
+// NewWorkRequestClient creates a new OCI Core Services WorkRequestClient
+// for the given region.
+func NewWorkRequestClient(env models.Environment) (*workrequests.WorkRequestClient, error) {
+	return newOCIClient(env, workRequestClientFactory, (*workrequests.WorkRequestClient).SetRegion,
+		func(c *workrequests.WorkRequestClient) *common.BaseClient { return &c.BaseClient },
+		"oci/workrequests", "work request client")
 }
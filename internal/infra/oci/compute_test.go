@@ -4,12 +4,16 @@ package oci
 import (
 	"crypto/rsa"
 	"errors"
+	"net/http"
+	"slices"
 	"testing"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
 	"github.com/stretchr/testify/require"
 
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -153,6 +157,31 @@ func TestNewComputeManagementClient_ClientFactoryError(t *testing.T) {
 	require.Contains(t, err.Error(), "mgmt client error")
 }
 
+func TestNewWorkRequestClient(t *testing.T) {
+	origProvider := computeConfigProviderFunc
+	origFactory := workRequestClientFactory
+	defer func() {
+		computeConfigProviderFunc = origProvider
+		workRequestClientFactory = origFactory
+	}()
+
+	computeConfigProviderFunc = func(_, _, _ string) (common.ConfigurationProvider, error) {
+		return &fakeProvider{}, nil
+	}
+	workRequestClientFactory = func(_ common.ConfigurationProvider) (workrequests.WorkRequestClient, error) {
+		return workrequests.WorkRequestClient{}, nil
+	}
+	client, err := NewWorkRequestClient(makeEnv())
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	workRequestClientFactory = func(_ common.ConfigurationProvider) (workrequests.WorkRequestClient, error) {
+		return workrequests.WorkRequestClient{}, errors.New("wr client error")
+	}
+	_, err = NewWorkRequestClient(makeEnv())
+	require.ErrorContains(t, err, "failed to create work request client: wr client error")
+}
+
 // TestNewOCIClient_PropagatesRegionToSetRegion locks the core wiring
 // of the newOCIClient scaffold: whatever region the caller hands in
 // must reach setRegion. The two public wrappers rely on this to make
@@ -173,8 +202,54 @@ func TestNewOCIClient_PropagatesRegionToSetRegion(t *testing.T) {
 	setRegion := func(_ *core.ComputeClient, r string) { gotRegion = r }
 
 	env := models.Environment{Realm: "oc1", Region: "us-ashburn-1"}
-	client, err := newOCIClient(env, factory, setRegion, "test client")
+	client, err := newOCIClient(env, factory, setRegion, computeBase, "oci/test", "test client")
 	require.NoError(t, err)
 	require.NotNil(t, client)
 	require.Equal(t, "us-ashburn-1", gotRegion)
 }
+
+func computeBase(c *core.ComputeClient) *common.BaseClient { return &c.BaseClient }
+
+// countingDispatcher answers every request with 200 and counts them.
+type countingDispatcher struct{ calls int }
+
+func (d *countingDispatcher) Do(*http.Request) (*http.Response, error) {
+	d.calls++
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+// TestNewOCIClient_RoutesCallsThroughBreaker checks the factory wraps the
+// SDK's dispatcher rather than replacing it, and registers the breaker
+// for the service in the environment's region on first call.
+func TestNewOCIClient_RoutesCallsThroughBreaker(t *testing.T) {
+	origProvider := computeConfigProviderFunc
+	defer func() { computeConfigProviderFunc = origProvider }()
+	computeConfigProviderFunc = func(_, _, _ string) (common.ConfigurationProvider, error) {
+		return &fakeProvider{}, nil
+	}
+
+	sdk := &countingDispatcher{}
+	factory := func(common.ConfigurationProvider) (core.ComputeClient, error) {
+		c := core.ComputeClient{}
+		c.HTTPClient = sdk
+		return c, nil
+	}
+	client, err := newOCIClient(makeEnv(), factory, (*core.ComputeClient).SetRegion, computeBase, "oci/routing-test", "test client")
+	require.NoError(t, err)
+	require.NotSame(t, sdk, client.HTTPClient)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://iaas.example/instances", nil)
+	resp, err := client.HTTPClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 1, sdk.calls)
+	require.True(t, slices.ContainsFunc(resilience.Default.Snapshot(), func(s resilience.Status) bool {
+		return s.Service == "oci/routing-test/"+makeEnv().Region
+	}))
+}
+
+func TestBreakerName(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "oci/compute/us-ashburn-1", breakerName("oci/compute", "us-ashburn-1"))
+	require.Equal(t, "oci/compute", breakerName("oci/compute", ""))
+}
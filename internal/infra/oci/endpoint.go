@@ -0,0 +1,192 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/generativeai"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// maxConcurrentLookups bounds the parallel GetDedicatedAiCluster calls
+// LoadEndpoints makes to find each DAC's compartment.
+const maxConcurrentLookups = 5
+
+// dacClient looks up a DAC; dacCompartments needs nothing more.
+type dacClient interface {
+	GetDedicatedAiCluster(ctx context.Context, req generativeai.GetDedicatedAiClusterRequest) (generativeai.GetDedicatedAiClusterResponse, error)
+}
+
+/*
+EndpointClient is the subset of the GenerativeAI client LoadEndpoints
+needs. *generativeai.GenerativeAiClient satisfies it.
+*/
+type EndpointClient interface {
+	GetDedicatedAiCluster(ctx context.Context, req generativeai.GetDedicatedAiClusterRequest) (generativeai.GetDedicatedAiClusterResponse, error)
+	ListEndpoints(ctx context.Context, req generativeai.ListEndpointsRequest) (generativeai.ListEndpointsResponse, error)
+}
+
+/*
+LoadEndpoints returns the endpoints hosted on the given DACs, keyed by
+DAC name (the OCID suffix, i.e. DedicatedAICluster.Name).
+
+ListEndpoints is compartment-scoped and has no DAC filter, so each DAC
+is first resolved to its compartment; every distinct compartment is then
+listed once and the results are matched back to the DACs by id. A DAC
+the service no longer knows (404, e.g. deleted since the cluster was
+read) is skipped; any other failure aborts the load.
+*/
+func LoadEndpoints(
+	ctx context.Context,
+	client EndpointClient,
+	env models.Environment,
+	dacs []models.DedicatedAICluster,
+) (map[string][]models.Endpoint, error) {
+	compartments, err := dacCompartments(ctx, client, env, dacs)
+	if err != nil {
+		return nil, err
+	}
+
+	dacNames := make(map[string]string, len(dacs))
+	for _, d := range dacs {
+		dacNames[d.OCID(env.Realm, env.Region)] = d.Name
+	}
+
+	result := make(map[string][]models.Endpoint)
+	now := time.Now()
+	for _, compartmentID := range compartments {
+		items, err := listEndpoints(ctx, client, compartmentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			name, ok := dacNames[derefOr(item.DedicatedAiClusterId, "")]
+			if !ok {
+				continue
+			}
+			result[name] = append(result[name], endpointFromSummary(item, name, now))
+		}
+	}
+	return result, nil
+}
+
+// dacCompartments resolves each DAC to its compartment, returning the
+// distinct compartment ids in sorted order.
+func dacCompartments(
+	ctx context.Context,
+	client dacClient,
+	env models.Environment,
+	dacs []models.DedicatedAICluster,
+) ([]string, error) {
+	found := make([]string, len(dacs))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i, d := range dacs {
+		g.Go(func() error {
+			id := d.OCID(env.Realm, env.Region)
+			resp, err := client.GetDedicatedAiCluster(gctx, generativeai.GetDedicatedAiClusterRequest{
+				DedicatedAiClusterId: &id,
+			})
+			if err != nil {
+				if isNotFound(err) {
+					return nil
+				}
+				return fmt.Errorf("failed to get DedicatedAiCluster %s: %w", d.Name, err)
+			}
+			found[i] = derefOr(resp.CompartmentId, "")
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return distinct(found), nil
+}
+
+// listEndpoints pages through every endpoint in a compartment.
+func listEndpoints(ctx context.Context, client EndpointClient, compartmentID string) ([]generativeai.EndpointSummary, error) {
+	var (
+		items []generativeai.EndpointSummary
+		page  *string
+	)
+	for {
+		resp, err := client.ListEndpoints(ctx, generativeai.ListEndpointsRequest{
+			CompartmentId: &compartmentID,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list endpoints in %s: %w", compartmentID, err)
+		}
+		items = append(items, resp.Items...)
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return items, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+func endpointFromSummary(s generativeai.EndpointSummary, dacName string, now time.Time) models.Endpoint {
+	id := derefOr(s.Id, "")
+	ep := models.Endpoint{
+		Name:               id[strings.LastIndex(id, ".")+1:],
+		DisplayName:        derefOr(s.DisplayName, ""),
+		DedicatedAICluster: dacName,
+		CompartmentID:      derefOr(s.CompartmentId, ""),
+		ModelID:            derefOr(s.ModelId, ""),
+		LifecycleState:     string(s.LifecycleState),
+		ContentModeration:  contentModeration(s.ContentModerationConfig),
+	}
+	if s.TimeCreated != nil {
+		ep.Age = k8s.FormatAge(now.Sub(s.TimeCreated.Time))
+	}
+	return ep
+}
+
+// contentModeration summarizes a moderation config as its mode, "ON"
+// when enabled without one, or models.ContentModerationOff.
+func contentModeration(c *generativeai.ContentModerationConfig) string {
+	if c == nil || !derefOr(c.IsEnabled, false) {
+		return models.ContentModerationOff
+	}
+	if c.Mode != "" {
+		return string(c.Mode)
+	}
+	return "ON"
+}
+
+func isNotFound(err error) bool {
+	svcErr, ok := common.IsServiceError(err)
+	return ok && svcErr.GetHTTPStatusCode() == http.StatusNotFound
+}
+
+// derefOr returns *p when p is non-nil, otherwise fallback. OCI SDK
+// summaries leave optional pointer fields nil.
+func derefOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// distinct returns the non-empty values of ss, deduplicated and sorted.
+func distinct(ss []string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	var out []string
+	for _, s := range ss {
+		if _, dup := seen[s]; dup || s == "" {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,147 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/generativeai"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string           { return "not found" }
+func (notFoundError) GetHTTPStatusCode() int  { return http.StatusNotFound }
+func (notFoundError) GetMessage() string      { return "not found" }
+func (notFoundError) GetCode() string         { return "NotAuthorizedOrNotFound" }
+func (notFoundError) GetOpcRequestID() string { return "req" }
+
+// fakeEndpointClient serves DAC compartments by id and endpoint pages by
+// compartment (one page per slice entry).
+type fakeEndpointClient struct {
+	mu           sync.Mutex
+	compartments map[string]string
+	getErr       map[string]error
+	pages        map[string][][]generativeai.EndpointSummary
+	listCalls    []string
+}
+
+func (f *fakeEndpointClient) GetDedicatedAiCluster(_ context.Context, req generativeai.GetDedicatedAiClusterRequest) (generativeai.GetDedicatedAiClusterResponse, error) {
+	if err := f.getErr[*req.DedicatedAiClusterId]; err != nil {
+		return generativeai.GetDedicatedAiClusterResponse{}, err
+	}
+	c := f.compartments[*req.DedicatedAiClusterId]
+	return generativeai.GetDedicatedAiClusterResponse{
+		DedicatedAiCluster: generativeai.DedicatedAiCluster{Id: req.DedicatedAiClusterId, CompartmentId: &c},
+	}, nil
+}
+
+func (f *fakeEndpointClient) ListEndpoints(_ context.Context, req generativeai.ListEndpointsRequest) (generativeai.ListEndpointsResponse, error) {
+	f.mu.Lock()
+	f.listCalls = append(f.listCalls, *req.CompartmentId)
+	f.mu.Unlock()
+	pages := f.pages[*req.CompartmentId]
+	i := 0
+	if req.Page != nil {
+		i = int((*req.Page)[0] - '0')
+	}
+	resp := generativeai.ListEndpointsResponse{
+		EndpointCollection: generativeai.EndpointCollection{Items: pages[i]},
+	}
+	if i+1 < len(pages) {
+		next := string(rune('0' + i + 1))
+		resp.OpcNextPage = &next
+	}
+	return resp, nil
+}
+
+func summary(id, dacID, name string, state generativeai.EndpointLifecycleStateEnum, cm *generativeai.ContentModerationConfig) generativeai.EndpointSummary {
+	model := "ocid1.generativeaimodel.oc1.us-chicago-1.amaaaaaamodel"
+	created := common.SDKTime{Time: time.Now().Add(-3 * time.Hour)}
+	return generativeai.EndpointSummary{
+		Id:                      &id,
+		DedicatedAiClusterId:    &dacID,
+		DisplayName:             &name,
+		ModelId:                 &model,
+		CompartmentId:           common.String("comp"),
+		LifecycleState:          state,
+		TimeCreated:             &created,
+		ContentModerationConfig: cm,
+	}
+}
+
+func TestLoadEndpoints(t *testing.T) {
+	t.Parallel()
+	env := models.Environment{Realm: "oc1", Region: "us-chicago-1"}
+	dacA := models.DedicatedAICluster{Name: "amaaaaaadaca"}
+	dacB := models.DedicatedAICluster{Name: "amaaaaaadacb"}
+	dacGone := models.DedicatedAICluster{Name: "amaaaaaagone"}
+	idA, idB, idGone := dacA.OCID("oc1", "us-chicago-1"), dacB.OCID("oc1", "us-chicago-1"), dacGone.OCID("oc1", "us-chicago-1")
+
+	client := &fakeEndpointClient{
+		// Both DACs share a compartment, so it must be listed once.
+		compartments: map[string]string{idA: "comp1", idB: "comp1"},
+		getErr:       map[string]error{idGone: notFoundError{}},
+		pages: map[string][][]generativeai.EndpointSummary{
+			"comp1": {
+				{
+					summary("ocid1.generativeaiendpoint.oc1.us-chicago-1.amaaaaaaep1", idA, "chat", generativeai.EndpointLifecycleStateActive,
+						&generativeai.ContentModerationConfig{IsEnabled: common.Bool(true), Mode: generativeai.ContentModerationConfigModeBlock}),
+					summary("ocid1.generativeaiendpoint.oc1.us-chicago-1.amaaaaaaother", "ocid1.generativeaidedicatedaicluster.oc1.us-chicago-1.unrelated", "x", generativeai.EndpointLifecycleStateActive, nil),
+				},
+				{
+					summary("ocid1.generativeaiendpoint.oc1.us-chicago-1.amaaaaaaep2", idB, "embed", generativeai.EndpointLifecycleStateFailed,
+						&generativeai.ContentModerationConfig{IsEnabled: common.Bool(false)}),
+				},
+			},
+		},
+	}
+
+	got, err := LoadEndpoints(context.Background(), client, env, []models.DedicatedAICluster{dacA, dacB, dacGone})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"comp1"}, client.listCalls[:1])
+	assert.Len(t, client.listCalls, 2, "two pages of one compartment")
+
+	require.Len(t, got, 2)
+	require.Len(t, got["amaaaaaadaca"], 1)
+	a := got["amaaaaaadaca"][0]
+	assert.Equal(t, "amaaaaaaep1", a.Name)
+	assert.Equal(t, "chat", a.DisplayName)
+	assert.Equal(t, "amaaaaaadaca", a.DedicatedAICluster)
+	assert.Equal(t, "ACTIVE", a.LifecycleState)
+	assert.Equal(t, "BLOCK", a.ContentModeration)
+	assert.Equal(t, "3h", a.Age)
+
+	require.Len(t, got["amaaaaaadacb"], 1)
+	b := got["amaaaaaadacb"][0]
+	assert.Equal(t, models.ContentModerationOff, b.ContentModeration)
+	assert.True(t, b.IsFaulty())
+}
+
+func TestLoadEndpoints_LookupError(t *testing.T) {
+	t.Parallel()
+	env := models.Environment{Realm: "oc1", Region: "us-chicago-1"}
+	dac := models.DedicatedAICluster{Name: "amaaaaaadaca"}
+	client := &fakeEndpointClient{
+		getErr: map[string]error{dac.OCID("oc1", "us-chicago-1"): errors.New("boom")},
+	}
+	_, err := LoadEndpoints(context.Background(), client, env, []models.DedicatedAICluster{dac})
+	require.ErrorContains(t, err, "boom")
+}
+
+func TestContentModeration(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, models.ContentModerationOff, contentModeration(nil))
+	assert.Equal(t, "ON", contentModeration(&generativeai.ContentModerationConfig{IsEnabled: common.Bool(true)}))
+	assert.Equal(t, "INFORM", contentModeration(&generativeai.ContentModerationConfig{
+		IsEnabled: common.Bool(true), Mode: generativeai.ContentModerationConfigModeInform,
+	}))
+}
@@ -36,8 +36,7 @@ func newGenAIClientWithDeps(
 	providerFn configProviderFunc,
 	clientFn genAIClientFunc,
 ) (*generativeai.GenerativeAiClient, error) {
-	profile := strings.ToUpper(env.Realm)
-	provider, err := providerFn(OciConfigPath, profile, "")
+	provider, err := newConfigProvider(env, providerFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get OCI config provider: %w", err)
 	}
@@ -51,12 +50,13 @@ func newGenAIClientWithDeps(
 	if env.Type != "prod" && client.Host != "" {
 		client.Host = getServiceEndpoint(client.Host, env.Type)
 	}
+	withResilience(&client.BaseClient, "oci/generativeai", env.Region)
 
 	return &client, nil
 }
 
-// NewGenAIClient returns a GenerativeAiClient for env (session-token
-// auth, profile name is uppercased and derived from env).
+// NewGenAIClient returns a GenerativeAiClient for env, authenticated as
+// Auth selects (by default, session tokens from the realm's profile).
 func NewGenAIClient(env models.Environment) (*generativeai.GenerativeAiClient, error) {
 	return newGenAIClientWithDeps(
 		env,
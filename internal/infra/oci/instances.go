@@ -0,0 +1,161 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"golang.org/x/sync/errgroup"
+)
+
+// InstanceNotFound is the State LoadInstances reports for an instance
+// the service no longer knows (404), e.g. one terminated long enough
+// ago to have been purged.
+const InstanceNotFound = "NOT_FOUND"
+
+// Instance is a compute instance as reconciliation sees it. State is the
+// upper-cased lifecycle state (RUNNING, STOPPED, TERMINATED, …) or
+// InstanceNotFound.
+type Instance struct {
+	ID      string    `json:"id"`
+	Name    string    `json:"displayName"`
+	State   string    `json:"state"`
+	Created time.Time `json:"timeCreated,omitzero"`
+}
+
+/*
+PoolInstanceClient is the subset of the ComputeManagement client
+LoadPoolInstances needs. *core.ComputeManagementClient satisfies it.
+*/
+type PoolInstanceClient interface {
+	ListInstancePoolInstances(ctx context.Context, req core.ListInstancePoolInstancesRequest) (core.ListInstancePoolInstancesResponse, error)
+}
+
+/*
+InstanceClient is the subset of the Compute client LoadInstances needs.
+*core.ComputeClient satisfies it.
+*/
+type InstanceClient interface {
+	GetInstance(ctx context.Context, req core.GetInstanceRequest) (core.GetInstanceResponse, error)
+}
+
+// InstanceID returns the instance OCID in a Kubernetes node's
+// spec.providerID, which OCI's cloud controller sets either to the bare
+// OCID or to "oci://<ocid>".
+func InstanceID(providerID string) string {
+	return strings.TrimPrefix(providerID, "oci://")
+}
+
+/*
+LoadPoolInstances lists the instances of each instance pool in
+poolIDs, keyed by pool OCID. Pools are listed concurrently; any failure
+aborts the load.
+*/
+func LoadPoolInstances(
+	ctx context.Context,
+	client PoolInstanceClient,
+	compartmentID string,
+	poolIDs []string,
+) (map[string][]Instance, error) {
+	found := make([][]Instance, len(poolIDs))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i, id := range poolIDs {
+		g.Go(func() error {
+			items, err := listPoolInstances(gctx, client, compartmentID, id)
+			if err != nil {
+				return err
+			}
+			found[i] = items
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	result := make(map[string][]Instance, len(poolIDs))
+	for i, id := range poolIDs {
+		result[id] = found[i]
+	}
+	return result, nil
+}
+
+// listPoolInstances pages through every instance in one pool.
+func listPoolInstances(ctx context.Context, client PoolInstanceClient, compartmentID, poolID string) ([]Instance, error) {
+	var (
+		items []Instance
+		page  *string
+	)
+	for {
+		resp, err := client.ListInstancePoolInstances(ctx, core.ListInstancePoolInstancesRequest{
+			CompartmentId:  &compartmentID,
+			InstancePoolId: &poolID,
+			Page:           page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instances of pool %s: %w, request id: %s",
+				poolID, err, derefOr(resp.OpcRequestId, ""))
+		}
+		for _, s := range resp.Items {
+			if s.Id == nil {
+				continue
+			}
+			inst := Instance{
+				ID:    *s.Id,
+				Name:  derefOr(s.DisplayName, ""),
+				State: strings.ToUpper(derefOr(s.State, "")),
+			}
+			if s.TimeCreated != nil {
+				inst.Created = s.TimeCreated.Time
+			}
+			items = append(items, inst)
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return items, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+/*
+LoadInstances looks up each instance in ids, keyed by OCID. An
+instance the service no longer knows is reported with State
+InstanceNotFound; any other failure aborts the load.
+*/
+func LoadInstances(ctx context.Context, client InstanceClient, ids []string) (map[string]Instance, error) {
+	found := make([]Instance, len(ids))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i, id := range ids {
+		g.Go(func() error {
+			resp, err := client.GetInstance(gctx, core.GetInstanceRequest{InstanceId: &id})
+			if err != nil {
+				if isNotFound(err) {
+					found[i] = Instance{ID: id, State: InstanceNotFound}
+					return nil
+				}
+				return fmt.Errorf("failed to get instance %s: %w", id, err)
+			}
+			inst := Instance{
+				ID:    id,
+				Name:  derefOr(resp.DisplayName, ""),
+				State: string(resp.LifecycleState),
+			}
+			if resp.TimeCreated != nil {
+				inst.Created = resp.TimeCreated.Time
+			}
+			found[i] = inst
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	result := make(map[string]Instance, len(ids))
+	for _, inst := range found {
+		result[inst.ID] = inst
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePoolInstanceClient serves instance pages by pool id (one page per
+// slice entry).
+type fakePoolInstanceClient struct {
+	pages map[string][][]core.InstanceSummary
+	err   error
+}
+
+func (f *fakePoolInstanceClient) ListInstancePoolInstances(_ context.Context, req core.ListInstancePoolInstancesRequest) (core.ListInstancePoolInstancesResponse, error) {
+	if f.err != nil {
+		return core.ListInstancePoolInstancesResponse{OpcRequestId: common.String("req-1")}, f.err
+	}
+	pages := f.pages[*req.InstancePoolId]
+	i := 0
+	if req.Page != nil {
+		i = int((*req.Page)[0] - '0')
+	}
+	resp := core.ListInstancePoolInstancesResponse{Items: pages[i]}
+	if i+1 < len(pages) {
+		next := string(rune('0' + i + 1))
+		resp.OpcNextPage = &next
+	}
+	return resp, nil
+}
+
+func poolInstance(id, name, state string, created time.Time) core.InstanceSummary {
+	return core.InstanceSummary{
+		Id:          &id,
+		DisplayName: &name,
+		State:       &state,
+		TimeCreated: &common.SDKTime{Time: created},
+	}
+}
+
+func TestLoadPoolInstances(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := &fakePoolInstanceClient{pages: map[string][][]core.InstanceSummary{
+		"pool-a": {
+			{poolInstance("i1", "inst-1", "Running", created)},
+			{poolInstance("i2", "inst-2", "Provisioning", created), {}},
+		},
+		"pool-b": {{}},
+	}}
+
+	got, err := LoadPoolInstances(context.Background(), client, "comp", []string{"pool-a", "pool-b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]Instance{
+		"pool-a": {
+			{ID: "i1", Name: "inst-1", State: "RUNNING", Created: created},
+			{ID: "i2", Name: "inst-2", State: "PROVISIONING", Created: created},
+		},
+		"pool-b": nil,
+	}, got)
+
+	client.err = errors.New("boom")
+	_, err = LoadPoolInstances(context.Background(), client, "comp", []string{"pool-a"})
+	require.ErrorContains(t, err, "failed to list instances of pool pool-a: boom, request id: req-1")
+}
+
+type fakeInstanceClient struct {
+	instances map[string]core.Instance
+	err       error
+}
+
+func (f *fakeInstanceClient) GetInstance(_ context.Context, req core.GetInstanceRequest) (core.GetInstanceResponse, error) {
+	if f.err != nil {
+		return core.GetInstanceResponse{}, f.err
+	}
+	inst, ok := f.instances[*req.InstanceId]
+	if !ok {
+		return core.GetInstanceResponse{}, notFoundError{}
+	}
+	return core.GetInstanceResponse{Instance: inst}, nil
+}
+
+func TestLoadInstances(t *testing.T) {
+	t.Parallel()
+	client := &fakeInstanceClient{instances: map[string]core.Instance{
+		"i1": {DisplayName: common.String("inst-1"), LifecycleState: core.InstanceLifecycleStateStopped},
+	}}
+
+	got, err := LoadInstances(context.Background(), client, []string{"i1", "i2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Instance{
+		"i1": {ID: "i1", Name: "inst-1", State: "STOPPED"},
+		"i2": {ID: "i2", State: InstanceNotFound},
+	}, got)
+
+	client.err = errors.New("boom")
+	_, err = LoadInstances(context.Background(), client, []string{"i1"})
+	require.ErrorContains(t, err, "failed to get instance i1: boom")
+}
+
+func TestInstanceID(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "ocid1.instance.oc1.iad.x", InstanceID("ocid1.instance.oc1.iad.x"))
+	assert.Equal(t, "ocid1.instance.oc1.iad.x", InstanceID("oci://ocid1.instance.oc1.iad.x"))
+}
@@ -0,0 +1,104 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+type fakeMetrics struct {
+	mu    sync.Mutex
+	items map[string][]monitoring.MetricData // by query
+	err   error
+	reqs  []monitoring.SummarizeMetricsDataRequest
+}
+
+func (f *fakeMetrics) SummarizeMetricsData(_ context.Context, req monitoring.SummarizeMetricsDataRequest) (monitoring.SummarizeMetricsDataResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	return monitoring.SummarizeMetricsDataResponse{Items: f.items[*req.Query]}, f.err
+}
+
+func datapoint(t time.Time, v float64) monitoring.AggregatedDatapoint {
+	return monitoring.AggregatedDatapoint{Timestamp: &common.SDKTime{Time: t}, Value: &v}
+}
+
+func TestSummarizeMetrics(t *testing.T) {
+	t.Parallel()
+	t0 := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	const (
+		input  = `GenerativeAiService.chat.InputTokenLength[1m]{DacId = "d"}.grouping().sum()`
+		output = `GenerativeAiService.chat.OutputTokenLength[1m]{DacId = "d"}.grouping().sum()`
+	)
+	client := &fakeMetrics{items: map[string][]monitoring.MetricData{
+		input: {
+			{AggregatedDatapoints: []monitoring.AggregatedDatapoint{datapoint(t0.Add(time.Minute), 7)}},
+			{AggregatedDatapoints: []monitoring.AggregatedDatapoint{datapoint(t0, 3), {}}},
+		},
+	}}
+	q := MetricsQuery{
+		CompartmentID: "ocid1.compartment", Namespace: "GenerativeAIService", ResourceGroup: "fleet",
+		Queries: []string{input, output}, Start: t0, End: t0.Add(time.Hour),
+	}
+
+	got, err := SummarizeMetrics(context.Background(), client, q)
+	require.NoError(t, err)
+	assert.Equal(t, []models.MetricSeries{
+		{Name: "chat.InputTokenLength", Query: input, Points: []models.MetricPoint{{Time: t0, Value: 3}, {Time: t0.Add(time.Minute), Value: 7}}},
+		{Name: "chat.OutputTokenLength", Query: output},
+	}, got)
+
+	require.Len(t, client.reqs, 2)
+	req := client.reqs[0]
+	assert.Equal(t, "ocid1.compartment", *req.CompartmentId)
+	assert.Equal(t, "GenerativeAIService", *req.Namespace)
+	assert.Equal(t, "fleet", *req.ResourceGroup)
+	assert.Equal(t, t0, req.StartTime.Time)
+	assert.Equal(t, t0.Add(time.Hour), req.EndTime.Time)
+}
+
+func TestSummarizeMetrics_Error(t *testing.T) {
+	t.Parallel()
+	client := &fakeMetrics{err: errors.New("boom")}
+	_, err := SummarizeMetrics(context.Background(), client, MetricsQuery{
+		Queries: []string{`GenerativeAiService.chat.InputTokenLength[1m]{DacId = "d"}.grouping().sum()`},
+	})
+	require.ErrorContains(t, err, "failed to summarize chat.InputTokenLength: boom")
+	assert.Nil(t, client.reqs[0].ResourceGroup, "no resource group unless set")
+}
+
+//nolint:paralleltest // swaps the package-level client factories
+func TestNewMonitoringClient(t *testing.T) {
+	origProvider := computeConfigProviderFunc
+	origFactory := monitoringClientFactory
+	defer func() {
+		computeConfigProviderFunc = origProvider
+		monitoringClientFactory = origFactory
+	}()
+
+	computeConfigProviderFunc = func(_, _, _ string) (common.ConfigurationProvider, error) {
+		return &fakeProvider{}, nil
+	}
+	monitoringClientFactory = func(_ common.ConfigurationProvider) (monitoring.MonitoringClient, error) {
+		return monitoring.MonitoringClient{}, nil
+	}
+	client, err := NewMonitoringClient(makeEnv())
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	monitoringClientFactory = func(_ common.ConfigurationProvider) (monitoring.MonitoringClient, error) {
+		return monitoring.MonitoringClient{}, errors.New("monitoring client error")
+	}
+	_, err = NewMonitoringClient(makeEnv())
+	require.ErrorContains(t, err, "failed to create monitoring client: monitoring client error")
+}
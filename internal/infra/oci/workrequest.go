@@ -0,0 +1,478 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/generativeai"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// RecentWorkRequests is how far back LoadWorkRequests looks. Work
+// requests still running are listed even when older (see
+// LoadWorkRequests for the Core Services caveat).
+const RecentWorkRequests = 24 * time.Hour
+
+// genAIWorkRequestPrefix starts every GenerativeAI work request OCID;
+// Core Services work requests use ocid1.coreservicesworkrequest.
+const genAIWorkRequestPrefix = "ocid1.generativeaiworkrequest."
+
+/*
+ComputeWorkRequestClient is the subset of the Core Services work
+request client the loaders need. *workrequests.WorkRequestClient
+satisfies it.
+*/
+type ComputeWorkRequestClient interface {
+	GetWorkRequest(ctx context.Context, req workrequests.GetWorkRequestRequest) (workrequests.GetWorkRequestResponse, error)
+	ListWorkRequests(ctx context.Context, req workrequests.ListWorkRequestsRequest) (workrequests.ListWorkRequestsResponse, error)
+	ListWorkRequestErrors(ctx context.Context, req workrequests.ListWorkRequestErrorsRequest) (workrequests.ListWorkRequestErrorsResponse, error)
+}
+
+/*
+GenAIWorkRequestClient is the subset of the GenerativeAI client the
+loaders need: the work request API, plus GetDedicatedAiCluster to find
+the compartments to list. *generativeai.GenerativeAiClient satisfies it.
+*/
+type GenAIWorkRequestClient interface {
+	GetDedicatedAiCluster(ctx context.Context, req generativeai.GetDedicatedAiClusterRequest) (generativeai.GetDedicatedAiClusterResponse, error)
+	GetWorkRequest(ctx context.Context, req generativeai.GetWorkRequestRequest) (generativeai.GetWorkRequestResponse, error)
+	ListWorkRequests(ctx context.Context, req generativeai.ListWorkRequestsRequest) (generativeai.ListWorkRequestsResponse, error)
+	ListWorkRequestErrors(ctx context.Context, req generativeai.ListWorkRequestErrorsRequest) (generativeai.ListWorkRequestErrorsResponse, error)
+}
+
+// WorkRequestService returns the service that owns the work request
+// id: models.WorkRequestServiceGenAI for GenerativeAI work requests,
+// models.WorkRequestServiceCompute for the rest.
+func WorkRequestService(id string) string {
+	if strings.HasPrefix(id, genAIWorkRequestPrefix) {
+		return models.WorkRequestServiceGenAI
+	}
+	return models.WorkRequestServiceCompute
+}
+
+/*
+LoadWorkRequests lists the work requests accepted since `since`, or
+still running, from both services: Core Services work requests in the
+given compartments (those of the cluster's GPU nodes), and GenerativeAI
+work requests in the compartments of the given DACs. Failed requests
+carry their error messages. The result is newest first.
+
+Core Services work requests are read newest first only until a page has
+nothing recent (see listComputeWorkRequests), so one still running from
+well before the window may be missed; GenerativeAI ones are listed
+however old they are.
+*/
+func LoadWorkRequests(
+	ctx context.Context,
+	compute ComputeWorkRequestClient,
+	genai GenAIWorkRequestClient,
+	env models.Environment,
+	compartments []string,
+	dacs []models.DedicatedAICluster,
+	since time.Time,
+) ([]models.WorkRequest, error) {
+	now := time.Now()
+	var result []models.WorkRequest
+	for _, compartmentID := range distinct(compartments) {
+		wrs, err := listComputeWorkRequests(ctx, compute, compartmentID, since, now)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, wrs...)
+	}
+
+	genaiCompartments, err := dacCompartments(ctx, genai, env, dacs)
+	if err != nil {
+		return nil, err
+	}
+	for _, compartmentID := range genaiCompartments {
+		items, err := listGenAIWorkRequests(ctx, genai, compartmentID, since)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			result = append(result, genAIWorkRequest(item.Id, item.OperationType, item.Status,
+				item.PercentComplete, item.Resources, item.TimeAccepted, item.TimeFinished, now))
+		}
+	}
+
+	if err := attachErrors(ctx, compute, genai, result); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].Accepted.Equal(result[j].Accepted) {
+			return result[i].Accepted.After(result[j].Accepted)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+/*
+GetWorkRequest returns the work request id from the service that owns
+it (see WorkRequestService), with its error messages when it failed.
+*/
+func GetWorkRequest(
+	ctx context.Context,
+	compute ComputeWorkRequestClient,
+	genai GenAIWorkRequestClient,
+	id string,
+) (models.WorkRequest, error) {
+	now := time.Now()
+	var wr models.WorkRequest
+	if WorkRequestService(id) == models.WorkRequestServiceGenAI {
+		resp, err := genai.GetWorkRequest(ctx, generativeai.GetWorkRequestRequest{WorkRequestId: &id})
+		if err != nil {
+			return models.WorkRequest{}, fmt.Errorf("failed to get work request %s: %w", id, err)
+		}
+		wr = genAIWorkRequest(resp.Id, resp.OperationType, resp.Status, resp.PercentComplete,
+			resp.Resources, resp.TimeAccepted, resp.TimeFinished, now)
+	} else {
+		resp, err := compute.GetWorkRequest(ctx, workrequests.GetWorkRequestRequest{WorkRequestId: &id})
+		if err != nil {
+			return models.WorkRequest{}, fmt.Errorf("failed to get work request %s: %w", id, err)
+		}
+		wr = computeWorkRequest(resp.Id, resp.OperationType, string(resp.Status),
+			resp.PercentComplete, resp.TimeAccepted, resp.TimeFinished, now)
+		for _, r := range resp.Resources {
+			wr.Resources = append(wr.Resources, derefOr(r.Identifier, ""))
+		}
+	}
+	if wr.IsFaulty() {
+		errs, err := workRequestErrors(ctx, compute, genai, wr)
+		if err != nil {
+			return models.WorkRequest{}, err
+		}
+		wr.Errors = errs
+	}
+	return wr, nil
+}
+
+// permanentError marks an error that retrying will not fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as one that retrying will not fix, e.g. a client
+// that could not be configured. nil stays nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// IsPermanent reports whether retrying the call that failed with err is
+// pointless: err was marked Permanent, or is an OCI service error with a
+// 4xx status other than 429 (not found, not authorized, bad request).
+// Transient failures are already retried by the resilience layer.
+func IsPermanent(err error) bool {
+	if _, ok := errors.AsType[permanentError](err); ok {
+		return true
+	}
+	var svcErr common.ServiceError
+	if !errors.As(err, &svcErr) {
+		return false
+	}
+	code := svcErr.GetHTTPStatusCode()
+	return code >= http.StatusBadRequest && code < http.StatusInternalServerError && code != http.StatusTooManyRequests
+}
+
+/*
+WaitWorkRequest polls get every interval until the work request it
+returns is done, calling progress (when non-nil) whenever its status or
+percent complete changes. A failed poll is logged and retried, unless
+the failure is permanent (see IsPermanent), which ends the wait. It
+returns the final work request, with an error when it did not succeed,
+or ctx's error once ctx is done.
+*/
+func WaitWorkRequest(
+	ctx context.Context,
+	get func(context.Context) (models.WorkRequest, error),
+	interval time.Duration,
+	progress func(models.WorkRequest),
+) (models.WorkRequest, error) {
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last models.WorkRequest
+	for {
+		wr, err := get(ctx)
+		switch {
+		case err != nil && IsPermanent(err):
+			return last, err
+		case err != nil:
+			logger.Warnw("failed to get work request status", "error", err)
+		case wr.IsDone():
+			if progress != nil && wr.Status != last.Status {
+				progress(wr)
+			}
+			if !strings.EqualFold(wr.Status, models.WorkRequestStatusSucceeded) {
+				return wr, workRequestError(wr)
+			}
+			return wr, nil
+		default:
+			if progress != nil && (wr.Status != last.Status || wr.PercentComplete != last.PercentComplete) {
+				progress(wr)
+			}
+			last = wr
+		}
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// workRequestError describes a work request that finished without
+// succeeding.
+func workRequestError(wr models.WorkRequest) error {
+	msg := fmt.Sprintf("work request %s %s", wr.ID, wr.Status)
+	if len(wr.Errors) > 0 {
+		msg += ": " + strings.Join(wr.Errors, "; ")
+	}
+	return errors.New(msg)
+}
+
+// maxComputeWorkRequestPages caps how many pages of a compartment's
+// Core Services work requests one load reads.
+const maxComputeWorkRequestPages = 10
+
+// listComputeWorkRequests pages through the Core Services work requests
+// in a compartment, which the service returns newest first, and keeps
+// the recent ones. It stops after the first page with nothing recent on
+// it, or after maxComputeWorkRequestPages, rather than walking the
+// compartment's whole history; the API has no time or status filter.
+func listComputeWorkRequests(
+	ctx context.Context,
+	client ComputeWorkRequestClient,
+	compartmentID string,
+	since time.Time,
+	now time.Time,
+) ([]models.WorkRequest, error) {
+	var (
+		result []models.WorkRequest
+		page   *string
+	)
+	for range maxComputeWorkRequestPages {
+		resp, err := client.ListWorkRequests(ctx, workrequests.ListWorkRequestsRequest{
+			CompartmentId: &compartmentID,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list compute work requests in %s: %w", compartmentID, err)
+		}
+		found := false
+		for _, item := range resp.Items {
+			wr := computeWorkRequest(item.Id, item.OperationType, string(item.Status),
+				item.PercentComplete, item.TimeAccepted, item.TimeFinished, now)
+			if recent(wr, since) {
+				result = append(result, wr)
+				found = true
+			}
+		}
+		if !found || resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return result, nil
+}
+
+// genAIRunningStatuses are the statuses of a GenerativeAI work request
+// that has not finished.
+var genAIRunningStatuses = []generativeai.ListWorkRequestsStatusEnum{
+	generativeai.ListWorkRequestsStatusAccepted,
+	generativeai.ListWorkRequestsStatusInProgress,
+	generativeai.ListWorkRequestsStatusWaiting,
+	generativeai.ListWorkRequestsStatusCanceling,
+}
+
+// listGenAIWorkRequests lists the GenerativeAI work requests in a
+// compartment accepted since `since`, paging newest first and stopping
+// at the first older one, plus those still running however old, listed
+// by status.
+func listGenAIWorkRequests(
+	ctx context.Context,
+	client GenAIWorkRequestClient,
+	compartmentID string,
+	since time.Time,
+) ([]generativeai.WorkRequestSummary, error) {
+	items, err := listGenAIPages(ctx, client, compartmentID, "", func(item generativeai.WorkRequestSummary) bool {
+		return item.TimeAccepted != nil && item.TimeAccepted.Before(since)
+	})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		seen[derefOr(item.Id, "")] = true
+	}
+	for _, status := range genAIRunningStatuses {
+		running, err := listGenAIPages(ctx, client, compartmentID, status, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range running {
+			if id := derefOr(item.Id, ""); !seen[id] {
+				seen[id] = true
+				items = append(items, item)
+			}
+		}
+	}
+	return items, nil
+}
+
+// listGenAIPages pages through a compartment's GenerativeAI work
+// requests with the given status ("" for any), newest first, until the
+// last page or the first item stop reports true.
+func listGenAIPages(
+	ctx context.Context,
+	client GenAIWorkRequestClient,
+	compartmentID string,
+	status generativeai.ListWorkRequestsStatusEnum,
+	stop func(generativeai.WorkRequestSummary) bool,
+) ([]generativeai.WorkRequestSummary, error) {
+	var (
+		items []generativeai.WorkRequestSummary
+		page  *string
+	)
+	for {
+		resp, err := client.ListWorkRequests(ctx, generativeai.ListWorkRequestsRequest{
+			CompartmentId: &compartmentID,
+			Status:        status,
+			SortBy:        generativeai.ListWorkRequestsSortByTimeaccepted,
+			SortOrder:     generativeai.ListWorkRequestsSortOrderDesc,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list GenAI work requests in %s: %w", compartmentID, err)
+		}
+		for _, item := range resp.Items {
+			if stop != nil && stop(item) {
+				return items, nil
+			}
+			items = append(items, item)
+		}
+		if resp.OpcNextPage == nil || *resp.OpcNextPage == "" {
+			return items, nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+// recent reports whether wr was accepted since `since` or is still
+// running.
+func recent(wr models.WorkRequest, since time.Time) bool {
+	return !wr.IsDone() || !wr.Accepted.Before(since)
+}
+
+// attachErrors fills in the error messages of the failed work requests,
+// looking them up concurrently.
+func attachErrors(
+	ctx context.Context,
+	compute ComputeWorkRequestClient,
+	genai GenAIWorkRequestClient,
+	wrs []models.WorkRequest,
+) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentLookups)
+	for i := range wrs {
+		if !wrs[i].IsFaulty() {
+			continue
+		}
+		g.Go(func() error {
+			errs, err := workRequestErrors(gctx, compute, genai, wrs[i])
+			wrs[i].Errors = errs
+			return err
+		})
+	}
+	return g.Wait()
+}
+
+// workRequestErrors returns the messages of the first page of wr's
+// errors from the service that owns it.
+func workRequestErrors(
+	ctx context.Context,
+	compute ComputeWorkRequestClient,
+	genai GenAIWorkRequestClient,
+	wr models.WorkRequest,
+) ([]string, error) {
+	var msgs []string
+	if wr.Service == models.WorkRequestServiceGenAI {
+		resp, err := genai.ListWorkRequestErrors(ctx, generativeai.ListWorkRequestErrorsRequest{WorkRequestId: &wr.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list errors of work request %s: %w", wr.ID, err)
+		}
+		for _, e := range resp.Items {
+			msgs = append(msgs, derefOr(e.Message, derefOr(e.Code, "")))
+		}
+		return msgs, nil
+	}
+	resp, err := compute.ListWorkRequestErrors(ctx, workrequests.ListWorkRequestErrorsRequest{WorkRequestId: &wr.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list errors of work request %s: %w", wr.ID, err)
+	}
+	for _, e := range resp.Items {
+		msgs = append(msgs, derefOr(e.Message, derefOr(e.Code, "")))
+	}
+	return msgs, nil
+}
+
+func computeWorkRequest(
+	id, operation *string, status string, percent *float32,
+	accepted, finished *common.SDKTime, now time.Time,
+) models.WorkRequest {
+	wr := models.WorkRequest{
+		ID:              derefOr(id, ""),
+		Service:         models.WorkRequestServiceCompute,
+		Operation:       derefOr(operation, ""),
+		Status:          status,
+		PercentComplete: derefOr(percent, 0),
+	}
+	setTimes(&wr, accepted, finished, now)
+	return wr
+}
+
+func genAIWorkRequest(
+	id *string, operation generativeai.OperationTypeEnum, status generativeai.OperationStatusEnum,
+	percent *float32, resources []generativeai.WorkRequestResource,
+	accepted, finished *common.SDKTime, now time.Time,
+) models.WorkRequest {
+	wr := models.WorkRequest{
+		ID:              derefOr(id, ""),
+		Service:         models.WorkRequestServiceGenAI,
+		Operation:       string(operation),
+		Status:          string(status),
+		PercentComplete: derefOr(percent, 0),
+	}
+	for _, r := range resources {
+		wr.Resources = append(wr.Resources, derefOr(r.Identifier, ""))
+	}
+	setTimes(&wr, accepted, finished, now)
+	return wr
+}
+
+func setTimes(wr *models.WorkRequest, accepted, finished *common.SDKTime, now time.Time) {
+	if accepted != nil {
+		wr.Accepted = accepted.Time
+		wr.Age = k8s.FormatAge(now.Sub(accepted.Time))
+	}
+	if finished != nil {
+		wr.Finished = finished.Time
+	}
+}
@@ -0,0 +1,333 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/generativeai"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+type fakeComputeWorkRequests struct {
+	items   map[string][]workrequests.WorkRequestSummary // by compartment, one page
+	pages   [][]workrequests.WorkRequestSummary          // when set, served for every compartment
+	get     map[string]workrequests.WorkRequest
+	errors  map[string][]workrequests.WorkRequestError
+	listErr error
+	listed  int
+}
+
+func (f *fakeComputeWorkRequests) GetWorkRequest(_ context.Context, req workrequests.GetWorkRequestRequest) (workrequests.GetWorkRequestResponse, error) {
+	wr, ok := f.get[*req.WorkRequestId]
+	if !ok {
+		return workrequests.GetWorkRequestResponse{}, notFoundError{}
+	}
+	return workrequests.GetWorkRequestResponse{WorkRequest: wr}, nil
+}
+
+func (f *fakeComputeWorkRequests) ListWorkRequests(_ context.Context, req workrequests.ListWorkRequestsRequest) (workrequests.ListWorkRequestsResponse, error) {
+	f.listed++
+	if f.pages == nil {
+		return workrequests.ListWorkRequestsResponse{Items: f.items[*req.CompartmentId]}, f.listErr
+	}
+	i := 0
+	if req.Page != nil {
+		i, _ = strconv.Atoi(*req.Page)
+	}
+	resp := workrequests.ListWorkRequestsResponse{Items: f.pages[i]}
+	next := strconv.Itoa(i + 1)
+	resp.OpcNextPage = &next // endless history
+	return resp, nil
+}
+
+func (f *fakeComputeWorkRequests) ListWorkRequestErrors(_ context.Context, req workrequests.ListWorkRequestErrorsRequest) (workrequests.ListWorkRequestErrorsResponse, error) {
+	return workrequests.ListWorkRequestErrorsResponse{Items: f.errors[*req.WorkRequestId]}, nil
+}
+
+type fakeGenAIWorkRequests struct {
+	dacCompartments map[string]string // DAC OCID → compartment
+	pages           map[string][][]generativeai.WorkRequestSummary
+	get             map[string]generativeai.WorkRequest
+	errors          map[string][]generativeai.WorkRequestError
+	listed          []string
+}
+
+func (f *fakeGenAIWorkRequests) GetDedicatedAiCluster(_ context.Context, req generativeai.GetDedicatedAiClusterRequest) (generativeai.GetDedicatedAiClusterResponse, error) {
+	c, ok := f.dacCompartments[*req.DedicatedAiClusterId]
+	if !ok {
+		return generativeai.GetDedicatedAiClusterResponse{}, notFoundError{}
+	}
+	return generativeai.GetDedicatedAiClusterResponse{DedicatedAiCluster: generativeai.DedicatedAiCluster{CompartmentId: &c}}, nil
+}
+
+func (f *fakeGenAIWorkRequests) GetWorkRequest(_ context.Context, req generativeai.GetWorkRequestRequest) (generativeai.GetWorkRequestResponse, error) {
+	wr, ok := f.get[*req.WorkRequestId]
+	if !ok {
+		return generativeai.GetWorkRequestResponse{}, notFoundError{}
+	}
+	return generativeai.GetWorkRequestResponse{WorkRequest: wr}, nil
+}
+
+func (f *fakeGenAIWorkRequests) ListWorkRequests(_ context.Context, req generativeai.ListWorkRequestsRequest) (generativeai.ListWorkRequestsResponse, error) {
+	f.listed = append(f.listed, *req.CompartmentId+"/"+string(req.Status))
+	if req.Status != "" {
+		var resp generativeai.ListWorkRequestsResponse
+		for _, page := range f.pages[*req.CompartmentId] {
+			for _, item := range page {
+				if string(item.Status) == string(req.Status) {
+					resp.Items = append(resp.Items, item)
+				}
+			}
+		}
+		return resp, nil
+	}
+	pages := f.pages[*req.CompartmentId]
+	i := 0
+	if req.Page != nil {
+		i = int((*req.Page)[0] - '0')
+	}
+	resp := generativeai.ListWorkRequestsResponse{}
+	if i < len(pages) {
+		resp.Items = pages[i]
+	}
+	if i+1 < len(pages) {
+		next := string(rune('0' + i + 1))
+		resp.OpcNextPage = &next
+	}
+	return resp, nil
+}
+
+func (f *fakeGenAIWorkRequests) ListWorkRequestErrors(_ context.Context, req generativeai.ListWorkRequestErrorsRequest) (generativeai.ListWorkRequestErrorsResponse, error) {
+	return generativeai.ListWorkRequestErrorsResponse{
+		WorkRequestErrorCollection: generativeai.WorkRequestErrorCollection{Items: f.errors[*req.WorkRequestId]},
+	}, nil
+}
+
+func sdkTime(t time.Time) *common.SDKTime { return &common.SDKTime{Time: t} }
+
+func TestLoadWorkRequests(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Truncate(time.Second)
+	since := now.Add(-RecentWorkRequests)
+	compute := &fakeComputeWorkRequests{
+		items: map[string][]workrequests.WorkRequestSummary{"comp": {
+			{
+				Id: common.String("ocid1.coreservicesworkrequest.oc1.iad.t1"), OperationType: common.String("TerminateInstance"),
+				Status: "SUCCEEDED", PercentComplete: common.Float32(100), TimeAccepted: sdkTime(now.Add(-time.Hour)),
+				TimeFinished: sdkTime(now.Add(-50 * time.Minute)),
+			},
+			{
+				Id: common.String("ocid1.coreservicesworkrequest.oc1.iad.old"), OperationType: common.String("TerminateInstance"),
+				Status: "FAILED", PercentComplete: common.Float32(0), TimeAccepted: sdkTime(now.Add(-48 * time.Hour)),
+				TimeFinished: sdkTime(now.Add(-47 * time.Hour)),
+			},
+			{
+				Id: common.String("ocid1.coreservicesworkrequest.oc1.iad.slow"), OperationType: common.String("UpdateInstancePool"),
+				Status: "IN_PROGRESS", PercentComplete: common.Float32(40), TimeAccepted: sdkTime(now.Add(-30 * time.Hour)),
+			},
+		}},
+		errors: map[string][]workrequests.WorkRequestError{},
+	}
+	dac := models.DedicatedAICluster{Name: "d1"}
+	genai := &fakeGenAIWorkRequests{
+		dacCompartments: map[string]string{dac.OCID("oc1", "us-ashburn-1"): "genai-comp"},
+		pages: map[string][][]generativeai.WorkRequestSummary{"genai-comp": {
+			{{
+				Id: common.String("ocid1.generativeaiworkrequest.oc1.iad.g1"), OperationType: generativeai.OperationTypeDeleteDedicatedAiCluster,
+				Status: generativeai.OperationStatusFailed, PercentComplete: common.Float32(50),
+				Resources:    []generativeai.WorkRequestResource{{Identifier: common.String("ocid1.dac.d1")}},
+				TimeAccepted: sdkTime(now.Add(-2 * time.Hour)), TimeFinished: sdkTime(now.Add(-time.Hour)),
+			}},
+			{{
+				Id: common.String("ocid1.generativeaiworkrequest.oc1.iad.g0"), OperationType: generativeai.OperationTypeCreateEndpoint,
+				Status: generativeai.OperationStatusSucceeded, TimeAccepted: sdkTime(now.Add(-72 * time.Hour)),
+				TimeFinished: sdkTime(now.Add(-71 * time.Hour)),
+			}},
+			{{
+				Id: common.String("ocid1.generativeaiworkrequest.oc1.iad.stuck"), OperationType: generativeai.OperationTypeCreateDedicatedAiCluster,
+				Status: generativeai.OperationStatusInProgress, PercentComplete: common.Float32(10),
+				TimeAccepted: sdkTime(now.Add(-96 * time.Hour)),
+			}},
+		}},
+		errors: map[string][]generativeai.WorkRequestError{
+			"ocid1.generativeaiworkrequest.oc1.iad.g1": {{Code: common.String("Conflict"), Message: common.String("endpoints still attached")}},
+		},
+	}
+	env := models.Environment{Realm: "oc1", Region: "us-ashburn-1"}
+
+	got, err := LoadWorkRequests(context.Background(), compute, genai, env, []string{"comp", "", "comp"}, []models.DedicatedAICluster{dac}, since)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"genai-comp/", "genai-comp/",
+		"genai-comp/ACCEPTED", "genai-comp/IN_PROGRESS", "genai-comp/WAITING", "genai-comp/CANCELING",
+	}, genai.listed, "paging stops at the first request older than since; running ones are listed by status")
+	assert.Equal(t, []models.WorkRequest{
+		{
+			ID: "ocid1.coreservicesworkrequest.oc1.iad.t1", Service: "compute", Operation: "TerminateInstance",
+			Status: "SUCCEEDED", PercentComplete: 100, Accepted: now.Add(-time.Hour), Finished: now.Add(-50 * time.Minute), Age: "1h",
+		},
+		{
+			ID: "ocid1.generativeaiworkrequest.oc1.iad.g1", Service: "genai", Operation: "DELETE_DEDICATED_AI_CLUSTER",
+			Status: "FAILED", PercentComplete: 50, Resources: []string{"ocid1.dac.d1"}, Errors: []string{"endpoints still attached"},
+			Accepted: now.Add(-2 * time.Hour), Finished: now.Add(-time.Hour), Age: "2h",
+		},
+		{
+			ID: "ocid1.coreservicesworkrequest.oc1.iad.slow", Service: "compute", Operation: "UpdateInstancePool",
+			Status: "IN_PROGRESS", PercentComplete: 40, Accepted: now.Add(-30 * time.Hour), Age: "30h",
+		},
+		{
+			ID: "ocid1.generativeaiworkrequest.oc1.iad.stuck", Service: "genai", Operation: "CREATE_DEDICATED_AI_CLUSTER",
+			Status: "IN_PROGRESS", PercentComplete: 10, Accepted: now.Add(-96 * time.Hour), Age: "4d",
+		},
+	}, got)
+
+	compute.listErr = errors.New("boom")
+	_, err = LoadWorkRequests(context.Background(), compute, genai, env, []string{"comp"}, nil, since)
+	require.ErrorContains(t, err, "failed to list compute work requests in comp: boom")
+}
+
+func TestListComputeWorkRequests_StopsOutsideWindow(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	since := now.Add(-RecentWorkRequests)
+	summary := func(id string, age time.Duration) workrequests.WorkRequestSummary {
+		return workrequests.WorkRequestSummary{
+			Id: common.String(id), OperationType: common.String("TerminateInstance"), Status: "SUCCEEDED",
+			TimeAccepted: sdkTime(now.Add(-age)), TimeFinished: sdkTime(now.Add(-age)),
+		}
+	}
+	client := &fakeComputeWorkRequests{pages: [][]workrequests.WorkRequestSummary{
+		{summary("new", time.Hour), summary("old1", 30*time.Hour)},
+		{summary("old2", 40*time.Hour)},
+		{summary("never-read", 50*time.Hour)},
+	}}
+
+	got, err := listComputeWorkRequests(context.Background(), client, "comp", since, now)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "new", got[0].ID)
+	assert.Equal(t, 2, client.listed, "stops after the first page with nothing recent")
+
+	recentPage := []workrequests.WorkRequestSummary{summary("r", time.Hour)}
+	client = &fakeComputeWorkRequests{pages: make([][]workrequests.WorkRequestSummary, 2*maxComputeWorkRequestPages)}
+	for i := range client.pages {
+		client.pages[i] = recentPage
+	}
+	_, err = listComputeWorkRequests(context.Background(), client, "comp", since, now)
+	require.NoError(t, err)
+	assert.Equal(t, maxComputeWorkRequestPages, client.listed, "page count is capped")
+}
+
+func TestGetWorkRequest(t *testing.T) {
+	t.Parallel()
+	compute := &fakeComputeWorkRequests{
+		get: map[string]workrequests.WorkRequest{"ocid1.coreservicesworkrequest.oc1.iad.t1": {
+			Id: common.String("ocid1.coreservicesworkrequest.oc1.iad.t1"), OperationType: common.String("TerminateInstance"),
+			Status: workrequests.WorkRequestStatusFailed, PercentComplete: common.Float32(10),
+			Resources: []workrequests.WorkRequestResource{{Identifier: common.String("ocid1.instance.i1")}},
+		}},
+		errors: map[string][]workrequests.WorkRequestError{
+			"ocid1.coreservicesworkrequest.oc1.iad.t1": {{Code: common.String("InternalError")}},
+		},
+	}
+	genai := &fakeGenAIWorkRequests{get: map[string]generativeai.WorkRequest{"ocid1.generativeaiworkrequest.oc1.iad.g1": {
+		Id: common.String("ocid1.generativeaiworkrequest.oc1.iad.g1"), Status: generativeai.OperationStatusInProgress,
+		OperationType: generativeai.OperationTypeDeleteEndpoint, PercentComplete: common.Float32(25),
+	}}}
+
+	got, err := GetWorkRequest(context.Background(), compute, genai, "ocid1.coreservicesworkrequest.oc1.iad.t1")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkRequest{
+		ID: "ocid1.coreservicesworkrequest.oc1.iad.t1", Service: "compute", Operation: "TerminateInstance",
+		Status: "FAILED", PercentComplete: 10, Resources: []string{"ocid1.instance.i1"}, Errors: []string{"InternalError"},
+	}, got)
+
+	got, err = GetWorkRequest(context.Background(), compute, genai, "ocid1.generativeaiworkrequest.oc1.iad.g1")
+	require.NoError(t, err)
+	assert.Equal(t, "genai", got.Service)
+	assert.Equal(t, "IN_PROGRESS", got.Status)
+
+	_, err = GetWorkRequest(context.Background(), compute, genai, "ocid1.generativeaiworkrequest.oc1.iad.missing")
+	require.ErrorContains(t, err, "failed to get work request ocid1.generativeaiworkrequest.oc1.iad.missing")
+}
+
+func TestWaitWorkRequest(t *testing.T) {
+	t.Parallel()
+	steps := []models.WorkRequest{
+		{ID: "wr", Status: "ACCEPTED"},
+		{ID: "wr", Status: "IN_PROGRESS", PercentComplete: 40},
+		{ID: "wr", Status: "IN_PROGRESS", PercentComplete: 40},
+		{},
+		{ID: "wr", Status: "SUCCEEDED", PercentComplete: 100},
+	}
+	i := 0
+	get := func(context.Context) (models.WorkRequest, error) {
+		step := steps[i]
+		i++
+		if step.ID == "" {
+			return models.WorkRequest{}, errors.New("transient")
+		}
+		return step, nil
+	}
+	var seen []string
+	got, err := WaitWorkRequest(context.Background(), get, time.Millisecond, func(wr models.WorkRequest) {
+		seen = append(seen, wr.Status)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SUCCEEDED", got.Status)
+	assert.Equal(t, []string{"ACCEPTED", "IN_PROGRESS", "SUCCEEDED"}, seen, "unchanged polls and failed polls are not reported")
+
+	failed := func(context.Context) (models.WorkRequest, error) {
+		return models.WorkRequest{ID: "wr", Status: "FAILED", Errors: []string{"a", "b"}}, nil
+	}
+	_, err = WaitWorkRequest(context.Background(), failed, time.Millisecond, nil)
+	require.EqualError(t, err, "work request wr FAILED: a; b")
+
+	polls := 0
+	missing := func(context.Context) (models.WorkRequest, error) {
+		polls++
+		return models.WorkRequest{}, fmt.Errorf("failed to get work request wr: %w", notFoundError{})
+	}
+	_, err = WaitWorkRequest(context.Background(), missing, time.Millisecond, nil)
+	require.ErrorContains(t, err, "failed to get work request wr")
+	assert.Equal(t, 1, polls, "a permanent failure is not retried")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	running := func(context.Context) (models.WorkRequest, error) {
+		return models.WorkRequest{ID: "wr", Status: "IN_PROGRESS"}, nil
+	}
+	got, err = WaitWorkRequest(ctx, running, time.Hour, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "IN_PROGRESS", got.Status)
+}
+
+func TestIsPermanent(t *testing.T) {
+	t.Parallel()
+	assert.True(t, IsPermanent(fmt.Errorf("get: %w", notFoundError{})))
+	assert.True(t, IsPermanent(Permanent(errors.New("no config"))))
+	assert.False(t, IsPermanent(errors.New("connection reset")))
+	assert.False(t, IsPermanent(throttledError{}))
+	assert.NoError(t, Permanent(nil))
+}
+
+// throttledError is an OCI 429 service error.
+type throttledError struct{ notFoundError }
+
+func (throttledError) GetHTTPStatusCode() int { return http.StatusTooManyRequests }
+
+func TestWorkRequestService(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "genai", WorkRequestService("ocid1.generativeaiworkrequest.oc1.iad.x"))
+	assert.Equal(t, "compute", WorkRequestService("ocid1.coreservicesworkrequest.oc1.iad.x"))
+}
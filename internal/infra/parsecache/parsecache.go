@@ -0,0 +1,389 @@
+/*
+Package parsecache is a persistent cache of data parsed from the repo, so
+that unchanged parts of the working tree load without re-reading every
+override JSON or re-evaluating Terraform locals.
+
+A cached value is stored with the files and directories its load read.
+Each input is recorded with its size, modification time and a SHA-256 of
+its content (for a directory, of its entry names). A lookup stats every
+input: an unchanged size and mtime is trusted, anything else is re-hashed,
+so touching a file or checking out a branch that leaves it as it was still
+hits. A changed, added or removed input is a miss, and the value is loaded
+and stored again. Entries are also scoped to the toolkit build, so a new
+binary never decodes values written by an older model layout; the first
+store of a process prunes the entries other builds left behind.
+
+The cache travels on the context (WithContext / FromContext) so loaders
+deep in configloader and terraform use it without threading it through
+every signature; with no cache on the context Load just calls load.
+*/
+package parsecache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jingle2008/toolkit/internal/fileutil"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+)
+
+// formatVersion is bumped when the entry layout changes.
+const formatVersion = "1"
+
+// subDir is the directory under the toolkit cache dir holding the entries.
+const subDir = "parse"
+
+// Cache is an on-disk parse cache rooted at a directory. It is safe for
+// concurrent use: entries are separate files, replaced atomically.
+type Cache struct {
+	dir   string
+	prune sync.Once
+}
+
+// New returns a cache storing its entries under dir/parse. The directory
+// is created on first store.
+func New(dir string) *Cache {
+	return &Cache{dir: filepath.Join(dir, subDir)}
+}
+
+// DefaultDir returns the toolkit cache directory under the user cache dir
+// (e.g. ~/.cache/toolkit on Linux, ~/Library/Caches/toolkit on macOS).
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "toolkit"), nil
+}
+
+// Dir returns the directory holding the cache entries.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+type ctxKey struct{}
+
+// WithContext returns a copy of ctx carrying c. A nil c disables caching.
+func WithContext(ctx context.Context, c *Cache) context.Context {
+	return context.WithValue(ctx, ctxKey{}, c)
+}
+
+// FromContext returns the cache carried by ctx, or nil.
+func FromContext(ctx context.Context) *Cache {
+	c, _ := ctx.Value(ctxKey{}).(*Cache)
+	return c
+}
+
+/*
+Inputs collects the files and directories a load reads. Load passes one to
+the load function, which records what it read; the cached value is valid
+for as long as every recorded input is unchanged. It is safe for
+concurrent use.
+*/
+type Inputs struct {
+	mu    sync.Mutex
+	paths map[string]bool // path → recorded as a directory
+}
+
+// AddFiles records files read by the load. A file that does not exist is
+// recorded as absent, so its creation invalidates the value.
+func (in *Inputs) AddFiles(paths ...string) {
+	in.add(false, paths)
+}
+
+// AddDirs records directories whose listing, and every file directly in
+// them, the load read. A directory that does not exist is recorded as
+// absent.
+func (in *Inputs) AddDirs(paths ...string) {
+	in.add(true, paths)
+}
+
+func (in *Inputs) add(dir bool, paths []string) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	if in.paths == nil {
+		in.paths = make(map[string]bool)
+	}
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		in.paths[p] = in.paths[p] || dir
+	}
+}
+
+// input is the recorded state of one file or directory.
+type input struct {
+	Path    string `json:"path"`
+	Dir     bool   `json:"dir,omitempty"`
+	Missing bool   `json:"missing,omitempty"`
+	Size    int64  `json:"size,omitempty"`
+	ModTime int64  `json:"mtime,omitempty"` // UnixNano
+	Hash    string `json:"hash,omitempty"`
+}
+
+// entry is one cached value as stored on disk.
+type entry struct {
+	Kind    string          `json:"kind"`
+	Key     string          `json:"key"`
+	Build   string          `json:"build"`
+	Created time.Time       `json:"created"`
+	Inputs  []input         `json:"inputs"`
+	Value   json.RawMessage `json:"value"`
+}
+
+/*
+Load returns the value cached under kind and key when its inputs are
+unchanged, and otherwise calls load, caches its result and returns it.
+kind names what is cached (e.g. "tenancy-overrides") and groups entries
+in Stats; key identifies the instance (a directory, plus the environment
+when the value depends on it). Errors from load are returned as-is and
+never cached. Cache I/O failures are logged at debug and fall back to
+load; a broken cache never fails a load.
+*/
+func Load[T any](ctx context.Context, kind, key string, load func(in *Inputs) (T, error)) (T, error) {
+	c := FromContext(ctx)
+	if c == nil {
+		return load(&Inputs{})
+	}
+	logger := logging.FromContext(ctx)
+	path := c.entryPath(kind, key)
+
+	if e, ok := c.lookup(path, kind, key); ok {
+		var v T
+		if err := json.Unmarshal(e.Value, &v); err == nil {
+			logger.Debugw("parse cache hit", "kind", kind, "key", key)
+			return v, nil
+		}
+	}
+
+	start := time.Now()
+	in := &Inputs{}
+	v, err := load(in)
+	if err != nil {
+		return v, err
+	}
+	if err := c.store(path, kind, key, start, in, v); err != nil {
+		logger.Debugw("parse cache store skipped", "kind", kind, "key", key, "error", err)
+	} else {
+		logger.Debugw("parse cache miss; stored", "kind", kind, "key", key, "elapsed", time.Since(start))
+	}
+	c.prune.Do(func() {
+		n, err := c.PruneStale()
+		logger.Debugw("parse cache pruned entries of other builds", "removed", n, "error", err)
+	})
+	return v, nil
+}
+
+// entryPath names the entry file for kind and key: the kind, for Stats,
+// then a hash of everything that scopes the value.
+func (c *Cache) entryPath(kind, key string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{formatVersion, buildID(), kind, key}, "\x00")))
+	return filepath.Join(c.dir, kind+"-"+hex.EncodeToString(sum[:16])+".json")
+}
+
+// lookup reads the entry at path and reports whether it is still valid.
+// An entry whose inputs were only re-hashed (new mtime, same content) is
+// rewritten with the fresh stats so the next lookup takes the fast path.
+func (c *Cache) lookup(path, kind, key string) (*entry, bool) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is derived from the cache dir, not user input
+	if err != nil {
+		return nil, false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil || e.Kind != kind || e.Key != key || e.Build != buildID() {
+		return nil, false
+	}
+	refreshed := false
+	for i, rec := range e.Inputs {
+		fresh, ok := rec.check()
+		if !ok {
+			return nil, false
+		}
+		if fresh != rec {
+			e.Inputs[i] = fresh
+			refreshed = true
+		}
+	}
+	if refreshed {
+		_ = c.write(path, &e)
+	}
+	return &e, true
+}
+
+// racyWindow widens "modified after the load started": file timestamps come
+// from a coarse clock (and some filesystems keep only seconds), so an mtime
+// can read slightly earlier than the write that set it.
+const racyWindow = 2 * time.Second
+
+// store records in's inputs and writes v under path. Inputs modified after
+// start may have changed while load read them, so such a value is not
+// stored; the next load caches it once the inputs have settled.
+func (c *Cache) store(path, kind, key string, start time.Time, in *Inputs, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	in.mu.Lock()
+	dirs := maps.Clone(in.paths)
+	in.mu.Unlock()
+
+	var inputs []input
+	for _, p := range slices.Sorted(maps.Keys(dirs)) {
+		recs, err := fingerprint(p, dirs[p])
+		if err != nil {
+			return err
+		}
+		for _, rec := range recs {
+			if rec.ModTime >= start.Add(-racyWindow).UnixNano() {
+				return fmt.Errorf("%s changed during load", rec.Path)
+			}
+		}
+		inputs = append(inputs, recs...)
+	}
+	return c.write(path, &entry{
+		Kind: kind, Key: key, Build: buildID(), Created: time.Now(), Inputs: inputs, Value: value,
+	})
+}
+
+// write replaces the entry file atomically.
+func (c *Cache) write(path string, e *entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(path, data, 0o600)
+}
+
+// fingerprint records p: a file, or a directory followed by every regular
+// file directly in it. A missing path is recorded as absent.
+func fingerprint(p string, dir bool) ([]input, error) {
+	rec, err := stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if !dir || rec.Missing || !rec.Dir {
+		return []input{rec}, nil
+	}
+	entries, err := os.ReadDir(p)
+	if err != nil {
+		return nil, err
+	}
+	recs := []input{rec}
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			f, err := stat(filepath.Join(p, e.Name()))
+			if err != nil {
+				return nil, err
+			}
+			recs = append(recs, f)
+		}
+	}
+	return recs, nil
+}
+
+// stat records the current state of p.
+func stat(p string) (input, error) {
+	info, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return input{Path: p, Missing: true}, nil
+	}
+	if err != nil {
+		return input{}, err
+	}
+	rec := input{Path: p, Dir: info.IsDir(), ModTime: info.ModTime().UnixNano()}
+	if rec.Dir {
+		rec.Hash, rec.Size, err = hashDir(p)
+	} else {
+		rec.Size = info.Size()
+		rec.Hash, err = hashFile(p)
+	}
+	return rec, err
+}
+
+// check reports whether the recorded input is unchanged, returning its
+// current state: the record itself when size and mtime match, or a
+// re-hashed record with the same content.
+func (rec input) check() (input, bool) {
+	info, err := os.Stat(rec.Path)
+	if rec.Missing || err != nil {
+		return rec, rec.Missing && errors.Is(err, fs.ErrNotExist)
+	}
+	if info.IsDir() != rec.Dir {
+		return rec, false
+	}
+	if info.ModTime().UnixNano() == rec.ModTime && (rec.Dir || info.Size() == rec.Size) {
+		return rec, true
+	}
+	fresh, err := stat(rec.Path)
+	if err != nil || fresh.Hash != rec.Hash {
+		return rec, false
+	}
+	return fresh, true
+}
+
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p) //nolint:gosec // p is a recorded repo input
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashDir hashes the directory's entry names (subdirectories marked with a
+// trailing "/") and returns the entry count as its size.
+func hashDir(p string) (string, int64, error) {
+	entries, err := os.ReadDir(p)
+	if err != nil {
+		return "", 0, err
+	}
+	h := sha256.New()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			name += "/"
+		}
+		_, _ = io.WriteString(h, name+"\x00")
+	}
+	return hex.EncodeToString(h.Sum(nil)), int64(len(entries)), nil
+}
+
+// buildID identifies the running binary, so entries written by another
+// build (whose models may encode differently) are never decoded. Local
+// builds of a modified tree share a VCS stamp, so they are told apart by
+// the executable's modification time.
+var buildID = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	id := info.Main.Version
+	for _, s := range info.Settings {
+		if s.Key == "vcs.revision" || s.Key == "vcs.modified" {
+			id += "+" + s.Value
+		}
+	}
+	if exe, err := os.Executable(); err == nil && (info.Main.Version == "(devel)" || info.Main.Version == "") {
+		if st, err := os.Stat(exe); err == nil {
+			id += "+" + st.ModTime().UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return id
+})
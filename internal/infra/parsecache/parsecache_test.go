@@ -0,0 +1,188 @@
+package parsecache
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// counter loads the sorted names of dir's files, recording dir as input,
+// and counts how often it ran.
+type counter struct {
+	dir   string
+	calls int
+}
+
+func (c *counter) load(in *Inputs) ([]string, error) {
+	c.calls++
+	in.AddDirs(c.dir)
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names, nil
+}
+
+// writeFile writes path and backdates it, and its directory, to mtime:
+// inputs modified moments before a load are deliberately not cached.
+func writeFile(t *testing.T, path, content string, mtime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+	require.NoError(t, os.Chtimes(filepath.Dir(path), mtime, mtime))
+}
+
+func TestLoad_HitsUntilAnInputChanges(t *testing.T) {
+	t.Parallel()
+	repo := t.TempDir()
+	past := time.Now().Add(-time.Hour)
+	writeFile(t, filepath.Join(repo, "a.json"), "a", past)
+	ctx := WithContext(context.Background(), New(t.TempDir()))
+	c := &counter{dir: repo}
+
+	get := func() []string {
+		t.Helper()
+		v, err := Load(ctx, "names", repo, c.load)
+		require.NoError(t, err)
+		return v
+	}
+
+	assert.Equal(t, []string{"a.json"}, get())
+	assert.Equal(t, []string{"a.json"}, get())
+	assert.Equal(t, 1, c.calls, "second load is served from the cache")
+
+	// Same content, new mtime: re-hashed, still a hit.
+	writeFile(t, filepath.Join(repo, "a.json"), "a", past.Add(time.Minute))
+	get()
+	assert.Equal(t, 1, c.calls)
+
+	// Changed content is a miss.
+	writeFile(t, filepath.Join(repo, "a.json"), "b", past.Add(2*time.Minute))
+	get()
+	assert.Equal(t, 2, c.calls)
+
+	// So is a new file in a recorded directory.
+	writeFile(t, filepath.Join(repo, "c.json"), "c", past.Add(3*time.Minute))
+	assert.Equal(t, []string{"a.json", "c.json"}, get())
+	assert.Equal(t, 3, c.calls)
+}
+
+func TestLoad_MissingInputCreated(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	dir := filepath.Join(root, "later")
+	ctx := WithContext(context.Background(), New(t.TempDir()))
+	calls := 0
+	load := func(in *Inputs) (bool, error) {
+		calls++
+		in.AddDirs(dir)
+		_, err := os.Stat(dir)
+		return err == nil, nil
+	}
+
+	for range 2 {
+		exists, err := Load(ctx, "exists", dir, load)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	}
+	assert.Equal(t, 1, calls)
+
+	require.NoError(t, os.Mkdir(dir, 0o750)) //nolint:gosec // test helper; 0o750 is fine for temp dirs
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(dir, past, past))
+	exists, err := Load(ctx, "exists", dir, load)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, 2, calls)
+}
+
+func TestLoad_ErrorsAndRacesAreNotCached(t *testing.T) {
+	t.Parallel()
+	repo := t.TempDir()
+	file := filepath.Join(repo, "a.json")
+	writeFile(t, file, "a", time.Now().Add(-time.Hour))
+	cache := New(t.TempDir())
+	ctx := WithContext(context.Background(), cache)
+
+	_, err := Load(ctx, "fail", repo, func(*Inputs) (int, error) { return 0, errors.New("boom") })
+	require.Error(t, err)
+
+	// The file changes while the load reads it: the value may be stale.
+	_, err = Load(ctx, "racy", repo, func(in *Inputs) (int, error) {
+		in.AddFiles(file)
+		require.NoError(t, os.WriteFile(file, []byte("b"), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+		return 1, nil
+	})
+	require.NoError(t, err)
+
+	st, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Zero(t, st.Entries)
+}
+
+func TestLoad_WithoutCacheAlwaysLoads(t *testing.T) {
+	t.Parallel()
+	c := &counter{dir: t.TempDir()}
+	for range 2 {
+		_, err := Load(context.Background(), "names", c.dir, c.load)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, c.calls)
+}
+
+func TestStatsAndClear(t *testing.T) {
+	t.Parallel()
+	repo := t.TempDir()
+	writeFile(t, filepath.Join(repo, "a.json"), "a", time.Now().Add(-time.Hour))
+	cache := New(t.TempDir())
+	ctx := WithContext(context.Background(), cache)
+	c := &counter{dir: repo}
+	for _, kind := range []string{"tenancy-overrides", "tenancy-overrides", "gpu-pools"} {
+		_, err := Load(ctx, kind, repo+kind+time.Now().String(), c.load)
+		require.NoError(t, err)
+	}
+
+	st, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 3, st.Entries)
+	assert.Equal(t, map[string]int{"tenancy-overrides": 2, "gpu-pools": 1}, st.Kinds)
+	assert.Positive(t, st.Bytes)
+	assert.False(t, st.Newest.Before(st.Oldest))
+
+	n, err := cache.Clear()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	st, err = cache.Stats()
+	require.NoError(t, err)
+	assert.Zero(t, st.Entries)
+}
+
+func TestLoad_PrunesOtherBuildsOnFirstStore(t *testing.T) {
+	t.Parallel()
+	repo := t.TempDir()
+	writeFile(t, filepath.Join(repo, "a.json"), "a", time.Now().Add(-time.Hour))
+	cache := New(t.TempDir())
+	require.NoError(t, os.MkdirAll(cache.Dir(), 0o750))
+	stale := filepath.Join(cache.Dir(), "gpu-pools-0123.json")
+	require.NoError(t, os.WriteFile(stale, []byte(`{"kind":"gpu-pools","key":"k","build":"v0.0.1","value":[1,2]}`), 0o600)) //nolint:gosec // test helper; 0o600 is fine for temp files
+
+	ctx := WithContext(context.Background(), cache)
+	c := &counter{dir: repo}
+	_, err := Load(ctx, "tenancy-overrides", repo, c.load)
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, stale, "an entry of another build is never read again")
+	st, err := cache.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"tenancy-overrides": 1}, st.Kinds, "this build's entry is kept")
+}
@@ -0,0 +1,137 @@
+package parsecache
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Stats summarizes the entries on disk.
+type Stats struct {
+	Dir     string         `json:"dir"`
+	Entries int            `json:"entries"`
+	Bytes   int64          `json:"bytes"`
+	Kinds   map[string]int `json:"kinds"`
+	Oldest  time.Time      `json:"oldest,omitzero"`
+	Newest  time.Time      `json:"newest,omitzero"`
+}
+
+// Stats reads the cache directory. A cache that was never written reports
+// zero entries.
+func (c *Cache) Stats() (Stats, error) {
+	st := Stats{Dir: c.dir, Kinds: map[string]int{}}
+	err := c.eachEntry(func(name string, info fs.FileInfo) error {
+		st.Entries++
+		st.Bytes += info.Size()
+		st.Kinds[entryKind(name)]++
+		if mt := info.ModTime(); st.Oldest.IsZero() || mt.Before(st.Oldest) {
+			st.Oldest = mt
+		}
+		if mt := info.ModTime(); mt.After(st.Newest) {
+			st.Newest = mt
+		}
+		return nil
+	})
+	return st, err
+}
+
+// Clear removes every entry and returns how many it removed.
+func (c *Cache) Clear() (int, error) {
+	n := 0
+	err := c.eachEntry(func(name string, _ fs.FileInfo) error {
+		if err := os.Remove(filepath.Join(c.dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// PruneStale removes the entries written by another build, which lookups
+// never match: a local build of a modified tree gets a new build ID each
+// time it is rebuilt, so without pruning its entries pile up. It returns
+// how many it removed.
+func (c *Cache) PruneStale() (int, error) {
+	n := 0
+	err := c.eachEntry(func(name string, _ fs.FileInfo) error {
+		path := filepath.Join(c.dir, name)
+		build, err := entryBuild(path)
+		if err != nil || build == buildID() {
+			return nil //nolint:nilerr // an unreadable entry is left for its own lookup to replace
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// entryBuild reads the build an entry was written by, stopping at the
+// build field rather than decoding the (possibly large) value.
+func entryBuild(path string) (string, error) {
+	f, err := os.Open(path) //nolint:gosec // path is derived from the cache dir, not user input
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+	dec := json.NewDecoder(f)
+	if _, err := dec.Token(); err != nil {
+		return "", err
+	}
+	for dec.More() {
+		name, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+		if name == "build" {
+			var build string
+			err := dec.Decode(&build)
+			return build, err
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// eachEntry calls fn for every entry file, skipping in-flight temp files.
+func (c *Cache) eachEntry(fn func(name string, info fs.FileInfo) error) error {
+	entries, err := os.ReadDir(c.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || !strings.HasSuffix(e.Name(), ".json") || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue // removed concurrently
+		}
+		if err := fn(e.Name(), info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entryKind recovers the kind from an entry file name (kind-hash.json).
+func entryKind(name string) string {
+	base := strings.TrimSuffix(name, ".json")
+	if i := strings.LastIndex(base, "-"); i > 0 {
+		return base[:i]
+	}
+	return base
+}
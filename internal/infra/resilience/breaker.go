@@ -0,0 +1,296 @@
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// State is a circuit breaker's state.
+type State int
+
+const (
+	// Closed lets every call through.
+	Closed State = iota
+	// Open refuses calls until the cooldown elapses.
+	Open
+	// HalfOpen lets a single probe call through.
+	HalfOpen
+)
+
+// String returns the state's name as shown in the status bar and doctor.
+func (s State) String() string {
+	switch s {
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// MarshalText encodes the state by name.
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a state name; unknown names are an error.
+func (s *State) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "closed":
+		*s = Closed
+	case "open":
+		*s = Open
+	case "half-open":
+		*s = HalfOpen
+	default:
+		return fmt.Errorf("unknown breaker state %q", b)
+	}
+	return nil
+}
+
+// ErrOpen matches, through errors.Is, every call refused by an open
+// breaker.
+var ErrOpen = errors.New("circuit breaker open")
+
+// OpenError is returned, without calling the service, for a call to a
+// service whose breaker is open. Until is when the next probe is allowed;
+// it is in the past while a probe is in flight.
+type OpenError struct {
+	Service string
+	Until   time.Time
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("%s: %v until %s", e.Service, ErrOpen, e.Until.Format(time.TimeOnly))
+}
+
+// Is reports ErrOpen as a match.
+func (e *OpenError) Is(target error) bool {
+	return target == ErrOpen
+}
+
+// Status is a snapshot of one service's breaker.
+type Status struct {
+	Service string `json:"service"`
+	State   State  `json:"state"`
+	// Failures counts the consecutive failed calls.
+	Failures int `json:"failures"`
+	// Until is when an open breaker allows its next probe.
+	Until time.Time `json:"until,omitzero"`
+	// LastError describes the most recent failed call.
+	LastError string `json:"lastError,omitempty"`
+	// Changed is when the breaker last changed state.
+	Changed time.Time `json:"changed"`
+}
+
+// breaker is a service's mutable breaker state, guarded by Registry.mu.
+type breaker struct {
+	Status
+	probing bool
+}
+
+// Registry holds a breaker per service, created on first use, and the
+// policy its calls are retried with. It is safe for concurrent use.
+type Registry struct {
+	policy    Policy
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu        sync.Mutex
+	breakers  map[string]*breaker
+	subs      []chan struct{}
+	stateFile string
+
+	// fileMu serializes state-file writes, which happen outside mu.
+	fileMu sync.Mutex
+}
+
+// DefaultThreshold and DefaultCooldown configure a registry's breakers
+// unless overridden by WithBreaker.
+const (
+	DefaultThreshold = 5
+	DefaultCooldown  = 30 * time.Second
+)
+
+// Option configures a Registry.
+type Option func(*Registry)
+
+// WithPolicy sets the retry policy.
+func WithPolicy(p Policy) Option {
+	return func(r *Registry) {
+		r.policy = p
+	}
+}
+
+// WithBreaker sets the consecutive failed calls that open a breaker and
+// how long it then stays open.
+func WithBreaker(threshold int, cooldown time.Duration) Option {
+	return func(r *Registry) {
+		r.threshold = max(threshold, 1)
+		r.cooldown = cooldown
+	}
+}
+
+// WithClock sets the registry's clock, for tests.
+func WithClock(now func() time.Time) Option {
+	return func(r *Registry) {
+		r.now = now
+	}
+}
+
+// WithStateFile sets the file the breakers' state is recorded in.
+func WithStateFile(path string) Option {
+	return func(r *Registry) {
+		r.stateFile = path
+	}
+}
+
+// NewRegistry returns a registry using DefaultPolicy, DefaultThreshold and
+// DefaultCooldown unless opts say otherwise.
+func NewRegistry(opts ...Option) *Registry {
+	r := &Registry{
+		policy:    DefaultPolicy,
+		threshold: DefaultThreshold,
+		cooldown:  DefaultCooldown,
+		now:       time.Now,
+		breakers:  make(map[string]*breaker),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Default is the process-wide registry the OCI and Kubernetes clients
+// use. Commands point it at a state file at startup (SetStateFile).
+var Default = NewRegistry()
+
+// SetStateFile sets, or with "" clears, the file state changes are
+// recorded in.
+func (r *Registry) SetStateFile(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stateFile = path
+}
+
+// Snapshot returns every breaker's status, ordered by service.
+func (r *Registry) Snapshot() []Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]Status, 0, len(r.breakers))
+	for _, b := range r.breakers {
+		out = append(out, b.Status)
+	}
+	slices.SortFunc(out, func(a, b Status) int { return strings.Compare(a.Service, b.Service) })
+	return out
+}
+
+// Notify returns a channel that receives after any breaker changes state.
+// Sends never block: changes made before the last one was received
+// coalesce into it, so a reader re-reads Snapshot.
+func (r *Registry) Notify() <-chan struct{} {
+	ch := make(chan struct{}, 1)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subs = append(r.subs, ch)
+	return ch
+}
+
+// get returns service's breaker, creating a closed one. r.mu must be held.
+func (r *Registry) get(service string) *breaker {
+	b, ok := r.breakers[service]
+	if !ok {
+		b = &breaker{Status: Status{Service: service}}
+		r.breakers[service] = b
+	}
+	return b
+}
+
+// allow admits a call to service or refuses it with an *OpenError. An
+// open breaker whose cooldown has elapsed turns half-open and admits the
+// call as its probe.
+func (r *Registry) allow(service string) error {
+	r.mu.Lock()
+	b := r.get(service)
+	changed := false
+	switch b.State {
+	case Closed:
+	case Open:
+		if r.now().Before(b.Until) {
+			r.mu.Unlock()
+			return &OpenError{Service: service, Until: b.Until}
+		}
+		r.transition(b, HalfOpen)
+		b.probing, changed = true, true
+	case HalfOpen:
+		if b.probing {
+			r.mu.Unlock()
+			return &OpenError{Service: service, Until: b.Until}
+		}
+		b.probing = true
+	}
+	r.mu.Unlock()
+	if changed {
+		r.changed()
+	}
+	return nil
+}
+
+// record counts the outcome of an admitted call against service's breaker.
+func (r *Registry) record(service string, o outcome, detail string) {
+	r.mu.Lock()
+	b := r.get(service)
+	from := b.State
+	switch o {
+	case abandoned:
+	case succeeded:
+		b.Failures = 0
+		if b.State != Closed {
+			r.transition(b, Closed)
+			b.Until = time.Time{}
+		}
+	case failed:
+		b.Failures++
+		b.LastError = detail
+		if b.State == HalfOpen || (b.State == Closed && b.Failures >= r.threshold) {
+			b.Until = r.now().Add(r.cooldown)
+			r.transition(b, Open)
+		}
+	}
+	b.probing = false
+	changed := b.State != from
+	r.mu.Unlock()
+	if changed {
+		r.changed()
+	}
+}
+
+// transition moves b to s. r.mu must be held.
+func (r *Registry) transition(b *breaker, s State) {
+	b.State = s
+	b.Changed = r.now()
+}
+
+// changed notifies subscribers and records the new state. Called without
+// r.mu held.
+func (r *Registry) changed() {
+	r.mu.Lock()
+	for _, ch := range r.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	path := r.stateFile
+	r.mu.Unlock()
+	if path != "" {
+		// Best effort: the state file only informs doctor.
+		_ = r.save(path)
+	}
+}
@@ -0,0 +1,198 @@
+package resilience
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a settable clock for WithClock.
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{t: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+// fail admits and fails n calls to service.
+func fail(t *testing.T, r *Registry, service string, n int) {
+	t.Helper()
+	for range n {
+		require.NoError(t, r.allow(service))
+		r.record(service, failed, "GET /x: 503 Service Unavailable")
+	}
+}
+
+func TestBreaker_OpensAfterThresholdAndProbes(t *testing.T) {
+	t.Parallel()
+	clock := newFakeClock()
+	r := NewRegistry(WithBreaker(3, time.Minute), WithClock(clock.now))
+
+	fail(t, r, "svc", 2)
+	assert.Equal(t, Closed, r.Snapshot()[0].State)
+	fail(t, r, "svc", 1)
+
+	st := r.Snapshot()[0]
+	assert.Equal(t, Open, st.State)
+	assert.Equal(t, 3, st.Failures)
+	assert.Equal(t, clock.now().Add(time.Minute), st.Until)
+	assert.Contains(t, st.LastError, "503")
+
+	err := r.allow("svc")
+	require.ErrorIs(t, err, ErrOpen)
+	var oe *OpenError
+	require.ErrorAs(t, err, &oe)
+	assert.Equal(t, "svc", oe.Service)
+
+	// After the cooldown one probe goes through; others are refused.
+	clock.advance(time.Minute)
+	require.NoError(t, r.allow("svc"))
+	assert.Equal(t, HalfOpen, r.Snapshot()[0].State)
+	require.ErrorIs(t, r.allow("svc"), ErrOpen)
+
+	// A failed probe reopens it for another cooldown.
+	r.record("svc", failed, "boom")
+	st = r.Snapshot()[0]
+	assert.Equal(t, Open, st.State)
+	assert.Equal(t, clock.now().Add(time.Minute), st.Until)
+
+	// A successful probe closes it.
+	clock.advance(time.Minute)
+	require.NoError(t, r.allow("svc"))
+	r.record("svc", succeeded, "")
+	st = r.Snapshot()[0]
+	assert.Equal(t, Closed, st.State)
+	assert.Zero(t, st.Failures)
+	assert.True(t, st.Until.IsZero())
+	require.NoError(t, r.allow("svc"))
+}
+
+func TestBreaker_SuccessResetsAndAbandonIsNeutral(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry(WithBreaker(2, time.Minute))
+	fail(t, r, "svc", 1)
+	require.NoError(t, r.allow("svc"))
+	r.record("svc", succeeded, "")
+	fail(t, r, "svc", 1)
+	assert.Equal(t, Closed, r.Snapshot()[0].State, "failures must be consecutive")
+
+	require.NoError(t, r.allow("svc"))
+	r.record("svc", abandoned, "")
+	assert.Equal(t, 1, r.Snapshot()[0].Failures)
+
+	// An abandoned probe frees the slot for the next one.
+	clock := newFakeClock()
+	r = NewRegistry(WithBreaker(1, time.Minute), WithClock(clock.now))
+	fail(t, r, "svc", 1)
+	clock.advance(time.Minute)
+	require.NoError(t, r.allow("svc"))
+	r.record("svc", abandoned, "")
+	require.NoError(t, r.allow("svc"))
+}
+
+func TestBreaker_ServicesAreIndependent(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry(WithBreaker(1, time.Minute))
+	fail(t, r, "a", 1)
+	require.ErrorIs(t, r.allow("a"), ErrOpen)
+	require.NoError(t, r.allow("b"))
+	r.record("b", succeeded, "")
+
+	snap := r.Snapshot()
+	require.Len(t, snap, 2)
+	assert.Equal(t, "a", snap[0].Service)
+	assert.Equal(t, "b", snap[1].Service)
+}
+
+func TestRegistry_NotifyCoalesces(t *testing.T) {
+	t.Parallel()
+	clock := newFakeClock()
+	r := NewRegistry(WithBreaker(1, time.Minute), WithClock(clock.now))
+	ch := r.Notify()
+
+	fail(t, r, "a", 1) // closed → open
+	fail(t, r, "b", 1) // a second change before the first is read
+	select {
+	case <-ch:
+	default:
+		t.Fatal("no notification after a state change")
+	}
+	select {
+	case <-ch:
+		t.Fatal("changes should coalesce")
+	default:
+	}
+
+	r.record("a", failed, "still open") // no transition: no notification
+	select {
+	case <-ch:
+		t.Fatal("notified without a state change")
+	default:
+	}
+}
+
+func TestRegistry_StateFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "cache", StateFileName)
+	_, err := LoadStatus(path)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	clock := newFakeClock()
+	r := NewRegistry(WithBreaker(1, time.Minute), WithClock(clock.now), WithStateFile(path))
+	fail(t, r, "oci/compute", 1)
+
+	got, err := LoadStatus(path)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "oci/compute", got[0].Service)
+	assert.Equal(t, Open, got[0].State)
+	assert.True(t, got[0].Until.Equal(clock.now().Add(time.Minute)))
+
+	// Another process's registry keeps the entries it has no breaker for.
+	other := NewRegistry(WithBreaker(1, time.Minute), WithStateFile(path))
+	fail(t, other, "k8s/dev", 1)
+	got, err = LoadStatus(path)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "k8s/dev", got[0].Service)
+	assert.Equal(t, "oci/compute", got[1].Service)
+	assert.Equal(t, Open, got[1].State)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = LoadStatus(path)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestState_Text(t *testing.T) {
+	t.Parallel()
+	for _, s := range []State{Closed, Open, HalfOpen} {
+		b, err := s.MarshalText()
+		require.NoError(t, err)
+		var got State
+		require.NoError(t, got.UnmarshalText(b))
+		assert.Equal(t, s, got)
+	}
+	var s State
+	require.Error(t, s.UnmarshalText([]byte("ajar")))
+}
@@ -0,0 +1,211 @@
+/*
+Package resilience retries transient failures of idempotent HTTP calls
+with jittered backoff, and fails fast through a per-service circuit
+breaker once a service keeps failing.
+
+It sits below the OCI SDK and client-go, as a request dispatcher
+(Dispatcher) or an http.RoundTripper (Transport), so every call those
+clients make goes through it: list and get calls, watches and
+work-request polling alike. Only GET, HEAD and OPTIONS requests without a
+body are retried, and reads that send their query as a POST body when
+their context is marked WithReplay; a mutation is sent once, so a retry
+never repeats it.
+A response is transient when the request failed without reaching the
+service, was throttled (429) or hit a server error (5xx other than 501).
+A throttled response's Retry-After header replaces the backoff; one asking
+for longer than the policy's MaxDelay, or past the request's deadline, is
+returned as is.
+
+Each logical call (its retries included) counts once against its
+service's breaker: DefaultThreshold consecutive failed calls open it, and
+calls are then refused with an *OpenError for DefaultCooldown. The first
+call after that is a probe; its success closes the breaker, its failure reopens it.
+A call the caller abandoned (context canceled) counts neither way.
+
+Breaker state lives in a Registry; Default is the one the OCI and
+Kubernetes clients use. When given a state file, the registry records
+every state change there, so `toolkit doctor` can report the breakers of
+the last session without calling any service.
+*/
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy controls how a transient failure is retried.
+type Policy struct {
+	// Attempts is the number of tries per call, the first one included.
+	Attempts int
+	// BaseDelay is the backoff before the first retry; each later retry
+	// doubles it.
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff, and is the longest Retry-After
+	// honored.
+	MaxDelay time.Duration
+}
+
+// DefaultPolicy retries a call up to three times, backing off from half
+// a second to at most 30s.
+var DefaultPolicy = Policy{
+	Attempts:  4,
+	BaseDelay: 500 * time.Millisecond,
+	MaxDelay:  30 * time.Second,
+}
+
+// backoff returns the wait before retry n (from 0): BaseDelay·2ⁿ capped at
+// MaxDelay, jittered to a random point in its upper half so clients that
+// failed together do not retry together.
+func (p Policy) backoff(n int) time.Duration {
+	d := p.MaxDelay
+	if n < 32 {
+		if exp := p.BaseDelay << n; exp > 0 && exp < d {
+			d = exp
+		}
+	}
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + rand.N(half) //nolint:gosec // G404: jitter needs no cryptographic randomness
+}
+
+// delay returns the wait before retry n of a call that got resp, and false
+// when the call should not be retried: the service asked, through
+// Retry-After, for longer than MaxDelay.
+func (p Policy) delay(n int, resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After"), now); ok {
+			return d, d <= p.MaxDelay
+		}
+	}
+	return p.backoff(n), true
+}
+
+// retryAfter parses a Retry-After value: delay seconds or an HTTP date.
+// A date in the past is a zero wait.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
+
+// outcome classifies one attempt of a call.
+type outcome int
+
+const (
+	// succeeded: the service answered with anything but a transient
+	// status, a 404 or 409 included.
+	succeeded outcome = iota
+	// failed: a transient failure; retryable, and counted by the breaker.
+	failed
+	// abandoned: the caller canceled the call; neither.
+	abandoned
+)
+
+// classify returns the outcome of an attempt and, when it failed, a
+// one-line description for the breaker's status.
+func classify(req *http.Request, resp *http.Response, err error) (outcome, string) {
+	if err != nil {
+		if errors.Is(req.Context().Err(), context.Canceled) {
+			return abandoned, ""
+		}
+		return failed, err.Error()
+	}
+	if transientStatus(resp.StatusCode) {
+		return failed, req.Method + " " + req.URL.Path + ": " + resp.Status
+	}
+	return succeeded, ""
+}
+
+// transientStatus reports whether a response status is worth retrying.
+func transientStatus(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		(code >= http.StatusInternalServerError && code != http.StatusNotImplemented)
+}
+
+type replayKey struct{}
+
+// WithReplay marks the requests made with ctx as reads that are safe to
+// send again although their method is not, such as OCI Monitoring's
+// SummarizeMetricsData, which POSTs its query. A marked request is
+// retried like a GET when its body can be rebuilt through GetBody.
+func WithReplay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replayKey{}, true)
+}
+
+// replayable reports whether req may be sent again: an idempotent read
+// without a body, or one marked WithReplay whose body GetBody rebuilds.
+func replayable(req *http.Request) bool {
+	bodiless := req.Body == nil || req.Body == http.NoBody
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		if bodiless {
+			return true
+		}
+	}
+	marked, _ := req.Context().Value(replayKey{}).(bool)
+	return marked && (bodiless || req.GetBody != nil)
+}
+
+// rewind returns req ready to be sent again: req itself when it has no
+// body, else a copy with a fresh body from GetBody.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	again := req.Clone(req.Context())
+	again.Body = body
+	return again, nil
+}
+
+// fits reports whether waiting d still leaves time before ctx's deadline.
+func fits(ctx context.Context, d time.Duration, now time.Time) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || deadline.Sub(now) > d
+}
+
+// discard drains and closes a response about to be retried, so its
+// connection can be reused.
+func discard(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+}
+
+// sleep waits d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,108 @@
+package resilience
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyBackoff_JitteredAndCapped(t *testing.T) {
+	t.Parallel()
+	p := Policy{Attempts: 4, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for range 50 {
+		d0 := p.backoff(0)
+		assert.GreaterOrEqual(t, d0, 50*time.Millisecond)
+		assert.Less(t, d0, 100*time.Millisecond)
+
+		d2 := p.backoff(2)
+		assert.GreaterOrEqual(t, d2, 200*time.Millisecond)
+		assert.Less(t, d2, 400*time.Millisecond)
+
+		// Past the cap, and far past the shift width, stays under MaxDelay.
+		for _, n := range []int{4, 40, 100} {
+			d := p.backoff(n)
+			assert.GreaterOrEqual(t, d, 500*time.Millisecond)
+			assert.Less(t, d, time.Second)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cases := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"7", 7 * time.Second, true},
+		{" 0 ", 0, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+	}
+	for _, c := range cases {
+		got, ok := retryAfter(c.in, now)
+		assert.Equal(t, c.ok, ok, c.in)
+		assert.Equal(t, c.want, got, c.in)
+	}
+}
+
+func TestPolicyDelay_HonorsRetryAfterUpToMaxDelay(t *testing.T) {
+	t.Parallel()
+	p := Policy{Attempts: 4, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Second}
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	d, ok := p.delay(0, resp, time.Now())
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	resp.Header.Set("Retry-After", "60")
+	_, ok = p.delay(0, resp, time.Now())
+	assert.False(t, ok, "a wait past MaxDelay is not retried")
+
+	d, ok = p.delay(0, nil, time.Now())
+	assert.True(t, ok)
+	assert.Less(t, d, time.Millisecond)
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	req, _ := http.NewRequest(http.MethodGet, "https://svc/x", nil)
+	for code, want := range map[int]outcome{
+		200: succeeded, 404: succeeded, 409: succeeded, 501: succeeded,
+		429: failed, 500: failed, 503: failed,
+	} {
+		o, _ := classify(req, &http.Response{StatusCode: code, Status: http.StatusText(code)}, nil)
+		assert.Equal(t, want, o, code)
+	}
+	o, detail := classify(req, nil, context.DeadlineExceeded)
+	assert.Equal(t, failed, o, "a timed-out call failed")
+	assert.NotEmpty(t, detail)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	o, _ = classify(req.WithContext(ctx), nil, context.Canceled)
+	assert.Equal(t, abandoned, o)
+}
+
+func TestReplayable(t *testing.T) {
+	t.Parallel()
+	get, _ := http.NewRequest(http.MethodGet, "https://svc/x", nil)
+	assert.True(t, replayable(get))
+	post, _ := http.NewRequest(http.MethodPost, "https://svc/x", nil)
+	assert.False(t, replayable(post))
+	del, _ := http.NewRequest(http.MethodDelete, "https://svc/x", nil)
+	assert.False(t, replayable(del))
+
+	query, _ := http.NewRequestWithContext(WithReplay(context.Background()), http.MethodPost, "https://svc/x", strings.NewReader("{}"))
+	assert.True(t, replayable(query), "a marked read with a rebuildable body")
+	query.GetBody = nil
+	assert.False(t, replayable(query), "a body that cannot be rebuilt is sent once")
+}
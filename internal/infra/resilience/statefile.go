@@ -0,0 +1,57 @@
+package resilience
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jingle2008/toolkit/internal/fileutil"
+)
+
+// StateFileName is the state file's name under the toolkit cache dir.
+const StateFileName = "breakers.json"
+
+// stateFile is the state file's layout.
+type stateFile struct {
+	Breakers []Status `json:"breakers"`
+}
+
+// LoadStatus reads the breaker statuses recorded in path, ordered by
+// service. A missing file is an error matching os.ErrNotExist.
+func LoadStatus(path string) ([]Status, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: the path is the configured cache dir
+	if err != nil {
+		return nil, err
+	}
+	var f stateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+	return f.Breakers, nil
+}
+
+// save records the registry's breakers in path, keeping the entries of
+// services it has no breaker for: several toolkit processes share the
+// file, each knowing only the services it called.
+func (r *Registry) save(path string) error {
+	r.fileMu.Lock()
+	defer r.fileMu.Unlock()
+
+	own := r.Snapshot()
+	merged := own
+	if prev, err := LoadStatus(path); err == nil {
+		for _, s := range prev {
+			if !slices.ContainsFunc(own, func(o Status) bool { return o.Service == s.Service }) {
+				merged = append(merged, s)
+			}
+		}
+	}
+	slices.SortFunc(merged, func(a, b Status) int { return strings.Compare(a.Service, b.Service) })
+	data, err := json.MarshalIndent(stateFile{Breakers: merged}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFileAtomic(path, data, 0o600)
+}
@@ -0,0 +1,88 @@
+package resilience
+
+import "net/http"
+
+// Doer sends an HTTP request. *http.Client and the OCI SDK's
+// common.HTTPRequestDispatcher both satisfy it.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Dispatcher wraps next so that calls to service go through the registry:
+// refused while its breaker is open, retried when idempotent.
+func (r *Registry) Dispatcher(service string, next Doer) Doer {
+	if next == nil {
+		next = http.DefaultClient
+	}
+	return &dispatcher{r: r, service: service, next: next}
+}
+
+type dispatcher struct {
+	r       *Registry
+	service string
+	next    Doer
+}
+
+func (d *dispatcher) Do(req *http.Request) (*http.Response, error) {
+	return d.r.send(d.service, req, d.next.Do)
+}
+
+// Transport is the http.RoundTripper counterpart of Dispatcher; a nil
+// next means http.DefaultTransport.
+func (r *Registry) Transport(service string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{r: r, service: service, next: next}
+}
+
+type transport struct {
+	r       *Registry
+	service string
+	next    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.r.send(t.service, req, t.next.RoundTrip)
+}
+
+// WrappedRoundTripper exposes next to client-go, which walks wrapped
+// transports to close idle connections.
+func (t *transport) WrappedRoundTripper() http.RoundTripper {
+	return t.next
+}
+
+// send makes one logical call to service through next: admitted by the
+// breaker, retried while transient and replayable, and counted once.
+func (r *Registry) send(service string, req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if err := r.allow(service); err != nil {
+		return nil, err
+	}
+	attempts := 1
+	if replayable(req) {
+		attempts = max(r.policy.Attempts, 1)
+	}
+	ctx := req.Context()
+	for n := 0; ; n++ {
+		resp, err := next(req)
+		o, detail := classify(req, resp, err)
+		if o != failed || n+1 >= attempts {
+			r.record(service, o, detail)
+			return resp, err
+		}
+		wait, ok := r.policy.delay(n, resp, r.now())
+		if !ok || !fits(ctx, wait, r.now()) {
+			r.record(service, o, detail)
+			return resp, err
+		}
+		discard(resp)
+		if err := sleep(ctx, wait); err != nil {
+			r.record(service, abandoned, "")
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			r.record(service, o, detail)
+			return nil, err
+		}
+	}
+}
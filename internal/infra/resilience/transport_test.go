@@ -0,0 +1,169 @@
+package resilience
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastPolicy retries without measurable waits.
+var fastPolicy = Policy{Attempts: 4, BaseDelay: time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+// flakyServer answers the first failures requests with status, the rest
+// with 200, counting every request in hits.
+func flakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func TestTransport_RetriesTransientReads(t *testing.T) {
+	t.Parallel()
+	srv, hits := flakyServer(t, 2, http.StatusServiceUnavailable, nil)
+	r := NewRegistry(WithPolicy(fastPolicy))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), hits.Load())
+	assert.Zero(t, r.Snapshot()[0].Failures, "a call that recovered is a success")
+}
+
+func TestTransport_GivesUpAfterAttempts(t *testing.T) {
+	t.Parallel()
+	srv, hits := flakyServer(t, 100, http.StatusInternalServerError, nil)
+	r := NewRegistry(WithPolicy(fastPolicy))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(4), hits.Load())
+	assert.Equal(t, 1, r.Snapshot()[0].Failures, "retries count as one call")
+}
+
+func TestTransport_NeverRetriesMutations(t *testing.T) {
+	t.Parallel()
+	srv, hits := flakyServer(t, 100, http.StatusServiceUnavailable, nil)
+	r := NewRegistry(WithPolicy(fastPolicy))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, int32(1), hits.Load())
+	assert.Equal(t, 1, r.Snapshot()[0].Failures)
+}
+
+func TestTransport_RetriesReadsMarkedWithReplay(t *testing.T) {
+	t.Parallel()
+	var (
+		hits   atomic.Int32
+		bodies []string
+		mu     sync.Mutex
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		if hits.Add(1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	r := NewRegistry(WithPolicy(fastPolicy))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+
+	req, err := http.NewRequestWithContext(WithReplay(context.Background()),
+		http.MethodPost, srv.URL, strings.NewReader(`{"query":"q"}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), hits.Load())
+	assert.Equal(t, []string{`{"query":"q"}`, `{"query":"q"}`, `{"query":"q"}`}, bodies,
+		"every attempt sends the whole body")
+}
+
+func TestTransport_RetryAfter(t *testing.T) {
+	t.Parallel()
+	// Honored when within MaxDelay…
+	srv, hits := flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+	r := NewRegistry(WithPolicy(fastPolicy))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), hits.Load())
+
+	// …returned as is when it asks for longer.
+	srv, hits = flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"120"}})
+	resp, err = client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestTransport_StopsAtDeadline(t *testing.T) {
+	t.Parallel()
+	srv, hits := flakyServer(t, 100, http.StatusServiceUnavailable, nil)
+	r := NewRegistry(WithPolicy(Policy{Attempts: 4, BaseDelay: time.Second, MaxDelay: time.Second}))
+	client := &http.Client{Transport: r.Transport("svc", nil)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), hits.Load(), "no retry that cannot finish in time")
+}
+
+func TestDispatcher_FailsFastWhenOpen(t *testing.T) {
+	t.Parallel()
+	srv, hits := flakyServer(t, 100, http.StatusBadGateway, nil)
+	r := NewRegistry(WithPolicy(Policy{Attempts: 1}), WithBreaker(2, time.Minute))
+	d := r.Dispatcher("oci/compute", srv.Client())
+
+	for range 2 {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		resp, err := d.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, err := d.Do(req)
+	require.ErrorIs(t, err, ErrOpen)
+	assert.Contains(t, err.Error(), "oci/compute")
+	assert.Equal(t, int32(2), hits.Load(), "an open breaker does not call the service")
+}
@@ -3,6 +3,7 @@ package telemetry
 import (
 	"encoding/base64"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -13,6 +14,12 @@ const exploreBaseURL = "https://devops.oci.oraclecorp.com/telemetry/mql/explore"
 // scopes GenAI service metrics. Callers pass it to MetricsURL.
 const Project = "GenerativeAIService"
 
+// Fleet is the OCI Telemetry fleet — the OCI Monitoring resource group —
+// that GenAI service metrics of an environment type are emitted under.
+func Fleet(envType string) string {
+	return "generative-ai-service-api-" + envType
+}
+
 // Capability selects which metric set a dashboard shows, derived from the
 // capability of the served model.
 type Capability int
@@ -134,6 +141,16 @@ func metricQueries(capability Capability, filter Filter) []string {
 	return queries
 }
 
+// MetricName returns the metric an MQL query reads, without the
+// GenerativeAiService prefix: "chat.InputTokenLength" for a token-length
+// query, "ContentModeration.TotalInvocation.Count" for a moderation one.
+func MetricName(query string) string {
+	if i := strings.IndexByte(query, '['); i >= 0 {
+		query = query[:i]
+	}
+	return strings.TrimPrefix(query, "GenerativeAiService.")
+}
+
 // MetricsURL builds the full OCI Telemetry MQL Explore URL: a Zipson
 // dashboard payload, base64-std-encoded and URL-escaped.
 func MetricsURL(filter Filter, capability Capability, regionID, project, fleet string, start, end time.Time) string {
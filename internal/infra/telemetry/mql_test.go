@@ -202,3 +202,16 @@ func TestMetricQueries_FixedReturnsCopy(t *testing.T) {
 	second := metricQueries(CapabilityImageContentModeration, Filter{Key: FilterDacID, Value: "x"})
 	assert.NotEqual(t, "MUTATED", second[0], "metricQueries must not return the shared table slice")
 }
+
+func TestMetricName(t *testing.T) {
+	t.Parallel()
+	f := Filter{Key: FilterDacID, Value: "ocid1.dac"}
+	assert.Equal(t, "chat.InputTokenLength", MetricName(metricQueries(CapabilityChat, f)[0]))
+	assert.Equal(t, "ContentModeration.TotalInvocation.Count",
+		MetricName(metricQueries(CapabilityTextClassification, f)[0]))
+}
+
+func TestFleet(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "generative-ai-service-api-prod", Fleet("prod"))
+}
@@ -0,0 +1,167 @@
+package telemetry
+
+import (
+	"strings"
+
+	"github.com/jingle2008/toolkit/internal/collections"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// ImportedModelPrefix is the OCID resource-id prefix carried by
+// tenant-owned imported/finetune model names and by DAC names. A model name
+// with this prefix resolves against the imported catalog, anything else
+// against the base catalog. A workload/imported-model namespace with this
+// prefix is a DAC name.
+const ImportedModelPrefix = "amaaaaaa"
+
+// Plan is the metric query set of one item: Filter scopes it and
+// Capability selects its queries.
+type Plan struct {
+	Filter     Filter
+	Capability Capability
+}
+
+// Queries returns the plan's MQL query strings.
+func (p Plan) Queries() []string {
+	return metricQueries(p.Capability, p.Filter)
+}
+
+// ResolvePlan maps an item (DAC, Endpoint, ImportedModel, or GPUWorkload)
+// to its metrics plan, plus ok/reason. ok=false with a non-empty reason is
+// a user-facing error; ok=false with an empty reason means the item has
+// nothing to show (unknown/nil item). ds supplies the model catalogs and
+// must be non-nil whenever the item names a model.
+//
+//nolint:cyclop // per-item-type metrics resolution; the switch is the routing surface
+func ResolvePlan(item any, env models.Environment, ds *models.Dataset) (Plan, bool, string) {
+	realm, region := env.Realm, env.Region
+	switch it := item.(type) {
+	case *models.DedicatedAICluster:
+		if it == nil {
+			return Plan{}, false, ""
+		}
+		filter := Filter{Key: FilterDacID, Value: it.OCID(realm, region)}
+		if it.ModelName == "" {
+			return Plan{Filter: filter, Capability: CapabilityChat}, true, ""
+		}
+		return dedicatedPlan(filter, ds.FindModelByName(it.ModelName))
+	case *models.Endpoint:
+		if it == nil {
+			return Plan{}, false, ""
+		}
+		// Endpoint metrics are emitted per hosting DAC; scope by DacId.
+		filter := Filter{Key: FilterDacID, Value: it.DedicatedAIClusterOCID(realm, region)}
+		name := EndpointModelName(ds, it)
+		if name == "" {
+			return Plan{Filter: filter, Capability: CapabilityChat}, true, ""
+		}
+		return dedicatedPlan(filter, ds.FindModelByName(name))
+	case *models.ImportedModel:
+		if it == nil {
+			return Plan{}, false, ""
+		}
+		if !strings.HasPrefix(it.Namespace, ImportedModelPrefix) {
+			return Plan{}, false, "imported model is not tied to a dedicated AI cluster"
+		}
+		ocid := models.DedicatedAICluster{Name: it.Namespace}.OCID(realm, region)
+		return dedicatedPlan(Filter{Key: FilterDacID, Value: ocid}, &it.BaseModel)
+	case *models.GPUWorkload:
+		if it == nil {
+			return Plan{}, false, ""
+		}
+		if it.Model == "" {
+			return Plan{}, false, "workload has no model"
+		}
+		if strings.HasPrefix(it.Namespace, ImportedModelPrefix) {
+			ocid := models.DedicatedAICluster{Name: it.Namespace}.OCID(realm, region)
+			return dedicatedPlan(Filter{Key: FilterDacID, Value: ocid}, ds.FindModelByName(it.Model))
+		}
+		bm := ds.FindBaseModelByName(it.Model)
+		if bm == nil {
+			return Plan{}, false, "model not found in base catalog"
+		}
+		capability := CapabilityForModel(bm)
+		if !capability.Supported() {
+			return Plan{}, false, "metrics not supported for this model"
+		}
+		return Plan{Filter: Filter{Key: FilterResourceID, Value: bm.DisplayName}, Capability: capability}, true, ""
+	default:
+		return Plan{}, false, ""
+	}
+}
+
+// dedicatedPlan finalizes a DacId-filtered (dedicated-mode) plan. A capability
+// with no dashboard at all is unsupported; an unfilterable capability (the
+// fixed, unfiltered moderation sets) cannot be DacId-scoped, so it has no
+// dedicated-mode dashboard.
+func dedicatedPlan(filter Filter, model *models.BaseModel) (Plan, bool, string) {
+	capability := CapabilityForModel(model)
+	switch {
+	case !capability.Supported():
+		return Plan{}, false, "metrics not supported for this model"
+	case !capability.Filterable():
+		return Plan{}, false, "metrics not available for this model in dedicated mode"
+	default:
+		return Plan{Filter: filter, Capability: capability}, true, ""
+	}
+}
+
+// EndpointModelName returns the name of the model an endpoint serves, as
+// the model catalogs key it. The hosting DAC's ModelName is preferred (it
+// uses the catalog's naming for base models too); the endpoint's own model
+// OCID suffix is the fallback when the DAC isn't loaded, which resolves
+// imported and fine-tuned models. ds may be nil.
+func EndpointModelName(ds *models.Dataset, ep *models.Endpoint) string {
+	if ds != nil {
+		for _, dacs := range ds.DedicatedAIClusterMap {
+			if dac := collections.FindByName(dacs, ep.DedicatedAICluster); dac != nil && dac.ModelName != "" {
+				return dac.ModelName
+			}
+		}
+	}
+	return ep.ModelName()
+}
+
+// capabilityPrecedence maps a model-capability string to its telemetry
+// capability, in priority order: the first capability the model declares
+// wins. CHAT is highest; the four unsupported capabilities are last, so any
+// supported capability outranks them (a purely-unsupported model resolves to
+// CapabilityUnsupported). Synonyms (EMBEDDING, CONTENT_MODERATION,
+// IMAGE_TEXT_TO_TEXT) map to the same telemetry capability as their primary.
+var capabilityPrecedence = []struct {
+	flag string
+	cap  Capability
+}{
+	{models.CapabilityChat, CapabilityChat},
+	{models.CapabilityTextToText, CapabilityChat},
+	{models.CapabilityImageTextToText, CapabilityChat},
+	{models.CapabilityTextRerank, CapabilityTextRerank},
+	{models.CapabilityTextEmbeddings, CapabilityTextEmbeddings},
+	{models.CapabilityEmbedding, CapabilityTextEmbeddings},
+	{models.CapabilityTextToImage, CapabilityTextToImage},
+	{models.CapabilityImageTextToImage, CapabilityImageTextToImage},
+	{models.CapabilityTextToAudio, CapabilityTextToAudio},
+	{models.CapabilityAudioToText, CapabilityAudioToText},
+	{models.CapabilityTextClassification, CapabilityTextClassification},
+	{models.CapabilityContentModeration, CapabilityTextClassification},
+	{models.CapabilityImageContentModeration, CapabilityImageContentModeration},
+	{models.CapabilityTextGeneration, CapabilityUnsupported},
+	{models.CapabilityAudioToAudio, CapabilityUnsupported},
+	{models.CapabilityRealtime, CapabilityUnsupported},
+	{models.CapabilityPromptInjectionProtection, CapabilityUnsupported},
+}
+
+// CapabilityForModel maps a resolved model to its metric capability via
+// capabilityPrecedence (first declared match wins). nil / finetune / a model
+// declaring no recognized capability fall back to CapabilityChat.
+func CapabilityForModel(model *models.BaseModel) Capability {
+	if model == nil || model.Type == "Fine-tuning" {
+		return CapabilityChat
+	}
+	for _, p := range capabilityPrecedence {
+		if model.HasCapability(p.flag) {
+			return p.cap
+		}
+	}
+	return CapabilityChat
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestCapabilityForModel(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name  string
+		model *models.BaseModel
+		want  Capability
+	}{
+		{"nil", nil, CapabilityChat},
+		{"finetune", &models.BaseModel{Type: "Fine-tuning", Capabilities: []string{"TEXT_RERANK"}}, CapabilityChat},
+		{"chat", &models.BaseModel{Capabilities: []string{"CHAT"}}, CapabilityChat},
+		{"rerank", &models.BaseModel{Capabilities: []string{"TEXT_RERANK"}}, CapabilityTextRerank},
+		{"embed", &models.BaseModel{Capabilities: []string{"TEXT_EMBEDDINGS"}}, CapabilityTextEmbeddings},
+		// Precedence CHAT > RERANK > EMBED when several are present.
+		{"multi", &models.BaseModel{Capabilities: []string{"TEXT_EMBEDDINGS", "CHAT"}}, CapabilityChat},
+		{"unknown", &models.BaseModel{Capabilities: []string{"SOMETHING_ELSE"}}, CapabilityChat},
+		{"classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION"}}, CapabilityTextClassification},
+		{"imagemod", &models.BaseModel{Capabilities: []string{"IMAGE_CONTENT_MODERATION"}}, CapabilityImageContentModeration},
+		{"chat-over-classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION", "CHAT"}}, CapabilityChat},
+		{"embed-over-classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION", "TEXT_EMBEDDINGS"}}, CapabilityTextEmbeddings},
+		{"classification-over-imagemod", &models.BaseModel{Capabilities: []string{"IMAGE_CONTENT_MODERATION", "TEXT_CLASSIFICATION"}}, CapabilityTextClassification},
+		{"text_to_text", &models.BaseModel{Capabilities: []string{"TEXT_TO_TEXT"}}, CapabilityChat},
+		{"image_text_to_text", &models.BaseModel{Capabilities: []string{"IMAGE_TEXT_TO_TEXT"}}, CapabilityChat},
+		{"chat-over-t2t", &models.BaseModel{Capabilities: []string{"TEXT_TO_TEXT", "CHAT"}}, CapabilityChat},
+		{"embedding-synonym", &models.BaseModel{Capabilities: []string{"EMBEDDING"}}, CapabilityTextEmbeddings},
+		{"content-moderation-synonym", &models.BaseModel{Capabilities: []string{"CONTENT_MODERATION"}}, CapabilityTextClassification},
+		{"text_to_image", &models.BaseModel{Capabilities: []string{"TEXT_TO_IMAGE"}}, CapabilityTextToImage},
+		{"image_text_to_image", &models.BaseModel{Capabilities: []string{"IMAGE_TEXT_TO_IMAGE"}}, CapabilityImageTextToImage},
+		{"text_to_audio", &models.BaseModel{Capabilities: []string{"TEXT_TO_AUDIO"}}, CapabilityTextToAudio},
+		{"audio_to_text", &models.BaseModel{Capabilities: []string{"AUDIO_TO_TEXT"}}, CapabilityAudioToText},
+		{"unsupported-only", &models.BaseModel{Capabilities: []string{"REALTIME"}}, CapabilityUnsupported},
+		{"supported-wins", &models.BaseModel{Capabilities: []string{"TEXT_GENERATION", "CHAT"}}, CapabilityChat},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, CapabilityForModel(tc.model))
+		})
+	}
+}
+
+func TestResolvePlan_DACWithoutModelIsChat(t *testing.T) {
+	t.Parallel()
+	env := models.Environment{Realm: "oc1", Region: "us-chicago-1"}
+	plan, ok, reason := ResolvePlan(&models.DedicatedAICluster{Name: "amaaaaaadac1"}, env, nil)
+	require.True(t, ok, reason)
+	assert.Equal(t, Filter{Key: FilterDacID, Value: "ocid1.generativeaidedicatedaicluster.oc1.us-chicago-1.amaaaaaadac1"}, plan.Filter)
+	assert.Equal(t, CapabilityChat, plan.Capability)
+	assert.Len(t, plan.Queries(), 9, "chat grid: 3 groups x 3 kinds")
+}
+
+func TestResolvePlan_UnknownItemIsNoOp(t *testing.T) {
+	t.Parallel()
+	_, ok, reason := ResolvePlan(&models.Tenant{}, models.Environment{}, nil)
+	assert.False(t, ok)
+	assert.Empty(t, reason)
+}
+
+func TestEndpointModelName(t *testing.T) {
+	t.Parallel()
+	ep := &models.Endpoint{DedicatedAICluster: "dac1", ModelID: "ocid1.generativeaimodel.oc1.iad.amaaaaaamodel"}
+	assert.Equal(t, "amaaaaaamodel", EndpointModelName(nil, ep), "falls back to the model OCID suffix")
+	ds := &models.Dataset{DedicatedAIClusterMap: map[string][]models.DedicatedAICluster{
+		"t1": {{Name: "dac1", ModelName: "cohere.command"}},
+	}}
+	assert.Equal(t, "cohere.command", EndpointModelName(ds, ep), "prefers the hosting DAC's model")
+}
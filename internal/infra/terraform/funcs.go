@@ -0,0 +1,323 @@
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/ext/tryfunc"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// localFuncMap is the subset of the Terraform function library available
+// when evaluating locals. Names follow Terraform, not cty: `reverse` is
+// the list reverse and `strrev` the string one. Functions that touch the
+// filesystem or the clock (file, templatefile, timestamp) are deliberately
+// absent — locals depending on them stay unresolved rather than taking a
+// value the real plan would not.
+var localFuncMap = map[string]function.Function{
+	// numeric
+	"abs":      stdlib.AbsoluteFunc,
+	"ceil":     stdlib.CeilFunc,
+	"floor":    stdlib.FloorFunc,
+	"log":      stdlib.LogFunc,
+	"max":      stdlib.MaxFunc,
+	"min":      stdlib.MinFunc,
+	"parseint": stdlib.ParseIntFunc,
+	"pow":      stdlib.PowFunc,
+	"signum":   stdlib.SignumFunc,
+
+	// string
+	"chomp":      stdlib.ChompFunc,
+	"format":     stdlib.FormatFunc,
+	"formatlist": stdlib.FormatListFunc,
+	"indent":     stdlib.IndentFunc,
+	"join":       stdlib.JoinFunc,
+	"lower":      stdlib.LowerFunc,
+	"regex":      stdlib.RegexFunc,
+	"regexall":   stdlib.RegexAllFunc,
+	"replace":    replaceFunc,
+	"split":      stdlib.SplitFunc,
+	"strrev":     stdlib.ReverseFunc,
+	"substr":     stdlib.SubstrFunc,
+	"title":      stdlib.TitleFunc,
+	"trim":       stdlib.TrimFunc,
+	"trimprefix": stdlib.TrimPrefixFunc,
+	"trimspace":  stdlib.TrimSpaceFunc,
+	"trimsuffix": stdlib.TrimSuffixFunc,
+	"upper":      stdlib.UpperFunc,
+
+	// collection
+	"chunklist":       stdlib.ChunklistFunc,
+	"coalesce":        coalesceFunc,
+	"coalescelist":    stdlib.CoalesceListFunc,
+	"compact":         stdlib.CompactFunc,
+	"concat":          stdlib.ConcatFunc,
+	"contains":        stdlib.ContainsFunc,
+	"distinct":        stdlib.DistinctFunc,
+	"element":         stdlib.ElementFunc,
+	"flatten":         stdlib.FlattenFunc,
+	"keys":            stdlib.KeysFunc,
+	"length":          lengthFunc,
+	"lookup":          stdlib.LookupFunc,
+	"merge":           stdlib.MergeFunc,
+	"range":           stdlib.RangeFunc,
+	"reverse":         stdlib.ReverseListFunc,
+	"setintersection": stdlib.SetIntersectionFunc,
+	"setproduct":      stdlib.SetProductFunc,
+	"setsubtract":     stdlib.SetSubtractFunc,
+	"setunion":        stdlib.SetUnionFunc,
+	"slice":           stdlib.SliceFunc,
+	"sort":            stdlib.SortFunc,
+	"values":          stdlib.ValuesFunc,
+	"zipmap":          stdlib.ZipmapFunc,
+
+	// encoding
+	"csvdecode":  stdlib.CSVDecodeFunc,
+	"jsondecode": stdlib.JSONDecodeFunc,
+	"jsonencode": stdlib.JSONEncodeFunc,
+
+	// date
+	"formatdate": stdlib.FormatDateFunc,
+	"timeadd":    stdlib.TimeAddFunc,
+
+	// type conversion and error handling
+	"can":      tryfunc.CanFunc,
+	"try":      tryfunc.TryFunc,
+	"tobool":   stdlib.MakeToFunc(cty.Bool),
+	"tolist":   stdlib.MakeToFunc(cty.List(cty.DynamicPseudoType)),
+	"tomap":    stdlib.MakeToFunc(cty.Map(cty.DynamicPseudoType)),
+	"tonumber": stdlib.MakeToFunc(cty.Number),
+	"toset":    stdlib.MakeToFunc(cty.Set(cty.DynamicPseudoType)),
+	"tostring": stdlib.MakeToFunc(cty.String),
+
+	// network
+	"cidrhost":    cidrHostFunc,
+	"cidrnetmask": cidrNetmaskFunc,
+	"cidrsubnet":  cidrSubnetFunc,
+	"cidrsubnets": cidrSubnetsFunc,
+}
+
+// replaceFunc is Terraform's replace: a substring wrapped in slashes is a
+// regular expression, anything else is replaced literally.
+var replaceFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{Name: "str", Type: cty.String},
+		{Name: "substr", Type: cty.String},
+		{Name: "replace", Type: cty.String},
+	},
+	Type: function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		substr := args[1].AsString()
+		if len(substr) > 1 && strings.HasPrefix(substr, "/") && strings.HasSuffix(substr, "/") {
+			return stdlib.RegexReplace(args[0], cty.StringVal(substr[1:len(substr)-1]), args[2])
+		}
+		return stdlib.Replace(args[0], args[1], args[2])
+	},
+})
+
+// coalesceFunc is Terraform's coalesce, which skips empty strings as well
+// as nulls.
+var coalesceFunc = function.New(&function.Spec{
+	VarParam: &function.Parameter{
+		Name:             "vals",
+		Type:             cty.DynamicPseudoType,
+		AllowNull:        true,
+		AllowDynamicType: true,
+	},
+	Type: func(args []cty.Value) (cty.Type, error) {
+		argTypes := make([]cty.Type, len(args))
+		for i, v := range args {
+			argTypes[i] = v.Type()
+		}
+		return stdlib.CoalesceFunc.ReturnType(argTypes)
+	},
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		for _, v := range args {
+			if v.IsNull() || (v.Type() == cty.String && v.AsString() == "") {
+				continue
+			}
+			return convert.Convert(v, retType)
+		}
+		return cty.NilVal, errors.New("no non-null, non-empty-string arguments")
+	},
+})
+
+// lengthFunc is Terraform's length, which unlike cty's also counts the
+// characters of a string.
+var lengthFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{Name: "value", Type: cty.DynamicPseudoType, AllowDynamicType: true},
+	},
+	Type: function.StaticReturnType(cty.Number),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		v := args[0]
+		switch ty := v.Type(); {
+		case ty == cty.String:
+			return stdlib.Strlen(v)
+		case ty.IsObjectType():
+			return cty.NumberIntVal(int64(len(ty.AttributeTypes()))), nil
+		case ty.IsTupleType():
+			return cty.NumberIntVal(int64(len(ty.TupleElementTypes()))), nil
+		case ty.IsCollectionType():
+			return v.Length(), nil
+		default:
+			return cty.UnknownVal(cty.Number), fmt.Errorf("argument must be a string, a collection type, or a structural type, not %s", ty.FriendlyName())
+		}
+	},
+})
+
+var cidrHostFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{Name: "prefix", Type: cty.String},
+		{Name: "hostnum", Type: cty.Number},
+	},
+	Type: function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		prefix, err := parseCIDR(args[0])
+		if err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		var hostnum int64
+		if err := gocty.FromCtyValue(args[1], &hostnum); err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		hostBits := prefix.Addr().BitLen() - prefix.Bits()
+		size := new(big.Int).Lsh(big.NewInt(1), uint(hostBits)) // #nosec G115 -- hostBits is within [0, 128]
+		num := big.NewInt(hostnum)
+		if num.Sign() < 0 {
+			num.Add(num, size)
+		}
+		if num.Sign() < 0 || num.Cmp(size) >= 0 {
+			return cty.UnknownVal(cty.String), fmt.Errorf("prefix of %d bits cannot accommodate host number %d", prefix.Bits(), hostnum)
+		}
+		addr := addrFromInt(num.Add(num, addrToInt(prefix.Addr())), prefix.Addr().Is4())
+		return cty.StringVal(addr.String()), nil
+	},
+})
+
+var cidrNetmaskFunc = function.New(&function.Spec{
+	Params: []function.Parameter{{Name: "prefix", Type: cty.String}},
+	Type:   function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		prefix, err := parseCIDR(args[0])
+		if err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		if !prefix.Addr().Is4() {
+			return cty.UnknownVal(cty.String), errors.New("only IPv4 networks have a netmask")
+		}
+		ones := new(big.Int).Lsh(big.NewInt(1), uint(prefix.Bits())) // #nosec G115 -- Bits is within [0, 32]
+		ones.Sub(ones, big.NewInt(1))
+		mask := ones.Lsh(ones, uint(32-prefix.Bits())) // #nosec G115 -- Bits is within [0, 32]
+		return cty.StringVal(addrFromInt(mask, true).String()), nil
+	},
+})
+
+var cidrSubnetFunc = function.New(&function.Spec{
+	Params: []function.Parameter{
+		{Name: "prefix", Type: cty.String},
+		{Name: "newbits", Type: cty.Number},
+		{Name: "netnum", Type: cty.Number},
+	},
+	Type: function.StaticReturnType(cty.String),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		prefix, err := parseCIDR(args[0])
+		if err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		var newbits, netnum int64
+		if err := gocty.FromCtyValue(args[1], &newbits); err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		if err := gocty.FromCtyValue(args[2], &netnum); err != nil {
+			return cty.UnknownVal(cty.String), err
+		}
+		length := int64(prefix.Bits()) + newbits
+		bitLen := int64(prefix.Addr().BitLen())
+		if newbits < 0 || length > bitLen {
+			return cty.UnknownVal(cty.String), fmt.Errorf("cannot extend prefix of %d bits by %d bits", prefix.Bits(), newbits)
+		}
+		if netnum < 0 || big.NewInt(netnum).BitLen() > int(newbits) {
+			return cty.UnknownVal(cty.String), fmt.Errorf("prefix extension of %d bits cannot accommodate network number %d", newbits, netnum)
+		}
+		base := addrToInt(prefix.Addr())
+		base.Add(base, new(big.Int).Lsh(big.NewInt(netnum), uint(bitLen-length))) // #nosec G115 -- length <= bitLen
+		return cty.StringVal(netip.PrefixFrom(addrFromInt(base, prefix.Addr().Is4()), int(length)).String()), nil
+	},
+})
+
+// cidrSubnetsFunc allocates consecutive subnets of the given extra bits,
+// each aligned to its own size, the same way Terraform does.
+var cidrSubnetsFunc = function.New(&function.Spec{
+	Params:   []function.Parameter{{Name: "prefix", Type: cty.String}},
+	VarParam: &function.Parameter{Name: "newbits", Type: cty.Number},
+	Type:     function.StaticReturnType(cty.List(cty.String)),
+	Impl: func(args []cty.Value, _ cty.Type) (cty.Value, error) {
+		prefix, err := parseCIDR(args[0])
+		if err != nil {
+			return cty.UnknownVal(cty.List(cty.String)), err
+		}
+		if len(args) == 1 {
+			return cty.ListValEmpty(cty.String), nil
+		}
+		bitLen := prefix.Addr().BitLen()
+		is4 := prefix.Addr().Is4()
+		start := addrToInt(prefix.Addr())
+		end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(bitLen-prefix.Bits()))) // #nosec G115 -- Bits <= bitLen
+		next := new(big.Int).Set(start)
+
+		subnets := make([]cty.Value, 0, len(args)-1)
+		for i, arg := range args[1:] {
+			var newbits int
+			if err := gocty.FromCtyValue(arg, &newbits); err != nil {
+				return cty.UnknownVal(cty.List(cty.String)), function.NewArgError(i+1, err)
+			}
+			length := prefix.Bits() + newbits
+			if newbits < 1 || length > bitLen {
+				return cty.UnknownVal(cty.List(cty.String)), function.NewArgErrorf(i+1, "would extend prefix to %d bits, which is too long for this address", length)
+			}
+			block := new(big.Int).Lsh(big.NewInt(1), uint(bitLen-length)) // #nosec G115 -- length <= bitLen
+			// Round up to the next boundary of this subnet's size.
+			aligned := new(big.Int).Add(next, new(big.Int).Sub(block, big.NewInt(1)))
+			aligned.Div(aligned, block).Mul(aligned, block)
+			next = new(big.Int).Add(aligned, block)
+			if next.Cmp(end) > 0 {
+				return cty.UnknownVal(cty.List(cty.String)), function.NewArgErrorf(i+1, "not enough remaining address space for a subnet with a prefix of %d bits", length)
+			}
+			subnets = append(subnets, cty.StringVal(netip.PrefixFrom(addrFromInt(aligned, is4), length).String()))
+		}
+		return cty.ListVal(subnets), nil
+	},
+})
+
+// parseCIDR parses a CIDR string and returns its network prefix (host
+// bits zeroed), matching Terraform's tolerance of "10.1.2.3/16".
+func parseCIDR(v cty.Value) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(v.AsString())
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR expression: %w", err)
+	}
+	return prefix.Masked(), nil
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func addrFromInt(n *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		var b [4]byte
+		n.FillBytes(b[:])
+		return netip.AddrFrom4(b)
+	}
+	var b [16]byte
+	n.FillBytes(b[:])
+	return netip.AddrFrom16(b)
+}
@@ -0,0 +1,72 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func evalFunc(t *testing.T, src string) (cty.Value, hcl.Diagnostics) {
+	t.Helper()
+	expr, diags := hclsyntax.ParseExpression([]byte(src), "test.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+	return expr.Value(&hcl.EvalContext{Functions: localFuncMap})
+}
+
+func TestLocalFuncMap(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		expr string
+		want cty.Value
+	}{
+		{`try(local.missing, "fallback")`, cty.StringVal("fallback")},
+		{`can(tonumber("x"))`, cty.False},
+		{`coalesce("", "b")`, cty.StringVal("b")},
+		{`coalesce(null, 2)`, cty.NumberIntVal(2)},
+		{`length("abc")`, cty.NumberIntVal(3)},
+		{`length({a = 1, b = 2})`, cty.NumberIntVal(2)},
+		{`length(concat([1], [2, 3]))`, cty.NumberIntVal(3)},
+		{`element(["a", "b"], 3)`, cty.StringVal("b")},
+		{`replace("a-b-c", "-", "_")`, cty.StringVal("a_b_c")},
+		{`replace("gpu42", "/[0-9]+/", "N")`, cty.StringVal("gpuN")},
+		{`regex("BM\\.GPU\\.(\\w+)", "BM.GPU.A100")`, cty.TupleVal([]cty.Value{cty.StringVal("A100")})},
+		{`tomap({a = "x"})["a"]`, cty.StringVal("x")},
+		{`length(toset(["a", "a", "b"]))`, cty.NumberIntVal(2)},
+		{`reverse([1, 2])[0]`, cty.NumberIntVal(2)},
+		{`cidrhost("10.0.0.0/24", 5)`, cty.StringVal("10.0.0.5")},
+		{`cidrhost("10.0.0.0/24", -2)`, cty.StringVal("10.0.0.254")},
+		{`cidrnetmask("172.16.0.0/12")`, cty.StringVal("255.240.0.0")},
+		{`cidrsubnet("10.1.2.0/24", 4, 15)`, cty.StringVal("10.1.2.240/28")},
+		{`cidrsubnet("fd00:fd12:3456:7890::/56", 16, 162)`, cty.StringVal("fd00:fd12:3456:7800:a200::/72")},
+		{`cidrsubnets("10.1.0.0/16", 4, 4, 8, 4)`, cty.ListVal([]cty.Value{
+			cty.StringVal("10.1.0.0/20"), cty.StringVal("10.1.16.0/20"),
+			cty.StringVal("10.1.32.0/24"), cty.StringVal("10.1.48.0/20"),
+		})},
+	}
+	for _, tc := range cases {
+		got, diags := evalFunc(t, tc.expr)
+		require.False(t, diags.HasErrors(), "%s: %s", tc.expr, diags.Error())
+		assert.True(t, tc.want.RawEquals(got), "%s = %#v, want %#v", tc.expr, got, tc.want)
+	}
+}
+
+func TestLocalFuncMap_Errors(t *testing.T) {
+	t.Parallel()
+	for _, expr := range []string{
+		`cidrhost("10.0.0.0/30", 4)`,
+		`cidrsubnet("10.0.0.0/30", 4, 0)`,
+		`cidrsubnet("10.0.0.0/24", 2, 4)`,
+		`cidrsubnets("10.0.0.0/24", 1, 1, 1)`,
+		`cidrnetmask("fd00::/8")`,
+		`cidrhost("not-a-cidr", 1)`,
+		`length(true)`,
+		`coalesce("", null)`,
+	} {
+		_, diags := evalFunc(t, expr)
+		assert.True(t, diags.HasErrors(), "%s should fail", expr)
+	}
+}
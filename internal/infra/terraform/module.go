@@ -0,0 +1,136 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jingle2008/toolkit/internal/fileutil"
+)
+
+// moduleMetaArgs are the module block arguments that are not input
+// variables.
+var moduleMetaArgs = map[string]struct{}{
+	"source":     {},
+	"version":    {},
+	"providers":  {},
+	"depends_on": {},
+	"count":      {},
+	"for_each":   {},
+}
+
+// moduleCall is a `module "name" { source = ... }` block.
+type moduleCall struct {
+	name   string
+	source string
+	block  *hclsyntax.Block
+	inputs hclsyntax.Attributes
+}
+
+// loadModuleBlocks returns the module calls and the names of the output
+// blocks declared in the .tf files under dirPath.
+func loadModuleBlocks(ctx context.Context, dirPath string) (map[string]*moduleCall, map[string]struct{}, error) {
+	tfFiles, err := fileutil.ListFiles(ctx, dirPath, ".tf")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	calls := make(map[string]*moduleCall)
+	outputs := make(map[string]struct{})
+	parser := hclparse.NewParser()
+	for _, fpath := range tfFiles {
+		file, diags := parser.ParseHCLFile(fpath)
+		if diags.HasErrors() {
+			return nil, nil, fmt.Errorf("terraform diagnostics error: %w", errors.New(diags.Error()))
+		}
+		for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+			if len(block.Labels) == 0 {
+				continue
+			}
+			switch block.Type {
+			case outputBlock:
+				outputs[block.Labels[0]] = struct{}{}
+			case moduleKey:
+				call := &moduleCall{name: block.Labels[0], block: block, inputs: make(hclsyntax.Attributes)}
+				for name, attr := range block.Body.Attributes {
+					if _, meta := moduleMetaArgs[name]; !meta {
+						call.inputs[name] = attr
+					}
+				}
+				if attr, ok := block.Body.Attributes["source"]; ok {
+					if v, diags := attr.Expr.Value(nil); !diags.HasErrors() && v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+						call.source = v.AsString()
+					}
+				}
+				calls[call.name] = call
+			}
+		}
+	}
+	return calls, outputs, nil
+}
+
+// unsupported returns why the call can never be evaluated, or "".
+func (c *moduleCall) unsupported() string {
+	switch {
+	case c.source == "":
+		return "module source must be a literal string"
+	case !strings.HasPrefix(c.source, "./") && !strings.HasPrefix(c.source, "../"):
+		return fmt.Sprintf("module source %q is not a local path; only ./ and ../ sources are evaluated", c.source)
+	case c.block.Body.Attributes["count"] != nil, c.block.Body.Attributes["for_each"] != nil:
+		return "count and for_each on module blocks are not supported"
+	default:
+		return ""
+	}
+}
+
+// dir resolves the call's local source against the calling module.
+func (c *moduleCall) dir(parent string) string {
+	return filepath.Clean(filepath.Join(parent, c.source))
+}
+
+// evalInputs evaluates every argument; ok is false until all of them
+// resolve to wholly known values.
+func (c *moduleCall) evalInputs(ctx *hcl.EvalContext) (map[string]cty.Value, bool) {
+	args := make(map[string]cty.Value, len(c.inputs))
+	for name, attr := range c.inputs {
+		v, diags := attr.Expr.Value(ctx)
+		if diags.HasErrors() || !v.IsWhollyKnown() {
+			return nil, false
+		}
+		args[name] = v
+	}
+	return args, true
+}
+
+// inputDiagnostic explains why evalInputs never succeeded.
+func (c *moduleCall) inputDiagnostic(ctx *hcl.EvalContext) string {
+	for _, name := range slices.Sorted(maps.Keys(c.inputs)) {
+		v, diags := c.inputs[name].Expr.Value(ctx)
+		if diags.HasErrors() {
+			return fmt.Sprintf("argument %s: %s", name, diags.Error())
+		}
+		if !v.IsWhollyKnown() {
+			return fmt.Sprintf("argument %s: %s", name, unknownValueDiagnostic)
+		}
+	}
+	return "module arguments did not resolve"
+}
+
+// unresolved reports the call as module.<name>.
+func (c *moduleCall) unresolved(reason string) UnresolvedLocal {
+	r := c.block.DefRange()
+	return UnresolvedLocal{
+		Name:       moduleKey + "." + c.name,
+		Range:      r,
+		Location:   r.String(),
+		Diagnostic: reason,
+	}
+}
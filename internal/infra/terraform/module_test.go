@@ -0,0 +1,147 @@
+package terraform
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// stageModuleRepo lays out a root module that wraps a pool config in a
+// local child module, passing the pool size through as an input.
+func stageModuleRepo(t *testing.T) string {
+	t.Helper()
+	repo := t.TempDir()
+	child := filepath.Join(repo, "modules", "pools")
+	root := filepath.Join(repo, "envs", "gpu")
+	require.NoError(t, os.MkdirAll(child, 0o750)) // #nosec G301
+	require.NoError(t, os.MkdirAll(root, 0o750))  // #nosec G301
+
+	writeTfFile(t, child, "main.tf", `
+variable "size" {
+  default = 1
+}
+variable "shape" {}
+locals {
+  pools = {
+    "gpu-a" = { shape = var.shape, size = var.size }
+  }
+  broken = local.nope
+}
+output "gpu_pools" {
+  value = local.pools
+}
+output "region" {
+  value = var.region
+}
+`)
+	writeTfFile(t, root, "main.tf", `
+locals {
+  size = 4
+  env_pools = module.pools.gpu_pools
+}
+module "pools" {
+  source = "../../modules/pools"
+  size   = local.size
+  shape  = "BM.GPU.A100"
+}
+module "remote" {
+  source = "git::https://example.com/mod.git"
+}
+`)
+	return repo
+}
+
+func TestResolveLocals_FollowsModules(t *testing.T) {
+	t.Parallel()
+	repo := stageModuleRepo(t)
+	env := models.Environment{Realm: "oc1", Type: "dev", Region: "us-test-1"}
+
+	locals, err := ResolveLocals(context.Background(), filepath.Join(repo, "envs", "gpu"), env)
+	require.NoError(t, err)
+
+	pool := locals.Values["env_pools"].GetAttr("gpu-a")
+	assert.Equal(t, "BM.GPU.A100", pool.GetAttr("shape").AsString())
+	size, _ := pool.GetAttr("size").AsBigFloat().Int64()
+	assert.Equal(t, int64(4), size)
+
+	// Only outputs are exposed on module.<name>; region is passed down.
+	mod := locals.Values["module"].GetAttr("pools")
+	assert.True(t, mod.Type().HasAttribute("region"))
+	assert.False(t, mod.Type().HasAttribute("broken"))
+	assert.Equal(t, "us-test-1", mod.GetAttr("region").AsString())
+
+	names := make([]string, 0, len(locals.Unresolved))
+	for _, u := range locals.Unresolved {
+		names = append(names, u.Name)
+	}
+	assert.Equal(t, []string{"module.pools.broken", "module.remote"}, names)
+	assert.Contains(t, locals.Unresolved[1].Diagnostic, "not a local path")
+}
+
+func TestResolveLocals_ModuleInputUnresolved(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	child := filepath.Join(dir, "child")
+	require.NoError(t, os.MkdirAll(child, 0o750)) // #nosec G301
+	writeTfFile(t, child, "main.tf", `output "x" { value = 1 }`)
+	writeTfFile(t, dir, "main.tf", `
+module "child" {
+  source = "./child"
+  in     = local.missing
+}
+module "self" {
+  source = "./"
+}
+`)
+	locals, err := ResolveLocals(context.Background(), dir, models.Environment{})
+	require.NoError(t, err)
+	byName := make(map[string]UnresolvedLocal, len(locals.Unresolved))
+	for _, u := range locals.Unresolved {
+		byName[u.Name] = u
+	}
+	require.Contains(t, byName, "module.child")
+	assert.Contains(t, byName["module.child"].Diagnostic, "argument in")
+	// A module sourcing itself is reported rather than followed.
+	require.Contains(t, byName, "module.self")
+	assert.Contains(t, byName["module.self"].Diagnostic, "cycles back")
+	assert.Len(t, byName, 2)
+}
+
+func TestLoadGPUPools_ConfiguredSources(t *testing.T) {
+	t.Parallel()
+	repo := stageModuleRepo(t)
+	extra := filepath.Join(repo, "extra")
+	require.NoError(t, os.MkdirAll(extra, 0o750)) // #nosec G301
+	writeTfFile(t, extra, "main.tf", `locals { gpus = { "oke-b" = { shape = "VM.GPU.A10.1", node_pool_size = 2 } } }`)
+	env := models.Environment{Realm: "oc1", Type: "dev", Region: "us-test-1"}
+
+	pools, err := LoadGPUPools(context.Background(), repo, env,
+		GPUPoolSource{Dir: "envs/gpu", Local: "module.pools.gpu_pools"},
+		GPUPoolSource{Dir: "extra", Local: "gpus", IsOkeManaged: true},
+	)
+	require.NoError(t, err)
+	byName := make(map[string]models.GPUPool, len(pools))
+	for _, p := range pools {
+		byName[p.Name] = p
+	}
+	require.Len(t, byName, 2)
+	assert.Equal(t, 4, byName["gpu-a"].Size)
+	assert.False(t, byName["gpu-a"].IsOkeManaged)
+	assert.Equal(t, 2, byName["oke-b"].Size)
+	assert.True(t, byName["oke-b"].IsOkeManaged)
+
+	// Pools are attributed to their entry, through module outputs too.
+	assert.Equal(t, &models.SourceLocation{File: filepath.Join(repo, "modules", "pools", "main.tf"), Line: 8, Column: 15},
+		byName["gpu-a"].Source)
+	assert.Equal(t, &models.SourceLocation{File: filepath.Join(extra, "main.tf"), Line: 1, Column: 29},
+		byName["oke-b"].Source)
+
+	_, err = LoadGPUPools(context.Background(), repo, env, GPUPoolSource{Dir: "envs/gpu", Local: "module.pools.missing"})
+	require.ErrorContains(t, err, "module.pools.missing not resolved")
+}
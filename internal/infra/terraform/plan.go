@@ -0,0 +1,259 @@
+package terraform
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// OCI resource types whose changes affect GPU pool capacity.
+const (
+	instancePoolType   = "oci_core_instance_pool"
+	clusterNetworkType = "oci_core_cluster_network"
+	okeNodePoolType    = "oci_containerengine_node_pool"
+)
+
+// Pool change actions, collapsed from Terraform's action lists.
+const (
+	PlanActionCreate  = "create"
+	PlanActionUpdate  = "update"
+	PlanActionDelete  = "delete"
+	PlanActionReplace = "replace"
+)
+
+// ErrNotPlanJSON is returned when the input is JSON but not the output of
+// `terraform show -json <planfile>`.
+var ErrNotPlanJSON = errors.New("not a terraform plan: missing format_version or resource_changes (use `terraform show -json <planfile>`)")
+
+/*
+PoolChange is a planned change to one GPU-capable pool: an OCI instance
+pool, one instance pool inside a cluster network, or an OKE node pool.
+Sizes are -1 when the side does not exist (before a create, after a
+delete) or the attribute is unknown until apply.
+*/
+type PoolChange struct {
+	// Address is the Terraform resource address, e.g.
+	// module.pools.oci_core_instance_pool.pool["gpu-a100"].
+	Address string `json:"address"`
+	// Name is the pool's display name, which is also the GPUPool.Name
+	// toolkit loads from the repo and the nodes' instance-pool.name label.
+	Name string `json:"name"`
+	// IsOkeManaged is true for OKE node pools.
+	IsOkeManaged bool   `json:"isOkeManaged"`
+	Action       string `json:"action"`
+	BeforeSize   int    `json:"beforeSize"`
+	AfterSize    int    `json:"afterSize"`
+	BeforeShape  string `json:"beforeShape,omitempty"`
+	AfterShape   string `json:"afterShape,omitempty"`
+}
+
+// SizeDelta is AfterSize - BeforeSize, treating the side a create or
+// delete lacks as 0. ok is false when a size that exists is unknown
+// until apply (e.g. an update whose after size is computed): reading
+// that -1 as 0 would report a shrink to nothing.
+func (c PoolChange) SizeDelta() (delta int, ok bool) {
+	before, after := c.BeforeSize, c.AfterSize
+	if c.Action == PlanActionCreate && before < 0 {
+		before = 0
+	}
+	if c.Action == PlanActionDelete && after < 0 {
+		after = 0
+	}
+	if before < 0 || after < 0 {
+		return 0, false
+	}
+	return after - before, true
+}
+
+// plan mirrors the subset of the `terraform show -json` format we read.
+type plan struct {
+	FormatVersion   string           `json:"format_version"`
+	ResourceChanges []resourceChange `json:"resource_changes"`
+}
+
+type resourceChange struct {
+	Address string          `json:"address"`
+	Mode    string          `json:"mode"`
+	Type    string          `json:"type"`
+	Index   json.RawMessage `json:"index"`
+	Change  struct {
+		Actions []string       `json:"actions"`
+		Before  map[string]any `json:"before"`
+		After   map[string]any `json:"after"`
+	} `json:"change"`
+}
+
+/*
+ParsePlan reads `terraform show -json` output and returns the changes to
+instance pools, cluster networks and OKE node pools, sorted by name.
+No-op and read actions are dropped. A cluster network yields one change
+per member instance pool, since that is the unit GPU nodes belong to.
+*/
+func ParsePlan(r io.Reader) ([]PoolChange, error) {
+	var p plan
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return nil, fmt.Errorf("decode plan: %w", err)
+	}
+	if p.FormatVersion == "" && p.ResourceChanges == nil {
+		return nil, ErrNotPlanJSON
+	}
+
+	var changes []PoolChange
+	for _, rc := range p.ResourceChanges {
+		if rc.Mode != "" && rc.Mode != "managed" {
+			continue
+		}
+		action := collapseActions(rc.Change.Actions)
+		if action == "" {
+			continue
+		}
+		switch rc.Type {
+		case instancePoolType:
+			c := PoolChange{Address: rc.Address, Action: action}
+			c.Name = poolName(rc, "display_name")
+			c.BeforeSize, c.AfterSize = intAttr(rc.Change.Before, "size"), intAttr(rc.Change.After, "size")
+			changes = append(changes, c)
+		case clusterNetworkType:
+			changes = append(changes, clusterNetworkChanges(rc, action)...)
+		case okeNodePoolType:
+			c := PoolChange{Address: rc.Address, Action: action, IsOkeManaged: true}
+			c.Name = poolName(rc, "name")
+			c.BeforeSize, c.AfterSize = nodePoolSize(rc.Change.Before), nodePoolSize(rc.Change.After)
+			c.BeforeShape, c.AfterShape = stringAttr(rc.Change.Before, "node_shape"), stringAttr(rc.Change.After, "node_shape")
+			changes = append(changes, c)
+		}
+	}
+	slices.SortFunc(changes, func(a, b PoolChange) int {
+		return strings.Compare(a.Name+"\x00"+a.Address, b.Name+"\x00"+b.Address)
+	})
+	return changes, nil
+}
+
+// collapseActions maps Terraform's action list to a single verb, or ""
+// for actions that don't change anything (no-op, read).
+func collapseActions(actions []string) string {
+	switch {
+	case slices.Contains(actions, "delete") && slices.Contains(actions, "create"):
+		return PlanActionReplace
+	case slices.Contains(actions, "create"):
+		return PlanActionCreate
+	case slices.Contains(actions, "delete"):
+		return PlanActionDelete
+	case slices.Contains(actions, "update"):
+		return PlanActionUpdate
+	default:
+		return ""
+	}
+}
+
+// clusterNetworkChanges splits a cluster network change into one change
+// per member instance pool, matched across before/after by display name
+// (or position when unnamed).
+func clusterNetworkChanges(rc resourceChange, action string) []PoolChange {
+	before := objectList(rc.Change.Before, "instance_pools")
+	after := objectList(rc.Change.After, "instance_pools")
+	fallback := poolName(rc, "display_name")
+
+	nameOf := func(pool map[string]any, i int) string {
+		if name := stringAttr(pool, "display_name"); name != "" {
+			return name
+		}
+		if i == 0 {
+			return fallback
+		}
+		return fmt.Sprintf("%s[%d]", fallback, i)
+	}
+
+	var (
+		order   []string
+		byName  = map[string]*PoolChange{}
+		changes []PoolChange
+	)
+	get := func(name string) *PoolChange {
+		if c, ok := byName[name]; ok {
+			return c
+		}
+		c := &PoolChange{Address: rc.Address, Name: name, Action: action, BeforeSize: -1, AfterSize: -1}
+		byName[name] = c
+		order = append(order, name)
+		return c
+	}
+	for i, pool := range before {
+		get(nameOf(pool, i)).BeforeSize = intAttr(pool, "size")
+	}
+	for i, pool := range after {
+		get(nameOf(pool, i)).AfterSize = intAttr(pool, "size")
+	}
+	for _, name := range order {
+		c := *byName[name]
+		// A member pool added to or dropped from a network that is
+		// itself only updated is a create/delete of that pool.
+		if action == PlanActionUpdate {
+			switch {
+			case c.BeforeSize < 0:
+				c.Action = PlanActionCreate
+			case c.AfterSize < 0:
+				c.Action = PlanActionDelete
+			}
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// poolName returns the pool's name from attr on whichever side of the
+// change exists, falling back to a string for_each key.
+func poolName(rc resourceChange, attr string) string {
+	if name := stringAttr(rc.Change.After, attr); name != "" {
+		return name
+	}
+	if name := stringAttr(rc.Change.Before, attr); name != "" {
+		return name
+	}
+	var key string
+	if err := json.Unmarshal(rc.Index, &key); err == nil && key != "" {
+		return key
+	}
+	return rc.Address
+}
+
+// nodePoolSize reads node_config_details[0].size from an OKE node pool.
+func nodePoolSize(obj map[string]any) int {
+	details := objectList(obj, "node_config_details")
+	if len(details) == 0 {
+		return -1
+	}
+	return intAttr(details[0], "size")
+}
+
+func stringAttr(obj map[string]any, key string) string {
+	s, _ := obj[key].(string)
+	return s
+}
+
+// intAttr returns obj[key] as an int, or -1 when obj is absent or the
+// value is missing or unknown (null in plan JSON).
+func intAttr(obj map[string]any, key string) int {
+	if obj == nil {
+		return -1
+	}
+	f, ok := obj[key].(float64)
+	if !ok {
+		return -1
+	}
+	return int(f)
+}
+
+func objectList(obj map[string]any, key string) []map[string]any {
+	items, _ := obj[key].([]any)
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
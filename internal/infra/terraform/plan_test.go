@@ -0,0 +1,84 @@
+package terraform
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlan(t *testing.T) {
+	t.Parallel()
+	f, err := os.Open("testdata/plan.json")
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	changes, err := ParsePlan(f)
+	require.NoError(t, err)
+
+	byName := make(map[string]PoolChange, len(changes))
+	names := make([]string, 0, len(changes))
+	for _, c := range changes {
+		byName[c.Name] = c
+		names = append(names, c.Name)
+	}
+	assert.Equal(t, []string{"gpu-a100", "gpu-computed", "gpu-h100", "gpu-new", "oke-gpu", "rdma-a", "rdma-b"}, names)
+
+	a100 := byName["gpu-a100"]
+	assert.Equal(t, PlanActionUpdate, a100.Action)
+	assert.Equal(t, 4, a100.BeforeSize)
+	assert.Equal(t, 2, a100.AfterSize)
+	assertDelta(t, -2, a100)
+
+	assert.Equal(t, PlanActionReplace, byName["gpu-h100"].Action)
+
+	oke := byName["oke-gpu"]
+	assert.True(t, oke.IsOkeManaged)
+	assert.Equal(t, PlanActionDelete, oke.Action)
+	assert.Equal(t, 3, oke.BeforeSize)
+	assert.Equal(t, -1, oke.AfterSize)
+	assert.Equal(t, "BM.GPU.A10.4", oke.BeforeShape)
+	assertDelta(t, -3, oke)
+
+	// Size unknown until apply.
+	assert.Equal(t, PlanActionCreate, byName["gpu-new"].Action)
+	assert.Equal(t, -1, byName["gpu-new"].AfterSize)
+	_, ok := byName["gpu-new"].SizeDelta()
+	assert.False(t, ok)
+
+	// An update whose new size is computed is unknown, not a shrink to 0.
+	computed := byName["gpu-computed"]
+	assert.Equal(t, PlanActionUpdate, computed.Action)
+	assert.Equal(t, -1, computed.AfterSize)
+	_, ok = computed.SizeDelta()
+	assert.False(t, ok)
+
+	// Cluster network members are split out; a new member is a create.
+	assert.Equal(t, PlanActionUpdate, byName["rdma-a"].Action)
+	assertDelta(t, 2, byName["rdma-a"])
+	assert.Equal(t, PlanActionCreate, byName["rdma-b"].Action)
+	assert.Equal(t, 2, byName["rdma-b"].AfterSize)
+}
+
+func assertDelta(t *testing.T, want int, c PoolChange) {
+	t.Helper()
+	delta, ok := c.SizeDelta()
+	require.Truef(t, ok, "%s: delta unknown", c.Name)
+	assert.Equalf(t, want, delta, "%s: delta", c.Name)
+}
+
+func TestParsePlan_Errors(t *testing.T) {
+	t.Parallel()
+	_, err := ParsePlan(strings.NewReader("not json"))
+	require.ErrorContains(t, err, "decode plan")
+
+	// HCL state or arbitrary JSON is not a plan.
+	_, err = ParsePlan(strings.NewReader(`{"values": {}}`))
+	require.ErrorIs(t, err, ErrNotPlanJSON)
+
+	changes, err := ParsePlan(strings.NewReader(`{"format_version": "1.2"}`))
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -19,14 +19,24 @@ import (
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
-	"github.com/zclconf/go-cty/cty/function"
-	"github.com/zclconf/go-cty/cty/function/stdlib"
 
 	"github.com/jingle2008/toolkit/internal/fileutil"
+	"github.com/jingle2008/toolkit/internal/infra/parsecache"
 	logging "github.com/jingle2008/toolkit/pkg/infra/logging"
 	models "github.com/jingle2008/toolkit/pkg/models"
 )
 
+// Repo-relative directories of the Terraform modules the dataset is read
+// from.
+const (
+	ServiceTenanciesDir = "shared_modules/shep_targets"
+	ModelArtifactsDir   = "shared_modules/tensorrt_models_config"
+)
+
+// modelArtifactMapLocal is the local in tensorrt_models_config that maps
+// model name → TensorRT version → GPU shape → GPU count → artifact.
+const modelArtifactMapLocal = "all_models_map"
+
 // ErrModelArtifactMapNotResolved is returned when the model artifact map cannot be resolved.
 var ErrModelArtifactMapNotResolved = errors.New("model artifact map not resolved")
 
@@ -40,19 +50,9 @@ const (
 	dataKey     = "data"
 	outputBlock = "output"
 	outputValue = "value"
+	moduleKey   = "module"
 )
 
-var localFuncMap = map[string]function.Function{
-	"format":   stdlib.FormatFunc,
-	"lookup":   stdlib.LookupFunc,
-	"merge":    stdlib.MergeFunc,
-	"join":     stdlib.JoinFunc,
-	"contains": stdlib.ContainsFunc,
-	"keys":     stdlib.KeysFunc,
-	"flatten":  stdlib.FlattenFunc,
-	"distinct": stdlib.DistinctFunc,
-}
-
 func getLocalAttributesDI(
 	ctx context.Context,
 	dirPath string,
@@ -155,11 +155,101 @@ func mergeObject(object cty.Value, key string, value cty.Value) cty.Value {
 	return cty.ObjectVal(valueMap)
 }
 
-func loadLocalValueMap(ctx context.Context, dirPath string, env models.Environment) (map[string]cty.Value, error) { //nolint:cyclop
+/*
+Locals is the outcome of evaluating a module's locals: the values that
+resolved and, for every local that did not, why.
+*/
+type Locals struct {
+	// Values holds every resolved local by name, plus the synthetic
+	// execution_target object locals may reference and, when the module
+	// calls others, a "module" object of their outputs.
+	Values map[string]cty.Value
+	// Unresolved lists the locals that failed to evaluate or evaluated to
+	// a value that is not wholly known, sorted by name.
+	Unresolved []UnresolvedLocal
+
+	// exprs and modules keep the definitions behind Values so loaders can
+	// attribute entries to a source range (see locate).
+	exprs   map[string]hclsyntax.Expression
+	modules map[string]*Locals
+	// dir is the module directory the locals were read from.
+	dir string
+}
+
+// moduleDirs returns the directories of the module and of every module it
+// called: the inputs a cached evaluation depends on.
+func (l *Locals) moduleDirs() []string {
+	dirs := []string{l.dir}
+	for _, child := range l.modules {
+		dirs = append(dirs, child.moduleDirs()...)
+	}
+	return dirs
+}
+
+// UnresolvedLocal describes a local that could not be resolved.
+type UnresolvedLocal struct {
+	Name string `json:"name"`
+	// Range is the source range of the local's definition.
+	Range hcl.Range `json:"-"`
+	// Location is Range formatted as file:line,column-column.
+	Location string `json:"location"`
+	// Diagnostic is the HCL error, or a note that the value depends on an
+	// unknown input (e.g. a variable without a default).
+	Diagnostic string `json:"diagnostic"`
+}
+
+// unknownValueDiagnostic explains a local that evaluated without errors
+// but still contains unknowns.
+const unknownValueDiagnostic = "value is not wholly known; it depends on an input toolkit cannot supply " +
+	"(a variable without a default, or a resource attribute)"
+
+/*
+ResolveLocals evaluates the locals (and output values) declared in the
+.tf files under dirPath against a synthetic environment derived from env.
+
+Variables take their declared defaults, overridden by terraform.tfvars
+and *.auto.tfvars in the directory, then by varFiles in the order given
+(mirroring terraform's -var-file); var.region and var.environment always
+reflect env. `module` blocks with a local source ("./…" or "../…") are
+evaluated recursively with their arguments as input variables, and their
+outputs are available as module.<name>.<output>. Locals and modules that
+cannot be resolved are reported in Locals.Unresolved (a child module's
+own unresolved locals as module.<name>.<local>) rather than failing the
+whole evaluation.
+*/
+func ResolveLocals(ctx context.Context, dirPath string, env models.Environment, varFiles ...string) (*Locals, error) {
+	autoFiles, err := tfvarsFiles(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	tfvars, err := loadTFVars(slices.Concat(autoFiles, varFiles))
+	if err != nil {
+		return nil, err
+	}
+	locals, _, err := resolveModule(ctx, dirPath, env, tfvars, nil)
+	return locals, err
+}
+
+// resolveModule evaluates the module rooted at dirPath. inputs override
+// the variable defaults: tfvars for the root module, module block
+// arguments for a child. For the root, var.region and var.environment are
+// forced to env; a child only gets them when its caller didn't pass them.
+// callers holds the directories of the calling modules (nil for the root)
+// so a module that sources itself is reported instead of recursing. It
+// also returns the names of the module's output blocks.
+func resolveModule(ctx context.Context, dirPath string, env models.Environment, //nolint:cyclop,funlen
+	inputs map[string]cty.Value, callers []string,
+) (*Locals, map[string]struct{}, error) {
 	logger := logging.FromContext(ctx)
+	root := len(callers) == 0
+	callers = append(slices.Clone(callers), filepath.Clean(dirPath))
 	attributes, err := LoadLocalAttributes(ctx, dirPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode HCL: %w", err)
+		return nil, nil, fmt.Errorf("failed to decode HCL: %w", err)
+	}
+	calls, outputs, err := loadModuleBlocks(ctx, dirPath)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	executionTarget := cty.ObjectVal(map[string]cty.Value{
@@ -180,13 +270,18 @@ func loadLocalValueMap(ctx context.Context, dirPath string, env models.Environme
 
 	// Start from any defaults declared in this module's variables.tf so
 	// references like `var.worker_nsgs` resolve to their declared defaults
-	// instead of failing. Then layer the toolkit-supplied region /
-	// environment on top so they always reflect the active env.
+	// instead of failing, layer the inputs over them, then the
+	// toolkit-supplied region / environment on top so they always reflect
+	// the active env.
 	varDefaults, _ := getVariableDefaults(ctx, dirPath)
-	varMap := make(map[string]cty.Value, len(varDefaults)+2)
+	varMap := make(map[string]cty.Value, len(varDefaults)+len(inputs)+2)
 	maps.Copy(varMap, varDefaults)
-	varMap["region"] = cty.StringVal(env.Region)
-	varMap["environment"] = cty.StringVal(env.Type)
+	maps.Copy(varMap, inputs)
+	for key, val := range map[string]string{"region": env.Region, "environment": env.Type} {
+		if _, passed := inputs[key]; root || !passed {
+			varMap[key] = cty.StringVal(val)
+		}
+	}
 	varObject := cty.ObjectVal(varMap)
 
 	dataObject := cty.ObjectVal(map[string]cty.Value{
@@ -194,19 +289,26 @@ func loadLocalValueMap(ctx context.Context, dirPath string, env models.Environme
 		"oci_objectstorage_namespace":       createObjectStorageNamespace(),
 	})
 
+	moduleValues := make(map[string]cty.Value, len(calls))
 	context := hcl.EvalContext{
 		Variables: map[string]cty.Value{
-			localKey: localObject,
-			varKey:   varObject,
-			dataKey:  dataObject,
+			localKey:  localObject,
+			varKey:    varObject,
+			dataKey:   dataObject,
+			moduleKey: cty.EmptyObjectVal,
 		},
 		Functions: localFuncMap,
 	}
 
 	keys := make([]string, 0, len(attributes))
-	for key := range attributes {
+	exprs := make(map[string]hclsyntax.Expression, len(attributes))
+	for key, attr := range attributes {
 		keys = append(keys, key)
+		if attr != nil {
+			exprs[key] = attr.Expr
+		}
 	}
+	children := make(map[string]*Locals, len(calls))
 
 	slices.SortFunc(keys, func(a, b string) int {
 		vi := len(attributes[a].Expr.Variables())
@@ -214,10 +316,24 @@ func loadLocalValueMap(ctx context.Context, dirPath string, env models.Environme
 		return cmp.Compare(vi, vj)
 	})
 
+	var unresolved []UnresolvedLocal
+	// Calls that can never resolve (remote source, count, a cycle) are
+	// reported up front rather than retried every iteration.
+	for name, call := range calls {
+		reason := call.unsupported()
+		if reason == "" && slices.Contains(callers, call.dir(dirPath)) {
+			reason = fmt.Sprintf("module source %q cycles back to %s", call.source, call.dir(dirPath))
+		}
+		if reason != "" {
+			unresolved = append(unresolved, call.unresolved(reason))
+			delete(calls, name)
+		}
+	}
+
 	const maxIterations = 100
 	progress := true
 	iterations := 0
-	for len(attributes) > 0 && progress && iterations < maxIterations {
+	for (len(attributes) > 0 || len(calls) > 0) && progress && iterations < maxIterations {
 		knownKeys := make(map[string]struct{})
 		for _, key := range keys {
 			attr, ok := attributes[key]
@@ -241,45 +357,165 @@ func loadLocalValueMap(ctx context.Context, dirPath string, env models.Environme
 		for key := range knownKeys {
 			delete(attributes, key)
 		}
+
+		for _, name := range slices.Sorted(maps.Keys(calls)) {
+			call := calls[name]
+			args, ok := call.evalInputs(&context)
+			if !ok {
+				continue
+			}
+			delete(calls, name)
+			progress = true
+			child, childOutputs, err := resolveModule(ctx, call.dir(dirPath), env, args, callers)
+			if err != nil {
+				unresolved = append(unresolved, call.unresolved(err.Error()))
+				continue
+			}
+			outputValues := make(map[string]cty.Value, len(childOutputs))
+			for out := range childOutputs {
+				if v, ok := child.Values[out]; ok {
+					outputValues[out] = v
+				}
+			}
+			moduleValues[name] = cty.ObjectVal(outputValues)
+			children[name] = child
+			context.Variables[moduleKey] = cty.ObjectVal(moduleValues)
+			for _, u := range child.Unresolved {
+				u.Name = moduleKey + "." + name + "." + u.Name
+				unresolved = append(unresolved, u)
+			}
+		}
 		iterations++
 	}
 	if iterations == maxIterations && len(attributes) > 0 {
 		logger.Errorw("max iterations reached while resolving locals; possible cyclic dependency", "unresolved", keys)
 	}
 
-	for key := range attributes {
-		attr, ok := attributes[key]
-		if !ok {
-			continue
+	for key, attr := range attributes {
+		u := UnresolvedLocal{Name: key, Range: attr.SrcRange, Location: attr.SrcRange.String()}
+		if _, diags := attr.Expr.Value(&context); diags.HasErrors() {
+			u.Diagnostic = diags.Error()
+		} else {
+			u.Diagnostic = unknownValueDiagnostic
 		}
+		unresolved = append(unresolved, u)
+	}
+	for _, call := range calls {
+		unresolved = append(unresolved, call.unresolved(call.inputDiagnostic(&context)))
+	}
+	slices.SortFunc(unresolved, func(a, b UnresolvedLocal) int { return cmp.Compare(a.Name, b.Name) })
 
-		value, diags := attr.Expr.Value(&context)
-		if diags.HasErrors() {
-			logger.Errorw(
-				"cannot resolve local",
-				"local", key,
-				"errors", diags.Errs(),
-			)
-			continue
+	values := localObject.AsValueMap()
+	if len(moduleValues) > 0 {
+		values[moduleKey] = cty.ObjectVal(moduleValues)
+	}
+	return &Locals{Values: values, Unresolved: unresolved, exprs: exprs, modules: children, dir: dirPath}, outputs, nil
+}
+
+// maxLocateDepth bounds locate's walk through local.* references, which
+// could otherwise loop on cyclic locals.
+const maxLocateDepth = 32
+
+/*
+locate returns the source range of the value at path: a local (or
+module.<name>.<output>) followed by keys into it. Object constructors,
+merge() arguments and local.* references are followed as far as the keys
+reach; when an expression can't be walked further (a for expression, a
+function result) the range of that expression is returned instead. ok is
+false only when the local itself is unknown.
+*/
+func (l *Locals) locate(path ...string) (hcl.Range, bool) {
+	if len(path) > 2 && path[0] == moduleKey {
+		if child, ok := l.modules[path[1]]; ok {
+			return child.locate(path[2:]...)
 		}
+		return hcl.Range{}, false
+	}
+	if len(path) == 0 {
+		return hcl.Range{}, false
+	}
+	expr, ok := l.exprs[path[0]]
+	if !ok {
+		return hcl.Range{}, false
+	}
+	r, _ := l.locateIn(expr, path[1:], 0)
+	return r, true
+}
 
-		// value.GoString() formats the whole cty value — only pay for it
-		// when debug logging is actually enabled.
-		if logger.DebugEnabled() {
-			logger.Debugw(
-				"cannot resolve local value",
-				"local", key,
-				"value", value.GoString(),
-			)
+// locateIn descends keys into expr; exact reports whether every key was
+// matched.
+func (l *Locals) locateIn(expr hclsyntax.Expression, keys []string, depth int) (hcl.Range, bool) {
+	if len(keys) == 0 {
+		return expr.Range(), true
+	}
+	if depth > maxLocateDepth {
+		return expr.Range(), false
+	}
+	switch e := expr.(type) {
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			k, diags := item.KeyExpr.Value(nil)
+			if !diags.HasErrors() && k.Type() == cty.String && k.IsKnown() && !k.IsNull() && k.AsString() == keys[0] {
+				return l.locateIn(item.ValueExpr, keys[1:], depth+1)
+			}
+		}
+	case *hclsyntax.FunctionCallExpr:
+		if e.Name == "merge" {
+			// Later arguments win, as in merge itself.
+			for i := len(e.Args) - 1; i >= 0; i-- {
+				if r, exact := l.locateIn(e.Args[i], keys, depth+1); exact {
+					return r, true
+				}
+			}
+		}
+	case *hclsyntax.ScopeTraversalExpr:
+		if len(e.Traversal) == 2 && e.Traversal.RootName() == localKey {
+			if attr, ok := e.Traversal[1].(hcl.TraverseAttr); ok {
+				if target, ok := l.exprs[attr.Name]; ok {
+					return l.locateIn(target, keys, depth+1)
+				}
+			}
 		}
 	}
+	return expr.Range(), false
+}
 
-	return localObject.AsValueMap(), nil
+// sourceOf converts an HCL range to the item's source location.
+func sourceOf(r hcl.Range) *models.SourceLocation {
+	return &models.SourceLocation{File: r.Filename, Line: r.Start.Line, Column: r.Start.Column}
+}
+
+// loadLocals resolves the locals under dirPath for the loaders, logging
+// the ones that did not resolve. `toolkit tf locals --unresolved` shows the
+// same diagnostics on demand.
+func loadLocals(ctx context.Context, dirPath string, env models.Environment) (*Locals, error) {
+	logger := logging.FromContext(ctx)
+	locals, err := ResolveLocals(ctx, dirPath, env)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range locals.Unresolved {
+		if u.Diagnostic == unknownValueDiagnostic {
+			logger.Debugw("cannot resolve local value", "dir", dirPath, "local", u.Name, "location", u.Location)
+			continue
+		}
+		logger.Errorw("cannot resolve local",
+			"dir", dirPath, "local", u.Name, "location", u.Location, "diagnostic", u.Diagnostic)
+	}
+	return locals, nil
 }
 
-// LoadServiceTenancies loads ServiceTenancy objects from the given repository path.
+// LoadServiceTenancies loads ServiceTenancy objects from the given repository
+// path, through the parse cache on ctx.
 func LoadServiceTenancies(ctx context.Context, repoPath string) ([]models.ServiceTenancy, error) {
-	dirPath := filepath.Join(repoPath, "shared_modules/shep_targets")
+	dirPath := filepath.Join(repoPath, ServiceTenanciesDir)
+	return parsecache.Load(ctx, "service-tenancies", dirPath, func(in *parsecache.Inputs) ([]models.ServiceTenancy, error) {
+		in.AddDirs(dirPath)
+		return loadServiceTenancies(ctx, dirPath)
+	})
+}
+
+func loadServiceTenancies(ctx context.Context, dirPath string) ([]models.ServiceTenancy, error) {
 	attributes, err := LoadLocalAttributes(ctx, dirPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse attributes: %w", err)
@@ -301,9 +537,15 @@ func LoadServiceTenancies(ctx context.Context, repoPath string) ([]models.Servic
 		}
 
 		tenancy := getServiceTenancy(value, realm)
+		tenancy.Source = sourceOf(attribute.SrcRange)
 		fullName := fmt.Sprintf("%s-%s", tenancy.Realm, tenancy.Name)
 		if t, ok := tenancyMap[fullName]; ok {
 			t.Regions = append(t.Regions, tenancy.Regions...)
+			// A tenancy split across several locals is attributed to the
+			// first one in the file, independent of map order.
+			if compareSource(tenancy.Source, t.Source) < 0 {
+				t.Source = tenancy.Source
+			}
 		} else {
 			tenancyMap[fullName] = tenancy
 		}
@@ -318,6 +560,11 @@ func LoadServiceTenancies(ctx context.Context, repoPath string) ([]models.Servic
 	return tenancies, nil
 }
 
+// compareSource orders locations by file, then line, then column.
+func compareSource(a, b *models.SourceLocation) int {
+	return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+}
+
 func getServiceTenancy(object cty.Value, realm string) *models.ServiceTenancy {
 	result := models.ServiceTenancy{
 		Realm: realm,
@@ -368,13 +615,37 @@ func (e *PartialLoadError) Error() string {
 // them. Requires Go 1.20+.
 func (e *PartialLoadError) Unwrap() []error { return e.Errs }
 
+/*
+GPUPoolSource is a Terraform module directory that declares GPU pools: the
+named local (or output) holds a map of pool name to pool config.
+*/
+type GPUPoolSource struct {
+	// Dir is the module directory, relative to the repo root.
+	Dir string `json:"dir"`
+	// Local names the value holding the pool map. It may reach into a
+	// child module's outputs as module.<name>.<output>.
+	Local string `json:"local"`
+	// IsOkeManaged marks OKE node pools (vs. self-managed instance pools
+	// and cluster networks).
+	IsOkeManaged bool `json:"isOkeManaged"`
+}
+
+// DefaultGPUPoolSources are the pool sources read when none are
+// configured.
+var DefaultGPUPoolSources = []GPUPoolSource{
+	{Dir: "shared_modules/instance_pools_config", Local: "env_instance_pools_config"},
+	{Dir: "shared_modules/cluster_networks_config", Local: "env_cluster_networks_config"},
+	{Dir: "shared_modules/oci_oke_nodepools_config", Local: "env_nodepools_config", IsOkeManaged: true},
+}
+
 /*
 LoadGPUPools loads GPUPool objects from the given repository path and environment.
 
-It reads three sources (self-managed instance pools, self-managed cluster
-networks, OKE-managed nodepools). Failures in individual sources are
-logged to ctx's logger and the function returns the union of pools from
-sources that succeeded. Return modes:
+It reads each of sources, or DefaultGPUPoolSources (self-managed instance
+pools, self-managed cluster networks, OKE-managed nodepools) when none are
+given. Failures in individual sources are logged to ctx's logger and the
+function returns the union of pools from sources that succeeded. Return
+modes:
 
   - All sources succeed: (pools, nil).
   - Some sources fail, some succeed: (pools, *PartialLoadError). Callers
@@ -382,16 +653,10 @@ sources that succeeded. Return modes:
     partial-failure warning.
   - Every source fails: (nil, error) with all source errors joined.
 */
-func LoadGPUPools(ctx context.Context, repoPath string, env models.Environment) ([]models.GPUPool, error) {
+func LoadGPUPools(ctx context.Context, repoPath string, env models.Environment, sources ...GPUPoolSource) ([]models.GPUPool, error) {
 	logger := logging.FromContext(ctx)
-	sources := []struct {
-		dir          string
-		localName    string
-		isOkeManaged bool
-	}{
-		{filepath.Join(repoPath, "shared_modules/instance_pools_config"), "env_instance_pools_config", false},
-		{filepath.Join(repoPath, "shared_modules/cluster_networks_config"), "env_cluster_networks_config", false},
-		{filepath.Join(repoPath, "shared_modules/oci_oke_nodepools_config"), "env_nodepools_config", true},
+	if len(sources) == 0 {
+		sources = DefaultGPUPoolSources
 	}
 
 	var (
@@ -399,11 +664,12 @@ func LoadGPUPools(ctx context.Context, repoPath string, env models.Environment)
 		errs     []error
 	)
 	for _, s := range sources {
-		pools, err := loadGPUPools(ctx, s.dir, s.localName, s.isOkeManaged, env)
+		dir := filepath.Join(repoPath, s.Dir)
+		pools, err := loadGPUPoolsCached(ctx, dir, s.Local, s.IsOkeManaged, env)
 		if err != nil {
 			logger.Warnw("skipping unresolved GPUPool source",
-				"dir", s.dir, "local", s.localName, "error", err)
-			errs = append(errs, fmt.Errorf("%s: %w", s.localName, err))
+				"dir", dir, "local", s.Local, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", s.Local, err))
 			continue
 		}
 		gpuPools = append(gpuPools, pools...)
@@ -422,15 +688,33 @@ func LoadGPUPools(ctx context.Context, repoPath string, env models.Environment)
 	}
 }
 
-func loadGPUPools(ctx context.Context, dirPath, poolConfigName string, isOkeManaged bool,
+// loadGPUPoolsCached loads one pool source through the parse cache on ctx.
+func loadGPUPoolsCached(ctx context.Context, dirPath, poolConfigName string, isOkeManaged bool,
 	env models.Environment,
 ) ([]models.GPUPool, error) {
-	valueMap, err := loadLocalValueMap(ctx, dirPath, env)
+	key := fmt.Sprintf("%s|%s|%t|%s", dirPath, poolConfigName, isOkeManaged, envKey(env))
+	return parsecache.Load(ctx, "gpu-pools", key, func(in *parsecache.Inputs) ([]models.GPUPool, error) {
+		return loadGPUPools(ctx, in, dirPath, poolConfigName, isOkeManaged, env)
+	})
+}
+
+// envKey scopes a cached evaluation to the environment it was evaluated for.
+func envKey(env models.Environment) string {
+	return env.Realm + "/" + env.Type + "/" + env.Region
+}
+
+// loadGPUPools reads one pool source, recording the module directories it
+// evaluated in in.
+func loadGPUPools(ctx context.Context, in *parsecache.Inputs, dirPath, poolConfigName string, isOkeManaged bool,
+	env models.Environment,
+) ([]models.GPUPool, error) {
+	locals, err := loadLocals(ctx, dirPath, env)
 	if err != nil {
 		return nil, err
 	}
+	in.AddDirs(locals.moduleDirs()...)
 
-	poolsValue, ok := valueMap[poolConfigName]
+	poolsValue, ok := lookupValue(locals.Values, poolConfigName)
 	if !ok {
 		return nil, fmt.Errorf("node pools config %s not resolved", poolConfigName)
 	}
@@ -438,6 +722,9 @@ func loadGPUPools(ctx context.Context, dirPath, poolConfigName string, isOkeMana
 	var gpuPools []models.GPUPool
 	for name, value := range poolsValue.AsValueMap() {
 		pool := models.GPUPool{Name: name, IsOkeManaged: isOkeManaged, CapacityType: "on-demand", Status: "..."}
+		if r, ok := locals.locate(append(strings.Split(poolConfigName, "."), name)...); ok {
+			pool.Source = sourceOf(r)
+		}
 		for k, v := range value.AsValueMap() {
 			switch k {
 			case "shape":
@@ -460,6 +747,27 @@ func loadGPUPools(ctx context.Context, dirPath, poolConfigName string, isOkeMana
 	return gpuPools, nil
 }
 
+// lookupValue resolves a dotted path such as "env_pools" or
+// "module.pools.gpu_pools" against resolved locals.
+func lookupValue(valueMap map[string]cty.Value, path string) (cty.Value, bool) {
+	parts := strings.Split(path, ".")
+	v, ok := valueMap[parts[0]]
+	for _, part := range parts[1:] {
+		if !ok || v.IsNull() || !v.IsKnown() {
+			return cty.NilVal, false
+		}
+		switch ty := v.Type(); {
+		case ty.IsObjectType() && ty.HasAttribute(part):
+			v = v.GetAttr(part)
+		case ty.IsMapType() && v.HasIndex(cty.StringVal(part)).True():
+			v = v.Index(cty.StringVal(part))
+		default:
+			return cty.NilVal, false
+		}
+	}
+	return v, ok
+}
+
 func extractAvailabilityDomain(v cty.Value) string {
 	t := v.Type()
 	if t.IsPrimitiveType() && t.FriendlyName() == "string" {
@@ -475,17 +783,27 @@ func extractAvailabilityDomain(v cty.Value) string {
 	return ""
 }
 
-// LoadModelArtifacts loads ModelArtifact objects from the given repository path and environment.
+// LoadModelArtifacts loads ModelArtifact objects from the given repository
+// path and environment, through the parse cache on ctx.
 func LoadModelArtifacts(ctx context.Context, repoPath string, env models.Environment) (map[string][]models.ModelArtifact, error) {
-	dirPath := filepath.Join(repoPath, "shared_modules/tensorrt_models_config")
-	valueMap, err := loadLocalValueMap(ctx, dirPath, env)
+	dirPath := filepath.Join(repoPath, ModelArtifactsDir)
+	return parsecache.Load(ctx, "model-artifacts", dirPath+"|"+envKey(env),
+		func(in *parsecache.Inputs) (map[string][]models.ModelArtifact, error) {
+			return loadModelArtifacts(ctx, in, dirPath, env)
+		})
+}
+
+func loadModelArtifacts(ctx context.Context, in *parsecache.Inputs, dirPath string, env models.Environment,
+) (map[string][]models.ModelArtifact, error) {
+	locals, err := loadLocals(ctx, dirPath, env)
 	if err != nil {
 		return nil, err
 	}
+	in.AddDirs(locals.moduleDirs()...)
 
-	modelMapValue, ok := valueMap["all_models_map"]
+	modelMapValue, ok := locals.Values[modelArtifactMapLocal]
 	if !ok {
-		return nil, fmt.Errorf("all_models_map: %w", ErrModelArtifactMapNotResolved)
+		return nil, fmt.Errorf("%s: %w", modelArtifactMapLocal, ErrModelArtifactMapNotResolved)
 	}
 
 	modelArtifactMap := map[string][]models.ModelArtifact{}
@@ -501,6 +819,9 @@ func LoadModelArtifacts(ctx context.Context, repoPath string, env models.Environ
 						GPUShape:        gpuShape,
 						ModelName:       modelName,
 					}
+					if r, ok := locals.locate(modelArtifactMapLocal, modelName, trtVersion, gpuShape, gpuCount); ok {
+						artifact.Source = sourceOf(r)
+					}
 
 					modelArtifacts = append(modelArtifacts, artifact)
 				}
@@ -276,7 +276,7 @@ func TestLoadGPUPools_PartialFailure(t *testing.T) {
 	assert.GreaterOrEqual(t, len(pools), 2, "should still return pools from working sources")
 }
 
-func TestLoadLocalValueMap_Success(t *testing.T) {
+func TestLoadLocals_Success(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
 	tf := `
@@ -287,24 +287,26 @@ locals {
 `
 	writeTfFile(t, dir, "locals.tf", tf)
 	env := models.Environment{Realm: "test", Type: "dev", Region: "us-test-1"}
-	vals, err := loadLocalValueMap(context.Background(), dir, env)
+	locals, err := loadLocals(context.Background(), dir, env)
 	require.NoError(t, err)
+	vals := locals.Values
 	assert.NotNil(t, vals)
 }
 
-func TestLoadLocalValueMap_NoTfFiles(t *testing.T) {
+func TestLoadLocals_NoTfFiles(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
 	env := models.Environment{Realm: "test", Type: "dev", Region: "us-test-1"}
-	vals, err := loadLocalValueMap(context.Background(), dir, env)
+	locals, err := loadLocals(context.Background(), dir, env)
 	require.NoError(t, err)
+	vals := locals.Values
 	assert.NotNil(t, vals)
 	assert.Len(t, vals, 1)
 	_, ok := vals["execution_target"]
 	assert.True(t, ok)
 }
 
-func TestLoadLocalValueMap_InvalidHCL(t *testing.T) {
+func TestLoadLocals_InvalidHCL(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
 	tf := `
@@ -313,11 +315,11 @@ locals {
 `
 	writeTfFile(t, dir, "bad.tf", tf)
 	env := models.Environment{Realm: "test", Type: "dev", Region: "us-test-1"}
-	_, err := loadLocalValueMap(context.Background(), dir, env)
+	_, err := loadLocals(context.Background(), dir, env)
 	require.Error(t, err)
 }
 
-func TestLoadLocalValueMap_CyclicLocals(t *testing.T) {
+func TestLoadLocals_CyclicLocals(t *testing.T) {
 	t.Parallel()
 	dir := t.TempDir()
 	tf := `
@@ -328,8 +330,9 @@ locals {
 `
 	writeTfFile(t, dir, "cyclic.tf", tf)
 	env := models.Environment{Realm: "test", Type: "dev", Region: "us-test-1"}
-	vals, err := loadLocalValueMap(context.Background(), dir, env)
+	locals, err := loadLocals(context.Background(), dir, env)
 	require.NoError(t, err)
+	vals := locals.Values
 	// Both a and b should not be resolved, so not present in vals
 	_, aOk := vals["a"]
 	_, bOk := vals["b"]
@@ -361,6 +364,8 @@ locals {
 	require.NoError(t, err)
 	assert.NotNil(t, arts)
 	assert.GreaterOrEqual(t, len(arts), 1)
+	assert.Equal(t, &models.SourceLocation{File: filepath.Join(subdir, "locals.tf"), Line: 7, Column: 20},
+		arts["model1"][0].Source)
 }
 
 func TestLoadModelArtifacts_MissingMap(t *testing.T) {
@@ -547,3 +552,72 @@ func TestGetLocalAttributesDI_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, called)
 }
+
+func TestResolveLocals_Unresolved(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTfFile(t, dir, "locals.tf", `
+variable "no_default" {}
+locals {
+  ok      = "fine"
+  typo    = local.nope
+  unknown = var.no_default
+}
+`)
+	env := models.Environment{Realm: "oc1", Type: "dev", Region: "us-test-1"}
+	locals, err := ResolveLocals(context.Background(), dir, env)
+	require.NoError(t, err)
+	assert.Equal(t, "fine", locals.Values["ok"].AsString())
+
+	require.Len(t, locals.Unresolved, 2)
+	typo := locals.Unresolved[0]
+	assert.Equal(t, "typo", typo.Name)
+	assert.Equal(t, 5, typo.Range.Start.Line)
+	assert.Contains(t, typo.Location, "locals.tf:5,")
+	assert.Contains(t, typo.Diagnostic, "Unsupported attribute")
+	assert.Equal(t, "unknown", locals.Unresolved[1].Name)
+	assert.Contains(t, locals.Unresolved[1].Diagnostic, "Unsupported attribute")
+}
+
+func TestLocals_Locate(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTfFile(t, dir, "main.tf", `locals {
+  base = {
+    a = { size = 1 }
+  }
+  extra = {
+    b = { size = 2 }
+  }
+  all  = merge(local.base, local.extra)
+  keys = { for k, v in local.all : k => v }
+}
+`)
+	locals, err := ResolveLocals(context.Background(), dir, models.Environment{})
+	require.NoError(t, err)
+	file := filepath.Join(dir, "main.tf")
+
+	tests := []struct {
+		path       []string
+		line, col  int
+		wantLocate bool
+	}{
+		{path: []string{"base", "a"}, line: 3, col: 9, wantLocate: true},
+		// merge and local references are followed to the entry.
+		{path: []string{"all", "b"}, line: 6, col: 9, wantLocate: true},
+		{path: []string{"all", "a", "size"}, line: 3, col: 18, wantLocate: true},
+		// A for expression can't be walked; the expression itself is the answer.
+		{path: []string{"keys", "a"}, line: 9, col: 10, wantLocate: true},
+		{path: []string{"missing"}},
+	}
+	for _, tt := range tests {
+		r, ok := locals.locate(tt.path...)
+		require.Equal(t, tt.wantLocate, ok, tt.path)
+		if !ok {
+			continue
+		}
+		assert.Equal(t, file, r.Filename, tt.path)
+		assert.Equal(t, tt.line, r.Start.Line, tt.path)
+		assert.Equal(t, tt.col, r.Start.Column, tt.path)
+	}
+}
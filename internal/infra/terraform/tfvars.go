@@ -0,0 +1,78 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// tfvarsFiles returns the variable files Terraform loads automatically
+// from dirPath, in precedence order (later wins): terraform.tfvars,
+// terraform.tfvars.json, then *.auto.tfvars and *.auto.tfvars.json in
+// lexical order.
+func tfvarsFiles(ctx context.Context, dirPath string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	var base, auto []string
+	for _, e := range entries {
+		name := e.Name()
+		switch {
+		case e.IsDir():
+		case name == "terraform.tfvars", name == "terraform.tfvars.json":
+			base = append(base, filepath.Join(dirPath, name))
+		case strings.HasSuffix(name, ".auto.tfvars"), strings.HasSuffix(name, ".auto.tfvars.json"):
+			auto = append(auto, filepath.Join(dirPath, name))
+		}
+	}
+	// ReadDir sorts by name, which already puts terraform.tfvars before
+	// terraform.tfvars.json and the auto files in lexical order.
+	return slices.Concat(base, auto), nil
+}
+
+// loadTFVars reads the given variable files and returns the merged
+// assignments, later files overriding earlier ones. Values must be
+// literals (as Terraform requires); a file that fails to parse or
+// evaluate is an error so a typo doesn't silently fall back to defaults.
+func loadTFVars(files []string) (map[string]cty.Value, error) {
+	vars := make(map[string]cty.Value)
+	parser := hclparse.NewParser()
+	for _, fpath := range files {
+		var (
+			file  *hcl.File
+			diags hcl.Diagnostics
+		)
+		if strings.HasSuffix(fpath, ".json") {
+			file, diags = parser.ParseJSONFile(fpath)
+		} else {
+			file, diags = parser.ParseHCLFile(fpath)
+		}
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("parse %s: %w", fpath, errors.New(diags.Error()))
+		}
+		attrs, diags := file.Body.JustAttributes()
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("read %s: %w", fpath, errors.New(diags.Error()))
+		}
+		for name, attr := range attrs {
+			val, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				return nil, fmt.Errorf("evaluate %s: %w", fpath, errors.New(diags.Error()))
+			}
+			vars[name] = val
+		}
+	}
+	return vars, nil
+}
@@ -0,0 +1,57 @@
+package terraform
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestResolveLocals_TFVarsPrecedence(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTfFile(t, dir, "main.tf", `
+variable "size" {
+  default = 1
+}
+variable "shape" {
+  default = "VM"
+}
+variable "tag" {}
+locals {
+  summary = format("%s:%d:%s", var.shape, var.size, var.tag)
+}
+`)
+	writeTfFile(t, dir, "terraform.tfvars", `size = 2
+tag = "base"`)
+	writeTfFile(t, dir, "b.auto.tfvars", `size = 4`)
+	writeTfFile(t, dir, "a.auto.tfvars.json", `{"size": 3, "shape": "BM"}`)
+	writeTfFile(t, dir, "other.tfvars", `size = 99`) // not auto-loaded
+	extra := writeTempFile(t, t.TempDir(), "extra.tfvars", `tag = "extra"`)
+	env := models.Environment{Realm: "oc1", Type: "dev", Region: "us-test-1"}
+
+	locals, err := ResolveLocals(context.Background(), dir, env)
+	require.NoError(t, err)
+	assert.Empty(t, locals.Unresolved)
+	assert.Equal(t, "BM:4:base", locals.Values["summary"].AsString())
+
+	locals, err = ResolveLocals(context.Background(), dir, env, extra)
+	require.NoError(t, err)
+	assert.Equal(t, "BM:4:extra", locals.Values["summary"].AsString())
+}
+
+func TestResolveLocals_BadTFVars(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeTfFile(t, dir, "main.tf", `locals { a = 1 }`)
+	writeTfFile(t, dir, "x.auto.tfvars", `size = var.other`)
+	_, err := ResolveLocals(context.Background(), dir, models.Environment{})
+	require.ErrorContains(t, err, "x.auto.tfvars")
+
+	_, err = ResolveLocals(context.Background(), t.TempDir(), models.Environment{}, filepath.Join(dir, "missing.tfvars"))
+	require.Error(t, err)
+}
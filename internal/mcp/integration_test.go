@@ -51,10 +51,26 @@ func (stubLoader) LoadGPUWorkloadsByNode(context.Context, string, models.Environ
 	return nil, nil //nolint:nilnil // empty-map test stub; integration tests never read this
 }
 
+func (stubLoader) LoadPendingGPUWorkloads(context.Context, string, models.Environment) ([]models.PendingGPUWorkload, error) {
+	return nil, nil
+}
+
 func (stubLoader) LoadDedicatedAIClusters(context.Context, string, models.Environment) (map[string][]models.DedicatedAICluster, error) {
 	return nil, nil //nolint:nilnil // empty-map test stub; integration tests never read this
 }
 
+func (stubLoader) LoadEndpoints(context.Context, string, models.Environment) (map[string][]models.Endpoint, error) {
+	return nil, nil //nolint:nilnil // empty-map test stub; integration tests never read this
+}
+
+func (stubLoader) LoadEvents(context.Context, string, models.Environment) (map[string][]models.Event, error) {
+	return nil, nil //nolint:nilnil // empty-map test stub; integration tests never read this
+}
+
+func (stubLoader) LoadWorkRequests(context.Context, string, models.Environment) ([]models.WorkRequest, error) {
+	return nil, nil
+}
+
 func (stubLoader) LoadTenancyOverrideGroup(context.Context, string, models.Environment) (models.TenancyOverrideGroup, error) {
 	return models.TenancyOverrideGroup{}, nil
 }
@@ -95,6 +111,19 @@ func (l partialGPUPoolsLoader) LoadGPUPools(context.Context, string, models.Envi
 	return nil, l.err
 }
 
+// partialGPUNodesLoader makes LoadGPUNodesByPool return the nodes of the
+// clusters that answered with a *terraform.PartialLoadError naming the
+// one that did not.
+type partialGPUNodesLoader struct {
+	stubLoader
+	nodes map[string][]models.GPUNode
+	err   *terraform.PartialLoadError
+}
+
+func (l partialGPUNodesLoader) LoadGPUNodesByPool(context.Context, string, models.Environment) (map[string][]models.GPUNode, error) {
+	return l.nodes, l.err
+}
+
 // fixedGPUPoolsLoader returns scripted pools so EnrichGPUPools's
 // fast-path (len(pools)==0) doesn't short-circuit the new
 // enrichment branch in handleListGPUPools. Everything else inherits
@@ -221,6 +250,30 @@ func TestIntegration_WarnsOnPartialLoad(t *testing.T) {
 	assert.Contains(t, fmt.Sprint(warnings), "oke nodepools dir missing")
 }
 
+func TestIntegration_ListsReachableClustersOnPartialLoad(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	ld := partialGPUNodesLoader{
+		nodes: map[string][]models.GPUNode{"pool-1": {{Name: "n1", NodePool: "pool-1", Cluster: "a"}}},
+		err: &terraform.PartialLoadError{
+			Source: "kube contexts",
+			Errs:   []error{errors.New("cluster b: connection refused")},
+		},
+	}
+	clientSess := newTestPair(ctx, t, ld)
+
+	res, err := clientSess.CallTool(ctx, &sdk.CallToolParams{Name: "list_gpu_nodes"})
+	require.NoError(t, err, "tools/call transport error")
+	require.NotNil(t, res)
+	assert.False(t, res.IsError, "an unreachable cluster should not fail the tool call")
+
+	sc := structured(t, res)
+	assert.EqualValues(t, 1, sc["count"], "the reachable cluster's nodes are listed")
+	assert.Contains(t, fmt.Sprint(sc["warnings"]), "cluster b: connection refused")
+}
+
 // TestIntegration_WarnsOnGPUPoolEnrichmentFailure pins the enrichment
 // branch in handleListGPUPools (TUI parity step). With a non-empty pool
 // slice and a deliberately bad kubeconfig path, resolve.EnrichGPUPools
@@ -330,7 +383,11 @@ func TestIntegration_ToolsListAndCall(t *testing.T) {
 		"list_gpu_pools",
 		"list_gpu_nodes",
 		"list_gpu_workloads",
+		"list_pending_gpu_workloads",
 		"list_dacs",
+		"list_endpoints",
+		"list_events",
+		"list_work_requests",
 		"list_environments",
 		"list_service_tenancies",
 		"list_model_artifacts",
@@ -338,6 +395,7 @@ func TestIntegration_ToolsListAndCall(t *testing.T) {
 		"list_tenancy_overrides",
 		"list_regional_overrides",
 		"list_aliases",
+		"reconcile_gpu_pools",
 		// Mutation tools (all gated on confirm=true; see mutations.go).
 		"cordon_node",
 		"uncordon_node",
@@ -398,3 +456,23 @@ func TestIntegration_ToolsListAndCall(t *testing.T) {
 	assert.Equal(t, envelope.Count, scEnvelope.Count, "structuredContent count matches text envelope")
 	assert.Equal(t, len(envelope.Items), len(scEnvelope.Items), "structuredContent items match text envelope")
 }
+
+// TestIntegration_ReconcileFailsWithoutEnrichment pins the opposite
+// choice for reconcile_gpu_pools: without pool OCIDs every instance
+// would be missing, so an enrichment failure fails the call instead of
+// reporting ghosts that are not there.
+func TestIntegration_ReconcileFailsWithoutEnrichment(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	ld := fixedGPUPoolsLoader{pools: []models.GPUPool{{Name: "p1", Shape: "BM.GPU", Size: 8}}}
+	clientSess := newTestPair(ctx, t, ld, func(c *config.Config) {
+		c.KubeConfig = "/dev/null/no-such-kubeconfig"
+	})
+
+	res, err := clientSess.CallTool(ctx, &sdk.CallToolParams{Name: "reconcile_gpu_pools"})
+	require.NoError(t, err, "tools/call transport error")
+	require.NotNil(t, res)
+	assert.True(t, res.IsError, "reconcile must fail when pools cannot be enriched")
+}
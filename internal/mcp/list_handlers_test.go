@@ -152,6 +152,50 @@ func (f *fakeDACLoader) LoadDedicatedAIClusters(context.Context, string, models.
 	return f.dacs, nil
 }
 
+// fakeEndpointLoader returns scripted LoadEndpoints data. All other
+// methods come from stubLoader.
+type fakeEndpointLoader struct {
+	stubLoader
+	endpoints map[string][]models.Endpoint
+}
+
+func (f *fakeEndpointLoader) LoadEndpoints(context.Context, string, models.Environment) (map[string][]models.Endpoint, error) {
+	return f.endpoints, nil
+}
+
+// fakeEventLoader returns scripted LoadEvents data. All other methods
+// come from stubLoader.
+type fakeEventLoader struct {
+	stubLoader
+	events map[string][]models.Event
+}
+
+func (f *fakeEventLoader) LoadEvents(context.Context, string, models.Environment) (map[string][]models.Event, error) {
+	return f.events, nil
+}
+
+// fakePendingGPUWorkloadLoader returns scripted LoadPendingGPUWorkloads
+// data. All other methods come from stubLoader.
+type fakePendingGPUWorkloadLoader struct {
+	stubLoader
+	pending []models.PendingGPUWorkload
+}
+
+func (f *fakePendingGPUWorkloadLoader) LoadPendingGPUWorkloads(context.Context, string, models.Environment) ([]models.PendingGPUWorkload, error) {
+	return f.pending, nil
+}
+
+// fakeWorkRequestLoader returns scripted LoadWorkRequests data. All
+// other methods come from stubLoader.
+type fakeWorkRequestLoader struct {
+	stubLoader
+	requests []models.WorkRequest
+}
+
+func (f *fakeWorkRequestLoader) LoadWorkRequests(context.Context, string, models.Environment) ([]models.WorkRequest, error) {
+	return f.requests, nil
+}
+
 // fakeModelArtifactLoader returns scripted artifacts from LoadDataset
 // (the only path artifact data flows through). All other methods come
 // from stubLoader.
@@ -187,6 +231,84 @@ func TestList_DACs_FlatShape(t *testing.T) {
 		})
 }
 
+// TestList_Endpoints_FlatShape pins the wire shape: each item is an
+// Endpoint with the hosting DAC already on it as `dedicatedAiCluster`.
+func TestList_Endpoints_FlatShape(t *testing.T) {
+	t.Parallel()
+	loader := &fakeEndpointLoader{
+		endpoints: map[string][]models.Endpoint{
+			"dac-1": {{Name: "ep-1", DedicatedAICluster: "dac-1", LifecycleState: "ACTIVE", ContentModeration: "OFF"}},
+		},
+	}
+	assertGroupedFlatShape(t, "list_endpoints", loader,
+		func(t *testing.T, item map[string]any) {
+			assert.Equal(t, "dac-1", item["dedicatedAiCluster"], "hosting DAC should come through as dedicatedAiCluster")
+			assert.Equal(t, "ep-1", item["name"])
+			assert.Equal(t, "ACTIVE", item["lifecycleState"])
+			assert.Equal(t, "OFF", item["contentModeration"])
+		})
+}
+
+// TestList_Events_FlatShape pins the wire shape: each item is an Event
+// with the involved node or pod already on it as `object`.
+func TestList_Events_FlatShape(t *testing.T) {
+	t.Parallel()
+	loader := &fakeEventLoader{
+		events: map[string][]models.Event{
+			"gpu-1": {{Name: "gpu-1.17a", ObjectKind: models.EventObjectNode, Object: "gpu-1", Type: models.EventTypeWarning, Reason: "Unhealthy", Count: 2}},
+		},
+	}
+	assertGroupedFlatShape(t, "list_events", loader,
+		func(t *testing.T, item map[string]any) {
+			assert.Equal(t, "gpu-1", item["object"], "involved object should come through as object")
+			assert.Equal(t, "Node", item["objectKind"])
+			assert.Equal(t, "Warning", item["type"])
+			assert.Equal(t, "Unhealthy", item["reason"])
+			assert.InDelta(t, 2, item["count"], 0)
+		})
+}
+
+// TestList_PendingGPUWorkloads_Shape pins the wire shape of an
+// unscheduled pod: the scheduler's reason and the pool hint are
+// top-level fields.
+func TestList_PendingGPUWorkloads_Shape(t *testing.T) {
+	t.Parallel()
+	loader := &fakePendingGPUWorkloadLoader{
+		pending: []models.PendingGPUWorkload{{
+			Name: "serv-0", Namespace: "ns1", GPUs: 8,
+			Reason: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", Hint: "pool-a (1 node)",
+		}},
+	}
+	assertGroupedItem(t, "list_pending_gpu_workloads", loader,
+		func(t *testing.T, item map[string]any) {
+			assert.Equal(t, "serv-0", item["name"])
+			assert.Equal(t, "ns1", item["namespace"])
+			assert.InDelta(t, 8, item["gpus"], 0)
+			assert.Equal(t, "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", item["reason"])
+			assert.Equal(t, "pool-a (1 node)", item["hint"])
+		})
+}
+
+// TestList_WorkRequests_Shape pins the wire shape of a failed work
+// request: progress and the service's errors are top-level fields.
+func TestList_WorkRequests_Shape(t *testing.T) {
+	t.Parallel()
+	loader := &fakeWorkRequestLoader{
+		requests: []models.WorkRequest{{
+			ID: "ocid1.wr", Service: models.WorkRequestServiceCompute, Operation: "TerminateInstance",
+			Status: "FAILED", PercentComplete: 40, Errors: []string{"instance is protected"},
+		}},
+	}
+	assertGroupedItem(t, "list_work_requests", loader,
+		func(t *testing.T, item map[string]any) {
+			assert.Equal(t, "ocid1.wr", item["id"])
+			assert.Equal(t, "compute", item["service"])
+			assert.Equal(t, "FAILED", item["status"])
+			assert.InDelta(t, 40, item["percentComplete"], 0)
+			assert.Equal(t, []any{"instance is protected"}, item["errors"])
+		})
+}
+
 // TestList_ModelArtifacts_FlatShape pins the wire shape: each item
 // is a ModelArtifact with the originating base model already on it
 // as `model_name`. No `model` field is added — the loader writes
@@ -280,11 +402,31 @@ func TestList_GPUNodes_Limit_ZeroAndOverflow(t *testing.T) {
 	assert.Equal(t, 4, count(t, nil), "omitting limit should default to unlimited")
 }
 
+func TestList_PendingGPUWorkloads(t *testing.T) {
+	t.Parallel()
+	callList(t, "list_pending_gpu_workloads", nil)
+}
+
+func TestList_WorkRequests(t *testing.T) {
+	t.Parallel()
+	callList(t, "list_work_requests", nil)
+}
+
 func TestList_DACs(t *testing.T) {
 	t.Parallel()
 	callList(t, "list_dacs", nil)
 }
 
+func TestList_Endpoints(t *testing.T) {
+	t.Parallel()
+	callList(t, "list_endpoints", nil)
+}
+
+func TestList_Events(t *testing.T) {
+	t.Parallel()
+	callList(t, "list_events", nil)
+}
+
 func TestList_ModelArtifacts(t *testing.T) {
 	t.Parallel()
 	callList(t, "list_model_artifacts", nil)
@@ -435,3 +577,18 @@ func TestList_InputSchemas_ExposeLimit(t *testing.T) {
 		assert.True(t, found, "expected %s in ListTools response", name)
 	}
 }
+
+func TestReconcileGPUPools(t *testing.T) {
+	t.Parallel()
+	// No pools and no nodes: nothing to ask OCI, an empty report.
+	res := callList(t, "reconcile_gpu_pools", nil)
+	scBytes, err := json.Marshal(res.StructuredContent)
+	require.NoError(t, err)
+	var report struct {
+		Pools    []any `json:"pools"`
+		Findings []any `json:"findings"`
+	}
+	require.NoError(t, json.Unmarshal(scBytes, &report))
+	assert.NotNil(t, report.Pools)
+	assert.Empty(t, report.Findings)
+}
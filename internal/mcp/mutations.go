@@ -24,6 +24,7 @@ import (
 var (
 	mcpSetCordonFn        = k8s.SetCordon
 	mcpDrainNodeFn        = k8s.DrainNode
+	mcpLocateNodeFn       = k8s.LocateNode
 	mcpSoftResetFn        = actions.SoftResetInstance
 	mcpTerminateFn        = actions.TerminateInstance
 	mcpIncreasePoolSizeFn = actions.IncreasePoolSize
@@ -32,7 +33,7 @@ var (
 		return resolve.GPUNode(ctx, s.loader, s.cfg.KubeConfig, env, name, ocid)
 	}
 	mcpResolveGPUPoolFn = func(ctx context.Context, s *Server, env models.Environment, name string) (*models.GPUPool, error) {
-		return resolve.GPUPool(ctx, s.loader, s.cfg.RepoPath, s.cfg.KubeConfig, env, name)
+		return resolve.GPUPool(ctx, s.loader, s.cfg.RepoPath, s.cfg.KubeConfig, s.cfg.KubeContexts, env, name)
 	}
 	mcpUpsertTenantFn = func(s *Server, entry models.TenantMetadata) error {
 		writer, ok := s.loader.(loader.TenantMetadataWriter)
@@ -134,13 +135,15 @@ func (s *Server) runMutationTool(action, kind, target string, confirm bool, perf
 // --- Input types --------------------------------------------------
 
 type cordonNodeInput struct {
-	Node string `json:"node" jsonschema:"the node name as reported by kubectl get nodes"`
+	Node    string `json:"node" jsonschema:"the node name as reported by kubectl get nodes"`
+	Cluster string `json:"cluster,omitempty" jsonschema:"kube context of the node's cluster, one of the environment's; by default the environment's clusters are searched for the node"`
 	confirmGate
 	envOverride
 }
 
 type drainNodeInput struct {
-	Node string `json:"node" jsonschema:"the node name as reported by kubectl get nodes"`
+	Node    string `json:"node" jsonschema:"the node name as reported by kubectl get nodes"`
+	Cluster string `json:"cluster,omitempty" jsonschema:"kube context of the node's cluster, one of the environment's; by default the environment's clusters are searched for the node"`
 	confirmGate
 	envOverride
 }
@@ -201,21 +204,33 @@ func (s *Server) handleMutation(
 
 func (s *Server) handleCordonNode(ctx context.Context, req *sdk.CallToolRequest, in cordonNodeInput) (*sdk.CallToolResult, mutationResult, error) {
 	return s.handleMutation("cordon", "node", in.Node, in.Confirm, in.envOverride, func(env models.Environment) error {
-		_, err := mcpSetCordonFn(ctx, s.cfg.KubeConfig, env.KubeContext(), in.Node, true)
+		kubeCtx, err := resolve.NodeContext(ctx, mcpLocateNodeFn, s.cfg.KubeConfig, s.cfg.KubeContexts, env, in.Cluster, in.Node)
+		if err != nil {
+			return err
+		}
+		_, err = mcpSetCordonFn(ctx, s.cfg.KubeConfig, kubeCtx, in.Node, true)
 		return err
 	})
 }
 
 func (s *Server) handleUncordonNode(ctx context.Context, req *sdk.CallToolRequest, in cordonNodeInput) (*sdk.CallToolResult, mutationResult, error) {
 	return s.handleMutation("uncordon", "node", in.Node, in.Confirm, in.envOverride, func(env models.Environment) error {
-		_, err := mcpSetCordonFn(ctx, s.cfg.KubeConfig, env.KubeContext(), in.Node, false)
+		kubeCtx, err := resolve.NodeContext(ctx, mcpLocateNodeFn, s.cfg.KubeConfig, s.cfg.KubeContexts, env, in.Cluster, in.Node)
+		if err != nil {
+			return err
+		}
+		_, err = mcpSetCordonFn(ctx, s.cfg.KubeConfig, kubeCtx, in.Node, false)
 		return err
 	})
 }
 
 func (s *Server) handleDrainNode(ctx context.Context, req *sdk.CallToolRequest, in drainNodeInput) (*sdk.CallToolResult, mutationResult, error) {
 	return s.handleMutation("drain", "node", in.Node, in.Confirm, in.envOverride, func(env models.Environment) error {
-		return mcpDrainNodeFn(ctx, s.cfg.KubeConfig, env.KubeContext(), in.Node)
+		kubeCtx, err := resolve.NodeContext(ctx, mcpLocateNodeFn, s.cfg.KubeConfig, s.cfg.KubeContexts, env, in.Cluster, in.Node)
+		if err != nil {
+			return err
+		}
+		return mcpDrainNodeFn(ctx, s.cfg.KubeConfig, kubeCtx, in.Node)
 	})
 }
 
@@ -230,13 +245,17 @@ func (s *Server) handleRebootNode(ctx context.Context, req *sdk.CallToolRequest,
 }
 
 func (s *Server) handleTerminateNode(ctx context.Context, req *sdk.CallToolRequest, in terminateNodeInput) (*sdk.CallToolResult, mutationResult, error) {
-	return s.handleMutation("terminate", "node", in.Node, in.Confirm, in.envOverride, func(env models.Environment) error {
+	var workRequest string
+	res, out, err := s.handleMutation("terminate", "node", in.Node, in.Confirm, in.envOverride, func(env models.Environment) error {
 		node, err := mcpResolveGPUNodeFn(ctx, s, env, in.Node, in.OCID)
 		if err != nil {
 			return err
 		}
-		return mcpTerminateFn(ctx, node, env, logging.FromContext(ctx))
+		workRequest, err = mcpTerminateFn(ctx, node, env, logging.FromContext(ctx))
+		return err
 	})
+	out.WorkRequest = workRequest
+	return res, out, err
 }
 
 func (s *Server) handleScaleGPUPool(ctx context.Context, req *sdk.CallToolRequest, in scaleGPUPoolInput) (*sdk.CallToolResult, mutationResult, error) {
@@ -325,7 +344,7 @@ func registerMutationTools(s *Server) {
 
 	sdk.AddTool(s.server, &sdk.Tool{
 		Name:        "terminate_node",
-		Description: "Terminate the OCI instance backing a GPU node (boot volume destroyed). DESTRUCTIVE." + mutationToolFooter,
+		Description: "Terminate the OCI instance backing a GPU node (boot volume destroyed). DESTRUCTIVE. The result's `workRequest` is the OCI work request tracking the termination (see `list_work_requests`)." + mutationToolFooter,
 	}, s.handleTerminateNode)
 
 	sdk.AddTool(s.server, &sdk.Tool{
@@ -86,7 +86,10 @@ func stubAllMutationSeams() (*bool, func()) {
 		return &models.GPUNode{Name: name, ID: ocid}, nil
 	}
 	mcpSoftResetFn = func(context.Context, *models.GPUNode, models.Environment, logging.Logger) error { mark(); return nil }
-	mcpTerminateFn = func(context.Context, *models.GPUNode, models.Environment, logging.Logger) error { mark(); return nil }
+	mcpTerminateFn = func(context.Context, *models.GPUNode, models.Environment, logging.Logger) (string, error) {
+		mark()
+		return "", nil
+	}
 	mcpResolveGPUPoolFn = func(_ context.Context, _ *Server, _ models.Environment, name string) (*models.GPUPool, error) {
 		mark()
 		return &models.GPUPool{Name: name, ID: "ocid1.pool", Size: 1}, nil
@@ -245,9 +248,9 @@ func TestIntegration_TerminateTool_OcidBypass(t *testing.T) {
 	var gotNode *models.GPUNode
 	orig := mcpTerminateFn
 	defer func() { mcpTerminateFn = orig }()
-	mcpTerminateFn = func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) error {
+	mcpTerminateFn = func(_ context.Context, n *models.GPUNode, _ models.Environment, _ logging.Logger) (string, error) {
 		gotNode = n
-		return nil
+		return "ocid1.workrequest.fake", nil
 	}
 
 	clientSess := newTestPair(ctx, t, stubLoader{})
@@ -265,6 +268,7 @@ func TestIntegration_TerminateTool_OcidBypass(t *testing.T) {
 	if gotNode == nil || gotNode.ID != "ocid1.instance.fake" {
 		t.Errorf("expected synthesized node, got: %+v", gotNode)
 	}
+	assert.Equal(t, "ocid1.workrequest.fake", structured(t, res)["workRequest"])
 }
 
 func TestIntegration_RebootTool_ConfirmTrueExecutes(t *testing.T) {
@@ -470,9 +474,9 @@ func TestIntegration_MutationTool_PropagatesEnvOverride(t *testing.T) {
 	gotActionEnv = models.Environment{}
 	origTerm := mcpTerminateFn
 	defer func() { mcpTerminateFn = origTerm }()
-	mcpTerminateFn = func(_ context.Context, _ *models.GPUNode, env models.Environment, _ logging.Logger) error {
+	mcpTerminateFn = func(_ context.Context, _ *models.GPUNode, env models.Environment, _ logging.Logger) (string, error) {
 		gotActionEnv = env
-		return nil
+		return "", nil
 	}
 	_, err = clientSess.CallTool(ctx, &sdk.CallToolParams{
 		Name: "terminate_node",
@@ -692,3 +696,41 @@ func TestIntegration_SetTenantTool_RequiresName(t *testing.T) {
 	require.NotNil(t, res)
 	assert.True(t, res.IsError, "missing name must error")
 }
+
+func TestIntegration_DrainNode_RoutesToCluster(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	var gotContexts []string
+	oDrain, oLocate := mcpDrainNodeFn, mcpLocateNodeFn
+	defer func() { mcpDrainNodeFn, mcpLocateNodeFn = oDrain, oLocate }()
+	mcpDrainNodeFn = func(_ context.Context, _, kubeCtx, _ string) error {
+		gotContexts = append(gotContexts, kubeCtx)
+		return nil
+	}
+	mcpLocateNodeFn = func(context.Context, string, []string, string) (string, error) {
+		return "located-ctx", nil
+	}
+
+	clientSess := newTestPair(ctx, t, stubLoader{}, func(c *config.Config) {
+		c.KubeContexts = models.KubeContexts{"dev-iad": {"located-ctx", "named-ctx"}}
+	})
+	for _, args := range []map[string]any{
+		{"node": "node-a", "confirm": true},
+		{"node": "node-a", "cluster": "named-ctx", "confirm": true},
+	} {
+		res, err := clientSess.CallTool(ctx, &sdk.CallToolParams{Name: "drain_node", Arguments: args})
+		require.NoError(t, err)
+		assert.False(t, res.IsError)
+	}
+	assert.Equal(t, []string{"located-ctx", "named-ctx"}, gotContexts,
+		"an unnamed cluster is looked up; a named one is used as is")
+
+	res, err := clientSess.CallTool(ctx, &sdk.CallToolParams{Name: "drain_node", Arguments: map[string]any{
+		"node": "node-a", "cluster": "other-ctx", "confirm": true,
+	}})
+	require.NoError(t, err)
+	assert.True(t, res.IsError, "a cluster outside the environment is rejected")
+	assert.Contains(t, resultText(t, res), `cluster "other-ctx" is not a kube context of dev-iad`)
+	assert.Len(t, gotContexts, 2, "the rejected call must not drain")
+}
@@ -129,12 +129,15 @@ type listResult[T any] struct {
 
 // mutationResult is the success envelope every mutating tool returns.
 // Distinct from listResult so the OutputSchema reflects the actual
-// payload shape (no `count: 0` / `items: []` noise).
+// payload shape (no `count: 0` / `items: []` noise). WorkRequest is
+// the OCI work request tracking an asynchronous mutation, when the
+// service returns one.
 type mutationResult struct {
-	Status string `json:"status"`
-	Action string `json:"action"`
-	Kind   string `json:"kind"`
-	Target string `json:"target"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+	Kind        string `json:"kind"`
+	Target      string `json:"target"`
+	WorkRequest string `json:"workRequest,omitempty"`
 }
 
 // jsonResult wraps items in the standard listResult envelope. Callers
@@ -206,5 +209,24 @@ func warningsFromPartial(err error) []string {
 	return out
 }
 
+// partialWarnings splits a load error: a partial load is not fatal and
+// yields its per-source warnings, any other error is returned. The client
+// sees the warnings via listResult.Warnings; the log line is for operators
+// reading cfg.LogFile, matching what mutations already do.
+func (s *Server) partialWarnings(what string, err error) ([]string, error) {
+	warnings := warningsFromPartial(err)
+	if err != nil && len(warnings) == 0 {
+		return nil, err
+	}
+	if len(warnings) > 0 {
+		s.logger.Warnw(
+			what, "surface", "mcp",
+			"phase", "partial", "sources", len(warnings),
+			"warnings", strings.Join(warnings, "; "),
+		)
+	}
+	return warnings, nil
+}
+
 // normFilter applies the same fuzzy substring matching the CLI uses.
 func normFilter(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
@@ -4,13 +4,13 @@ import (
 	"context"
 	"fmt"
 	"sort"
-	"strings"
 
 	sdk "github.com/modelcontextprotocol/go-sdk/mcp"
 
 	"github.com/jingle2008/toolkit/internal/cli/output"
 	"github.com/jingle2008/toolkit/internal/collections"
 	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/reconcile"
 	"github.com/jingle2008/toolkit/internal/resolve"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
@@ -66,19 +66,39 @@ func registerTools(s *Server) {
 
 	sdk.AddTool(s.server, &sdk.Tool{
 		Name:        "list_gpu_nodes",
-		Description: "List GPU nodes across all pools as a flat array. The originating pool is preserved on each item as `poolName`. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
+		Description: "List GPU nodes across all pools as a flat array. The originating pool is preserved on each item as `poolName`. `allocatable`/`allocated` sum every configured accelerator resource; `resources` breaks them down per resource as {name, allocatable, allocated} (e.g. `amd.com/gpu`, `nvidia.com/mig-1g.10gb`). `findings` lists the health rules the node matched as {rule, severity, message} (`issues` holds their messages); error findings make the node unhealthy. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
 	}, s.handleListGPUNodes)
 
 	sdk.AddTool(s.server, &sdk.Tool{
 		Name:        "list_gpu_workloads",
-		Description: "List GPU-consuming pods (workloads) across all nodes as a flat array. The hosting node is preserved on each item as `node`. Each item is {name, node, tenantId, namespace, model, runtime, gpus, mode}; model/runtime/mode are best-effort and blank for non-serving GPU pods. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
+		Description: "List GPU-consuming pods (workloads) across all nodes as a flat array. The hosting node is preserved on each item as `node`. Each item is {name, node, tenantId, namespace, model, runtime, gpus, restarts, age, mode, containers, crashLoop, lastRestart, resources}; `gpus` sums the pod's accelerator requests and `resources` breaks them down as {name, count}. model/runtime/mode are best-effort and blank for non-serving GPU pods. `containers` lists each main container's {name, ready, restarts, state, lastTerminationReason, lastExitCode, lastRestart} — `state` is `Running` or the waiting/terminated reason such as `CrashLoopBackOff`; `crashLoop` is true when any container is crash-looping. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
 	}, s.handleListGPUWorkloads)
 
+	sdk.AddTool(s.server, &sdk.Tool{
+		Name:        "list_pending_gpu_workloads",
+		Description: "List GPU-requesting pods that are Pending without a node (the scheduler could not place them), most GPUs first. Each item is {name, namespace, tenantId, model, gpus, age, reason, hint}: `reason` is the latest FailedScheduling message (blank if the scheduler has not rejected the pod yet, which also makes the item non-faulty); `hint` names pools with a Ready, uncordoned node that has enough free GPUs, or says none has. Supports `limit` (max items after filter; 0 = unlimited).",
+	}, s.handleListPendingGPUWorkloads)
+
 	sdk.AddTool(s.server, &sdk.Tool{
 		Name:        "list_dacs",
 		Description: "List dedicated AI clusters as a flat array. The owning tenant is preserved on each item as `tenantId`. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
 	}, s.handleListDACs)
 
+	sdk.AddTool(s.server, &sdk.Tool{
+		Name:        "list_endpoints",
+		Description: "List GenAI endpoints hosted on dedicated AI clusters as a flat array. The hosting DAC is preserved on each item as `dedicatedAiCluster`. Each item is {name, displayName, dedicatedAiCluster, compartmentId, modelId, lifecycleState, contentModeration, age}. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
+	}, s.handleListEndpoints)
+
+	sdk.AddTool(s.server, &sdk.Tool{
+		Name:        "list_events",
+		Description: "List Kubernetes events for GPU nodes and GPU workload pods as a flat array, most recent first within each object. The involved node or pod name is preserved on each item as `object` (with `objectKind` Node or Pod). Each item is {name, namespace, objectKind, object, type, reason, count, firstSeen, lastSeen, message}; `type` Warning marks a faulty event. Supports `limit` (max items after filter, across all groups; 0 = unlimited).",
+	}, s.handleListEvents)
+
+	sdk.AddTool(s.server, &sdk.Tool{
+		Name:        "list_work_requests",
+		Description: "List recent OCI work requests (asynchronous operations such as instance termination, instance pool updates and DAC deletion) from the Core Services (`service` compute) and Generative AI (`service` genai) work request APIs, newest first. Each item is {id, service, operation, status, percentComplete, resources, errors, timeAccepted, timeFinished, age}; `status` FAILED marks a faulty item and `errors` holds the service's messages. `terminate_node` returns the `workRequest` id to look for here. Supports `limit` (max items after filter; 0 = unlimited).",
+	}, s.handleListWorkRequests)
+
 	sdk.AddTool(s.server, &sdk.Tool{
 		Name:        "list_environments",
 		Description: "List all known toolkit environments (type/region/realm tuples). No env_override needed; returns all envs visible to the configured repo. Supports `limit` (max items after filter; 0 = unlimited).",
@@ -114,6 +134,11 @@ func registerTools(s *Server) {
 		Description: "Discovery tool. Lists each registered alias and its canonical category name. Useful for an agent that wants to confirm short codes before calling other tools.",
 	}, s.handleListAliases)
 
+	sdk.AddTool(s.server, &sdk.Tool{
+		Name:        "reconcile_gpu_pools",
+		Description: "Find ghost GPU capacity: lists the instances of every GPU instance pool and cluster network from OCI and matches them against the cluster's GPU nodes by instance OCID. Returns {pools, findings, warnings}: `pools` is {name, id, actualSize, instances, joined, skipped} per pool (`skipped` says why a pool was not listed, e.g. OKE-managed); each finding is {kind, pool, instance, instanceName, node, state, detail} where `kind` is `not-joined` (an instance older than 15 minutes with no node), `dead-instance` (a node whose instance is stopped, terminated or gone) or `size-mismatch` (a pool whose actualSize differs from its joined nodes). Read-only; fails if OCI or the cluster cannot be reached.",
+	}, s.handleReconcileGPUPools)
+
 	registerMutationTools(s)
 }
 
@@ -152,42 +177,35 @@ func (s *Server) handleListTenants(ctx context.Context, req *sdk.CallToolRequest
 
 func (s *Server) handleListBaseModels(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.BaseModel], error) {
 	items, err := s.loader.LoadBaseModels(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load base models", err)
 	if err != nil {
 		return failTool[listResult[models.BaseModel]]("load base models", err)
 	}
-	return listFlatResult(items, in.Filter, in.Limit, nil)
+	return listFlatResult(items, in.Filter, in.Limit, warnings)
 }
 
 func (s *Server) handleListImportedModels(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.ImportedModel], error) {
 	grouped, err := s.loader.LoadImportedModels(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load imported models", err)
 	if err != nil {
 		return failTool[listResult[models.ImportedModel]]("load imported models", err)
 	}
 	// Each item carries its own `tenantId` field, mirroring DAC's
 	// post-wrapper-drop shape — no group key needs to be injected.
-	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), nil)
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
 }
 
 func (s *Server) handleListGPUPools(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.GPUPool], error) {
 	env := s.envFor(in.envOverride)
 	items, err := s.loader.LoadGPUPools(ctx, s.cfg.RepoPath, env)
-	warnings := warningsFromPartial(err)
-	if err != nil && len(warnings) == 0 {
+	warnings, err := s.partialWarnings("load gpu pools", err)
+	if err != nil {
 		return failTool[listResult[models.GPUPool]]("load gpu pools", err)
 	}
-	// The client sees these via listResult.Warnings; the log line is for
-	// operators reading cfg.LogFile, matching what mutations already do.
-	if len(warnings) > 0 {
-		s.logger.Warnw(
-			"load gpu pools", "surface", "mcp",
-			"phase", "partial", "sources", len(warnings),
-			"warnings", strings.Join(warnings, "; "),
-		)
-	}
 	// Enrich ActualSize / Status from OCI's ListInstancePools (same
 	// step the TUI runs after load). Degrades to a warning if the K8s
 	// or OCI call fails so callers still get Terraform-derived data.
-	if err := resolve.EnrichGPUPools(ctx, items, s.cfg.KubeConfig, env); err != nil {
+	if err := resolve.EnrichGPUPools(ctx, items, s.cfg.KubeConfig, s.cfg.KubeContexts, env); err != nil {
 		warnings = append(warnings, "enrichment incomplete: "+err.Error())
 		s.logger.Warnw(
 			"load gpu pools", "surface", "mcp",
@@ -197,35 +215,86 @@ func (s *Server) handleListGPUPools(ctx context.Context, req *sdk.CallToolReques
 	return listFlatResult(items, in.Filter, in.Limit, warnings)
 }
 
+func (s *Server) handleReconcileGPUPools(ctx context.Context, req *sdk.CallToolRequest, in envOverride) (*sdk.CallToolResult, reconcile.Report, error) {
+	report, err := reconcile.Run(ctx, s.loader, s.cfg.RepoPath, s.cfg.KubeConfig, s.cfg.KubeContexts, s.envFor(in))
+	if err != nil {
+		return failTool[reconcile.Report]("reconcile gpu pools", err)
+	}
+	return &sdk.CallToolResult{}, report, nil
+}
+
 func (s *Server) handleListGPUNodes(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.GPUNode], error) {
 	grouped, err := s.loader.LoadGPUNodesByPool(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load gpu nodes", err)
 	if err != nil {
 		return failTool[listResult[models.GPUNode]]("load gpu nodes", err)
 	}
 	// No wrapper: GPUNode.NodePool (JSON `poolName`) already carries
 	// the group key. Wrapping would duplicate.
-	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), nil)
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
 }
 
 func (s *Server) handleListGPUWorkloads(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.GPUWorkload], error) {
 	grouped, err := s.loader.LoadGPUWorkloadsByNode(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load gpu workloads", err)
 	if err != nil {
 		return failTool[listResult[models.GPUWorkload]]("load gpu workloads", err)
 	}
 	// No wrapper: GPUWorkload.Node (JSON `node`) already carries the
 	// group key. Wrapping would duplicate.
-	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), nil)
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
+}
+
+func (s *Server) handleListPendingGPUWorkloads(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.PendingGPUWorkload], error) {
+	items, err := s.loader.LoadPendingGPUWorkloads(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load pending gpu workloads", err)
+	if err != nil {
+		return failTool[listResult[models.PendingGPUWorkload]]("load pending gpu workloads", err)
+	}
+	return listFlatResult(items, in.Filter, in.Limit, warnings)
+}
+
+func (s *Server) handleListWorkRequests(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.WorkRequest], error) {
+	items, err := s.loader.LoadWorkRequests(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load work requests", err)
+	if err != nil {
+		return failTool[listResult[models.WorkRequest]]("load work requests", err)
+	}
+	return listFlatResult(items, in.Filter, in.Limit, warnings)
 }
 
 func (s *Server) handleListDACs(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.DedicatedAICluster], error) {
 	grouped, err := s.loader.LoadDedicatedAIClusters(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load dedicated AI clusters", err)
 	if err != nil {
 		return failTool[listResult[models.DedicatedAICluster]]("load dedicated AI clusters", err)
 	}
 	// No wrapper: the loader keys this map by dac.TenantID
 	// (internal/infra/k8s/dac.go:157), which is already the flat
 	// `tenantId` field on each value. Wrapping would duplicate.
-	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), nil)
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
+}
+
+func (s *Server) handleListEndpoints(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.Endpoint], error) {
+	grouped, err := s.loader.LoadEndpoints(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load endpoints", err)
+	if err != nil {
+		return failTool[listResult[models.Endpoint]]("load endpoints", err)
+	}
+	// No wrapper: Endpoint.DedicatedAICluster (JSON
+	// `dedicatedAiCluster`) already carries the group key.
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
+}
+
+func (s *Server) handleListEvents(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.Event], error) {
+	grouped, err := s.loader.LoadEvents(ctx, s.cfg.KubeConfig, s.envFor(in.envOverride))
+	warnings, err := s.partialWarnings("load events", err)
+	if err != nil {
+		return failTool[listResult[models.Event]]("load events", err)
+	}
+	// No wrapper: Event.Object (JSON `object`) already carries the
+	// group key.
+	return jsonResult(flattenGrouped(grouped, in.Filter, in.Limit), warnings)
 }
 
 func (s *Server) handleListEnvironments(ctx context.Context, req *sdk.CallToolRequest, in listInput) (*sdk.CallToolResult, listResult[models.Environment], error) {
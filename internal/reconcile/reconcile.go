@@ -0,0 +1,326 @@
+/*
+Package reconcile matches the instances in each GPU instance pool and
+cluster network against the cluster's GPU nodes, by instance OCID, to
+find the ghosts that cost GPU capacity without serving it: instances
+that never joined the cluster, nodes whose instance is stopped or gone,
+and pools whose size differs from the number of nodes that joined.
+Used by `toolkit reconcile` and the MCP reconcile_gpu_pools tool.
+*/
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/internal/resolve"
+	"github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// Finding kinds.
+const (
+	// KindNotJoined is an instance in a pool with no GPU node.
+	KindNotJoined = "not-joined"
+	// KindDeadInstance is a GPU node whose instance is stopped,
+	// terminated or gone.
+	KindDeadInstance = "dead-instance"
+	// KindSizeMismatch is a pool whose actual size differs from the
+	// number of its instances that joined as GPU nodes, counting those
+	// still within JoinGrace as joined.
+	KindSizeMismatch = "size-mismatch"
+)
+
+// JoinGrace is how long a new instance may take to boot and join the
+// cluster before it is reported as not joined.
+const JoinGrace = 15 * time.Minute
+
+// Finding is one ghost.
+type Finding struct {
+	Kind         string `json:"kind"`
+	Pool         string `json:"pool,omitempty"`
+	Instance     string `json:"instance,omitempty"`
+	InstanceName string `json:"instanceName,omitempty"`
+	Node         string `json:"node,omitempty"`
+	State        string `json:"state,omitempty"`
+	Detail       string `json:"detail"`
+}
+
+// PoolReport summarizes one pool. Skipped says why a pool was not
+// reconciled; its counts are then zero.
+type PoolReport struct {
+	Name       string `json:"name"`
+	ID         string `json:"id,omitempty"`
+	ActualSize int    `json:"actualSize"`
+	Instances  int    `json:"instances"`
+	Joined     int    `json:"joined"`
+	Skipped    string `json:"skipped,omitempty"`
+}
+
+// Report is the result of a reconciliation. Warnings lists the sources
+// that loaded only partially.
+type Report struct {
+	Pools    []PoolReport `json:"pools"`
+	Findings []Finding    `json:"findings"`
+	Warnings []string     `json:"warnings,omitempty"`
+}
+
+// Loader is the part of loader.Composite Run reads.
+type Loader interface {
+	loader.GPUPoolLoader
+	loader.GPUNodeLoader
+}
+
+// Seam variables — tests swap them to avoid touching a live cluster or
+// OCI tenancy.
+var (
+	enrichGPUPoolsFn = resolve.EnrichGPUPools
+	compartmentIDFn  = resolve.CompartmentID
+	newPoolClient    = func(env models.Environment) (oci.PoolInstanceClient, error) {
+		return oci.NewComputeManagementClient(env)
+	}
+	newInstanceClient = func(env models.Environment) (oci.InstanceClient, error) {
+		return oci.NewComputeClient(env)
+	}
+)
+
+/*
+Run reconciles env's GPU pools from the repo at repoPath against its
+GPU nodes. Pools are enriched with their OCIDs and actual sizes as
+`toolkit get gpupool` does, their instances are listed, and every node
+whose instance is in no listed pool (e.g. OKE-managed nodes) is looked
+up on its own. A partial pool load is reported in Warnings; any other
+failure aborts the run, since a report missing a source would flag
+ghosts that are not there.
+*/
+func Run(
+	ctx context.Context, ld Loader, repoPath, kubeConfig string,
+	contexts models.KubeContexts, env models.Environment,
+) (Report, error) {
+	var warnings []string
+	pools, err := ld.LoadGPUPools(ctx, repoPath, env)
+	if err != nil {
+		partial, ok := errors.AsType[*terraform.PartialLoadError](err)
+		if !ok {
+			return Report{}, fmt.Errorf("load gpu pools: %w", err)
+		}
+		logging.FromContext(ctx).Warnw("gpu pools loaded with partial failures", "error", err)
+		for _, e := range partial.Errs {
+			warnings = append(warnings, e.Error())
+		}
+	}
+	if err := enrichGPUPoolsFn(ctx, pools, kubeConfig, contexts, env); err != nil {
+		return Report{}, fmt.Errorf("enrich gpu pools: %w", err)
+	}
+	grouped, err := ld.LoadGPUNodesByPool(ctx, kubeConfig, env)
+	if err != nil {
+		return Report{}, fmt.Errorf("load gpu nodes: %w", err)
+	}
+	var nodes []models.GPUNode
+	for _, group := range grouped {
+		nodes = append(nodes, group...)
+	}
+
+	instances := map[string][]oci.Instance{}
+	if ids := poolIDs(pools); len(ids) > 0 {
+		compartmentID, err := compartmentIDFn(ctx, kubeConfig, contexts, env)
+		if err != nil {
+			return Report{}, fmt.Errorf("resolve compartment ID: %w", err)
+		}
+		client, err := newPoolClient(env)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to create compute management client: %w", err)
+		}
+		if instances, err = oci.LoadPoolInstances(ctx, client, compartmentID, ids); err != nil {
+			return Report{}, err
+		}
+	}
+
+	nodeInstances := map[string]oci.Instance{}
+	if ids := unlistedNodes(nodes, instances); len(ids) > 0 {
+		client, err := newInstanceClient(env)
+		if err != nil {
+			return Report{}, fmt.Errorf("failed to create compute client: %w", err)
+		}
+		if nodeInstances, err = oci.LoadInstances(ctx, client, ids); err != nil {
+			return Report{}, err
+		}
+	}
+
+	report := Build(pools, instances, nodes, nodeInstances, time.Now())
+	report.Warnings = warnings
+	return report, nil
+}
+
+// reconciled reports whether p's instances can be listed: it is an
+// instance pool (not an OKE-managed node pool) that OCI knows.
+func reconciled(p models.GPUPool) bool {
+	return !p.IsOkeManaged && p.ID != ""
+}
+
+// poolIDs returns the OCIDs of the pools that are reconciled.
+func poolIDs(pools []models.GPUPool) []string {
+	var ids []string
+	for _, p := range pools {
+		if reconciled(p) {
+			ids = append(ids, p.ID)
+		}
+	}
+	return ids
+}
+
+// unlistedNodes returns the instance OCIDs of the nodes whose instance
+// is in none of the listed pools, sorted.
+func unlistedNodes(nodes []models.GPUNode, instances map[string][]oci.Instance) []string {
+	listed := map[string]bool{}
+	for _, insts := range instances {
+		for _, inst := range insts {
+			listed[inst.ID] = true
+		}
+	}
+	var ids []string
+	for _, n := range nodes {
+		if id := oci.InstanceID(n.ID); id != "" && !listed[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+/*
+Build joins pools, the instances listed for them (keyed by pool OCID),
+GPU nodes, and the instances of nodes in no listed pool (keyed by
+instance OCID) into a report. It makes no calls; now dates instance
+ages. Pools are reported in name order, then each pool's findings,
+then those for nodes outside any listed pool in node name order.
+*/
+func Build(
+	pools []models.GPUPool,
+	instances map[string][]oci.Instance,
+	nodes []models.GPUNode,
+	nodeInstances map[string]oci.Instance,
+	now time.Time,
+) Report {
+	nodeByInstance := make(map[string]models.GPUNode, len(nodes))
+	for _, n := range nodes {
+		nodeByInstance[oci.InstanceID(n.ID)] = n
+	}
+
+	sorted := append([]models.GPUPool(nil), pools...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	report := Report{Pools: []PoolReport{}, Findings: []Finding{}}
+	seen := map[string]bool{}
+	for _, p := range sorted {
+		pr := PoolReport{Name: p.Name, ID: p.ID, ActualSize: p.ActualSize}
+		switch {
+		case p.IsOkeManaged:
+			pr.Skipped = "OKE-managed node pool"
+		case p.ID == "":
+			pr.Skipped = "not found in OCI"
+		}
+		if pr.Skipped != "" {
+			report.Pools = append(report.Pools, pr)
+			continue
+		}
+		booting := 0
+		for _, inst := range instances[p.ID] {
+			seen[inst.ID] = true
+			if !leaving(inst.State) {
+				pr.Instances++
+			}
+			n, hasNode := nodeByInstance[inst.ID]
+			switch {
+			case hasNode && gone(inst.State):
+				report.Findings = append(report.Findings, deadInstance(p.Name, n, inst))
+			case hasNode:
+				pr.Joined++
+			case leaving(inst.State):
+			case !gone(inst.State) && !inst.Created.IsZero() && now.Sub(inst.Created) < JoinGrace:
+				// Still booting: expected to join, so not a mismatch
+				// either.
+				booting++
+			default:
+				report.Findings = append(report.Findings, Finding{
+					Kind:         KindNotJoined,
+					Pool:         p.Name,
+					Instance:     inst.ID,
+					InstanceName: inst.Name,
+					State:        inst.State,
+					Detail:       notJoinedDetail(inst, now),
+				})
+			}
+		}
+		if pr.ActualSize != pr.Joined+booting {
+			detail := fmt.Sprintf("actual size %d, %d node(s) joined", pr.ActualSize, pr.Joined)
+			if booting > 0 {
+				detail += fmt.Sprintf(", %d booting", booting)
+			}
+			report.Findings = append(report.Findings, Finding{
+				Kind:   KindSizeMismatch,
+				Pool:   p.Name,
+				Detail: detail,
+			})
+		}
+		report.Pools = append(report.Pools, pr)
+	}
+
+	others := append([]models.GPUNode(nil), nodes...)
+	sort.SliceStable(others, func(i, j int) bool { return others[i].Name < others[j].Name })
+	for _, n := range others {
+		id := oci.InstanceID(n.ID)
+		if seen[id] {
+			continue
+		}
+		if inst, ok := nodeInstances[id]; ok && gone(inst.State) {
+			report.Findings = append(report.Findings, deadInstance(n.NodePool, n, inst))
+		}
+	}
+	return report
+}
+
+// leaving reports whether an instance in state is being removed from
+// its pool.
+func leaving(state string) bool {
+	return state == "TERMINATING" || state == "TERMINATED"
+}
+
+// gone reports whether an instance in state can no longer serve as a
+// node.
+func gone(state string) bool {
+	switch state {
+	case "STOPPING", "STOPPED", "TERMINATING", "TERMINATED", oci.InstanceNotFound:
+		return true
+	}
+	return false
+}
+
+func deadInstance(pool string, n models.GPUNode, inst oci.Instance) Finding {
+	detail := "node's instance is " + inst.State
+	if inst.State == oci.InstanceNotFound {
+		detail = "node's instance no longer exists"
+	}
+	return Finding{
+		Kind:         KindDeadInstance,
+		Pool:         pool,
+		Instance:     inst.ID,
+		InstanceName: inst.Name,
+		Node:         n.Name,
+		State:        inst.State,
+		Detail:       detail,
+	}
+}
+
+func notJoinedDetail(inst oci.Instance, now time.Time) string {
+	if inst.Created.IsZero() {
+		return "no GPU node for this instance"
+	}
+	return "no GPU node for this instance, created " + k8s.FormatAge(now.Sub(inst.Created)) + " ago"
+}
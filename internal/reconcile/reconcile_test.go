@@ -0,0 +1,192 @@
+//nolint:paralleltest // Run tests mutate shared seam vars and must run sequentially
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/terraform"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var now = time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+func inst(id, state string, age time.Duration) oci.Instance {
+	return oci.Instance{ID: id, Name: "inst-" + id, State: state, Created: now.Add(-age)}
+}
+
+func TestBuild(t *testing.T) {
+	pools := []models.GPUPool{
+		{Name: "pool-b", ID: "pb", ActualSize: 2},
+		{Name: "pool-a", ID: "pa", ActualSize: 4},
+		{Name: "pool-c", ID: "pc", ActualSize: 2},
+		{Name: "oke", IsOkeManaged: true},
+		{Name: "unapplied"},
+	}
+	instances := map[string][]oci.Instance{
+		"pa": {
+			inst("i1", "RUNNING", time.Hour),        // joined
+			inst("i2", "RUNNING", 3*time.Hour),      // never joined
+			inst("i3", "RUNNING", 5*time.Minute),    // still booting
+			inst("i4", "STOPPED", 2*time.Hour),      // node, instance stopped
+			inst("i5", "TERMINATING", time.Hour),    // leaving, no node
+			inst("i6", "STOPPED", 48*time.Hour),     // stopped, no node
+			inst("i7", "PROVISIONING", time.Minute), // still booting
+		},
+		"pb": {inst("i8", "RUNNING", time.Hour), inst("i9", "RUNNING", time.Hour)},
+		// Just scaled up: the only unjoined instance is within grace.
+		"pc": {inst("i10", "RUNNING", time.Hour), inst("i11", "PROVISIONING", 2*time.Minute)},
+	}
+	nodes := []models.GPUNode{
+		{Name: "n1", ID: "i1", NodePool: "pool-a"},
+		{Name: "n4", ID: "oci://i4", NodePool: "pool-a"},
+		{Name: "n8", ID: "i8", NodePool: "pool-b"},
+		{Name: "n9", ID: "i9", NodePool: "pool-b"},
+		{Name: "n10", ID: "i10", NodePool: "pool-c"},
+		{Name: "oke-1", ID: "k1", NodePool: "oke"},
+		{Name: "oke-2", ID: "k2", NodePool: "oke"},
+		{Name: "oke-3", ID: "k3", NodePool: "oke"},
+	}
+	nodeInstances := map[string]oci.Instance{
+		"k1": inst("k1", "RUNNING", time.Hour),
+		"k2": {ID: "k2", State: oci.InstanceNotFound},
+		"k3": inst("k3", "TERMINATED", time.Hour),
+	}
+
+	got := Build(pools, instances, nodes, nodeInstances, now)
+
+	assert.Equal(t, []PoolReport{
+		{Name: "oke", Skipped: "OKE-managed node pool"},
+		{Name: "pool-a", ID: "pa", ActualSize: 4, Instances: 6, Joined: 1},
+		{Name: "pool-b", ID: "pb", ActualSize: 2, Instances: 2, Joined: 2},
+		{Name: "pool-c", ID: "pc", ActualSize: 2, Instances: 2, Joined: 1},
+		{Name: "unapplied", Skipped: "not found in OCI"},
+	}, got.Pools)
+	assert.Equal(t, []Finding{
+		{
+			Kind: KindNotJoined, Pool: "pool-a", Instance: "i2", InstanceName: "inst-i2", State: "RUNNING",
+			Detail: "no GPU node for this instance, created 3h ago",
+		},
+		{
+			Kind: KindDeadInstance, Pool: "pool-a", Instance: "i4", InstanceName: "inst-i4", Node: "n4", State: "STOPPED",
+			Detail: "node's instance is STOPPED",
+		},
+		{
+			Kind: KindNotJoined, Pool: "pool-a", Instance: "i6", InstanceName: "inst-i6", State: "STOPPED",
+			Detail: "no GPU node for this instance, created 2d ago",
+		},
+		{Kind: KindSizeMismatch, Pool: "pool-a", Detail: "actual size 4, 1 node(s) joined, 2 booting"},
+		{
+			Kind: KindDeadInstance, Pool: "oke", Instance: "k2", Node: "oke-2", State: oci.InstanceNotFound,
+			Detail: "node's instance no longer exists",
+		},
+		{
+			Kind: KindDeadInstance, Pool: "oke", Instance: "k3", InstanceName: "inst-k3", Node: "oke-3", State: "TERMINATED",
+			Detail: "node's instance is TERMINATED",
+		},
+	}, got.Findings)
+}
+
+func TestBuild_Empty(t *testing.T) {
+	got := Build(nil, nil, nil, nil, now)
+	assert.Equal(t, Report{Pools: []PoolReport{}, Findings: []Finding{}}, got)
+}
+
+type stubLoader struct {
+	pools    []models.GPUPool
+	poolsErr error
+	nodes    map[string][]models.GPUNode
+}
+
+func (l stubLoader) LoadGPUPools(context.Context, string, models.Environment) ([]models.GPUPool, error) {
+	return l.pools, l.poolsErr
+}
+
+func (l stubLoader) LoadGPUNodesByPool(context.Context, string, models.Environment) (map[string][]models.GPUNode, error) {
+	return l.nodes, nil
+}
+
+type fakePoolClient struct{ compartment string }
+
+func (f *fakePoolClient) ListInstancePoolInstances(_ context.Context, req core.ListInstancePoolInstancesRequest) (core.ListInstancePoolInstancesResponse, error) {
+	f.compartment = *req.CompartmentId
+	return core.ListInstancePoolInstancesResponse{Items: []core.InstanceSummary{{
+		Id:          common.String("i1"),
+		State:       common.String("Running"),
+		TimeCreated: &common.SDKTime{Time: time.Now().Add(-time.Hour)},
+	}, {
+		Id:          common.String("i2"),
+		State:       common.String("Running"),
+		TimeCreated: &common.SDKTime{Time: time.Now().Add(-time.Hour)},
+	}}}, nil
+}
+
+type fakeInstanceClient struct{ asked []string }
+
+func (f *fakeInstanceClient) GetInstance(_ context.Context, req core.GetInstanceRequest) (core.GetInstanceResponse, error) {
+	f.asked = append(f.asked, *req.InstanceId)
+	return core.GetInstanceResponse{Instance: core.Instance{LifecycleState: core.InstanceLifecycleStateStopped}}, nil
+}
+
+// stubSeams replaces the OCI and compartment seams for one test.
+func stubSeams(t *testing.T, enrichErr error) (*fakePoolClient, *fakeInstanceClient) {
+	t.Helper()
+	origEnrich, origCompartment, origPool, origInstance := enrichGPUPoolsFn, compartmentIDFn, newPoolClient, newInstanceClient
+	t.Cleanup(func() {
+		enrichGPUPoolsFn, compartmentIDFn, newPoolClient, newInstanceClient = origEnrich, origCompartment, origPool, origInstance
+	})
+	pools, instances := &fakePoolClient{}, &fakeInstanceClient{}
+	enrichGPUPoolsFn = func(_ context.Context, pools []models.GPUPool, _ string, _ models.KubeContexts, _ models.Environment) error {
+		for i := range pools {
+			pools[i].ID, pools[i].ActualSize = "id-"+pools[i].Name, 2
+		}
+		return enrichErr
+	}
+	compartmentIDFn = func(context.Context, string, models.KubeContexts, models.Environment) (string, error) {
+		return "comp", nil
+	}
+	newPoolClient = func(models.Environment) (oci.PoolInstanceClient, error) { return pools, nil }
+	newInstanceClient = func(models.Environment) (oci.InstanceClient, error) { return instances, nil }
+	return pools, instances
+}
+
+func TestRun(t *testing.T) {
+	poolClient, instanceClient := stubSeams(t, nil)
+	ld := stubLoader{
+		pools:    []models.GPUPool{{Name: "pool-a"}},
+		poolsErr: &terraform.PartialLoadError{Errs: []error{errors.New("cluster networks: boom")}},
+		nodes: map[string][]models.GPUNode{
+			"pool-a": {{Name: "n1", ID: "i1"}},
+			"other":  {{Name: "n9", ID: "i9"}},
+		},
+	}
+
+	got, err := Run(context.Background(), ld, "/repo", "kubeconfig", nil, models.Environment{})
+	require.NoError(t, err)
+	assert.Equal(t, "comp", poolClient.compartment)
+	assert.Equal(t, []string{"i9"}, instanceClient.asked, "only nodes outside the listed pools are looked up")
+	assert.Equal(t, []string{"cluster networks: boom"}, got.Warnings)
+	assert.Equal(t, []PoolReport{{Name: "pool-a", ID: "id-pool-a", ActualSize: 2, Instances: 2, Joined: 1}}, got.Pools)
+	kinds := make([]string, len(got.Findings))
+	for i, f := range got.Findings {
+		kinds[i] = f.Kind
+	}
+	assert.Equal(t, []string{KindNotJoined, KindSizeMismatch, KindDeadInstance}, kinds)
+}
+
+func TestRun_Failures(t *testing.T) {
+	stubSeams(t, errors.New("no compartment"))
+	_, err := Run(context.Background(), stubLoader{pools: []models.GPUPool{{Name: "pool-a"}}}, "/repo", "", nil, models.Environment{})
+	require.ErrorContains(t, err, "enrich gpu pools: no compartment")
+
+	_, err = Run(context.Background(), stubLoader{poolsErr: errors.New("no repo")}, "/repo", "", nil, models.Environment{})
+	require.ErrorContains(t, err, "load gpu pools: no repo")
+}
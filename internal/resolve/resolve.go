@@ -10,6 +10,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/jingle2008/toolkit/internal/infra/k8s"
@@ -31,14 +33,18 @@ var (
 // GPUNode finds a *models.GPUNode for the OCI compute actions. With
 // ocid set, no cluster call is made — a stub {Name, ID:ocid} is
 // returned. With ocid empty, the loader is consulted and the named
-// node is returned by walking every pool.
+// node is returned by walking every pool; a partial load is tolerated as
+// long as the node is on a cluster that did load.
 func GPUNode(ctx context.Context, ld loader.Composite, kubeConfig string, env models.Environment, name, ocid string) (*models.GPUNode, error) {
 	if ocid != "" {
 		return &models.GPUNode{Name: name, ID: ocid}, nil
 	}
 	grouped, err := ld.LoadGPUNodesByPool(ctx, kubeConfig, env)
 	if err != nil {
-		return nil, fmt.Errorf("load gpu nodes: %w", err)
+		if _, ok := errors.AsType[*terraform.PartialLoadError](err); !ok {
+			return nil, fmt.Errorf("load gpu nodes: %w", err)
+		}
+		logging.FromContext(ctx).Warnw("gpu nodes loaded with partial failures", "error", err)
 	}
 	for _, nodes := range grouped {
 		for i := range nodes {
@@ -47,15 +53,45 @@ func GPUNode(ctx context.Context, ld loader.Composite, kubeConfig string, env mo
 			}
 		}
 	}
+	if err != nil {
+		// The node may be on a cluster that did not answer.
+		return nil, fmt.Errorf("gpu node %q not found in any pool: %w", name, err)
+	}
 	return nil, fmt.Errorf("gpu node %q not found in any pool", name)
 }
 
+// NodeLocator finds which of contexts has the node named node;
+// k8s.LocateNode in production.
+type NodeLocator func(ctx context.Context, kubeConfig string, contexts []string, node string) (string, error)
+
+// NodeContext returns the kube context a node mutation targets: cluster
+// when the caller names one (--cluster, or MCP `cluster`), which must be
+// one of env's contexts, else whichever of env's clusters locate finds
+// the node in.
+func NodeContext(
+	ctx context.Context, locate NodeLocator, kubeConfig string,
+	contexts models.KubeContexts, env models.Environment, cluster, node string,
+) (string, error) {
+	known := contexts.For(env)
+	if cluster == "" {
+		return locate(ctx, kubeConfig, known, node)
+	}
+	if !slices.Contains(known, cluster) {
+		return "", fmt.Errorf("cluster %q is not a kube context of %s (%s)",
+			cluster, env.GetName(), strings.Join(known, ", "))
+	}
+	return cluster, nil
+}
+
 // GPUPool loads GPU pools from the Terraform repo, finds the named
 // one, then enriches with the live OCI ID + ActualSize via
 // PopulateGPUPools. Partial-load on the Terraform pass is tolerated
 // as long as the named pool is among the rows that did load — that
 // matches the behavior of `toolkit get gpupool`.
-func GPUPool(ctx context.Context, ld loader.Composite, repoPath, kubeConfig string, env models.Environment, name string) (*models.GPUPool, error) {
+func GPUPool(
+	ctx context.Context, ld loader.Composite, repoPath, kubeConfig string,
+	contexts models.KubeContexts, env models.Environment, name string,
+) (*models.GPUPool, error) {
 	pools, err := ld.LoadGPUPools(ctx, repoPath, env)
 	if err != nil {
 		if _, ok := errors.AsType[*terraform.PartialLoadError](err); !ok {
@@ -75,7 +111,7 @@ func GPUPool(ctx context.Context, ld loader.Composite, repoPath, kubeConfig stri
 		return nil, fmt.Errorf("gpu pool %q not found in repo", name)
 	}
 
-	compartmentID, err := CompartmentID(ctx, kubeConfig, env)
+	compartmentID, err := CompartmentID(ctx, kubeConfig, contexts, env)
 	if err != nil {
 		return nil, fmt.Errorf("resolve compartment ID: %w", err)
 	}
@@ -104,12 +140,12 @@ func GPUPool(ctx context.Context, ld loader.Composite, repoPath, kubeConfig stri
 // the TUI's enriched view. Mutation paths (resolve.GPUPool) keep
 // their per-pool enrichment for the single-pool ID lookup they
 // actually need.
-func EnrichGPUPools(ctx context.Context, pools []models.GPUPool, kubeConfig string, env models.Environment) error {
+func EnrichGPUPools(ctx context.Context, pools []models.GPUPool, kubeConfig string, contexts models.KubeContexts, env models.Environment) error {
 	if len(pools) == 0 {
 		return nil
 	}
 	logger := logging.FromContext(ctx)
-	compartmentID, err := CompartmentID(ctx, kubeConfig, env)
+	compartmentID, err := CompartmentID(ctx, kubeConfig, contexts, env)
 	if err != nil {
 		logger.Infow("gpu pool enrichment failed", "step", "compartment_id", "error", err)
 		return fmt.Errorf("compartment lookup failed: %w", err)
@@ -128,8 +164,8 @@ func EnrichGPUPools(ctx context.Context, pools []models.GPUPool, kubeConfig stri
 // identity for a given cluster is stable for the cluster's life, so
 // the cache has no semantic risk.
 //
-// Key is env.KubeContext() — a string like "dp-dev-iad" that
-// uniquely identifies the target cluster. kubeConfig is intentionally
+// Key is the environment's primary kube context — a string like
+// "dp-dev-iad" that uniquely identifies the target cluster. kubeConfig is intentionally
 // NOT part of the key: it's set once at NewServer (MCP) or once per
 // CLI invocation and never mutated within a process, so it can't
 // vary across cache lookups.
@@ -152,12 +188,13 @@ func clearCompartmentCache() {
 	})
 }
 
-// CompartmentID queries the cluster for any GPU node and returns its
+// CompartmentID queries the environment's primary cluster (see
+// models.KubeContexts.Primary) for any GPU node and returns its
 // CompartmentID. Used to scope OCI ListInstancePools calls during
 // pool enrichment. Successful lookups are cached per kubeContext for
 // the life of the process.
-func CompartmentID(ctx context.Context, kubeConfig string, env models.Environment) (string, error) {
-	kubeContext := env.KubeContext()
+func CompartmentID(ctx context.Context, kubeConfig string, contexts models.KubeContexts, env models.Environment) (string, error) {
+	kubeContext := contexts.Primary(env)
 	if cached, ok := compartmentCache.Load(kubeContext); ok {
 		return cached.(string), nil //nolint:forcetypeassert // only Stored values are string
 	}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
 	"github.com/jingle2008/toolkit/internal/infra/terraform"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
@@ -51,10 +52,26 @@ func (l stubLoader) LoadGPUWorkloadsByNode(context.Context, string, models.Envir
 	return map[string][]models.GPUWorkload{}, nil
 }
 
+func (l stubLoader) LoadPendingGPUWorkloads(context.Context, string, models.Environment) ([]models.PendingGPUWorkload, error) {
+	return nil, nil
+}
+
 func (l stubLoader) LoadDedicatedAIClusters(context.Context, string, models.Environment) (map[string][]models.DedicatedAICluster, error) {
 	return nil, nil //nolint:nilnil // empty-map test stub; resolve tests never read this
 }
 
+func (l stubLoader) LoadEndpoints(context.Context, string, models.Environment) (map[string][]models.Endpoint, error) {
+	return nil, nil //nolint:nilnil // empty-map test stub; resolve tests never read this
+}
+
+func (l stubLoader) LoadEvents(context.Context, string, models.Environment) (map[string][]models.Event, error) {
+	return nil, nil //nolint:nilnil // empty-map test stub; resolve tests never read this
+}
+
+func (l stubLoader) LoadWorkRequests(context.Context, string, models.Environment) ([]models.WorkRequest, error) {
+	return nil, nil
+}
+
 func (l stubLoader) LoadTenancyOverrideGroup(context.Context, string, models.Environment) (models.TenancyOverrideGroup, error) {
 	return models.TenancyOverrideGroup{}, nil
 }
@@ -133,7 +150,7 @@ func fakeCompartmentResolver(t *testing.T, compartmentID string) {
 	newClientsetFromKubeFn = func(string, string) (kubernetes.Interface, error) {
 		return fake.NewSimpleClientset(), nil
 	}
-	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int) ([]models.GPUNode, error) {
+	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int, ...k8s.NodeOption) ([]models.GPUNode, error) {
 		if compartmentID == "" {
 			return nil, nil // simulate empty cluster
 		}
@@ -169,7 +186,7 @@ func TestGPUPool_HappyPath(t *testing.T) {
 		{Name: "p1", Size: 8},
 		{Name: "p2", Size: 12},
 	}}
-	pool, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p2")
+	pool, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p2")
 	require.NoError(t, err)
 	require.NotNil(t, pool)
 	assert.Equal(t, "p2", pool.Name)
@@ -182,11 +199,30 @@ func TestGPUPool_NotFound(t *testing.T) {
 	fakePopulate(t, "", nil)
 
 	ld := stubLoader{pools: []models.GPUPool{{Name: "p1"}}}
-	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p-missing")
+	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p-missing")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found in repo")
 }
 
+func TestGPUNode_TolerantOfPartialLoad(t *testing.T) {
+	t.Parallel()
+	partial := &terraform.PartialLoadError{
+		Source: "kube contexts",
+		Errs:   []error{errors.New("cluster b: connection refused")},
+	}
+	ld := stubLoader{
+		nodes:    map[string][]models.GPUNode{"pool-1": {{Name: "n1", ID: "ocid1.instance.n1"}}},
+		nodesErr: partial,
+	}
+	node, err := GPUNode(context.Background(), ld, "/kube", models.Environment{}, "n1", "")
+	require.NoError(t, err, "a node on a reachable cluster resolves")
+	assert.Equal(t, "ocid1.instance.n1", node.ID)
+
+	_, err = GPUNode(context.Background(), ld, "/kube", models.Environment{}, "n2", "")
+	require.ErrorContains(t, err, `gpu node "n2" not found in any pool`)
+	require.ErrorContains(t, err, "cluster b: connection refused", "the miss names the cluster that did not answer")
+}
+
 func TestGPUPool_TolerantOfPartialLoad(t *testing.T) {
 	// PartialLoadError is informational — proceed when the named pool
 	// is still in the returned slice.
@@ -201,7 +237,7 @@ func TestGPUPool_TolerantOfPartialLoad(t *testing.T) {
 		pools:    []models.GPUPool{{Name: "p1", Size: 8}},
 		poolsErr: partial,
 	}
-	pool, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p1")
+	pool, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p1")
 	require.NoError(t, err, "partial-load should not be fatal")
 	assert.Equal(t, "ocid1.instancepool.fake", pool.ID)
 }
@@ -209,7 +245,7 @@ func TestGPUPool_TolerantOfPartialLoad(t *testing.T) {
 func TestGPUPool_HardLoaderErrorFatal(t *testing.T) {
 	// Non-partial errors must NOT be tolerated.
 	ld := stubLoader{poolsErr: errors.New("repo path invalid")}
-	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p1")
+	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "load gpu pools")
 }
@@ -219,7 +255,7 @@ func TestGPUPool_PopulateError(t *testing.T) {
 	fakePopulate(t, "", errors.New("OCI throttled"))
 
 	ld := stubLoader{pools: []models.GPUPool{{Name: "p1", Size: 8}}}
-	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p1")
+	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "populate gpu pool")
 }
@@ -232,7 +268,7 @@ func TestGPUPool_NoOcidAfterPopulate(t *testing.T) {
 	fakePopulate(t, "", nil) // no ID assigned
 
 	ld := stubLoader{pools: []models.GPUPool{{Name: "p1", Size: 8}}}
-	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", models.Environment{}, "p1")
+	_, err := GPUPool(context.Background(), ld, "/repo", "/kube", nil, models.Environment{}, "p1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no OCID")
 }
@@ -242,7 +278,7 @@ func TestGPUPool_NoOcidAfterPopulate(t *testing.T) {
 func TestCompartmentID_HappyPath(t *testing.T) {
 	fakeCompartmentResolver(t, "ocid1.compartment.real")
 
-	got, err := CompartmentID(context.Background(), "/kube", models.Environment{Type: "dev", Region: "us-ashburn-1"})
+	got, err := CompartmentID(context.Background(), "/kube", nil, models.Environment{Type: "dev", Region: "us-ashburn-1"})
 	require.NoError(t, err)
 	assert.Equal(t, "ocid1.compartment.real", got)
 }
@@ -251,7 +287,7 @@ func TestCompartmentID_EmptyCluster(t *testing.T) {
 	// listGPUNodesByCompartmentFn returns no nodes — we can't infer.
 	fakeCompartmentResolver(t, "")
 
-	_, err := CompartmentID(context.Background(), "/kube", models.Environment{Type: "dev"})
+	_, err := CompartmentID(context.Background(), "/kube", nil, models.Environment{Type: "dev"})
 	require.Error(t, err)
 	assert.True(t, strings.Contains(err.Error(), "no GPU nodes"),
 		"expected error about empty cluster, got: %v", err)
@@ -266,7 +302,7 @@ func TestCompartmentID_KubeUnreachable(t *testing.T) {
 		return nil, errors.New("kubeconfig parse failed")
 	}
 
-	_, err := CompartmentID(context.Background(), "/kube", models.Environment{})
+	_, err := CompartmentID(context.Background(), "/kube", nil, models.Environment{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "kubeconfig parse failed")
 }
@@ -280,20 +316,20 @@ func TestCompartmentID_Caches(t *testing.T) {
 	fakeCompartmentResolver(t, "ocid1.compartment.first")
 
 	env := models.Environment{Type: "dev", Region: "us-ashburn-1"}
-	got1, err := CompartmentID(context.Background(), "/kube", env)
+	got1, err := CompartmentID(context.Background(), "/kube", nil, env)
 	require.NoError(t, err)
 	assert.Equal(t, "ocid1.compartment.first", got1)
 
 	// Swap the seam to a different value. Cache should win.
-	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int) ([]models.GPUNode, error) {
+	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int, ...k8s.NodeOption) ([]models.GPUNode, error) {
 		return []models.GPUNode{{CompartmentID: "ocid1.compartment.SHOULD_NOT_SEE"}}, nil
 	}
-	got2, err := CompartmentID(context.Background(), "/kube", env)
+	got2, err := CompartmentID(context.Background(), "/kube", nil, env)
 	require.NoError(t, err)
 	assert.Equal(t, "ocid1.compartment.first", got2, "cache should win against the changed seam")
 
 	// Different env → different kubeContext → cache miss → seam fires.
-	got3, err := CompartmentID(context.Background(), "/kube", models.Environment{Type: "dev", Region: "us-phoenix-1"})
+	got3, err := CompartmentID(context.Background(), "/kube", nil, models.Environment{Type: "dev", Region: "us-phoenix-1"})
 	require.NoError(t, err)
 	assert.Equal(t, "ocid1.compartment.SHOULD_NOT_SEE", got3, "different env key must miss the cache and call the seam")
 }
@@ -307,15 +343,15 @@ func TestCompartmentID_DoesNotCacheErrors(t *testing.T) {
 	fakeCompartmentResolver(t, "") // empty cluster → "no GPU nodes" error
 	env := models.Environment{Type: "dev", Region: "us-ashburn-1"}
 
-	_, err := CompartmentID(context.Background(), "/kube", env)
+	_, err := CompartmentID(context.Background(), "/kube", nil, env)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no GPU nodes")
 
 	// Cluster recovers. Cache shouldn't have stored the prior error.
-	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int) ([]models.GPUNode, error) {
+	listGPUNodesByCompartmentFn = func(context.Context, kubernetes.Interface, int, ...k8s.NodeOption) ([]models.GPUNode, error) {
 		return []models.GPUNode{{CompartmentID: "ocid1.compartment.recovered"}}, nil
 	}
-	got, err := CompartmentID(context.Background(), "/kube", env)
+	got, err := CompartmentID(context.Background(), "/kube", nil, env)
 	require.NoError(t, err, "second call must not hit a cached error")
 	assert.Equal(t, "ocid1.compartment.recovered", got)
 }
@@ -327,7 +363,7 @@ func TestEnrichGPUPools_HappyPath(t *testing.T) {
 	fakePopulate(t, "ocid1.instancepool.fake", nil)
 
 	pools := []models.GPUPool{{Name: "p1"}, {Name: "p2"}}
-	err := EnrichGPUPools(context.Background(), pools, "/kube", models.Environment{})
+	err := EnrichGPUPools(context.Background(), pools, "/kube", nil, models.Environment{})
 	assert.NoError(t, err, "happy path should not return a warning error")
 	assert.Equal(t, 4, pools[0].ActualSize, "fakePopulate fills ActualSize=4")
 	assert.Equal(t, 4, pools[1].ActualSize)
@@ -335,7 +371,7 @@ func TestEnrichGPUPools_HappyPath(t *testing.T) {
 
 func TestEnrichGPUPools_EmptySlice_NoOp(t *testing.T) {
 	// Don't wire seams: the empty-slice early-return must avoid them.
-	err := EnrichGPUPools(context.Background(), nil, "/kube", models.Environment{})
+	err := EnrichGPUPools(context.Background(), nil, "/kube", nil, models.Environment{})
 	assert.NoError(t, err, "empty input should be a no-op")
 }
 
@@ -345,7 +381,7 @@ func TestEnrichGPUPools_CompartmentLookupFailure_Warns(t *testing.T) {
 	fakeCompartmentResolver(t, "")
 
 	pools := []models.GPUPool{{Name: "p1", Status: "..."}}
-	err := EnrichGPUPools(context.Background(), pools, "/kube", models.Environment{})
+	err := EnrichGPUPools(context.Background(), pools, "/kube", nil, models.Environment{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "compartment lookup failed")
 	assert.Equal(t, "...", pools[0].Status, "placeholder must survive enrichment failure")
@@ -356,7 +392,7 @@ func TestEnrichGPUPools_PopulateFailure_Warns(t *testing.T) {
 	fakePopulate(t, "", errors.New("OCI 500"))
 
 	pools := []models.GPUPool{{Name: "p1"}}
-	err := EnrichGPUPools(context.Background(), pools, "/kube", models.Environment{})
+	err := EnrichGPUPools(context.Background(), pools, "/kube", nil, models.Environment{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "OCI populate failed")
 	assert.Contains(t, err.Error(), "OCI 500")
@@ -1,5 +1,5 @@
 /*
-Package actions implements synchronous deletion of DedicatedAICluster via OCI Generative AI SDK.
+Package actions implements synchronous deletion of DedicatedAICluster and Endpoint via OCI Generative AI SDK.
 */
 package actions
 
@@ -85,6 +85,26 @@ func DeleteDedicatedAICluster(ctx context.Context, dac *models.DedicatedAICluste
 	return nil
 }
 
+/*
+DeleteEndpoint deletes a single GenAI endpoint using the OCI Generative AI SDK
+and waits for the work request to finish.
+*/
+func DeleteEndpoint(ctx context.Context, ep *models.Endpoint, env models.Environment, logger logging.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	client, err := newGenAIClient(env)
+	if err != nil {
+		return fmt.Errorf("failed to create GenerativeAI client: %w", err)
+	}
+
+	id := ep.OCID(env.Realm, env.Region)
+	return deleteEndpoint(ctx, client, &generativeai.EndpointSummary{
+		Id:          &id,
+		DisplayName: &ep.DisplayName,
+	}, logger)
+}
+
 func deleteEndpointsInDAC(
 	ctx context.Context,
 	client genAI,
@@ -25,6 +25,8 @@ type fakeGenAI struct {
 	// call (Err fails every call), letting tests exercise the delete path
 	// after Get/ListEndpoints succeed.
 	DeleteDedicatedErr error
+	// DeletedEndpointIDs records every DeleteEndpoint request's id.
+	DeletedEndpointIDs []string
 }
 
 func TestDeleteDedicatedAICluster_Success(t *testing.T) {
@@ -97,7 +99,8 @@ func (f *fakeGenAI) ListEndpoints(_ context.Context, _ generativeai.ListEndpoint
 	return f.ListEndpointsResp, f.Err
 }
 
-func (f *fakeGenAI) DeleteEndpoint(_ context.Context, _ generativeai.DeleteEndpointRequest) (generativeai.DeleteEndpointResponse, error) {
+func (f *fakeGenAI) DeleteEndpoint(_ context.Context, req generativeai.DeleteEndpointRequest) (generativeai.DeleteEndpointResponse, error) {
+	f.DeletedEndpointIDs = append(f.DeletedEndpointIDs, derefOr(req.EndpointId, ""))
 	return f.DeleteEndpointResp, f.Err
 }
 
@@ -209,3 +212,45 @@ func TestDeleteDedicatedAICluster_NilEndpointClusterID_Skipped(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "reached-delete")
 }
+
+// DeleteEndpoint must target the endpoint's full OCID and surface a
+// delete failure as a controlled error.
+func TestDeleteEndpoint_DeleteError(t *testing.T) {
+	origNewGenAIClient := newGenAIClient
+	defer func() { newGenAIClient = origNewGenAIClient }()
+
+	fakeClient := &fakeGenAI{Err: errors.New("boom")}
+	newGenAIClient = func(_ models.Environment) (genAI, error) { return fakeClient, nil }
+
+	ep := &models.Endpoint{Name: "amaaaaaaep1", DisplayName: "chat"}
+	env := models.Environment{Type: "prod", Region: "us-phoenix-1", Realm: "oc1"}
+	err := DeleteEndpoint(context.Background(), ep, env, &fakeLogger{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to delete endpoint")
+	assert.Equal(t, []string{"ocid1.generativeaiendpoint.oc1.phx.amaaaaaaep1"}, fakeClient.DeletedEndpointIDs)
+}
+
+func TestDeleteEndpoint_NilWorkRequestID(t *testing.T) {
+	origNewGenAIClient := newGenAIClient
+	defer func() { newGenAIClient = origNewGenAIClient }()
+
+	fakeClient := &fakeGenAI{} // DeleteEndpointResp has no OpcWorkRequestId
+	newGenAIClient = func(_ models.Environment) (genAI, error) { return fakeClient, nil }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err := DeleteEndpoint(ctx, &models.Endpoint{Name: "amaaaaaaep1"},
+		models.Environment{Region: "us-phoenix-1", Realm: "oc1"}, &fakeLogger{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "work request id is nil")
+}
+
+func TestDeleteEndpoint_ClientError(t *testing.T) {
+	origNewGenAIClient := newGenAIClient
+	defer func() { newGenAIClient = origNewGenAIClient }()
+
+	newGenAIClient = func(_ models.Environment) (genAI, error) { return nil, errors.New("no client") }
+	err := DeleteEndpoint(context.Background(), &models.Endpoint{}, models.Environment{}, &fakeLogger{})
+	require.ErrorContains(t, err, "failed to create GenerativeAI client")
+}
@@ -96,16 +96,17 @@ func IncreasePoolSize(
 	return nil
 }
 
-// TerminateInstance terminates the given instance, optionally preserving the boot volume.
+// TerminateInstance terminates the given instance without preserving the
+// boot volume and returns the OCID of the work request tracking it.
 func TerminateInstance(
 	ctx context.Context,
 	node *models.GPUNode,
 	env models.Environment,
 	logger logging.Logger,
-) error {
+) (string, error) {
 	client, err := newComputeClient(env)
 	if err != nil {
-		return fmt.Errorf("failed to create compute client: %w", err)
+		return "", fmt.Errorf("failed to create compute client: %w", err)
 	}
 
 	logger.Infow("deleting node", "id", node.ID, "name", node.Name)
@@ -118,11 +119,12 @@ func TerminateInstance(
 		if resp.OpcRequestId != nil {
 			reqID = *resp.OpcRequestId
 		}
-		return fmt.Errorf("failed to terminate instance: %w, request id: %s", err, reqID)
+		return "", fmt.Errorf("failed to terminate instance: %w, request id: %s", err, reqID)
 	}
+	workRequestID := derefOr(resp.OpcWorkRequestId, "")
 	logger.Infow("deletion request is submitted successfully", "id",
-		node.ID, "opc-request-id", resp.OpcRequestId)
-	return nil
+		node.ID, "opc-request-id", resp.OpcRequestId, "work-request-id", workRequestID)
+	return workRequestID, nil
 }
 
 // PopulateGPUPools populates ActualSize and Status for a GPUPool using OCI instance pool API.
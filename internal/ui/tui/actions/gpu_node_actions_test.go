@@ -157,14 +157,15 @@ func TestTerminateInstance_Success(t *testing.T) {
 	newComputeClient = func(_ models.Environment) (computeClient, error) {
 		return &fakeComputeClient{
 			TerminateInstanceFunc: func(_ context.Context, _ core.TerminateInstanceRequest) (core.TerminateInstanceResponse, error) {
-				return core.TerminateInstanceResponse{OpcRequestId: strPtr("reqid")}, nil
+				return core.TerminateInstanceResponse{OpcRequestId: strPtr("reqid"), OpcWorkRequestId: strPtr("wrid")}, nil
 			},
 		}, nil
 	}
 	node := &models.GPUNode{ID: "id1", Name: "n1"}
 	logger := &testLogger{}
-	err := TerminateInstance(context.Background(), node, makeEnv(), logger)
+	wr, err := TerminateInstance(context.Background(), node, makeEnv(), logger)
 	require.NoError(t, err)
+	require.Equal(t, "wrid", wr)
 	require.Contains(t, logger.Infos[0], "deleting node")
 	require.Contains(t, logger.Infos[1], "deletion request is submitted successfully")
 }
@@ -181,8 +182,9 @@ func TestTerminateInstance_Error(t *testing.T) {
 	}
 	node := &models.GPUNode{ID: "id1", Name: "n1"}
 	logger := &testLogger{}
-	err := TerminateInstance(context.Background(), node, makeEnv(), logger)
+	wr, err := TerminateInstance(context.Background(), node, makeEnv(), logger)
 	require.Error(t, err)
+	require.Empty(t, wr)
 	require.Contains(t, err.Error(), "fail")
 	require.Contains(t, err.Error(), "reqid")
 }
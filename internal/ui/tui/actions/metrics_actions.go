@@ -0,0 +1,45 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/telemetry"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var newMetricsClient = func(env models.Environment) (oci.MetricsClient, error) {
+	client, err := oci.NewMonitoringClient(env)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+/*
+FetchMetrics summarizes the plan's queries between start and end from
+OCI Monitoring: the GenAI service metrics (telemetry.Project namespace,
+the environment's telemetry.Fleet resource group) posted in
+compartmentID, the compartment of the environment's GPU nodes.
+*/
+func FetchMetrics(
+	ctx context.Context,
+	env models.Environment,
+	compartmentID string,
+	plan telemetry.Plan,
+	start, end time.Time,
+) ([]models.MetricSeries, error) {
+	client, err := newMetricsClient(env)
+	if err != nil {
+		return nil, err
+	}
+	return oci.SummarizeMetrics(ctx, client, oci.MetricsQuery{
+		CompartmentID: compartmentID,
+		Namespace:     telemetry.Project,
+		ResourceGroup: telemetry.Fleet(env.Type),
+		Queries:       plan.Queries(),
+		Start:         start,
+		End:           end,
+	})
+}
@@ -0,0 +1,58 @@
+//nolint:paralleltest
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/infra/telemetry"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+type fakeMetricsClient struct {
+	reqs []monitoring.SummarizeMetricsDataRequest
+}
+
+func (f *fakeMetricsClient) SummarizeMetricsData(_ context.Context, req monitoring.SummarizeMetricsDataRequest) (monitoring.SummarizeMetricsDataResponse, error) {
+	f.reqs = append(f.reqs, req)
+	return monitoring.SummarizeMetricsDataResponse{}, nil
+}
+
+func TestFetchMetrics(t *testing.T) {
+	orig := newMetricsClient
+	defer func() { newMetricsClient = orig }()
+	client := &fakeMetricsClient{}
+	newMetricsClient = func(models.Environment) (oci.MetricsClient, error) { return client, nil }
+
+	env := models.Environment{Realm: "oc1", Region: "us-chicago-1", Type: "prod"}
+	plan := telemetry.Plan{
+		Filter:     telemetry.Filter{Key: telemetry.FilterDacID, Value: "ocid1.dac"},
+		Capability: telemetry.CapabilityTextRerank,
+	}
+	end := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	series, err := FetchMetrics(context.Background(), env, "ocid1.compartment", plan, end.Add(-time.Hour), end)
+	require.NoError(t, err)
+	require.Len(t, series, 1)
+	assert.Equal(t, "rerankText.InputTokenLength", series[0].Name)
+
+	require.Len(t, client.reqs, 1)
+	assert.Equal(t, "ocid1.compartment", *client.reqs[0].CompartmentId)
+	assert.Equal(t, telemetry.Project, *client.reqs[0].Namespace)
+	assert.Equal(t, "generative-ai-service-api-prod", *client.reqs[0].ResourceGroup)
+}
+
+func TestFetchMetrics_ClientError(t *testing.T) {
+	orig := newMetricsClient
+	defer func() { newMetricsClient = orig }()
+	newMetricsClient = func(models.Environment) (oci.MetricsClient, error) { return nil, errors.New("no auth") }
+
+	_, err := FetchMetrics(context.Background(), makeEnv(), "c", telemetry.Plan{}, time.Time{}, time.Time{})
+	require.ErrorContains(t, err, "no auth")
+}
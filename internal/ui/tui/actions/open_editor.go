@@ -0,0 +1,47 @@
+package actions
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// getenv is the seam tests use to pin $VISUAL / $EDITOR.
+var getenv = os.Getenv
+
+// defaultEditor is used when neither $VISUAL nor $EDITOR is set.
+const defaultEditor = "vi"
+
+/*
+EditorCommand builds the command that opens loc in the user's editor:
+$VISUAL, else $EDITOR, else vi. The variable may carry arguments
+("code --wait"). The line is passed as +N, which vi, vim, nvim, nano,
+emacs, micro and helix all accept; VS Code-family editors get
+--goto file:line:column instead. The caller runs it in the foreground
+(tea.ExecProcess) so terminal editors get the TTY.
+*/
+func EditorCommand(loc models.SourceLocation) *exec.Cmd {
+	editor := getenv("VISUAL")
+	if strings.TrimSpace(editor) == "" {
+		editor = getenv("EDITOR")
+	}
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		fields = []string{defaultEditor}
+	}
+	name, args := fields[0], fields[1:]
+
+	switch base := filepath.Base(name); {
+	case base == "code" || base == "code-insiders" || base == "codium" || base == "cursor":
+		args = append(args, "--goto", loc.String())
+	case loc.Line > 0:
+		args = append(args, "+"+strconv.Itoa(loc.Line), loc.File)
+	default:
+		args = append(args, loc.File)
+	}
+	return execCommand(name, args...)
+}
@@ -0,0 +1,45 @@
+package actions
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+//nolint:paralleltest // mutates the package-global execCommand and getenv seams
+func TestEditorCommand(t *testing.T) {
+	origExec, origEnv := execCommand, getenv
+	t.Cleanup(func() { execCommand, getenv = origExec, origEnv })
+	var gotName string
+	var gotArgs []string
+	execCommand = func(name string, args ...string) *exec.Cmd {
+		gotName, gotArgs = name, args
+		return exec.Command("true")
+	}
+
+	tfLoc := models.SourceLocation{File: "/repo/pools.tf", Line: 12, Column: 5}
+	tests := []struct {
+		visual, editor string
+		loc            models.SourceLocation
+		wantName       string
+		wantArgs       []string
+	}{
+		{editor: "nvim", loc: tfLoc, wantName: "nvim", wantArgs: []string{"+12", "/repo/pools.tf"}},
+		// $VISUAL wins over $EDITOR.
+		{visual: "emacs -nw", editor: "nano", loc: tfLoc, wantName: "emacs", wantArgs: []string{"-nw", "+12", "/repo/pools.tf"}},
+		{editor: "/usr/bin/code --wait", loc: tfLoc, wantName: "/usr/bin/code", wantArgs: []string{"--wait", "--goto", "/repo/pools.tf:12:5"}},
+		// Whole-file locations (override records) have no line.
+		{loc: models.SourceLocation{File: "/repo/o.json"}, wantName: "vi", wantArgs: []string{"/repo/o.json"}},
+	}
+	for _, tt := range tests {
+		getenv = func(k string) string {
+			return map[string]string{"VISUAL": tt.visual, "EDITOR": tt.editor}[k]
+		}
+		EditorCommand(tt.loc)
+		assert.Equal(t, tt.wantName, gotName)
+		assert.Equal(t, tt.wantArgs, gotArgs)
+	}
+}
@@ -0,0 +1,33 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+var newWorkRequestClients = func(env models.Environment) (oci.ComputeWorkRequestClient, oci.GenAIWorkRequestClient, error) {
+	compute, err := oci.NewWorkRequestClient(env)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create work request client: %w", err)
+	}
+	genai, err := oci.NewGenAIClient(env)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create GenerativeAI client: %w", err)
+	}
+	return compute, genai, nil
+}
+
+/*
+GetWorkRequest returns the current state of the work request id, from
+whichever of the Core Services and GenerativeAI work request APIs owns it.
+*/
+func GetWorkRequest(ctx context.Context, id string, env models.Environment) (models.WorkRequest, error) {
+	compute, genai, err := newWorkRequestClients(env)
+	if err != nil {
+		return models.WorkRequest{}, oci.Permanent(err)
+	}
+	return oci.GetWorkRequest(ctx, compute, genai, id)
+}
@@ -0,0 +1,53 @@
+//nolint:paralleltest
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/workrequests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+type fakeWorkRequests struct {
+	oci.ComputeWorkRequestClient
+	status workrequests.WorkRequestStatusEnum
+}
+
+func (f fakeWorkRequests) GetWorkRequest(_ context.Context, req workrequests.GetWorkRequestRequest) (workrequests.GetWorkRequestResponse, error) {
+	return workrequests.GetWorkRequestResponse{WorkRequest: workrequests.WorkRequest{
+		Id: req.WorkRequestId, OperationType: common.String("TerminateInstance"),
+		Status: f.status, PercentComplete: common.Float32(50),
+	}}, nil
+}
+
+func TestGetWorkRequest(t *testing.T) {
+	orig := newWorkRequestClients
+	defer func() { newWorkRequestClients = orig }()
+	newWorkRequestClients = func(models.Environment) (oci.ComputeWorkRequestClient, oci.GenAIWorkRequestClient, error) {
+		return fakeWorkRequests{status: workrequests.WorkRequestStatusInProgress}, nil, nil
+	}
+
+	wr, err := GetWorkRequest(context.Background(), "ocid1.coreservicesworkrequest.oc1.iad.x", makeEnv())
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkRequestServiceCompute, wr.Service)
+	assert.Equal(t, "IN_PROGRESS", wr.Status)
+	assert.InDelta(t, 50, wr.PercentComplete, 0.01)
+}
+
+func TestGetWorkRequest_ClientError(t *testing.T) {
+	orig := newWorkRequestClients
+	defer func() { newWorkRequestClients = orig }()
+	newWorkRequestClients = func(models.Environment) (oci.ComputeWorkRequestClient, oci.GenAIWorkRequestClient, error) {
+		return nil, nil, errors.New("no auth")
+	}
+
+	_, err := GetWorkRequest(context.Background(), "ocid1.coreservicesworkrequest.oc1.iad.x", makeEnv())
+	require.ErrorContains(t, err, "no auth")
+}
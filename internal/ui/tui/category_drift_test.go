@@ -84,6 +84,9 @@ var noStatsCategories = map[domain.Category]struct{}{
 	domain.ModelArtifact:                   {},
 	domain.Environment:                     {},
 	domain.ServiceTenancy:                  {},
+	domain.Endpoint:                        {},
+	domain.Event:                           {},
+	domain.WorkRequest:                     {},
 	domain.Alias:                           {},
 }
 
@@ -1,33 +1,52 @@
 package tui
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/charmbracelet/bubbles/table"
 
-	"github.com/jingle2008/toolkit/internal/collections"
 	"github.com/jingle2008/toolkit/internal/columns"
 	"github.com/jingle2008/toolkit/internal/domain"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
-// tuiRowsFlatWith is the shared core of tuiRowsFlat and
-// tuiRowsFlatForExport. The cell argument decides whether each cell
-// uses the display-mode Render or the export-mode RenderForExport (with
-// fallback). The filter + faulty pipeline is identical for both.
-func tuiRowsFlatWith[T models.NamedFilterable](s columns.Set[T], items []T, name *string, filter string, faultyOnly bool, cell func(columns.Column[T], T) string) []table.Row {
-	var pred func(T) bool
-	if faultyOnly {
-		pred = faultyPred
+// flatBase is the shared core of the flat row builders: it narrows items
+// by name (when non-nil) and the faulty gate, and renders cells through
+// cell lazily. The cell argument decides whether each cell uses the
+// display-mode Render or the export-mode RenderForExport (with fallback).
+// The text filter is applied by the rowIndex built over the result.
+// Items are referenced, not copied, so a base over 100k items costs a
+// pointer each.
+func flatBase[T models.NamedFilterable](s columns.Set[T], items []T, name *string, faultyOnly bool, cell func(columns.Column[T], T) string) *rowBase {
+	kept := make([]*T, 0, len(items))
+	for i := range items {
+		it := &items[i]
+		if (name == nil || *name == (*it).GetName()) && (!faultyOnly || faultyPred(*it)) {
+			kept = append(kept, it)
+		}
 	}
-	matches := collections.FilterSlice(items, name, filter, pred)
-	rows := make([]table.Row, len(matches))
-	for i, m := range matches {
-		row := make(table.Row, len(s.Columns))
-		for j, c := range s.Columns {
-			row[j] = cell(c, m)
+	cols := s.Defaults()
+	return newRowBase(len(kept), len(cols),
+		func(item, col int) string { return cell(cols[col], *kept[item]) },
+		func(item int) string { return filterText((*kept[item]).FilterableFields()...) })
+}
+
+// flatCell renders a flat column for display.
+func flatCell[T any](c columns.Column[T], m T) string {
+	return c.Render(m)
+}
+
+// flatExportCell returns a flat cell renderer that consults each column's
+// RenderForExport when present, so OCID-shaped columns emit
+// fully-qualified IDs rather than raw suffixes.
+func flatExportCell[T any](realm, region string) func(columns.Column[T], T) string {
+	return func(c columns.Column[T], m T) string {
+		if c.RenderForExport != nil {
+			return c.RenderForExport(realm, region, m)
 		}
-		rows[i] = row
+		return c.Render(m)
 	}
-	return rows
 }
 
 // tuiRowsFlat renders a slice through a flat Set, applying the
@@ -36,9 +55,7 @@ func tuiRowsFlatWith[T models.NamedFilterable](s columns.Set[T], items []T, name
 // scope-context "name" gate). Display-mode: every cell uses the
 // column's Render closure.
 func tuiRowsFlat[T models.NamedFilterable](s columns.Set[T], items []T, name *string, filter string, faultyOnly bool) []table.Row {
-	return tuiRowsFlatWith(s, items, name, filter, faultyOnly, func(c columns.Column[T], m T) string {
-		return c.Render(m)
-	})
+	return newRowIndex(flatBase(s, items, name, faultyOnly, flatCell[T]), filter).materialize()
 }
 
 // tuiRowsFlatForExport mirrors tuiRowsFlat but consults each column's
@@ -46,27 +63,30 @@ func tuiRowsFlat[T models.NamedFilterable](s columns.Set[T], items []T, name *st
 // OCID-shaped columns emit fully-qualified IDs rather than raw
 // suffixes.
 func tuiRowsFlatForExport[T models.NamedFilterable](s columns.Set[T], items []T, name *string, realm, region, filter string, faultyOnly bool) []table.Row {
-	return tuiRowsFlatWith(s, items, name, filter, faultyOnly, func(c columns.Column[T], m T) string {
-		if c.RenderForExport != nil {
-			return c.RenderForExport(realm, region, m)
-		}
-		return c.Render(m)
-	})
+	return newRowIndex(flatBase(s, items, name, faultyOnly, flatExportCell[T](realm, region)), filter).materialize()
 }
 
-// tuiRowsGroupedWith is the shared core of tuiRowsGrouped and
-// tuiRowsGroupedForExport. The cell argument selects display vs
-// export rendering; the scope-context + filter + faulty pipeline is
-// identical for both.
-func tuiRowsGroupedWith[T models.NamedFilterable](
+// groupedItem references one item of a grouped map together with its
+// group key.
+type groupedItem[T any] struct {
+	key  string
+	item *T
+}
+
+// groupedBase is the grouped counterpart to flatBase. It applies the
+// scope-context gate first: a scope naming scopeCategory narrows to that
+// group (key), any other scope narrows to items of that name. Groups are
+// flattened in key order, and items are referenced rather than copied, as
+// in flatBase. Outside a key scope the group key is filterable text too,
+// as in collections.FilterMap.
+func groupedBase[T models.NamedFilterable](
 	g columns.GroupedSet[T],
 	m map[string][]T,
 	scopeCategory domain.Category,
 	ctx *domain.Scope,
-	filter string,
 	faultyOnly bool,
 	cell func(columns.GroupedColumn[T], string, T) string,
-) []table.Row {
+) *rowBase {
 	var (
 		key  *string
 		name *string
@@ -78,22 +98,52 @@ func tuiRowsGroupedWith[T models.NamedFilterable](
 			name = &ctx.Name
 		}
 	}
-	var pred func(T) bool
-	if faultyOnly {
-		pred = faultyPred
+	keys := slices.Sorted(maps.Keys(m))
+	if key != nil {
+		keys = nil
+		if _, ok := m[*key]; ok {
+			keys = []string{*key}
+		}
+	}
+	total := 0
+	for _, k := range keys {
+		total += len(m[k])
 	}
-	matches := collections.FilterMap(m, key, name, filter, pred)
-	rows := make([]table.Row, 0)
-	for k, items := range matches {
-		for _, it := range items {
-			row := make(table.Row, len(g.Columns))
-			for j, c := range g.Columns {
-				row[j] = cell(c, k, it)
+	kept := make([]groupedItem[T], 0, total)
+	for _, k := range keys {
+		items := m[k]
+		for i := range items {
+			it := &items[i]
+			if (name == nil || *name == (*it).GetName()) && (!faultyOnly || faultyPred(*it)) {
+				kept = append(kept, groupedItem[T]{key: k, item: it})
 			}
-			rows = append(rows, row)
 		}
 	}
-	return rows
+	cols := g.Defaults()
+	return newRowBase(len(kept), len(cols),
+		func(item, col int) string { return cell(cols[col], kept[item].key, *kept[item].item) },
+		func(item int) string {
+			fields := (*kept[item].item).FilterableFields()
+			if key == nil {
+				fields = append([]string{kept[item].key}, fields...)
+			}
+			return filterText(fields...)
+		})
+}
+
+// groupedCell renders a grouped column for display.
+func groupedCell[T any](c columns.GroupedColumn[T], k string, it T) string {
+	return c.Render(k, it)
+}
+
+// groupedExportCell is the grouped counterpart to flatExportCell.
+func groupedExportCell[T any](realm, region string) func(columns.GroupedColumn[T], string, T) string {
+	return func(c columns.GroupedColumn[T], k string, it T) string {
+		if c.RenderForExport != nil {
+			return c.RenderForExport(realm, region, k, it)
+		}
+		return c.Render(k, it)
+	}
 }
 
 // tuiRowsGrouped renders a grouped map, applying the scope-context
@@ -107,9 +157,7 @@ func tuiRowsGrouped[T models.NamedFilterable](
 	filter string,
 	faultyOnly bool,
 ) []table.Row {
-	return tuiRowsGroupedWith(g, m, scopeCategory, ctx, filter, faultyOnly, func(c columns.GroupedColumn[T], k string, it T) string {
-		return c.Render(k, it)
-	})
+	return newRowIndex(groupedBase(g, m, scopeCategory, ctx, faultyOnly, groupedCell[T]), filter).materialize()
 }
 
 // tuiRowsGroupedForExport mirrors tuiRowsGrouped but consults each
@@ -123,10 +171,6 @@ func tuiRowsGroupedForExport[T models.NamedFilterable](
 	realm, region, filter string,
 	faultyOnly bool,
 ) []table.Row {
-	return tuiRowsGroupedWith(g, m, scopeCategory, ctx, filter, faultyOnly, func(c columns.GroupedColumn[T], k string, it T) string {
-		if c.RenderForExport != nil {
-			return c.RenderForExport(realm, region, k, it)
-		}
-		return c.Render(k, it)
-	})
+	base := groupedBase(g, m, scopeCategory, ctx, faultyOnly, groupedExportCell[T](realm, region))
+	return newRowIndex(base, filter).materialize()
 }
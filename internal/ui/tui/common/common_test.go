@@ -53,6 +53,10 @@ func TestViewModeString(t *testing.T) {
 		{ExportView, "Export"},
 		{EditTenantView, "EditTenant"},
 		{LogView, "Log"},
+		{ConfirmView, "Confirm"},
+		{PodLogView, "PodLog"},
+		{JobsView, "Jobs"},
+		{MetricsView, "Metrics"},
 		{ViewMode(99), "Unknown"},
 	}
 	for _, tt := range tests {
@@ -27,4 +27,6 @@ const (
 	VendorCol = "Vendor"
 	// GpusCol is the column name for "GPUs".
 	GpusCol = "GPUs"
+	// LastSeenCol is the column name for "Last Seen".
+	LastSeenCol = "Last Seen"
 )
@@ -20,6 +20,14 @@ const (
 	LogView
 	// ConfirmView is the modal that gates destructive actions.
 	ConfirmView
+	// PodLogView is the full-screen container log viewer for a GPU workload.
+	PodLogView
+	// JobsView is the full-screen panel of background jobs launched from
+	// the session.
+	JobsView
+	// MetricsView is the full-screen panel of a DAC's or GPU workload's
+	// metrics, rendered as sparklines.
+	MetricsView
 )
 
 // String returns the string representation of the ViewMode.
@@ -41,6 +49,12 @@ func (v ViewMode) String() string {
 		return "Log"
 	case ConfirmView:
 		return "Confirm"
+	case PodLogView:
+		return "PodLog"
+	case JobsView:
+		return "Jobs"
+	case MetricsView:
+		return "Metrics"
 	default:
 		return "Unknown"
 	}
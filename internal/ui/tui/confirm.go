@@ -72,8 +72,8 @@ func (c confirmOverlay) decide(s string) (confirm, cancel bool) {
 }
 
 // confirmDelete builds the irreversible overlay for the Delete key. The
-// action label and warning depend on the category: a DAC is deleted, a GPU
-// node's backing instance is terminated. run re-resolves nothing extra —
+// action label and warning depend on the category: a DAC or endpoint is
+// deleted, a GPU node's backing instance is terminated. run re-resolves nothing extra —
 // deleteItem already re-finds its target by key at execution time.
 func (m *Model) confirmDelete(itemKey models.ItemKey) confirmOverlay {
 	c := confirmOverlay{
@@ -85,6 +85,9 @@ func (m *Model) confirmDelete(itemKey models.ItemKey) confirmOverlay {
 	case domain.GPUNode:
 		c.action, c.kind = "Terminate", "node"
 		c.warning = "Boot volume destroyed. Cannot undo."
+	case domain.Endpoint:
+		c.action, c.kind = "Delete", "endpoint"
+		c.warning = "This is irreversible."
 	default: // DedicatedAICluster
 		c.action, c.kind = "Delete", "DAC"
 		c.warning = "This is irreversible."
@@ -167,6 +167,12 @@ func TestConfirmDelete_TierByCategory(t *testing.T) {
 	assert.Equal(t, tierIrreversible, node.tier)
 	assert.Equal(t, "Terminate", node.action)
 	assert.Equal(t, "node", node.kind)
+
+	m.category = domain.Endpoint
+	ep := m.confirmDelete(key)
+	assert.Equal(t, tierIrreversible, ep.tier)
+	assert.Equal(t, "Delete", ep.action)
+	assert.Equal(t, "endpoint", ep.kind)
 }
 
 func TestConfirmRecoverableBuilders(t *testing.T) {
@@ -0,0 +1,129 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
+	loader "github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// Diff line styles for the history view. Package-level rather than on
+// Styles: they only color git output and never vary per model.
+var (
+	diffAddStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("34"))
+	diffDelStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("160"))
+	diffHunkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("33"))
+	commitStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Bold(true)
+)
+
+// hasContextKey reports whether b is bound in the current category+mode
+// context, so view handlers can gate category-specific keys without
+// keeping a parallel category list. Bindings are compared by their keys,
+// not their help text, which is free to change or repeat.
+func (m *Model) hasContextKey(b key.Binding) bool {
+	return slices.ContainsFunc(m.keys.Context, func(c key.Binding) bool {
+		return slices.Equal(c.Keys(), b.Keys())
+	})
+}
+
+// toggleDetailHistory flips the details view between the item JSON and
+// the git history of its backing file, then re-renders.
+func (m *Model) toggleDetailHistory() tea.Cmd {
+	m.detailHistory = !m.detailHistory
+	m.viewport.GotoTop()
+	return m.updateContentAsync()
+}
+
+// historyTarget splits the selected item key into the (tenant, name) pair
+// the history lookup needs. Tenancy overrides are keyed by
+// ScopedItemKey{Scope: tenant dir, Name}; every other repo-backed
+// category is keyed by its bare name.
+func historyTarget(key models.ItemKey) (string, string) {
+	switch k := key.(type) {
+	case models.ScopedItemKey:
+		return k.Scope, k.Name
+	case string:
+		return "", k
+	default:
+		return "", ""
+	}
+}
+
+// historyContentCmd loads the history of the selected item's backing file
+// off the UI goroutine and renders it as the details-view content. Lookup
+// failures (no git repo, loader without HistoryReader) are rendered in
+// place of the history so the user sees why it is empty.
+func (m *Model) historyContentCmd(gen int) tea.Cmd {
+	ctx := m.sessionCtx()
+	ld := m.loader
+	repoPath, env, cat := m.repoPath, m.environment, m.category
+	tenant, name := historyTarget(m.selectedKey)
+
+	return func() tea.Msg {
+		reader, ok := ld.(loader.HistoryReader)
+		if !ok {
+			return detailContentRenderedMsg{Content: renderHistoryError(errors.New("loader does not support reading history")), Gen: gen}
+		}
+		h, err := reader.ItemHistory(ctx, repoPath, env, cat, tenant, name, gitlog.DefaultLimit, true)
+		if err != nil {
+			return detailContentRenderedMsg{Content: renderHistoryError(err), Gen: gen}
+		}
+		return detailContentRenderedMsg{Content: renderHistory(h), Gen: gen}
+	}
+}
+
+func renderHistoryError(err error) string {
+	return fmt.Sprintf("History unavailable: %v\n\nPress <shift+h> to return to the item.", err)
+}
+
+// renderHistory formats a file history for the details viewport: the
+// source path and last change, then each commit (newest first) with its
+// colorized diff.
+func renderHistory(h gitlog.History) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Source: %s\n", h.Path)
+	last := h.LastChange()
+	if last == nil {
+		b.WriteString("No commits touch this file (new or untracked).\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Last changed by %s on %s (%s)\n",
+		last.Author, last.Date.Local().Format(gitlog.DateLayout), last.ShortHash())
+
+	for _, c := range h.Commits {
+		b.WriteString("\n")
+		b.WriteString(commitStyle.Render(fmt.Sprintf("%s  %s  %s",
+			c.ShortHash(), c.Date.Local().Format(gitlog.DateLayout), c.Author)))
+		fmt.Fprintf(&b, "\n    %s\n\n", c.Subject)
+		for line := range strings.SplitSeq(c.Diff, "\n") {
+			b.WriteString(styleDiffLine(line))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// styleDiffLine colors one unified-diff line by its prefix; file headers
+// (---/+++) are left plain so only content changes stand out.
+func styleDiffLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return line
+	case strings.HasPrefix(line, "+"):
+		return diffAddStyle.Render(line)
+	case strings.HasPrefix(line, "-"):
+		return diffDelStyle.Render(line)
+	case strings.HasPrefix(line, "@@"):
+		return diffHunkStyle.Render(line)
+	default:
+		return line
+	}
+}
@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/gitlog"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	logging "github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// historyLoader adds the optional HistoryReader capability to fakeLoader
+// and records the lookup it was asked for.
+type historyLoader struct {
+	fakeLoader
+	history gitlog.History
+	err     error
+
+	gotCat          domain.Category
+	gotTenant, name string
+}
+
+func (h *historyLoader) ItemHistory(_ context.Context, _ string, _ models.Environment, cat domain.Category,
+	tenant, name string, _ int, _ bool,
+) (gitlog.History, error) {
+	h.gotCat, h.gotTenant, h.name = cat, tenant, name
+	return h.history, h.err
+}
+
+func newHistoryModel(t *testing.T, ld *historyLoader) *Model {
+	t.Helper()
+	m, err := NewModel(
+		WithRepoPath("repo"),
+		WithEnvironment(models.Environment{Type: "dev", Region: "us-phx-1", Realm: "oc1"}),
+		WithLoader(ld),
+		WithLogger(logging.NewNoOpLogger()),
+	)
+	require.NoError(t, err)
+	m.category = domain.LimitTenancyOverride
+	m.viewMode = common.DetailsView
+	m.keys = keys.ResolveKeys(m.category, m.viewMode)
+	m.selectedKey = models.ScopedItemKey{Scope: "acme", Name: "gpu-count"}
+	m.dataset = &models.Dataset{}
+	return m
+}
+
+func TestDetailHistory_ToggleRendersHistory(t *testing.T) {
+	t.Parallel()
+	ld := &historyLoader{history: gitlog.History{
+		Path: "limits/acme/gpu.json",
+		Commits: []gitlog.Commit{{
+			Hash: "abcdef123456", Author: "Jane Doe", Date: time.Now(), Subject: "raise gpu limit",
+			Diff: "@@ -1 +1 @@\n-1\n+8",
+		}},
+	}}
+	m := newHistoryModel(t, ld)
+
+	_, cmd := m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	require.True(t, m.detailHistory)
+	require.NotNil(t, cmd)
+
+	msg := m.historyContentCmd(m.gens.detail)()
+	rendered, ok := msg.(detailContentRenderedMsg)
+	require.True(t, ok)
+	assert.Equal(t, domain.LimitTenancyOverride, ld.gotCat)
+	assert.Equal(t, "acme", ld.gotTenant)
+	assert.Equal(t, "gpu-count", ld.name)
+	assert.Contains(t, rendered.Content, "Last changed by Jane Doe")
+	assert.Contains(t, rendered.Content, "raise gpu limit")
+	assert.Contains(t, rendered.Content, "+8")
+
+	// Toggling again returns to JSON; leaving the view resets the mode.
+	m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	assert.False(t, m.detailHistory)
+	m.detailHistory = true
+	m.exitDetailView()
+	assert.False(t, m.detailHistory)
+}
+
+func TestDetailHistory_ErrorRenderedInPlace(t *testing.T) {
+	t.Parallel()
+	ld := &historyLoader{err: errors.New("not a git repository")}
+	m := newHistoryModel(t, ld)
+	m.detailHistory = true
+
+	msg := m.updateContentAsync()()
+	rendered, ok := msg.(detailContentRenderedMsg)
+	require.True(t, ok)
+	require.NoError(t, rendered.Err)
+	assert.Contains(t, rendered.Content, "History unavailable: not a git repository")
+}
+
+func TestDetailHistory_IgnoredOutsideRepoCategories(t *testing.T) {
+	t.Parallel()
+	m := newHistoryModel(t, &historyLoader{})
+	m.category = domain.GPUNode
+	m.keys = keys.ResolveKeys(m.category, m.viewMode)
+
+	m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+	assert.False(t, m.detailHistory)
+}
+
+// hasContextKey matches bindings by key, so a binding that only shares
+// ViewHistory's help text is not mistaken for it.
+func TestHasContextKey_MatchesByKeys(t *testing.T) {
+	t.Parallel()
+	m := newHistoryModel(t, &historyLoader{})
+	assert.True(t, m.hasContextKey(keys.ViewHistory))
+	assert.False(t, m.hasContextKey(keys.ViewRaw))
+
+	lookalike := key.NewBinding(key.WithKeys("ctrl+h"), key.WithHelp("<shift+h>", keys.ViewHistory.Help().Desc))
+	assert.False(t, m.hasContextKey(lookalike))
+}
+
+func TestRenderHistory_Empty(t *testing.T) {
+	t.Parallel()
+	out := renderHistory(gitlog.History{Path: "a.json"})
+	assert.Contains(t, out, "Source: a.json")
+	assert.Contains(t, out, "No commits")
+}
+
+func TestHistoryTarget(t *testing.T) {
+	t.Parallel()
+	tenant, name := historyTarget(models.ScopedItemKey{Scope: "t", Name: "n"})
+	assert.Equal(t, "t", tenant)
+	assert.Equal(t, "n", name)
+	tenant, name = historyTarget("def")
+	assert.Empty(t, tenant)
+	assert.Equal(t, "def", name)
+	tenant, name = historyTarget(nil)
+	assert.Empty(t, tenant)
+	assert.Empty(t, name)
+}
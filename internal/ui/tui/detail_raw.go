@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"sigs.k8s.io/yaml"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	loader "github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// toggleDetailRaw flips the details view between toolkit's model of the
+// item and the live Kubernetes object behind it, then re-renders.
+func (m *Model) toggleDetailRaw() tea.Cmd {
+	m.detailRaw = !m.detailRaw
+	m.viewport.GotoTop()
+	return m.updateContentAsync()
+}
+
+// rawTarget returns the category, namespace and name to fetch the live
+// object for item by. A cluster-scoped imported model is a tenant-labeled
+// ClusterBaseModel, so it is looked up as a BaseModel — otherwise a
+// namespaced BaseModel with the same name would shadow it.
+func rawTarget(cat domain.Category, item any) (domain.Category, string, string, bool) {
+	switch it := item.(type) {
+	case *models.BaseModel:
+		return cat, "", it.Name, true
+	case *models.ImportedModel:
+		if it.Namespace == "" {
+			return domain.BaseModel, "", it.Name, true
+		}
+		return cat, it.Namespace, it.Name, true
+	case *models.DedicatedAICluster:
+		return cat, "", it.Name, true
+	case *models.GPUNode:
+		return cat, "", it.Name, true
+	case *models.GPUWorkload:
+		return cat, it.Namespace, it.Name, true
+	default:
+		return cat, "", "", false
+	}
+}
+
+// rawContentCmd fetches the live object behind the selected item off the
+// UI goroutine and renders it as YAML for the details view. Failures are
+// rendered in place of the object so the user sees why it is missing.
+func (m *Model) rawContentCmd(gen int) tea.Cmd {
+	ctx := m.sessionCtx()
+	ld := m.loader
+	kubeCfg, env := m.kubeConfig, m.environment
+	item := findItem(m.dataset, m.category, m.selectedKey)
+	cat, namespace, name, ok := rawTarget(m.category, item)
+	var cluster string
+	if c, isClustered := item.(models.Clustered); isClustered {
+		cluster = c.GetCluster()
+	}
+
+	return func() tea.Msg {
+		if !ok {
+			return detailContentRenderedMsg{Content: renderRawError(errors.New("the selected item is no longer loaded")), Gen: gen}
+		}
+		reader, ok := ld.(loader.RawObjectReader)
+		if !ok {
+			return detailContentRenderedMsg{Content: renderRawError(errors.New("loader does not support reading raw objects")), Gen: gen}
+		}
+		obj, err := reader.RawObject(ctx, kubeCfg, env, cat, cluster, namespace, name)
+		if err != nil {
+			return detailContentRenderedMsg{Content: renderRawError(err), Gen: gen}
+		}
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return detailContentRenderedMsg{Content: renderRawError(err), Gen: gen}
+		}
+		return detailContentRenderedMsg{Content: string(out), Gen: gen}
+	}
+}
+
+func renderRawError(err error) string {
+	return fmt.Sprintf("Raw object unavailable: %v\n\nPress <shift+y> to return to the item.", err)
+}
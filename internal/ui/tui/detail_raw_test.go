@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	logging "github.com/jingle2008/toolkit/pkg/infra/logging"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// rawLoader adds the optional RawObjectReader capability to fakeLoader
+// and records the lookup it was asked for.
+type rawLoader struct {
+	fakeLoader
+	obj map[string]any
+	err error
+
+	gotCat                                 domain.Category
+	gotCluster, gotNS, gotName, gotKubeCfg string
+}
+
+func (r *rawLoader) RawObject(_ context.Context, kubeCfg string, _ models.Environment, cat domain.Category,
+	cluster, namespace, name string,
+) (map[string]any, error) {
+	r.gotCat, r.gotCluster, r.gotNS, r.gotName, r.gotKubeCfg = cat, cluster, namespace, name, kubeCfg
+	return r.obj, r.err
+}
+
+func newRawModel(t *testing.T, ld *rawLoader, cat domain.Category, key models.ItemKey, ds *models.Dataset) *Model {
+	t.Helper()
+	m, err := NewModel(
+		WithRepoPath("repo"),
+		WithKubeConfig("kc"),
+		WithEnvironment(models.Environment{Type: "dev", Region: "us-phx-1", Realm: "oc1"}),
+		WithLoader(ld),
+		WithLogger(logging.NewNoOpLogger()),
+	)
+	require.NoError(t, err)
+	m.category = cat
+	m.viewMode = common.DetailsView
+	m.keys = keys.ResolveKeys(m.category, m.viewMode)
+	m.selectedKey = key
+	m.dataset = ds
+	return m
+}
+
+func workloadDataset() *models.Dataset {
+	return &models.Dataset{GPUWorkloadMap: map[string][]models.GPUWorkload{
+		"gpu-1": {{Name: "serv-0", Namespace: "ns1", Node: "gpu-1", Cluster: "dp-dev-phx-b"}},
+	}}
+}
+
+func TestDetailRaw_ToggleRendersYAML(t *testing.T) {
+	t.Parallel()
+	ld := &rawLoader{obj: map[string]any{
+		"kind":     "Pod",
+		"metadata": map[string]any{"name": "serv-0", "annotations": map[string]any{"team": "genai"}},
+	}}
+	m := newRawModel(t, ld, domain.GPUWorkload, models.ScopedItemKey{Scope: "gpu-1", Name: "serv-0"}, workloadDataset())
+
+	_, cmd := m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	require.True(t, m.detailRaw)
+	require.NotNil(t, cmd)
+
+	msg := m.rawContentCmd(m.gens.detail)()
+	rendered, ok := msg.(detailContentRenderedMsg)
+	require.True(t, ok)
+	assert.Equal(t, domain.GPUWorkload, ld.gotCat)
+	assert.Equal(t, "dp-dev-phx-b", ld.gotCluster, "the object is read from the cluster the row came from")
+	assert.Equal(t, "ns1", ld.gotNS)
+	assert.Equal(t, "serv-0", ld.gotName)
+	assert.Equal(t, "kc", ld.gotKubeCfg)
+	assert.Contains(t, rendered.Content, "kind: Pod")
+	assert.Contains(t, rendered.Content, "team: genai")
+
+	// Toggling back returns to the JSON view; leaving details resets it.
+	_, _ = m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	assert.False(t, m.detailRaw)
+	m.detailRaw = true
+	m.exitDetailView()
+	assert.False(t, m.detailRaw)
+}
+
+func TestDetailRaw_KeyIgnoredWithoutBackingObject(t *testing.T) {
+	t.Parallel()
+	m := newRawModel(t, &rawLoader{}, domain.Tenant, "acme", &models.Dataset{})
+
+	_, _ = m.updateDetailView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Y")})
+	assert.False(t, m.detailRaw)
+}
+
+func TestDetailRaw_RendersErrors(t *testing.T) {
+	t.Parallel()
+	ld := &rawLoader{err: errors.New("pods \"serv-0\" not found")}
+	m := newRawModel(t, ld, domain.GPUWorkload, models.ScopedItemKey{Scope: "gpu-1", Name: "serv-0"}, workloadDataset())
+
+	rendered, ok := m.rawContentCmd(1)().(detailContentRenderedMsg)
+	require.True(t, ok)
+	assert.Contains(t, rendered.Content, "Raw object unavailable: pods \"serv-0\" not found")
+
+	// A loader without the capability reports it instead of panicking.
+	m.loader = fakeLoader{}
+	rendered, ok = m.rawContentCmd(1)().(detailContentRenderedMsg)
+	require.True(t, ok)
+	assert.Contains(t, rendered.Content, "does not support reading raw objects")
+}
+
+func TestRawTarget(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name     string
+		cat      domain.Category
+		item     any
+		wantCat  domain.Category
+		wantNS   string
+		wantName string
+		wantOK   bool
+	}{
+		{"node", domain.GPUNode, &models.GPUNode{Name: "gpu-1"}, domain.GPUNode, "", "gpu-1", true},
+		{"dac", domain.DedicatedAICluster, &models.DedicatedAICluster{Name: "dac1"}, domain.DedicatedAICluster, "", "dac1", true},
+		{"namespaced imported model", domain.ImportedModel,
+			&models.ImportedModel{BaseModel: models.BaseModel{Name: "im"}, Namespace: "t1"}, domain.ImportedModel, "t1", "im", true},
+		{"cluster-scoped imported model", domain.ImportedModel,
+			&models.ImportedModel{BaseModel: models.BaseModel{Name: "im"}}, domain.BaseModel, "", "im", true},
+		{"missing item", domain.GPUNode, nil, domain.GPUNode, "", "", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cat, ns, name, ok := rawTarget(tc.cat, tc.item)
+			assert.Equal(t, tc.wantCat, cat)
+			assert.Equal(t, tc.wantNS, ns)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
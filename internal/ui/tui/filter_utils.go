@@ -15,7 +15,7 @@ func filterTableAsync(m *Model, filter string) tea.Cmd {
 		return nil
 	}
 	m.filter = filter
-	return m.updateRowsAsync()
+	return m.refilterRowsAsync()
 }
 
 /*
@@ -0,0 +1,285 @@
+// Package tui — background-jobs panel.
+//
+// Every mutation launched from the session (delete, terminate, scale,
+// cordon, drain, reboot) is recorded as a job when it starts and
+// finished by its result message. A job whose service returned a work
+// request (terminate) stays running until polling reports the work
+// request done. The panel lists them all, newest first.
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/ui/tui/actions"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// Job statuses for jobs without a work request; a job tracking one
+// reports the work request's own status.
+const (
+	jobRunning   = "RUNNING"
+	jobSucceeded = "SUCCEEDED"
+	jobFailed    = "FAILED"
+)
+
+// jobPollInterval is how often a job's work request is polled; tests
+// shorten it.
+var jobPollInterval = 10 * time.Second
+
+// jobsRefreshInterval is how often the open jobs panel re-renders, so
+// running jobs' elapsed time keeps counting while the app is idle.
+const jobsRefreshInterval = time.Second
+
+// jobsTickMsg drives the jobs panel's periodic re-render.
+type jobsTickMsg struct{}
+
+// jobsTickCmd schedules the next jobs-panel refresh.
+func jobsTickCmd() tea.Cmd {
+	return tea.Tick(jobsRefreshInterval, func(time.Time) tea.Msg { return jobsTickMsg{} })
+}
+
+// getWorkRequest is the seam tests use to fake polling a work request.
+var getWorkRequest = actions.GetWorkRequest
+
+// job is one mutation launched from the session.
+type job struct {
+	id          int
+	action      string // "terminate", "scale up", ...
+	target      string // "node/<name>", "GPU pool/<name>", ...
+	started     time.Time
+	finished    time.Time // zero while running
+	status      string
+	workRequest string
+	percent     float32
+	err         error
+}
+
+func (j *job) running() bool { return j.finished.IsZero() }
+
+// jobPanel holds the session's jobs, oldest first, and the view to
+// restore when the panel closes. seq is the job id source; id 0 never
+// names a job, so a result message built without one finishes nothing.
+type jobPanel struct {
+	items      []*job
+	seq        int
+	returnView common.ViewMode
+}
+
+// startJob records a running job and returns its id, to be carried by
+// the mutation's result message.
+func (m *Model) startJob(action, kind, target string) int {
+	m.jobs.seq++
+	m.jobs.items = append(m.jobs.items, &job{
+		id:      m.jobs.seq,
+		action:  action,
+		target:  kind + "/" + target,
+		started: time.Now(),
+		status:  jobRunning,
+	})
+	return m.jobs.seq
+}
+
+// findJob returns the job with the given id, or nil.
+func (m *Model) findJob(id int) *job {
+	for _, j := range m.jobs.items {
+		if j.id == id {
+			return j
+		}
+	}
+	return nil
+}
+
+// finishJob marks a job done, failed when err is non-nil.
+func (m *Model) finishJob(id int, err error) {
+	j := m.findJob(id)
+	if j == nil || !j.running() {
+		return
+	}
+	j.finished = time.Now()
+	j.err = err
+	j.status = jobSucceeded
+	if err != nil {
+		j.status = jobFailed
+	}
+}
+
+// trackWorkRequest keeps job id running on the work request wr and
+// starts polling it. With no work request the job simply finishes.
+func (m *Model) trackWorkRequest(id int, wr string) tea.Cmd {
+	j := m.findJob(id)
+	if j == nil {
+		return nil
+	}
+	if wr == "" {
+		m.finishJob(id, nil)
+		return nil
+	}
+	j.workRequest = wr
+	return m.pollJobCmd(id, wr)
+}
+
+// pollJobCmd fetches the job's work request after jobPollInterval.
+func (m *Model) pollJobCmd(id int, wr string) tea.Cmd {
+	env := m.environment
+	return tea.Tick(jobPollInterval, func(time.Time) tea.Msg {
+		ctx, cancel := m.opCtx()
+		defer cancel()
+		got, err := getWorkRequest(ctx, wr, env)
+		return jobPolledMsg{job: id, wr: got, err: err}
+	})
+}
+
+// handleJobPolled applies one work request poll: progress while it runs
+// (a failed poll is logged and retried, unless the failure is permanent,
+// which fails the job), and the final status, with a toast, once it is
+// done.
+func (m *Model) handleJobPolled(msg jobPolledMsg) tea.Cmd {
+	j := m.findJob(msg.job)
+	if j == nil || !j.running() {
+		return nil
+	}
+	if msg.err != nil && oci.IsPermanent(msg.err) {
+		m.finishJob(j.id, msg.err)
+		return m.showToast(fmt.Sprintf("%s %s: %s", j.action, j.target, j.status), toastError)
+	}
+	if msg.err != nil {
+		m.logger.Warnw("failed to poll work request", "workRequest", j.workRequest, "error", msg.err)
+		return m.pollJobCmd(j.id, j.workRequest)
+	}
+	j.status = msg.wr.Status
+	j.percent = msg.wr.PercentComplete
+	if !msg.wr.IsDone() {
+		return m.pollJobCmd(j.id, j.workRequest)
+	}
+	j.finished = time.Now()
+	sev := toastInfo
+	if !strings.EqualFold(msg.wr.Status, models.WorkRequestStatusSucceeded) {
+		sev = toastError
+		if len(msg.wr.Errors) > 0 {
+			j.err = errors.New(strings.Join(msg.wr.Errors, "; "))
+		}
+	}
+	return m.showToast(fmt.Sprintf("%s %s: %s", j.action, j.target, j.status), sev)
+}
+
+// runningJobs counts the jobs still running, for the status bar.
+func (m *Model) runningJobs() int {
+	n := 0
+	for _, j := range m.jobs.items {
+		if j.running() {
+			n++
+		}
+	}
+	return n
+}
+
+// interceptToggleJobs opens the jobs panel when msg is the ToggleJobs key
+// pressed from the list or details view, recording the view to return to
+// and arming the refresh tick.
+func (m *Model) interceptToggleJobs(msg tea.Msg) (tea.Model, tea.Cmd, bool) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok || !key.Matches(km, keys.ToggleJobs) {
+		return m, nil, false
+	}
+	if m.viewMode != common.ListView && m.viewMode != common.DetailsView {
+		return m, nil, false
+	}
+	m.jobs.returnView = m.viewMode
+	m.viewMode = common.JobsView
+	return m, jobsTickCmd(), true
+}
+
+// updateJobsView handles input while the jobs panel is open: close keys
+// and quit; everything else is ignored.
+func (m *Model) updateJobsView(msg tea.Msg) (tea.Model, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(km, keys.ToggleJobs, keys.Back):
+		m.viewMode = m.jobs.returnView
+	case key.Matches(km, keys.Quit):
+		m.cancelInFlight()
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// jobsView renders the full-screen jobs panel: a title bar with the
+// running count, one line per job (newest first, as many as fit) and a
+// key hint footer.
+func (m *Model) jobsView() string {
+	width := m.viewWidth
+	bodyHeight := max(m.viewHeight-2, 1) // title + hint lines
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("231")).
+		Background(lipgloss.Color("24")).
+		Width(width).
+		Render(fmt.Sprintf("BACKGROUND JOBS — %d running", m.runningJobs()))
+
+	lines := []string{"(no jobs launched this session)"}
+	if len(m.jobs.items) > 0 {
+		now := time.Now()
+		lines = []string{lipgloss.NewStyle().Bold(true).Render(
+			fmt.Sprintf("%-8s  %-10s  %-5s  %-7s  %s", "STARTED", "STATUS", "%", "TIME", "JOB"))}
+		for i := len(m.jobs.items) - 1; i >= 0 && len(lines) < bodyHeight; i-- {
+			line := runewidth.Truncate(formatJob(m.jobs.items[i], now), max(width, 1), "…")
+			lines = append(lines, jobStyle(m.jobs.items[i]).Render(line))
+		}
+	}
+	body := lipgloss.NewStyle().Height(bodyHeight).Render(strings.Join(lines, "\n"))
+	hint := lipgloss.NewStyle().Foreground(lipgloss.Color("245")).
+		Render("ctrl+b/esc close · terminate progress polls every 10s")
+	return lipgloss.JoinVertical(lipgloss.Left, title, body, hint)
+}
+
+// formatJob renders one job as an uncolored panel line: start time,
+// status, percent complete (work requests only), elapsed time, the
+// action and target, then the work request and error when present.
+func formatJob(j *job, now time.Time) string {
+	pct := "-"
+	if j.workRequest != "" {
+		pct = fmt.Sprintf("%.0f", j.percent)
+	}
+	end := now
+	if !j.running() {
+		end = j.finished
+	}
+	line := fmt.Sprintf("%-8s  %-10s  %-5s  %-7s  %s %s",
+		j.started.Format("15:04:05"), j.status, pct,
+		end.Sub(j.started).Round(time.Second), j.action, j.target)
+	if j.workRequest != "" {
+		line += "  " + j.workRequest
+	}
+	if j.err != nil {
+		line += "  " + j.err.Error()
+	}
+	return line
+}
+
+// jobStyle colors a job line by outcome: plain while running, green on
+// success, red otherwise.
+func jobStyle(j *job) lipgloss.Style {
+	switch {
+	case j.running():
+		return lipgloss.NewStyle()
+	case j.status == jobSucceeded:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	default:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	}
+}
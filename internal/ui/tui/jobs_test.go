@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/oci"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestStartFinishJob(t *testing.T) {
+	t.Parallel()
+	m := &Model{}
+	a := m.startJob("delete", "DAC", "dac1")
+	b := m.startJob("reboot", "node", "n1")
+	assert.NotEqual(t, a, b)
+	assert.Equal(t, 2, m.runningJobs())
+
+	m.finishJob(a, nil)
+	m.finishJob(b, errors.New("boom"))
+	m.finishJob(0, nil) // id 0 names no job
+	assert.Equal(t, 0, m.runningJobs())
+	assert.Equal(t, jobSucceeded, m.findJob(a).status)
+	assert.Equal(t, jobFailed, m.findJob(b).status)
+	assert.EqualError(t, m.findJob(b).err, "boom")
+
+	// A finished job stays finished.
+	m.finishJob(b, nil)
+	assert.Equal(t, jobFailed, m.findJob(b).status)
+}
+
+func TestTrackWorkRequest_NoWorkRequestFinishes(t *testing.T) {
+	t.Parallel()
+	m := &Model{}
+	id := m.startJob("terminate", "node", "n1")
+	assert.Nil(t, m.trackWorkRequest(id, ""))
+	assert.Equal(t, jobSucceeded, m.findJob(id).status)
+	assert.Nil(t, m.trackWorkRequest(99, "ocid1.wr"), "unknown job")
+}
+
+//nolint:paralleltest // swaps the package-level getWorkRequest seam
+func TestTrackWorkRequest_PollsUntilDone(t *testing.T) {
+	origGet, origInterval := getWorkRequest, jobPollInterval
+	defer func() { getWorkRequest, jobPollInterval = origGet, origInterval }()
+	jobPollInterval = time.Millisecond
+	var gotID string
+	getWorkRequest = func(_ context.Context, id string, _ models.Environment) (models.WorkRequest, error) {
+		gotID = id
+		return models.WorkRequest{ID: id, Status: "IN_PROGRESS", PercentComplete: 40}, nil
+	}
+
+	m := newTestModel(t)
+	id := m.startJob("terminate", "node", "n1")
+	cmd := m.trackWorkRequest(id, "ocid1.wr")
+	require.NotNil(t, cmd)
+	msg, ok := cmd().(jobPolledMsg)
+	require.True(t, ok)
+	assert.Equal(t, "ocid1.wr", gotID)
+
+	require.NotNil(t, m.handleJobPolled(msg), "still running: polls again")
+	j := m.findJob(id)
+	assert.True(t, j.running())
+	assert.Equal(t, "IN_PROGRESS", j.status)
+	assert.InDelta(t, 40, j.percent, 0.001)
+	assert.Equal(t, "ocid1.wr", j.workRequest)
+}
+
+func TestHandleJobPolled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		msg     jobPolledMsg
+		running bool
+		status  string
+		sev     toastSeverity
+		err     string
+	}{
+		{
+			name:    "poll error retries",
+			msg:     jobPolledMsg{err: errors.New("throttled")},
+			running: true,
+			status:  jobRunning,
+		},
+		{
+			name:   "permanent poll error fails the job",
+			msg:    jobPolledMsg{err: oci.Permanent(errors.New("no such work request"))},
+			status: jobFailed,
+			sev:    toastError,
+			err:    "no such work request",
+		},
+		{
+			name:   "succeeded",
+			msg:    jobPolledMsg{wr: models.WorkRequest{Status: "SUCCEEDED", PercentComplete: 100}},
+			status: "SUCCEEDED",
+			sev:    toastInfo,
+		},
+		{
+			name:   "failed",
+			msg:    jobPolledMsg{wr: models.WorkRequest{Status: "FAILED", Errors: []string{"a", "b"}}},
+			status: "FAILED",
+			sev:    toastError,
+			err:    "a; b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newTestModel(t)
+			id := m.startJob("terminate", "node", "n1")
+			m.findJob(id).workRequest = "ocid1.wr"
+			tt.msg.job = id
+
+			cmd := m.handleJobPolled(tt.msg)
+			require.NotNil(t, cmd)
+			j := m.findJob(id)
+			assert.Equal(t, tt.running, j.running())
+			assert.Equal(t, tt.status, j.status)
+			if tt.running {
+				assert.Nil(t, m.toasts.active)
+				return
+			}
+			require.NotNil(t, m.toasts.active)
+			assert.Equal(t, "terminate node/n1: "+tt.status, m.toasts.active.msg)
+			assert.Equal(t, tt.sev, m.toasts.active.sev)
+			if tt.err != "" {
+				assert.EqualError(t, j.err, tt.err)
+			}
+		})
+	}
+}
+
+func TestHandleDeleteDoneMsg_TracksWorkRequest(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	id := m.startJob("terminate", "node", "n1")
+	cmd := m.handleDeleteDoneMsg(deleteDoneMsg{
+		category:    domain.GPUNode,
+		key:         models.ScopedItemKey{Scope: "pool1", Name: "n1"},
+		job:         id,
+		workRequest: "ocid1.wr",
+	})
+	assert.NotNil(t, cmd)
+	assert.True(t, m.findJob(id).running(), "runs until the work request is done")
+}
+
+func TestJobsView_Toggle(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.viewMode = common.ListView
+	ctrlB := tea.KeyMsg{Type: tea.KeyCtrlB}
+
+	_, cmd := m.Update(ctrlB)
+	assert.Equal(t, common.JobsView, m.viewMode)
+	assert.NotNil(t, cmd, "arms the refresh tick")
+	assert.Contains(t, m.View(), "(no jobs launched this session)")
+
+	_, _ = m.Update(jobsTickMsg{})
+	assert.Equal(t, common.JobsView, m.viewMode)
+
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, common.ListView, m.viewMode)
+}
+
+func TestStatusView_ShowsRunningJobs(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	assert.NotContains(t, m.statusView(), "job(s)")
+	m.startJob("drain", "node", "n1")
+	assert.Contains(t, m.statusView(), "⚙ 1 job(s)")
+}
+
+func TestFormatJob(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	j := &job{
+		action: "terminate", target: "node/n1", started: start,
+		finished: start.Add(90 * time.Second), status: "FAILED",
+		workRequest: "ocid1.wr", percent: 40, err: errors.New("protected"),
+	}
+	assert.Equal(t, "03:04:05  FAILED      40     1m30s    terminate node/n1  ocid1.wr  protected",
+		formatJob(j, start.Add(time.Hour)))
+
+	j = &job{action: "drain", target: "node/n1", started: start, status: jobRunning}
+	assert.Equal(t, "03:04:05  RUNNING     -      10s      drain node/n1",
+		formatJob(j, start.Add(10*time.Second)))
+}
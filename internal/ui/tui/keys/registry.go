@@ -38,6 +38,10 @@ var (
 		key.WithKeys("`"),
 		key.WithHelp("<`>", "Toggle Log"),
 	)
+	ToggleJobs = key.NewBinding(
+		key.WithKeys("ctrl+b"),
+		key.WithHelp("<ctrl+b>", "Background Jobs"),
+	)
 )
 
 var globalKeys = []key.Binding{
@@ -45,6 +49,7 @@ var globalKeys = []key.Binding{
 	ViewDetails,
 	CopyName,
 	ToggleLog,
+	ToggleJobs,
 	Back,
 	Quit,
 }
@@ -125,6 +130,13 @@ var (
 		key.WithKeys("m"),
 		key.WithHelp("<m>", "Open Metrics"),
 	)
+	// ViewMetrics fetches the selected DAC's or GPU workload's metrics
+	// from OCI Monitoring and shows them as sparklines in the terminal,
+	// for sessions with no browser (list view only).
+	ViewMetrics = key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("<shift+m>", "View Metrics"),
+	)
 )
 
 var listModeKeys = []key.Binding{
@@ -221,6 +233,16 @@ var (
 		key.WithKeys("A"),
 		key.WithHelp("<shift+a>", SortPrefix+common.AgeCol),
 	)
+	// ViewLogs opens the container log view for the selected GPU workload.
+	ViewLogs = key.NewBinding(
+		key.WithKeys("l"),
+		key.WithHelp("<l>", "View Logs"),
+	)
+	// SortLastSeen is a key binding for sorting by the "Last Seen" column.
+	SortLastSeen = key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("<shift+l>", SortPrefix+common.LastSeenCol),
+	)
 	// SortUsage is a key binding for sorting by the "Usage" column.
 	SortUsage = key.NewBinding(
 		key.WithKeys("U"),
@@ -256,60 +278,170 @@ var (
 		key.WithKeys("E"),
 		key.WithHelp("<shift+e>", "Edit Tenant"),
 	)
+	// ViewHistory toggles the details view between the item's JSON and
+	// the git history (with diffs) of the repo file backing it. Details
+	// view only, on the definition and override categories.
+	ViewHistory = key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("<shift+h>", "Toggle History"),
+	)
+	// ViewRaw toggles the details view between the item's JSON and the
+	// live Kubernetes object (CR, Node or Pod) behind it, as YAML. Details
+	// view only, on the cluster-derived categories with a backing object.
+	ViewRaw = key.NewBinding(
+		key.WithKeys("Y"),
+		key.WithHelp("<shift+y>", "Toggle Raw YAML"),
+	)
+	// OpenEditor suspends the TUI and opens the selected item's source
+	// file in $EDITOR at the item's line, reloading the repo data when the
+	// editor exits. A control key so it never collides with filter input.
+	OpenEditor = key.NewBinding(
+		key.WithKeys("ctrl+e"),
+		key.WithHelp("<ctrl+e>", "Open in Editor"),
+	)
 )
 
+// Pod log view key bindings. The view handles its own keys (Back closes
+// it, Quit exits), so these only need to be distinct from each other and
+// from those two — not from the list-view bindings that share letters.
+var (
+	// LogContainer switches the log view to the pod's next container.
+	LogContainer = key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("<shift+c>", "Next Container"),
+	)
+	// LogFollow toggles streaming new lines as they are written.
+	LogFollow = key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("<f>", "Toggle Follow"),
+	)
+	// LogPrevious toggles the log of the container's previous
+	// (terminated) instance.
+	LogPrevious = key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("<p>", "Toggle Previous"),
+	)
+	// LogSearch opens the search prompt.
+	LogSearch = key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("</>", "Search"),
+	)
+	// LogNextMatch jumps to the next search match.
+	LogNextMatch = key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("<n>", "Next Match"),
+	)
+	// LogPrevMatch jumps to the previous search match.
+	LogPrevMatch = key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("<shift+n>", "Previous Match"),
+	)
+	// LogSave writes the buffered log lines to a file.
+	LogSave = key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("<s>", "Save to File"),
+	)
+)
+
+// PodLogKeys lists the pod log view bindings in help order.
+var PodLogKeys = []key.Binding{
+	LogContainer,
+	LogFollow,
+	LogPrevious,
+	LogSearch,
+	LogNextMatch,
+	LogPrevMatch,
+	LogSave,
+	Back,
+}
+
 // Category+mode-specific key bindings
 var catContext = map[domain.Category]map[common.ViewMode][]key.Binding{
 	domain.BaseModel: {
-		common.ListView: {SortSize, SortContext, ToggleFaulty, Refresh},
+		common.ListView:    {SortSize, SortContext, ToggleFaulty, Refresh},
+		common.DetailsView: {ViewRaw},
 	},
 	domain.Tenant: {
 		common.ListView: {SortInternal, CopyTenant, ToggleFaulty},
 	},
+	domain.LimitDefinition: {
+		common.ListView:    {OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
+	},
 	domain.ConsolePropertyDefinition: {
-		common.ListView: {SortValue},
+		common.ListView:    {SortValue, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.PropertyDefinition: {
-		common.ListView: {SortValue},
+		common.ListView:    {SortValue, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.GPUPool: {
-		common.ListView: {SortSize, ToggleFaulty, ScaleUp, Refresh},
+		common.ListView:    {SortSize, ToggleFaulty, ScaleUp, Refresh, OpenEditor},
+		common.DetailsView: {OpenEditor},
 	},
 	domain.GPUNode: {
-		common.ListView: {Parent, SortFree, SortType, SortAge, Refresh, ToggleCordon, DrainNode, ToggleFaulty, RebootNode, Delete},
+		common.ListView:    {Parent, SortFree, SortType, SortAge, Refresh, ToggleCordon, DrainNode, ToggleFaulty, RebootNode, Delete},
+		common.DetailsView: {ViewRaw},
 	},
 	domain.GPUWorkload: {
-		common.ListView: {Parent, SortTenant, SortAge, OpenMetrics, ToggleFaulty, Refresh},
+		common.ListView:    {Parent, SortTenant, SortAge, ViewLogs, OpenMetrics, ViewMetrics, ToggleFaulty, Refresh},
+		common.DetailsView: {ViewRaw},
+	},
+	domain.PendingGPUWorkload: {
+		common.ListView: {SortTenant, SortAge, Refresh, ToggleFaulty},
+	},
+	domain.WorkRequest: {
+		common.ListView: {SortAge, Refresh, ToggleFaulty},
 	},
 	domain.DedicatedAICluster: {
-		common.ListView: {Parent, SortTenant, SortInternal, SortUsage, SortSize, SortAge, CopyTenant, EditTenant, OpenMetrics, Refresh, ToggleFaulty, Delete},
+		common.ListView:    {Parent, SortTenant, SortInternal, SortUsage, SortSize, SortAge, CopyTenant, EditTenant, OpenMetrics, ViewMetrics, Refresh, ToggleFaulty, Delete},
+		common.DetailsView: {ViewRaw},
+	},
+	domain.Endpoint: {
+		common.ListView: {Parent, SortAge, OpenMetrics, Refresh, ToggleFaulty, Delete},
+	},
+	domain.Event: {
+		common.ListView: {Parent, SortType, SortLastSeen, Refresh, ToggleFaulty},
 	},
 	domain.ImportedModel: {
-		common.ListView: {Parent, SortTenant, SortSize, SortContext, SortVendor, CopyTenant, EditTenant, OpenMetrics, Refresh},
+		common.ListView:    {Parent, SortTenant, SortSize, SortContext, SortVendor, CopyTenant, EditTenant, OpenMetrics, Refresh},
+		common.DetailsView: {ViewRaw},
 	},
 	domain.LimitTenancyOverride: {
-		common.ListView: {Parent, SortTenant, SortRegions, CopyTenant},
+		common.ListView:    {Parent, SortTenant, SortRegions, CopyTenant, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.ConsolePropertyTenancyOverride: {
-		common.ListView: {Parent, SortTenant, SortRegions, SortValue, CopyTenant},
+		common.ListView:    {Parent, SortTenant, SortRegions, SortValue, CopyTenant, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.PropertyTenancyOverride: {
-		common.ListView: {Parent, SortTenant, SortRegions, SortValue, CopyTenant},
+		common.ListView:    {Parent, SortTenant, SortRegions, SortValue, CopyTenant, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.LimitRegionalOverride: {
-		common.ListView: {Parent, SortRegions},
+		common.ListView:    {Parent, SortRegions, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.PropertyRegionalOverride: {
-		common.ListView: {Parent, SortRegions, SortValue},
+		common.ListView:    {Parent, SortRegions, SortValue, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.ConsolePropertyRegionalOverride: {
-		common.ListView: {Parent, SortRegions, SortValue},
+		common.ListView:    {Parent, SortRegions, SortValue, OpenEditor},
+		common.DetailsView: {ViewHistory, OpenEditor},
 	},
 	domain.Environment: {
 		common.ListView: {SortType},
 	},
+	domain.ModelArtifact: {
+		common.ListView:    {OpenEditor},
+		common.DetailsView: {OpenEditor},
+	},
 	domain.ServiceTenancy: {
-		common.ListView: {SortType},
+		common.ListView:    {SortType, OpenEditor},
+		common.DetailsView: {OpenEditor},
 	},
 }
 
@@ -41,3 +41,16 @@ func TestNoKeyConflictsPerCategoryAndMode(t *testing.T) {
 		}
 	}
 }
+
+func TestNoKeyConflictsInPodLogView(t *testing.T) {
+	t.Parallel()
+	seen := map[string]string{}
+	for _, b := range append([]key.Binding{Quit}, PodLogKeys...) {
+		for _, k := range b.Keys() {
+			if prev, ok := seen[k]; ok {
+				t.Errorf("duplicate key %q in pod log view: %s and %s", k, prev, b.Help().Desc)
+			}
+			seen[k] = b.Help().Desc
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package keys
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -55,11 +56,14 @@ func TestResolveKeys_GlobalAndContext(t *testing.T) {
 	assert.NotEmpty(t, km.Mode)
 	assert.NotEmpty(t, km.Context)
 
-	km2 := ResolveKeys(domain.BaseModel, common.DetailsView)
+	km2 := ResolveKeys(domain.Tenant, common.DetailsView)
 	assert.NotNil(t, km2.Global)
 	assert.NotEmpty(t, km2.Mode)
 	assert.Empty(t, km2.Context)
 
+	// Cluster-derived categories with a backing object offer the raw toggle.
+	assert.Equal(t, []key.Binding{ViewRaw}, ResolveKeys(domain.BaseModel, common.DetailsView).Context)
+
 	km3 := ResolveKeys(domain.Tenant, common.ListView)
 	assert.NotNil(t, km3.Global)
 	assert.NotEmpty(t, km3.Context)
@@ -78,3 +82,27 @@ func TestToggleLogInGlobalKeys(t *testing.T) {
 		t.Error("backtick (ToggleLog) not present in global keys")
 	}
 }
+
+func TestToggleJobsInGlobalKeys(t *testing.T) {
+	t.Parallel()
+	for _, mode := range []common.ViewMode{common.ListView, common.DetailsView} {
+		km := ResolveKeys(domain.GPUNode, mode)
+		assert.True(t, slices.ContainsFunc(km.Global, func(b key.Binding) bool {
+			return key.Matches(tea.KeyMsg{Type: tea.KeyCtrlB}, b)
+		}), "ctrl+b (ToggleJobs) not present in %s global keys", mode)
+	}
+}
+
+// The raw-object toggle is offered exactly where the loader can serve it.
+func TestViewRaw_MatchesHasRawObject(t *testing.T) {
+	t.Parallel()
+	for _, c := range domain.Categories {
+		bound := false
+		for _, b := range ResolveKeys(c, common.DetailsView).Context {
+			if b.Help() == ViewRaw.Help() {
+				bound = true
+			}
+		}
+		assert.Equalf(t, c.HasRawObject(), bound, "ViewRaw binding mismatch for %s", c)
+	}
+}
@@ -14,14 +14,29 @@ import (
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
+// loadFailed returns the errMsg for a failed load of cat, or nil when the
+// load succeeded. A partial load is non-fatal in the TUI: the items still
+// hold the rows that did load (every GPU pool source or kube context that
+// answered), so the failures are only logged.
+func loadFailed(ctx context.Context, cat domain.Category, err error, gen int) tea.Msg {
+	if err == nil {
+		return nil
+	}
+	if partial, ok := errors.AsType[*terraform.PartialLoadError](err); ok {
+		logging.FromContext(ctx).Warnw("loaded with partial failures", "category", cat, "error", partial)
+		return nil
+	}
+	return errMsg{err: fmt.Errorf("failed to load %s: %w", cat, err), Gen: gen}
+}
+
 // Pure command constructors. Each builds a tea.Cmd that loads one
 // category and returns a typed *LoadedMsg on success or errMsg on
 // failure; the gen counter lets the reducer drop stale responses.
 func loadBaseModelsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		items, err := ld.LoadBaseModels(ctx, kubeCfg, env)
-		if err != nil {
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.BaseModel, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.BaseModel, err, gen); msg != nil {
+			return msg
 		}
 		return baseModelsLoadedMsg{Items: items, Gen: gen}
 	}
@@ -30,8 +45,8 @@ func loadBaseModelsCmd(ctx context.Context, ld loader.Composite, kubeCfg string,
 func loadImportedModelsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		grouped, err := ld.LoadImportedModels(ctx, kubeCfg, env)
-		if err != nil {
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.ImportedModel, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.ImportedModel, err, gen); msg != nil {
+			return msg
 		}
 		return importedModelsLoadedMsg{Items: grouped, Gen: gen}
 	}
@@ -40,16 +55,8 @@ func loadImportedModelsCmd(ctx context.Context, ld loader.Composite, kubeCfg str
 func loadGPUPoolsCmd(ctx context.Context, ld loader.Composite, repoPath string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		items, err := ld.LoadGPUPools(ctx, repoPath, env)
-		if err != nil {
-			// Partial-success is non-fatal in the TUI: items still has
-			// the rows we could load, and the per-source error has
-			// already been logged inside the terraform package.
-			if partial, ok := errors.AsType[*terraform.PartialLoadError](err); ok {
-				logging.FromContext(ctx).Warnw("loaded GPU pools with partial failures",
-					"category", domain.GPUPool, "error", partial)
-				return gpuPoolsLoadedMsg{Items: items, Gen: gen}
-			}
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.GPUPool, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.GPUPool, err, gen); msg != nil {
+			return msg
 		}
 		return gpuPoolsLoadedMsg{Items: items, Gen: gen}
 	}
@@ -58,8 +65,8 @@ func loadGPUPoolsCmd(ctx context.Context, ld loader.Composite, repoPath string,
 func loadGPUNodesCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		items, err := ld.LoadGPUNodesByPool(ctx, kubeCfg, env)
-		if err != nil {
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.GPUNode, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.GPUNode, err, gen); msg != nil {
+			return msg
 		}
 		return gpuNodesLoadedMsg{Items: items, Gen: gen}
 	}
@@ -68,23 +75,63 @@ func loadGPUNodesCmd(ctx context.Context, ld loader.Composite, kubeCfg string, e
 func loadGPUWorkloadsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		items, err := ld.LoadGPUWorkloadsByNode(ctx, kubeCfg, env)
-		if err != nil {
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.GPUWorkload, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.GPUWorkload, err, gen); msg != nil {
+			return msg
 		}
 		return gpuWorkloadsLoadedMsg{Items: items, Gen: gen}
 	}
 }
 
+func loadPendingGPUWorkloadsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
+	return func() tea.Msg {
+		items, err := ld.LoadPendingGPUWorkloads(ctx, kubeCfg, env)
+		if msg := loadFailed(ctx, domain.PendingGPUWorkload, err, gen); msg != nil {
+			return msg
+		}
+		return pendingGPUWorkloadsLoadedMsg{Items: items, Gen: gen}
+	}
+}
+
+func loadWorkRequestsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
+	return func() tea.Msg {
+		items, err := ld.LoadWorkRequests(ctx, kubeCfg, env)
+		if msg := loadFailed(ctx, domain.WorkRequest, err, gen); msg != nil {
+			return msg
+		}
+		return workRequestsLoadedMsg{Items: items, Gen: gen}
+	}
+}
+
 func loadDedicatedAIClustersCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		items, err := ld.LoadDedicatedAIClusters(ctx, kubeCfg, env)
-		if err != nil {
-			return errMsg{err: fmt.Errorf("failed to load %s: %w", domain.DedicatedAICluster, err), Gen: gen}
+		if msg := loadFailed(ctx, domain.DedicatedAICluster, err, gen); msg != nil {
+			return msg
 		}
 		return dedicatedAIClustersLoadedMsg{Items: items, Gen: gen}
 	}
 }
 
+func loadEndpointsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
+	return func() tea.Msg {
+		items, err := ld.LoadEndpoints(ctx, kubeCfg, env)
+		if msg := loadFailed(ctx, domain.Endpoint, err, gen); msg != nil {
+			return msg
+		}
+		return endpointsLoadedMsg{Items: items, Gen: gen}
+	}
+}
+
+func loadEventsCmd(ctx context.Context, ld loader.Composite, kubeCfg string, env models.Environment, gen int) tea.Cmd {
+	return func() tea.Msg {
+		items, err := ld.LoadEvents(ctx, kubeCfg, env)
+		if msg := loadFailed(ctx, domain.Event, err, gen); msg != nil {
+			return msg
+		}
+		return eventsLoadedMsg{Items: items, Gen: gen}
+	}
+}
+
 func loadTenancyOverrideGroupCmd(ctx context.Context, ld loader.Composite, repoPath string, env models.Environment, gen int) tea.Cmd {
 	return func() tea.Msg {
 		group, err := ld.LoadTenancyOverrideGroup(ctx, repoPath, env)
@@ -149,8 +196,18 @@ func startK8sWatchCmd(ctx context.Context, ld loader.Composite, cat domain.Categ
 			trigger, err = w.WatchGPUNodes(ctx, kubeCfg, env)
 		case domain.GPUWorkload:
 			trigger, err = w.WatchGPUWorkloads(ctx, kubeCfg, env)
-		case domain.DedicatedAICluster:
+		case domain.PendingGPUWorkload:
+			trigger, err = w.WatchPendingGPUWorkloads(ctx, kubeCfg, env)
+		case domain.DedicatedAICluster, domain.Endpoint:
+			// Endpoints live in OCI, not the cluster; the DAC watch is the
+			// closest live signal (endpoint create/delete moves the DAC).
 			trigger, err = w.WatchDedicatedAIClusters(ctx, kubeCfg, env)
+		case domain.Event:
+			trigger, err = w.WatchEvents(ctx, kubeCfg, env)
+		case domain.WorkRequest:
+			// Work requests live in OCI; the GPU node watch is the closest
+			// live signal (terminate and pool resizes add or remove nodes).
+			trigger, err = w.WatchGPUNodes(ctx, kubeCfg, env)
 		default:
 			return k8sWatchUnavailableMsg{Cat: cat, Gen: gen}
 		}
@@ -191,14 +248,15 @@ func startRepoWatchCmd(ctx context.Context, ld loader.Composite, repoPath string
 	}
 }
 
-// waitForRepoTriggerCmd blocks on one value from the repo trigger: a tick →
-// repoWatchTriggeredMsg, a close → repoWatchClosedMsg.
-func waitForRepoTriggerCmd(trigger <-chan struct{}) tea.Cmd {
+// waitForRepoTriggerCmd blocks on one value from the repo trigger: a set of
+// changed paths → repoWatchTriggeredMsg, a close → repoWatchClosedMsg.
+func waitForRepoTriggerCmd(trigger <-chan []string) tea.Cmd {
 	return func() tea.Msg {
-		if _, ok := <-trigger; !ok {
+		paths, ok := <-trigger
+		if !ok {
 			return repoWatchClosedMsg{}
 		}
-		return repoWatchTriggeredMsg{}
+		return repoWatchTriggeredMsg{Paths: paths}
 	}
 }
 
@@ -216,6 +274,22 @@ func reloadDatasetCmd(ctx context.Context, ld loader.Composite, repoPath string,
 	}
 }
 
+// reloadDatasetPartsCmd reloads only the given dataset parts and returns
+// datasetReloadedMsg carrying them. Like reloadDatasetCmd it is quiet on
+// error.
+func reloadDatasetPartsCmd(ctx context.Context, rw loader.RepoWatcher, repoPath string, env models.Environment,
+	parts models.RepoParts, logger logging.Logger,
+) tea.Cmd {
+	return func() tea.Msg {
+		ds, err := rw.LoadDatasetParts(ctx, repoPath, env, parts)
+		if err != nil {
+			logger.Warnw("background dataset reload failed; keeping current data", "parts", parts.String(), "error", err)
+			return nil
+		}
+		return datasetReloadedMsg{Dataset: ds, Parts: parts}
+	}
+}
+
 // reloadGPUPoolsCmd re-runs LoadGPUPools on the session context and returns
 // gpuPoolsReloadedMsg. Like reloadDatasetCmd it is quiet on error.
 func reloadGPUPoolsCmd(ctx context.Context, ld loader.Composite, repoPath string, env models.Environment, logger logging.Logger) tea.Cmd {
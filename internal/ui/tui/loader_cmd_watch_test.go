@@ -46,6 +46,13 @@ func (w *watchableLoader) WatchGPUWorkloads(_ context.Context, _ string, _ model
 	return w.trigger, nil
 }
 
+func (w *watchableLoader) WatchPendingGPUWorkloads(_ context.Context, _ string, _ models.Environment) (<-chan struct{}, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.trigger, nil
+}
+
 func (w *watchableLoader) WatchDedicatedAIClusters(_ context.Context, _ string, _ models.Environment) (<-chan struct{}, error) {
 	if w.err != nil {
 		return nil, w.err
@@ -53,6 +60,13 @@ func (w *watchableLoader) WatchDedicatedAIClusters(_ context.Context, _ string,
 	return w.trigger, nil
 }
 
+func (w *watchableLoader) WatchEvents(_ context.Context, _ string, _ models.Environment) (<-chan struct{}, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.trigger, nil
+}
+
 func TestStartWatchCmd_SuccessEmitsStarted(t *testing.T) {
 	t.Parallel()
 	trig := make(chan struct{}, 1)
@@ -4,9 +4,8 @@ Package tui defines message types for the TUI model.
 package tui
 
 import (
-	"github.com/charmbracelet/bubbles/table"
-
 	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -56,8 +55,7 @@ type filterApplyMsg struct {
 }
 
 type tableRowsComputedMsg struct {
-	Rows  []table.Row
-	Stats tableStats
+	Index *rowIndex
 	Gen   int
 }
 
@@ -67,9 +65,13 @@ type detailContentRenderedMsg struct {
 	Gen     int
 }
 
+// deleteDoneMsg reports an accepted delete. workRequest is the OCI work
+// request still carrying it out, when the service returned one.
 type deleteDoneMsg struct {
-	category domain.Category
-	key      models.ItemKey
+	category    domain.Category
+	key         models.ItemKey
+	job         int
+	workRequest string
 }
 
 type deleteErrMsg struct {
@@ -77,6 +79,7 @@ type deleteErrMsg struct {
 	category  domain.Category
 	key       models.ItemKey
 	prevState string
+	job       int
 }
 
 type updateDoneMsg struct {
@@ -90,22 +93,34 @@ type gpuPoolScaleStartedMsg struct {
 
 type gpuPoolScaleResultMsg struct {
 	key models.ItemKey
+	job int
 	err error
 }
 
 type cordonNodeResultMsg struct {
 	key   models.ItemKey
 	state bool
+	job   int
 	err   error
 }
 
 type drainNodeResultMsg struct {
 	key models.ItemKey
+	job int
 	err error
 }
 
 type rebootNodeResultMsg struct {
 	key models.ItemKey
+	job int
+	err error
+}
+
+// jobPolledMsg carries one poll of the work request a background job
+// tracks.
+type jobPolledMsg struct {
+	job int
+	wr  models.WorkRequest
 	err error
 }
 
@@ -140,11 +155,31 @@ type gpuWorkloadsLoadedMsg struct {
 	Gen   int
 }
 
+type pendingGPUWorkloadsLoadedMsg struct {
+	Items []models.PendingGPUWorkload
+	Gen   int
+}
+
+type workRequestsLoadedMsg struct {
+	Items []models.WorkRequest
+	Gen   int
+}
+
 type dedicatedAIClustersLoadedMsg struct {
 	Items map[string][]models.DedicatedAICluster
 	Gen   int
 }
 
+type endpointsLoadedMsg struct {
+	Items map[string][]models.Endpoint
+	Gen   int
+}
+
+type eventsLoadedMsg struct {
+	Items map[string][]models.Event
+	Gen   int
+}
+
 type tenancyOverridesLoadedMsg struct {
 	Group models.TenancyOverrideGroup
 	Gen   int
@@ -181,12 +216,19 @@ type k8sWatchTriggeredMsg struct {
 }
 
 // k8sWatchClosedMsg signals the trigger channel closed (ctx cancel or
-// stream death); the reducer falls back to a final one-shot load.
+// stream death); the reducer reloads once and starts reconnecting.
 type k8sWatchClosedMsg struct {
 	Cat domain.Category
 	Gen int
 }
 
+// k8sWatchReconnectMsg fires when the backoff after a dropped watch has
+// elapsed; the reducer tries to re-establish the watch.
+type k8sWatchReconnectMsg struct {
+	Cat domain.Category
+	Gen int
+}
+
 // k8sWatchUnavailableMsg signals watch setup failed or is unsupported; the
 // static load result stays on screen, no live indicator.
 type k8sWatchUnavailableMsg struct {
@@ -197,20 +239,66 @@ type k8sWatchUnavailableMsg struct {
 // --- repo (working-tree) watch: session-scoped, no Gen/Cat. ---
 
 // repoWatchStartedMsg signals the working-tree watch is live.
-type repoWatchStartedMsg struct{ Trigger <-chan struct{} }
+type repoWatchStartedMsg struct{ Trigger <-chan []string }
 
-// repoWatchTriggeredMsg signals one debounced working-tree change; the reducer
-// issues quiet background reloads and re-arms the listener.
-type repoWatchTriggeredMsg struct{}
+// repoWatchTriggeredMsg signals one debounced working-tree change and carries
+// the changed paths; the reducer issues quiet background reloads of the parts
+// they affect and re-arms the listener.
+type repoWatchTriggeredMsg struct{ Paths []string }
 
 // repoWatchClosedMsg signals the working-tree watch is unavailable or died;
 // the live repo indicator drops. No auto-reconnect.
 type repoWatchClosedMsg struct{}
 
+// breakersChangedMsg carries the circuit breakers that are not closed,
+// after any of them changed state.
+type breakersChangedMsg struct{ Open []resilience.Status }
+
 // datasetReloadedMsg carries a freshly loaded dataset to be merged into the
-// in-memory one (repo-owned fields only; live k8s fields preserved).
-type datasetReloadedMsg struct{ Dataset *models.Dataset }
+// in-memory one (repo-owned fields only; live k8s fields preserved). Parts
+// names the parts a partial reload carries; zero means a full LoadDataset.
+type datasetReloadedMsg struct {
+	Dataset *models.Dataset
+	Parts   models.RepoParts
+}
 
 // gpuPoolsReloadedMsg carries freshly loaded GPU pools (repo-sourced via their
 // own loader, not LoadDataset) to refresh the cached pool list.
 type gpuPoolsReloadedMsg struct{ Items []models.GPUPool }
+
+// podLogContainersMsg carries the container names of the pod whose log
+// view is open.
+type podLogContainersMsg struct {
+	containers []string
+	err        error
+	gen        int
+}
+
+// podLogStartedMsg reports an opened log stream; the view starts
+// listening on it.
+type podLogStartedMsg struct {
+	stream *podLogStream
+	gen    int
+}
+
+// podLogLinesMsg carries a batch of log lines and the stream to keep
+// listening on.
+type podLogLinesMsg struct {
+	lines  []string
+	stream *podLogStream
+	gen    int
+}
+
+// podLogEndedMsg reports the end of a log stream; err is nil when the
+// log simply ended (a non-followed read, or the container exited).
+type podLogEndedMsg struct {
+	err error
+	gen int
+}
+
+// podLogSavedMsg reports the result of saving the buffered log to a file.
+type podLogSavedMsg struct {
+	path  string
+	lines int
+	err   error
+}
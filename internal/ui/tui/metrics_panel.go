@@ -0,0 +1,199 @@
+// Package tui — in-terminal metrics panel.
+//
+// <shift+m> on a DAC or GPU workload row resolves the same metrics plan
+// as the <m> browser dashboard, fetches its series from OCI Monitoring
+// and renders one sparkline per metric, so throughput can be checked
+// over SSH where no browser is available.
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/jingle2008/toolkit/internal/infra/telemetry"
+	"github.com/jingle2008/toolkit/internal/ui/tui/actions"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	"github.com/jingle2008/toolkit/internal/ui/tui/view"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// metricsPanelWindow is how far back the metrics panel looks; shorter
+// than the dashboard's metricsWindow so a sparkline's buckets stay fine
+// enough to show recent changes.
+const metricsPanelWindow = 6 * time.Hour
+
+// fetchMetrics is the seam tests use to fake the OCI Monitoring call.
+var fetchMetrics = actions.FetchMetrics
+
+// metricsLoadedMsg carries one metrics panel fetch; gen pins it to the
+// fetch that produced it.
+type metricsLoadedMsg struct {
+	series []models.MetricSeries
+	err    error
+	gen    int
+}
+
+// metricsPanel holds the open metrics panel: the item it shows, its
+// plan (kept for refresh), the fetched window and series, and the view
+// to restore when it closes.
+type metricsPanel struct {
+	target     string
+	plan       telemetry.Plan
+	start, end time.Time
+	series     []models.MetricSeries
+	loading    bool
+	err        error
+	returnView common.ViewMode
+}
+
+// viewMetrics opens the metrics panel for a DAC or GPU workload row,
+// loading the model catalog its plan needs first. Other items are a
+// no-op.
+func (m *Model) viewMetrics(item any) tea.Cmd {
+	switch it := item.(type) {
+	case *models.DedicatedAICluster:
+		if it == nil {
+			return nil
+		}
+	case *models.GPUWorkload:
+		if it == nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	return m.withMetricsPlan(item, true)
+}
+
+// metricsTarget names an item in the metrics panel's title.
+func metricsTarget(item any) string {
+	switch it := item.(type) {
+	case *models.DedicatedAICluster:
+		return "DAC " + it.Name
+	case *models.GPUWorkload:
+		return "workload " + it.Namespace + "/" + it.Name
+	default:
+		return "metrics"
+	}
+}
+
+// openMetricsPanel switches to the metrics panel for target and starts
+// fetching plan's series.
+func (m *Model) openMetricsPanel(target string, plan telemetry.Plan) tea.Cmd {
+	returnView := m.viewMode
+	if returnView == common.MetricsView {
+		returnView = m.metrics.returnView
+	}
+	m.metrics = metricsPanel{target: target, plan: plan, returnView: returnView}
+	m.viewMode = common.MetricsView
+	m.logger.Infow("action started", "action", "viewMetrics", "target", target)
+	return m.fetchMetricsCmd()
+}
+
+// fetchMetricsCmd fetches the panel's plan over the window ending now,
+// in the compartment of the environment's GPU nodes.
+func (m *Model) fetchMetricsCmd() tea.Cmd {
+	gen := m.gens.nextMetrics()
+	m.metrics.end = time.Now()
+	m.metrics.start = m.metrics.end.Add(-metricsPanelWindow)
+	m.metrics.loading = true
+	env, plan, start, end := m.environment, m.metrics.plan, m.metrics.start, m.metrics.end
+	return func() tea.Msg {
+		ctx, cancel := m.opCtx()
+		defer cancel()
+		compartmentID, err := m.lookupCompartmentID(ctx)
+		if err == nil && compartmentID == "" {
+			err = errors.New("no GPU nodes to resolve the compartment from")
+		}
+		if err != nil {
+			return metricsLoadedMsg{err: fmt.Errorf("resolve compartment ID: %w", err), gen: gen}
+		}
+		series, err := fetchMetrics(ctx, env, compartmentID, plan, start, end)
+		return metricsLoadedMsg{series: series, err: err, gen: gen}
+	}
+}
+
+// handleMetricsLoaded applies a fetch to the panel, dropping one a later
+// fetch superseded. A failure is shown in the panel and as a toast.
+func (m *Model) handleMetricsLoaded(msg metricsLoadedMsg) tea.Cmd {
+	if msg.gen != m.gens.metrics {
+		return nil
+	}
+	m.metrics.loading = false
+	m.metrics.series, m.metrics.err = msg.series, msg.err
+	if msg.err != nil {
+		m.logger.Errorw("failed to fetch metrics", "target", m.metrics.target, "error", msg.err)
+		return m.showToast(fmt.Sprintf("failed to fetch metrics: %v", msg.err), toastError)
+	}
+	return nil
+}
+
+// updateMetricsView handles input while the metrics panel is open:
+// refresh, close keys and quit; everything else is ignored. Closing
+// bumps the generation so a fetch still in flight is dropped.
+func (m *Model) updateMetricsView(msg tea.Msg) (tea.Model, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case key.Matches(km, keys.Refresh):
+		if !m.metrics.loading {
+			return m, m.fetchMetricsCmd()
+		}
+	case key.Matches(km, keys.ViewMetrics, keys.Back):
+		m.gens.nextMetrics()
+		m.viewMode = m.metrics.returnView
+	case key.Matches(km, keys.Quit):
+		m.cancelInFlight()
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// metricsView renders the full-screen metrics panel: a title bar with
+// the item and window, one sparkline line per metric (as many as fit)
+// and a key hint footer.
+func (m *Model) metricsView() string {
+	width := m.viewWidth
+	bodyHeight := max(m.viewHeight-2, 1) // title + hint lines
+
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("231")).
+		Background(lipgloss.Color("24")).
+		Width(width).
+		Render(runewidth.Truncate(fmt.Sprintf("METRICS — %s — last %s (%s to %s)",
+			m.metrics.target, metricsPanelWindow,
+			m.metrics.start.Format("15:04"), m.metrics.end.Format("15:04")), max(width, 1), "…"))
+
+	var lines []string
+	switch {
+	case m.metrics.loading:
+		lines = []string{"Loading metrics…"}
+	case m.metrics.err != nil:
+		lines = []string{lipgloss.NewStyle().Foreground(lipgloss.Color("196")).
+			Render(runewidth.Truncate(m.metrics.err.Error(), max(width, 1), "…"))}
+	case len(m.metrics.series) == 0:
+		lines = []string{"(no metrics for this item)"}
+	default:
+		for _, line := range view.MetricLines(m.metrics.series, width) {
+			if len(lines) == bodyHeight {
+				break
+			}
+			lines = append(lines, runewidth.Truncate(line, max(width, 1), "…"))
+		}
+	}
+	body := lipgloss.NewStyle().Height(bodyHeight).Render(strings.Join(lines, "\n"))
+	hint := lipgloss.NewStyle().Foreground(lipgloss.Color("245")).
+		Render("r refresh · shift+m/esc close")
+	return lipgloss.JoinVertical(lipgloss.Left, title, body, hint)
+}
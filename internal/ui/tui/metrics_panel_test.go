@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/telemetry"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+//nolint:paralleltest // swaps the package-level fetchMetrics seam
+func TestViewMetrics_FetchesAndRenders(t *testing.T) {
+	orig := fetchMetrics
+	defer func() { fetchMetrics = orig }()
+	var (
+		gotCompartment string
+		gotPlan        telemetry.Plan
+		gotWindow      time.Duration
+	)
+	fetchMetrics = func(_ context.Context, _ models.Environment, compartmentID string, plan telemetry.Plan, start, end time.Time) ([]models.MetricSeries, error) {
+		gotCompartment, gotPlan, gotWindow = compartmentID, plan, end.Sub(start)
+		return []models.MetricSeries{
+			{Name: "chat.InputTokenLength", Points: []models.MetricPoint{{Value: 1000}, {Value: 2500}}},
+			{Name: "chat.OutputTokenLength"},
+		}, nil
+	}
+
+	m := newResolveModel(t)
+	m.viewWidth, m.viewHeight = 100, 20
+	m.viewMode = common.ListView
+	m.dataset.GPUNodeMap = map[string][]models.GPUNode{"pool": {{Name: "n1", CompartmentID: "ocid1.compartment.x"}}}
+
+	cmd := m.viewMetrics(&models.DedicatedAICluster{Name: "dac1", ModelName: "gpt"})
+	require.NotNil(t, cmd)
+	assert.Equal(t, common.MetricsView, m.viewMode)
+	assert.Contains(t, m.View(), "Loading metrics…")
+
+	_, _ = m.Update(cmd())
+	assert.Equal(t, "ocid1.compartment.x", gotCompartment)
+	assert.Equal(t, telemetry.CapabilityChat, gotPlan.Capability)
+	assert.Equal(t, telemetry.FilterDacID, gotPlan.Filter.Key)
+	assert.Equal(t, metricsPanelWindow, gotWindow)
+	out := m.View()
+	assert.Contains(t, out, "METRICS — DAC dac1")
+	assert.Contains(t, out, "peak 2.5k")
+	assert.Contains(t, out, "chat.OutputTokenLength  no data")
+
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, common.ListView, m.viewMode)
+}
+
+//nolint:paralleltest // swaps the package-level fetchMetrics seam
+func TestViewMetrics_FetchError(t *testing.T) {
+	orig := fetchMetrics
+	defer func() { fetchMetrics = orig }()
+	fetchMetrics = func(context.Context, models.Environment, string, telemetry.Plan, time.Time, time.Time) ([]models.MetricSeries, error) {
+		return nil, errors.New("not authorized")
+	}
+
+	m := newResolveModel(t)
+	m.dataset.GPUNodeMap = map[string][]models.GPUNode{"pool": {{Name: "n1", CompartmentID: "ocid1.compartment.x"}}}
+	cmd := m.viewMetrics(&models.GPUWorkload{Name: "p", Namespace: "team-x", Model: "gpt"})
+	require.NotNil(t, cmd)
+
+	_, _ = m.Update(cmd())
+	require.NotNil(t, m.toasts.active)
+	assert.Contains(t, m.View(), "not authorized")
+}
+
+func TestViewMetrics_IgnoresOtherItems(t *testing.T) {
+	t.Parallel()
+	m := newResolveModel(t)
+	assert.Nil(t, m.viewMetrics(&models.Endpoint{Name: "ep"}))
+	assert.Nil(t, m.viewMetrics((*models.DedicatedAICluster)(nil)))
+	assert.Equal(t, common.ListView, m.viewMode)
+}
+
+func TestHandleMetricsLoaded_DropsStale(t *testing.T) {
+	t.Parallel()
+	m := newResolveModel(t)
+	m.metrics.loading = true
+	gen := m.gens.nextMetrics()
+	m.gens.nextMetrics() // superseded, e.g. by closing the panel
+
+	assert.Nil(t, m.handleMetricsLoaded(metricsLoadedMsg{err: errors.New("late"), gen: gen}))
+	assert.True(t, m.metrics.loading)
+	assert.NoError(t, m.metrics.err)
+}
+
+func TestKeys_ViewMetricsOnDACAndWorkload(t *testing.T) {
+	t.Parallel()
+	assert.Contains(t, keyHelpDescs(domain.DedicatedAICluster), "View Metrics")
+	assert.Contains(t, keyHelpDescs(domain.GPUWorkload), "View Metrics")
+	assert.NotContains(t, keyHelpDescs(domain.Endpoint), "View Metrics")
+}
@@ -79,6 +79,46 @@ func TestResolveMetricsPlan_WorkloadDedicated(t *testing.T) {
 	assert.Equal(t, telemetry.CapabilityChat, capability)
 }
 
+func TestResolveMetricsPlan_EndpointUsesHostingDAC(t *testing.T) {
+	t.Parallel()
+	m := newResolveModel(t)
+	m.dataset.DedicatedAIClusterMap = map[string][]models.DedicatedAICluster{
+		"tenant1": {{Name: "amaaaaaadac1", TenantID: "tenant1", ModelName: "gpt"}},
+	}
+	ep := &models.Endpoint{
+		Name: "amaaaaaaep1", DedicatedAICluster: "amaaaaaadac1",
+		ModelID: "ocid1.generativeaimodel.oc1.me-abudhabi-1.amaaaaaabase",
+	}
+	cat, need := m.metricsCatalog(ep)
+	assert.True(t, need)
+	assert.Equal(t, domain.BaseModel, cat, "the DAC's model name routes the catalog")
+
+	filter, capability, ok, reason := m.resolveMetricsPlan(ep)
+	require.True(t, ok, reason)
+	assert.Equal(t, telemetry.FilterDacID, filter.Key)
+	assert.Equal(t, "ocid1.generativeaidedicatedaicluster.oc1.me-abudhabi-1.amaaaaaadac1", filter.Value)
+	assert.Equal(t, telemetry.CapabilityChat, capability)
+}
+
+func TestResolveMetricsPlan_EndpointFallsBackToModelOCID(t *testing.T) {
+	t.Parallel()
+	m := newResolveModel(t)
+	m.dataset.ImportedModelMap = map[string][]models.ImportedModel{
+		"tenant1": {{BaseModel: models.BaseModel{Name: "amaaaaaaimp", Capabilities: []string{"TEXT_EMBEDDINGS"}}}},
+	}
+	ep := &models.Endpoint{
+		Name: "amaaaaaaep1", DedicatedAICluster: "amaaaaaadac1",
+		ModelID: "ocid1.generativeaimodel.oc1.me-abudhabi-1.amaaaaaaimp",
+	}
+	cat, need := m.metricsCatalog(ep)
+	assert.True(t, need)
+	assert.Equal(t, domain.ImportedModel, cat)
+
+	_, capability, ok, reason := m.resolveMetricsPlan(ep)
+	require.True(t, ok, reason)
+	assert.Equal(t, telemetry.CapabilityTextEmbeddings, capability)
+}
+
 func TestResolveMetricsPlan_WorkloadOnDemand(t *testing.T) {
 	t.Parallel()
 	m := newResolveModel(t)
@@ -141,7 +181,7 @@ func TestMetricsCatalog_NewCategories(t *testing.T) {
 
 func TestKeys_OpenMetricsOnNewCategories(t *testing.T) {
 	t.Parallel()
-	for _, cat := range []domain.Category{domain.GPUWorkload, domain.ImportedModel, domain.DedicatedAICluster} {
+	for _, cat := range []domain.Category{domain.GPUWorkload, domain.ImportedModel, domain.DedicatedAICluster, domain.Endpoint} {
 		assert.Contains(t, keyHelpDescs(cat), "Open Metrics", "category %v", cat)
 	}
 }
@@ -190,7 +230,7 @@ func TestFinishMetrics_UnresolvableShowsToast(t *testing.T) {
 	t.Parallel()
 	m := newResolveModel(t)
 	// GPUWorkload with empty Model → resolveMetricsPlan returns ok=false, reason="workload has no model"
-	cmd := m.finishMetrics(&models.GPUWorkload{Name: "p", Namespace: "amaaaaaadac1"})
+	cmd := m.finishMetrics(&models.GPUWorkload{Name: "p", Namespace: "amaaaaaadac1"}, false)
 	assert.NotNil(t, cmd, "a toast cmd must be dispatched for unresolvable items with a reason")
 	require.NotNil(t, m.toasts.active, "toast must be set after finishMetrics on unresolvable item")
 	assert.Equal(t, "workload has no model", m.toasts.active.msg)
@@ -58,7 +58,11 @@ var lazyLoadedCategories = map[domain.Category]struct{}{
 	domain.GPUPool:            {},
 	domain.GPUNode:            {},
 	domain.GPUWorkload:        {},
+	domain.PendingGPUWorkload: {},
 	domain.DedicatedAICluster: {},
+	domain.Endpoint:           {},
+	domain.Event:              {},
+	domain.WorkRequest:        {},
 }
 
 // Init implements the tea.Model interface and initializes the model.
@@ -71,9 +75,11 @@ func (m *Model) Init() tea.Cmd {
 
 	cmds = append(cmds, setFilter(m.initialFilter))
 	// Establish the always-on working-tree watch in parallel with the initial
-	// load, on the session context so navigation never cancels it.
+	// load, on the session context so navigation never cancels it, and
+	// follow the circuit breakers likewise.
 	return tea.Batch(
 		tea.Sequence(cmds...),
 		startRepoWatchCmd(m.sessionCtx(), m.loader, m.repoPath),
+		m.waitForBreakersCmd(),
 	)
 }
@@ -7,50 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jingle2008/toolkit/internal/domain"
-	"github.com/jingle2008/toolkit/internal/infra/telemetry"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
-func TestCapabilityForModel(t *testing.T) {
-	t.Parallel()
-	cases := []struct {
-		name  string
-		model *models.BaseModel
-		want  telemetry.Capability
-	}{
-		{"nil", nil, telemetry.CapabilityChat},
-		{"finetune", &models.BaseModel{Type: "Fine-tuning", Capabilities: []string{"TEXT_RERANK"}}, telemetry.CapabilityChat},
-		{"chat", &models.BaseModel{Capabilities: []string{"CHAT"}}, telemetry.CapabilityChat},
-		{"rerank", &models.BaseModel{Capabilities: []string{"TEXT_RERANK"}}, telemetry.CapabilityTextRerank},
-		{"embed", &models.BaseModel{Capabilities: []string{"TEXT_EMBEDDINGS"}}, telemetry.CapabilityTextEmbeddings},
-		// Precedence CHAT > RERANK > EMBED when several are present.
-		{"multi", &models.BaseModel{Capabilities: []string{"TEXT_EMBEDDINGS", "CHAT"}}, telemetry.CapabilityChat},
-		{"unknown", &models.BaseModel{Capabilities: []string{"SOMETHING_ELSE"}}, telemetry.CapabilityChat},
-		{"classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION"}}, telemetry.CapabilityTextClassification},
-		{"imagemod", &models.BaseModel{Capabilities: []string{"IMAGE_CONTENT_MODERATION"}}, telemetry.CapabilityImageContentModeration},
-		{"chat-over-classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION", "CHAT"}}, telemetry.CapabilityChat},
-		{"embed-over-classification", &models.BaseModel{Capabilities: []string{"TEXT_CLASSIFICATION", "TEXT_EMBEDDINGS"}}, telemetry.CapabilityTextEmbeddings},
-		{"classification-over-imagemod", &models.BaseModel{Capabilities: []string{"IMAGE_CONTENT_MODERATION", "TEXT_CLASSIFICATION"}}, telemetry.CapabilityTextClassification},
-		{"text_to_text", &models.BaseModel{Capabilities: []string{"TEXT_TO_TEXT"}}, telemetry.CapabilityChat},
-		{"image_text_to_text", &models.BaseModel{Capabilities: []string{"IMAGE_TEXT_TO_TEXT"}}, telemetry.CapabilityChat},
-		{"chat-over-t2t", &models.BaseModel{Capabilities: []string{"TEXT_TO_TEXT", "CHAT"}}, telemetry.CapabilityChat},
-		{"embedding-synonym", &models.BaseModel{Capabilities: []string{"EMBEDDING"}}, telemetry.CapabilityTextEmbeddings},
-		{"content-moderation-synonym", &models.BaseModel{Capabilities: []string{"CONTENT_MODERATION"}}, telemetry.CapabilityTextClassification},
-		{"text_to_image", &models.BaseModel{Capabilities: []string{"TEXT_TO_IMAGE"}}, telemetry.CapabilityTextToImage},
-		{"image_text_to_image", &models.BaseModel{Capabilities: []string{"IMAGE_TEXT_TO_IMAGE"}}, telemetry.CapabilityImageTextToImage},
-		{"text_to_audio", &models.BaseModel{Capabilities: []string{"TEXT_TO_AUDIO"}}, telemetry.CapabilityTextToAudio},
-		{"audio_to_text", &models.BaseModel{Capabilities: []string{"AUDIO_TO_TEXT"}}, telemetry.CapabilityAudioToText},
-		{"unsupported-only", &models.BaseModel{Capabilities: []string{"REALTIME"}}, telemetry.CapabilityUnsupported},
-		{"supported-wins", &models.BaseModel{Capabilities: []string{"TEXT_GENERATION", "CHAT"}}, telemetry.CapabilityChat},
-	}
-	for _, tc := range cases {
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-			assert.Equal(t, tc.want, capabilityForModel(tc.model))
-		})
-	}
-}
-
 func TestCatalogLoaded(t *testing.T) {
 	t.Parallel()
 	m := makeTestModel() // dataset nil
@@ -5,7 +5,10 @@ package tui
 import (
 	"context"
 	"math"
+	"math/rand/v2"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -30,7 +33,7 @@ func (m *Model) lookupCompartmentID(ctx context.Context) (string, error) {
 		}
 	}
 
-	clientset, err := k8s.NewClientsetFromKubeConfig(m.kubeConfig, m.environment.KubeContext())
+	clientset, err := k8s.NewClientsetFromKubeConfig(m.kubeConfig, m.kubeContexts.Primary(m.environment))
 	if err != nil {
 		return "", err
 	}
@@ -57,12 +60,12 @@ func (m *Model) updateGPUPoolState() tea.Cmd {
 
 /*
 updateRows updates the table rows based on the current model state.
-Now also sets m.stats from computeTableRows.
+Now also sets m.stats from computeRowIndex.
 */
 func (m *Model) updateRows(autoSelect bool) {
 	m.gens.nextRows()
-	rows, stats := computeTableRows(m.dataset, m.category, m.scope, m.filter, m.sortColumn, m.sortAsc, m.showFaulty)
-	m.applyRows(rows, stats, autoSelect)
+	ix := computeRowIndex(m.dataset, m.category, m.scope, m.filter, m.sortColumn, m.sortAsc, m.showFaulty)
+	m.applyIndex(ix, autoSelect)
 }
 
 func (m *Model) updateRowsAsync() tea.Cmd {
@@ -75,24 +78,53 @@ func (m *Model) updateRowsAsync() tea.Cmd {
 	sortAsc := m.sortAsc
 	showFaulty := m.showFaulty
 	return func() tea.Msg {
-		rows, stats := computeTableRows(dataset, category, scope, filter, sortColumn, sortAsc, showFaulty)
 		return tableRowsComputedMsg{
-			Rows:  rows,
-			Stats: stats,
+			Index: computeRowIndex(dataset, category, scope, filter, sortColumn, sortAsc, showFaulty),
 			Gen:   gen,
 		}
 	}
 }
 
+/*
+refilterRowsAsync recomputes the rows after a filter change. When the
+current index is the latest one applied and was built from the model's
+current dataset, category, scope, sort and faulty toggle, only the filter
+differs, so the index is re-filtered over its base (narrowing the current
+rows while the user types) instead of rebuilding it from the dataset.
+*/
+func (m *Model) refilterRowsAsync() tea.Cmd {
+	ix := m.index
+	if ix == nil || ix.base.key.dataset == nil || m.indexGen != m.gens.rows ||
+		ix.base.key != newBaseKey(m.dataset, m.category, m.scope, m.sortColumn, m.sortAsc, m.showFaulty) {
+		return m.updateRowsAsync()
+	}
+	gen := m.gens.nextRows()
+	category := m.category
+	filter := m.filter
+	return func() tea.Msg {
+		next := ix.withFilter(filter)
+		next.stats = computeStats(category, next)
+		return tableRowsComputedMsg{Index: next, Gen: gen}
+	}
+}
+
 func (m *Model) handleTableRowsComputedMsg(msg tableRowsComputedMsg) {
 	if msg.Gen != m.gens.rows {
 		return
 	}
-	m.applyRows(msg.Rows, msg.Stats, true)
+	m.applyIndex(msg.Index, true)
 }
 
+// applyRows shows prebuilt rows; see applyIndex.
 func (m *Model) applyRows(rows []table.Row, stats tableStats, autoSelect bool) {
-	// Capture the prior selection before m.rawRows is replaced below, so an
+	m.applyIndex(rowIndexOf(rows, stats), autoSelect)
+}
+
+// applyIndex makes ix the table's rows. The table is handed one empty
+// slot per row and only the rows around the cursor are rendered into it
+// (syncTableWindow), so applying 100k rows costs one slice allocation.
+func (m *Model) applyIndex(ix *rowIndex, autoSelect bool) {
+	// Capture the prior selection before m.index is replaced below, so an
 	// in-place reload can re-home the cursor onto the same item by identity.
 	// Identity is the per-category item key (itemKeyFrom), not the bare Name
 	// cell, so scoped categories whose rows can share a Name (e.g.
@@ -109,27 +141,30 @@ func (m *Model) applyRows(rows []table.Row, stats tableStats, autoSelect bool) {
 		prevKey = itemKeyFrom(m.category, m.selectedRawRow())
 	}
 
-	m.stats = stats
-	m.rawRows = cloneRows(rows)
-	m.applyMiddleTruncation(rows)
-	table.WithRows(rows)(m.table)
+	m.index, m.indexGen = ix, m.gens.rows
+	m.stats = nil
+	if ix != nil {
+		m.stats = ix.stats
+	}
+	m.shown = make([]table.Row, ix.Len())
+	table.WithRows(m.shown)(m.table)
 
 	if autoSelect {
-		// Match identity against m.rawRows, not the just-truncated `rows`:
-		// applyMiddleTruncation may have shortened the key cells (Name/Tenant
+		// Match identity against the index, not the displayed rows:
+		// middle truncation may have shortened the key cells (Name/Tenant
 		// for scoped categories), which would defeat the key comparison.
 		idx := -1
 		// Fast path: if the selected item still sits at its previous offset,
 		// skip the scan. Reloads usually preserve order, so this hits often.
-		if prevKey != nil && prevIdx >= 0 && prevIdx < len(m.rawRows) &&
-			itemKeyFrom(m.category, m.rawRows[prevIdx]) == prevKey {
+		if prevKey != nil && prevIdx >= 0 && prevIdx < ix.Len() &&
+			ix.keyAt(m.category, prevIdx, clusterColumn(m.category)) == prevKey {
 			idx = prevIdx
 		}
-		if idx < 0 {
-			idx = indexOfItemKey(m.rawRows, m.category, prevKey)
+		if idx < 0 && ix != nil {
+			idx = ix.indexOfKey(m.category, prevKey)
 		}
 		if idx < 0 {
-			idx = m.findContextIndex(rows)
+			idx = m.findContextIndex()
 		}
 		if idx >= 0 {
 			// SetCursor moves the cursor and render window but leaves the
@@ -142,11 +177,58 @@ func (m *Model) applyRows(rows []table.Row, stats tableStats, autoSelect bool) {
 		} else {
 			m.table.GotoTop()
 		}
-	} else {
+		m.syncTableWindow()
+	} else if !m.syncTableWindow() {
 		m.table.UpdateViewport()
 	}
 }
 
+/*
+syncTableWindow renders the index rows around the cursor into m.shown.
+bubbles' table draws rows [cursor-H, cursor+H) of its row slice and
+renders a nil row as a blank line, so the slots outside that window can
+stay empty without disturbing its scroll arithmetic. Rows are rendered
+for twice that window, so moving the cursor rarely reaches an empty
+slot; when it does, or when the column widths changed (rendered rows are
+truncated to them), the table is redrawn and true is returned.
+
+Call it after anything that moves the cursor or resizes the columns.
+*/
+func (m *Model) syncTableWindow() bool {
+	n := m.index.Len()
+	if n == 0 || len(m.shown) != n {
+		return false
+	}
+	cols := m.table.Columns()
+	widths := make([]int, len(cols))
+	for i, c := range cols {
+		widths[i] = c.Width
+	}
+	redraw := false
+	if !slices.Equal(widths, m.shownWidths) {
+		clear(m.shown)
+		m.shownWidths = widths
+		redraw = true
+	}
+
+	h := m.table.Height()
+	cursor := m.table.Cursor()
+	for i := max(cursor-2*h, 0); i < min(cursor+2*h, n); i++ {
+		if m.shown[i] != nil {
+			continue
+		}
+		m.shown[i] = m.index.row(i)
+		m.truncateRowMiddle(m.shown[i])
+		if i >= cursor-h && i < cursor+h {
+			redraw = true
+		}
+	}
+	if redraw {
+		m.table.UpdateViewport()
+	}
+	return redraw
+}
+
 // applyMiddleTruncation shortens cells in columns marked
 // TruncateMiddle so values wider than their column's display width
 // are elided in the MIDDLE (head + "…" + tail) instead of having
@@ -160,23 +242,23 @@ func (m *Model) applyRows(rows []table.Row, stats tableStats, autoSelect bool) {
 // override grouped sets); the head identifies the OCID shape while
 // the tail is the distinguishing portion — both worth keeping.
 func (m *Model) applyMiddleTruncation(rows []table.Row) {
-	if len(rows) == 0 || len(m.headers) == 0 {
+	for _, r := range rows {
+		m.truncateRowMiddle(r)
+	}
+}
+
+// truncateRowMiddle applies middle truncation to a single row in place.
+func (m *Model) truncateRowMiddle(row table.Row) {
+	if len(m.headers) == 0 {
 		return
 	}
 	cols := m.table.Columns()
 	for c, h := range m.headers {
-		if !h.truncateMiddle || c >= len(cols) {
+		if !h.truncateMiddle || c >= len(cols) || c >= len(row) {
 			continue
 		}
-		w := cols[c].Width
-		if w <= 0 {
-			continue
-		}
-		for r := range rows {
-			if c >= len(rows[r]) {
-				continue
-			}
-			rows[r][c] = truncateMiddle(rows[r][c], w)
+		if w := cols[c].Width; w > 0 {
+			row[c] = truncateMiddle(row[c], w)
 		}
 	}
 }
@@ -263,24 +345,24 @@ func (m *Model) updateColumns() {
 }
 
 // findContextIndex returns the index of the row to move the cursor to, based on environment or context.
-func (m *Model) findContextIndex(rows []table.Row) int {
-	if len(rows) == 0 {
+func (m *Model) findContextIndex() int {
+	if m.index.Len() == 0 {
 		return -1
 	}
 
-	var name string
 	switch {
 	case m.category == domain.Environment:
-		name = m.environment.GetName()
+		name := m.environment.GetName()
+		for i := range m.index.Len() {
+			if m.index.cell(i, 0) == name {
+				return i
+			}
+		}
 	case m.scope != nil && m.category == m.scope.Category:
-		name = m.scope.Name
-	default:
-		return -1
-	}
-
-	for i, r := range rows {
-		if r[0] == name {
-			return i
+		for i := range m.index.Len() {
+			if scopeName(m.category, m.index.row(i)) == m.scope.Name {
+				return i
+			}
 		}
 	}
 
@@ -320,7 +402,9 @@ func (m *Model) updateLayout(w, h int) {
 		// row gets clipped by bubbletea's renderer until a manual resize).
 		m.updateColumns()
 		table.WithHeight(h - borderHeight - top)(m.table)
-		m.table.UpdateViewport()
+		if !m.syncTableWindow() {
+			m.table.UpdateViewport()
+		}
 	}
 }
 
@@ -442,10 +526,26 @@ func (m *Model) handleGPUWorkloadsLoaded(items map[string][]models.GPUWorkload,
 	m.applyLoaded(gen, func(ds *models.Dataset) { ds.SetGPUWorkloadMap(items) }, domain.GPUWorkload, mapLen(items))
 }
 
+func (m *Model) handlePendingGPUWorkloadsLoaded(items []models.PendingGPUWorkload, gen int) {
+	m.applyLoaded(gen, func(ds *models.Dataset) { ds.PendingGPUWorkloads = items }, domain.PendingGPUWorkload, len(items))
+}
+
+func (m *Model) handleWorkRequestsLoaded(items []models.WorkRequest, gen int) {
+	m.applyLoaded(gen, func(ds *models.Dataset) { ds.WorkRequests = items }, domain.WorkRequest, len(items))
+}
+
 func (m *Model) handleDedicatedAIClustersLoaded(items map[string][]models.DedicatedAICluster, gen int) {
 	m.applyLoaded(gen, func(ds *models.Dataset) { ds.SetDedicatedAIClusterMap(items) }, domain.DedicatedAICluster, mapLen(items))
 }
 
+func (m *Model) handleEndpointsLoaded(items map[string][]models.Endpoint, gen int) {
+	m.applyLoaded(gen, func(ds *models.Dataset) { ds.EndpointMap = items }, domain.Endpoint, mapLen(items))
+}
+
+func (m *Model) handleEventsLoaded(items map[string][]models.Event, gen int) {
+	m.applyLoaded(gen, func(ds *models.Dataset) { ds.EventMap = items }, domain.Event, mapLen(items))
+}
+
 func (m *Model) handleTenancyOverridesLoaded(group models.TenancyOverrideGroup, gen int) {
 	m.applyLoaded(gen, func(ds *models.Dataset) {
 		ds.Tenants = group.Tenants
@@ -480,8 +580,16 @@ func (m *Model) reloadCategoryCmd(cat domain.Category, gen int) tea.Cmd {
 		return loadGPUNodesCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
 	case domain.GPUWorkload:
 		return loadGPUWorkloadsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	case domain.PendingGPUWorkload:
+		return loadPendingGPUWorkloadsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
 	case domain.DedicatedAICluster:
 		return loadDedicatedAIClustersCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	case domain.Endpoint:
+		return loadEndpointsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	case domain.Event:
+		return loadEventsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	case domain.WorkRequest:
+		return loadWorkRequestsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
 	default:
 		return nil
 	}
@@ -497,8 +605,23 @@ func (m *Model) handleK8sWatchStarted(msg k8sWatchStartedMsg) tea.Cmd {
 	}
 	m.watch.k8sActive = true
 	m.watch.k8sTrigger = msg.Trigger
-	m.logger.Infow("watch started", "category", msg.Cat, "gen", msg.Gen)
-	return waitForK8sTriggerCmd(msg.Cat, msg.Trigger, msg.Gen)
+	m.watch.k8sUpSince = time.Now()
+	if !m.watch.k8sReconnecting {
+		m.logger.Infow("watch started", "category", msg.Cat, "gen", msg.Gen)
+		return waitForK8sTriggerCmd(msg.Cat, msg.Trigger, msg.Gen)
+	}
+	// Re-established after a drop: reload to pick up what changed while
+	// the watch was down. k8sAttempt is kept until the watch proves
+	// stable (see handleK8sWatchClosed).
+	m.watch.k8sReconnecting = false
+	m.watch.k8sReconnects++
+	m.logger.Infow("watch re-established", "category", msg.Cat, "gen", msg.Gen,
+		"attempts", m.watch.k8sAttempt, "reconnects", m.watch.k8sReconnects)
+	cmds := []tea.Cmd{waitForK8sTriggerCmd(msg.Cat, msg.Trigger, msg.Gen)}
+	if reload := m.reloadCategoryCmd(msg.Cat, msg.Gen); reload != nil {
+		cmds = append(cmds, m.beginTask(), reload)
+	}
+	return tea.Batch(cmds...)
 }
 
 // handleK8sWatchTriggered re-runs the category loader and re-arms the
@@ -517,31 +640,104 @@ func (m *Model) handleK8sWatchTriggered(msg k8sWatchTriggeredMsg) tea.Cmd {
 	return tea.Batch(m.beginTask(), reload, m.waitForK8sTrigger(msg.Cat, msg.Gen))
 }
 
-// handleK8sWatchClosed falls back to a final one-shot load and clears the
-// live indicator (no auto-reconnect).
+// handleK8sWatchClosed reloads once to catch up and, unless the load
+// context is gone, starts re-establishing the watch with backoff; the
+// status bar shows RECONNECTING meanwhile. The backoff restarts from the
+// first step only when the watch had been up for watchStableAfter; one
+// that drops again soon after coming back continues where it left off.
 func (m *Model) handleK8sWatchClosed(msg k8sWatchClosedMsg) tea.Cmd {
 	if msg.Gen != m.gens.msg {
 		m.logger.Debugw("watch closed ignored (stale gen)", "category", msg.Cat, "msgGen", msg.Gen, "gen", m.gens.msg)
 		return nil
 	}
 	m.watch.k8sActive = false
-	m.logger.Infow("watch closed — clearing live indicator (no reconnect)", "category", msg.Cat, "gen", msg.Gen)
-	reload := m.reloadCategoryCmd(msg.Cat, msg.Gen)
-	if reload == nil {
-		return nil
+	m.watch.k8sTrigger = nil
+	var cmds []tea.Cmd
+	if reload := m.reloadCategoryCmd(msg.Cat, msg.Gen); reload != nil {
+		cmds = append(cmds, m.beginTask(), reload)
+	}
+	if m.loadCtx == nil || m.loadCtx.Err() != nil {
+		m.logger.Infow("watch closed — clearing live indicator", "category", msg.Cat, "gen", msg.Gen)
+		return tea.Batch(cmds...)
+	}
+	m.watch.k8sReconnecting = true
+	if time.Since(m.watch.k8sUpSince) >= watchStableAfter {
+		m.watch.k8sAttempt = 0
 	}
-	return tea.Batch(m.beginTask(), reload)
+	m.logger.Warnw("watch dropped — reconnecting", "category", msg.Cat, "gen", msg.Gen,
+		"attempt", m.watch.k8sAttempt, "reconnects", m.watch.k8sReconnects)
+	return tea.Batch(append(cmds, m.scheduleK8sReconnect(msg.Cat, msg.Gen))...)
 }
 
 // handleK8sWatchUnavailable records that no live watch is active. The
-// static load result remains on screen.
-func (m *Model) handleK8sWatchUnavailable(msg k8sWatchUnavailableMsg) {
+// static load result remains on screen; while reconnecting, the next
+// attempt is scheduled instead.
+func (m *Model) handleK8sWatchUnavailable(msg k8sWatchUnavailableMsg) tea.Cmd {
 	if msg.Gen != m.gens.msg {
 		m.logger.Debugw("watch unavailable ignored (stale gen)", "category", msg.Cat, "msgGen", msg.Gen, "gen", m.gens.msg)
-		return
+		return nil
 	}
 	m.watch.k8sActive = false
+	if m.watch.k8sReconnecting {
+		return m.scheduleK8sReconnect(msg.Cat, msg.Gen)
+	}
 	m.logger.Infow("watch unavailable (no live watch)", "category", msg.Cat, "gen", msg.Gen)
+	return nil
+}
+
+// Reconnect backoff for a dropped k8s watch: the first attempt waits
+// watchReconnectBase and each failed one doubles the wait, up to
+// watchReconnectMax, plus up to a fifth more as jitter so TUIs that lost
+// the same API server do not retry in lockstep. A watch counts as stable,
+// resetting the backoff, once it has been up for watchStableAfter.
+// Package-level so tests can shorten them.
+var (
+	watchReconnectBase = time.Second
+	watchReconnectMax  = time.Minute
+	watchStableAfter   = 2 * time.Minute
+)
+
+// watchReconnectDelay returns the wait before reconnect attempt n
+// (0-based).
+func watchReconnectDelay(n int) time.Duration {
+	d := watchReconnectBase
+	for range n {
+		d *= 2
+		if d >= watchReconnectMax {
+			return watchReconnectMax
+		}
+	}
+	return d
+}
+
+// jitter returns d lengthened by a random amount below d/5.
+func jitter(d time.Duration) time.Duration {
+	if d < 5 {
+		return d
+	}
+	return d + rand.N(d/5)
+}
+
+// scheduleK8sReconnect arms the next reconnect attempt after its
+// jittered backoff.
+func (m *Model) scheduleK8sReconnect(cat domain.Category, gen int) tea.Cmd {
+	delay := jitter(watchReconnectDelay(m.watch.k8sAttempt))
+	m.watch.k8sAttempt++
+	m.logger.Infow("watch reconnect scheduled", "category", cat, "gen", gen,
+		"attempt", m.watch.k8sAttempt, "delay", delay.String(), "reconnects", m.watch.k8sReconnects)
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return k8sWatchReconnectMsg{Cat: cat, Gen: gen}
+	})
+}
+
+// handleK8sWatchReconnect re-establishes a dropped watch; the result
+// arrives as k8sWatchStartedMsg or k8sWatchUnavailableMsg.
+func (m *Model) handleK8sWatchReconnect(msg k8sWatchReconnectMsg) tea.Cmd {
+	if msg.Gen != m.gens.msg || !m.watch.k8sReconnecting {
+		m.logger.Debugw("watch reconnect ignored (stale)", "category", msg.Cat, "msgGen", msg.Gen, "gen", m.gens.msg)
+		return nil
+	}
+	return startK8sWatchCmd(m.loadCtx, m.loader, msg.Cat, m.kubeConfig, m.environment, msg.Gen)
 }
 
 // waitForK8sTrigger re-arms the listener on the stored trigger channel.
@@ -363,8 +363,8 @@ func scopedTruncatedModel(t *testing.T) *Model {
 	return m
 }
 
-// The reload identity match must run against m.rawRows (un-truncated), not the
-// `rows` slice that applyMiddleTruncation has already shortened. With the key
+// The reload identity match must run against m.index (un-truncated), not the
+// displayed rows that middle truncation has already shortened. With the key
 // cells truncated, comparing the displayed cells against the un-truncated
 // prevKey would miss and drop the cursor to the top. Same offset → fast path.
 func TestApplyRows_FastPath_TruncatedKeyColumns(t *testing.T) {
@@ -391,7 +391,7 @@ func TestApplyRows_FastPath_TruncatedKeyColumns(t *testing.T) {
 }
 
 // When the selected item moves to a new offset, the fast path misses and the
-// scan (also over m.rawRows) must still find it by identity — even with the
+// scan (also over m.index) must still find it by identity — even with the
 // key cells truncated.
 func TestApplyRows_FallbackScan_AfterReorder_TruncatedKeyColumns(t *testing.T) {
 	t.Parallel()
@@ -19,8 +19,10 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/jingle2008/toolkit/internal/columns"
 	"github.com/jingle2008/toolkit/internal/domain"
 	loader "github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 	"github.com/jingle2008/toolkit/internal/ui/tui/common"
 	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
 	view "github.com/jingle2008/toolkit/internal/ui/tui/view"
@@ -39,12 +41,33 @@ type watchState struct {
 	// k8sWatchTriggeredMsg can re-arm the listener on the same stream.
 	k8sTrigger <-chan struct{}
 
+	// k8sReconnecting is true while a dropped k8s watch is being
+	// re-established with backoff; k8sAttempt counts the attempts since
+	// the watch was last stable (up for watchStableAfter), so a flapping
+	// watch keeps backing off. k8sUpSince is when the watch last came up.
+	// k8sReconnects counts the watches re-established this session, shown
+	// next to the live indicator and reported in the log.
+	k8sReconnecting bool
+	k8sAttempt      int
+	k8sUpSince      time.Time
+	k8sReconnects   int
+
 	// repoTrigger is the live working-tree trigger channel; nil when the
 	// repo watch is unavailable. repoActive is true while it is established.
-	repoTrigger <-chan struct{}
+	repoTrigger <-chan []string
 	repoActive  bool
 }
 
+// breakerState holds the circuit breakers shown in the status bar.
+// registry is nil when the model was built without WithBreakers; notify is
+// its change subscription, and open the breakers not closed at the last
+// change.
+type breakerState struct {
+	registry *resilience.Registry
+	notify   <-chan struct{}
+	open     []resilience.Status
+}
+
 // logOverlay holds the state for the log-viewer overlay (toggled with the
 // log keybinding); returnView restores the prior view when it closes.
 type logOverlay struct {
@@ -71,21 +94,27 @@ type toastManager struct {
 //   - filter: list filtering
 //   - rows:   table-row recomputation
 //   - detail: detail-view rendering
+//   - podLog: the pod log view's container lookup and log stream
+//   - metrics: the metrics panel's fetch
 type genCounters struct {
-	msg    int
-	filter int
-	rows   int
-	detail int
+	msg     int
+	filter  int
+	rows    int
+	detail  int
+	podLog  int
+	metrics int
 }
 
 // next* advance a counter and return the new generation in one step, so a
 // caller cannot accidentally capture a stale value or bump without
 // capturing. Called as a statement (ignoring the result) they serve as a
 // pure "invalidate in-flight work" bump.
-func (g *genCounters) nextMsg() int    { g.msg++; return g.msg }
-func (g *genCounters) nextFilter() int { g.filter++; return g.filter }
-func (g *genCounters) nextRows() int   { g.rows++; return g.rows }
-func (g *genCounters) nextDetail() int { g.detail++; return g.detail }
+func (g *genCounters) nextMsg() int     { g.msg++; return g.msg }
+func (g *genCounters) nextFilter() int  { g.filter++; return g.filter }
+func (g *genCounters) nextRows() int    { g.rows++; return g.rows }
+func (g *genCounters) nextDetail() int  { g.detail++; return g.detail }
+func (g *genCounters) nextPodLog() int  { g.podLog++; return g.podLog }
+func (g *genCounters) nextMetrics() int { g.metrics++; return g.metrics }
 
 /*
 Model represents the main TUI model for the toolkit application.
@@ -115,6 +144,8 @@ type Model struct {
 	lastViewMode  common.ViewMode // for toggling help view
 	confirm       confirmOverlay  // destructive-action confirmation modal state
 	selectedKey   models.ItemKey
+	detailHistory bool // details view shows the backing file's git history instead of JSON
+	detailRaw     bool // details view shows the live Kubernetes object as YAML instead of JSON
 	viewport      *viewport.Model
 	renderer      view.Renderer
 	loader        loader.Composite
@@ -122,6 +153,7 @@ type Model struct {
 	keys          keys.KeyMap
 	help          *help.Model
 	kubeConfig    string
+	kubeContexts  models.KubeContexts
 	version       string
 	// theme holds the app-level lipgloss styles (status bar, info pane,
 	// help view). Set once via setStyles; see the Styles struct in styles.go.
@@ -142,6 +174,10 @@ type Model struct {
 	// repo working-tree watch. See the watchState type.
 	watch watchState
 
+	// breakers holds the OCI and Kubernetes circuit breakers shown in the
+	// status bar. See the breakerState type.
+	breakers breakerState
+
 	// Table sorting state
 	sortColumn string
 	sortAsc    bool
@@ -153,11 +189,18 @@ type Model struct {
 	// Show only faulty items in list view (Tenant, GPUNode, DedicatedAICluster)
 	showFaulty bool
 
-	// rawRows mirrors m.table.Rows() pre-truncation so itemKeyFrom can
-	// recover un-elided Name/Tenant cells. applyMiddleTruncation
-	// mutates the table's rows in place, which would otherwise leak
-	// "…" into ScopedItemKey lookups.
-	rawRows []table.Row
+	// index is the filtered, sorted row set behind the list table;
+	// itemKeyFrom reads un-elided Name/Tenant cells from it, since the
+	// table's cells are middle-truncated. indexGen is the gens.rows it
+	// was applied under, so a filter change can tell whether it is
+	// still the latest.
+	index    *rowIndex
+	indexGen int
+	// shown is the table's row slice: one slot per index row, of which
+	// only the rows around the cursor are rendered (syncTableWindow).
+	// shownWidths are the column widths those rows were truncated for.
+	shown       []table.Row
+	shownWidths []int
 
 	// Export CSV popup state
 	dirPicker *filepicker.Model
@@ -168,9 +211,20 @@ type Model struct {
 	// log holds the log-overlay state. See the logOverlay type.
 	log logOverlay
 
+	// podLog holds the GPU workload log view state. See podLogState.
+	podLog podLogState
+
 	// toasts holds the transient banner shown over the active view. See
 	// the toastManager type.
 	toasts toastManager
+
+	// jobs holds the mutations launched from this session, shown in the
+	// background-jobs panel. See the jobPanel type.
+	jobs jobPanel
+
+	// metrics holds the in-terminal metrics panel state. See the
+	// metricsPanel type.
+	metrics metricsPanel
 }
 
 /*
@@ -195,6 +249,7 @@ func NewModel(opts ...ModelOption) (*Model, error) {
 		return nil, err
 	}
 	setDefaults(m)
+	m.syncMultiCluster()
 
 	// Seed initial category in history if not already present
 	if len(m.history) == 0 {
@@ -296,6 +351,17 @@ func setDefaults(m *Model) {
 		lvp := viewport.New(20, 20)
 		m.log.viewport = &lvp
 	}
+	if m.podLog.viewport == nil {
+		pvp := viewport.New(20, 20)
+		pvp.SetHorizontalStep(8)
+		m.podLog.viewport = &pvp
+	}
+	if m.podLog.search == nil {
+		si := textinput.New()
+		si.CharLimit = 256
+		si.Prompt = "/"
+		m.podLog.search = &si
+	}
 	if m.help == nil {
 		keyStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color("33"))
@@ -334,6 +400,12 @@ func setDefaults(m *Model) {
 	}
 }
 
+// syncMultiCluster shows the Cluster column when the current environment
+// spans more than one kube context, and hides it otherwise.
+func (m *Model) syncMultiCluster() {
+	columns.SetMultiCluster(len(m.kubeContexts.For(m.environment)) > 1)
+}
+
 // cancelInFlight cancels any in-flight async operations (loads, actions).
 func (m *Model) cancelInFlight() {
 	if m.loadCancel != nil {
@@ -50,10 +50,26 @@ func (f fakeLoader) LoadGPUWorkloadsByNode(_ context.Context, _ string, _ models
 	return map[string][]models.GPUWorkload{}, nil
 }
 
+func (f fakeLoader) LoadPendingGPUWorkloads(_ context.Context, _ string, _ models.Environment) ([]models.PendingGPUWorkload, error) {
+	return []models.PendingGPUWorkload{}, nil
+}
+
 func (f fakeLoader) LoadDedicatedAIClusters(_ context.Context, _ string, _ models.Environment) (map[string][]models.DedicatedAICluster, error) {
 	return map[string][]models.DedicatedAICluster{}, nil
 }
 
+func (f fakeLoader) LoadEndpoints(_ context.Context, _ string, _ models.Environment) (map[string][]models.Endpoint, error) {
+	return map[string][]models.Endpoint{}, nil
+}
+
+func (f fakeLoader) LoadEvents(_ context.Context, _ string, _ models.Environment) (map[string][]models.Event, error) {
+	return map[string][]models.Event{}, nil
+}
+
+func (f fakeLoader) LoadWorkRequests(_ context.Context, _ string, _ models.Environment) ([]models.WorkRequest, error) {
+	return []models.WorkRequest{}, nil
+}
+
 // TenancyOverrideLoader stubs
 func (f fakeLoader) LoadLimitTenancyOverrides(_ context.Context, _ string, _ models.Environment) (map[string][]models.LimitTenancyOverride, error) {
 	return map[string][]models.LimitTenancyOverride{}, nil
@@ -172,7 +188,7 @@ func assertLoadErrorMessage(t *testing.T, msg any, wantError error) {
 	}
 }
 
-func newTestModel(t *testing.T) *Model {
+func newTestModel(t testing.TB) *Model {
 	t.Helper()
 	env := models.Environment{
 		Realm:  "realm",
@@ -39,6 +39,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, logTickCmd()
 		}
 		return m, nil
+	case jobsTickMsg:
+		if m.viewMode == common.JobsView {
+			return m, jobsTickCmd()
+		}
+		return m, nil
 	case stopwatch.TickMsg, stopwatch.StartStopMsg, stopwatch.ResetMsg:
 		return m, m.handleStopwatchMsg(msg)
 	// Data / loaded messages: routed at the top so a load completing
@@ -53,8 +58,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.handleDataMsg(dataMsg{Data: msg.Dataset, Gen: msg.Gen})
 		return m, nil
 	case baseModelsLoadedMsg, importedModelsLoadedMsg, gpuPoolsLoadedMsg,
-		gpuNodesLoadedMsg, gpuWorkloadsLoadedMsg, dedicatedAIClustersLoadedMsg, tenancyOverridesLoadedMsg,
-		limitRegionalOverridesLoadedMsg, consolePropertyRegionalOverridesLoadedMsg,
+		gpuNodesLoadedMsg, gpuWorkloadsLoadedMsg, pendingGPUWorkloadsLoadedMsg, workRequestsLoadedMsg, dedicatedAIClustersLoadedMsg,
+		endpointsLoadedMsg, eventsLoadedMsg, tenancyOverridesLoadedMsg, limitRegionalOverridesLoadedMsg,
+		consolePropertyRegionalOverridesLoadedMsg,
 		propertyRegionalOverridesLoadedMsg:
 		return m, tea.Batch(m.routeListLoadedMsg(msg)...)
 	// Tenant-save results are intercepted here so they fire from any
@@ -63,6 +69,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// silently dropped (no toast, no reload).
 	case tenantSavedMsg:
 		return m, m.handleTenantSavedMsg(msg)
+	// Mutation results likewise: the user may have opened the jobs panel
+	// or the log overlay while the operation ran, and its job must still
+	// finish.
+	case deleteErrMsg, deleteDoneMsg, gpuPoolScaleStartedMsg, gpuPoolScaleResultMsg,
+		cordonNodeResultMsg, drainNodeResultMsg, rebootNodeResultMsg, jobPolledMsg:
+		return m, m.routeMutationMsg(msg)
 	case tenantSaveErrMsg:
 		return m, m.handleTenantSaveErrMsg(msg)
 	case portalOpenErrMsg:
@@ -73,6 +85,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.showToast(fmt.Sprintf("failed to open metrics: %v", msg.err), toastError)
 	case openMetricsTriggerMsg:
 		return m, m.handleOpenMetricsTrigger(msg)
+	case metricsLoadedMsg:
+		return m, m.handleMetricsLoaded(msg)
+	case editorClosedMsg:
+		return m, m.handleEditorClosed(msg)
 	case tableRowsComputedMsg:
 		m.handleTableRowsComputedMsg(msg)
 		return m, nil
@@ -85,20 +101,37 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case k8sWatchClosedMsg:
 		return m, m.handleK8sWatchClosed(msg)
 	case k8sWatchUnavailableMsg:
-		m.handleK8sWatchUnavailable(msg)
-		return m, nil
+		return m, m.handleK8sWatchUnavailable(msg)
+	case k8sWatchReconnectMsg:
+		return m, m.handleK8sWatchReconnect(msg)
 	case repoWatchStartedMsg:
 		return m, m.handleRepoWatchStarted(msg)
 	case repoWatchTriggeredMsg:
-		return m, m.handleRepoWatchTriggered()
+		return m, m.handleRepoWatchTriggered(msg)
 	case repoWatchClosedMsg:
 		m.handleRepoWatchClosed()
 		return m, nil
+	case breakersChangedMsg:
+		return m, m.handleBreakersChanged(msg)
 	case datasetReloadedMsg:
 		m.handleDatasetReloaded(msg)
 		return m, nil
 	case gpuPoolsReloadedMsg:
 		return m, m.handleGPUPoolsReloaded(msg)
+	// Pod log stream messages are routed here rather than by view mode:
+	// each handler drops results whose gen no longer matches, which is
+	// what happens once the log view closes or switches streams.
+	case podLogContainersMsg:
+		return m, m.handlePodLogContainers(msg)
+	case podLogStartedMsg:
+		return m, m.handlePodLogStarted(msg)
+	case podLogLinesMsg:
+		return m, m.handlePodLogLines(msg)
+	case podLogEndedMsg:
+		m.handlePodLogEnded(msg)
+		return m, nil
+	case podLogSavedMsg:
+		return m, m.handlePodLogSaved(msg)
 	default:
 		return m.delegateToActiveView(msg)
 	}
@@ -133,6 +166,9 @@ func (m *Model) delegateToActiveView(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if model, cmd, intercepted := m.interceptToggleLog(msg); intercepted {
 		return model, cmd
 	}
+	if model, cmd, intercepted := m.interceptToggleJobs(msg); intercepted {
+		return model, cmd
+	}
 	switch m.viewMode {
 	case common.HelpView:
 		return m.updateHelpView(msg)
@@ -150,6 +186,12 @@ func (m *Model) delegateToActiveView(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.updateLogView(msg)
 	case common.ConfirmView:
 		return m.updateConfirmView(msg)
+	case common.PodLogView:
+		return m.updatePodLogView(msg)
+	case common.JobsView:
+		return m.updateJobsView(msg)
+	case common.MetricsView:
+		return m.updateMetricsView(msg)
 	}
 	return m, nil
 }
@@ -25,7 +25,7 @@ func (m *Model) infoView() string {
 		m.environment.Realm,
 		m.environment.Type,
 		m.environment.Region,
-		m.environment.KubeContext(),
+		strings.Join(m.kubeContexts.For(m.environment), ", "),
 		m.version,
 	}
 
@@ -91,15 +91,41 @@ func (m *Model) statusView() string {
 	liveCell := ""
 	// k8s categories: live while their watch is established (m.watch.k8sActive).
 	// repo categories: live while the always-on working-tree watch runs.
-	if m.watch.k8sActive || (m.watch.repoActive && !m.category.NeedsKubeConfig()) {
+	// A dropped k8s watch shows RECONNECTING, with its attempt, until it is
+	// re-established; the k8s live cell then counts the session's
+	// reconnects (↻n) so a flapping watch is visible without the log.
+	// In a multi-cluster environment the watch is the merge of the
+	// clusters' watches, which stays up while one cluster's watch is
+	// reopened (production.rewatchCluster): that shows here only as
+	// reloads, and in the log.
+	switch {
+	case m.watch.k8sActive && m.watch.k8sReconnects > 0:
+		liveCell = m.theme.Live.Render(fmt.Sprintf("● LIVE ↻%d", m.watch.k8sReconnects))
+	case m.watch.k8sActive || (m.watch.repoActive && !m.category.NeedsKubeConfig()):
 		liveCell = m.theme.Live.Render("● LIVE")
+	case m.watch.k8sReconnecting:
+		liveCell = m.theme.Reconnecting.Render(fmt.Sprintf("◌ RECONNECTING #%d", max(m.watch.k8sAttempt, 1)))
+	}
+
+	// An OCI or Kubernetes service whose circuit breaker is not closed:
+	// its calls fail fast until a probe succeeds.
+	breakerCell := ""
+	if label := breakerLabel(m.breakers.open); label != "" {
+		breakerCell = m.theme.Breaker.Render(label)
+	}
+
+	// Mutations launched from the session that are still running; the
+	// jobs panel (ctrl+b) lists them.
+	jobsCell := ""
+	if n := m.runningJobs(); n > 0 {
+		jobsCell = m.theme.Jobs.Render(fmt.Sprintf("⚙ %d job(s)", n))
 	}
 
 	// Render-time width depends on the surrounding cells, so compute
 	// it here rather than in updateLayout. We deliberately operate on
 	// a copy of the textinput so View() stays pure — the original
 	// *m.textInput owned by the reducer is never mutated.
-	inputWidth := max(m.viewWidth-w(contextCell)-w(loadingCell)-w(liveCell)-w(statsCell)-
+	inputWidth := max(m.viewWidth-w(contextCell)-w(loadingCell)-w(liveCell)-w(breakerCell)-w(jobsCell)-w(statsCell)-
 		w(m.textInput.Prompt)-1, 0)
 	ti := *m.textInput
 	ti.Width = inputWidth
@@ -111,6 +137,8 @@ func (m *Model) statusView() string {
 		inputCell,
 		loadingCell,
 		liveCell,
+		breakerCell,
+		jobsCell,
 		statsCell,
 	)
 }
@@ -130,6 +158,12 @@ func (m *Model) updateContentAsync() tea.Cmd {
 	}
 
 	gen := m.gens.nextDetail()
+	if m.detailHistory {
+		return m.historyContentCmd(gen)
+	}
+	if m.detailRaw {
+		return m.rawContentCmd(gen)
+	}
 	item := findItem(m.dataset, m.category, m.selectedKey)
 	width := m.detailRenderWidth()
 	renderer := m.renderer
@@ -191,6 +225,12 @@ func (m *Model) renderActiveView() string {
 		return m.logView()
 	case common.ConfirmView:
 		return m.centered(m.confirmView())
+	case common.PodLogView:
+		return m.podLogView()
+	case common.JobsView:
+		return m.jobsView()
+	case common.MetricsView:
+		return m.metricsView()
 	default:
 		return ""
 	}
@@ -1,10 +1,17 @@
 package tui
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 )
 
 func TestStatusView_ShowsLiveIndicatorWhenWatching(t *testing.T) {
@@ -23,3 +30,63 @@ func TestStatusView_ShowsLiveIndicatorWhenWatching(t *testing.T) {
 	assert.True(t, strings.Contains(on, "LIVE") || strings.Contains(on, "●"),
 		"expected a live marker in the status bar, got %q", on)
 }
+
+func TestStatusView_ShowsReconnectingWhileWatchIsDown(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.viewWidth = 120
+	m.viewHeight = 40
+	m.updateLayout(m.viewWidth, m.viewHeight)
+	m.category = domain.GPUNode
+
+	m.watch.k8sReconnecting = true
+	m.watch.k8sAttempt = 2
+	view := m.statusView()
+	assert.Contains(t, view, "RECONNECTING #2")
+	assert.NotContains(t, view, "LIVE")
+
+	m.watch.k8sReconnecting = false
+	m.watch.k8sActive = true
+	m.watch.k8sReconnects = 3
+	assert.Contains(t, m.statusView(), "● LIVE ↻3", "the live cell counts the session's reconnects")
+}
+
+// tripBreaker opens service's breaker in r with one failed call.
+func tripBreaker(t *testing.T, r *resilience.Registry, service string) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	client := &http.Client{Transport: r.Transport(service, nil)}
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestStatusView_ShowsOpenBreakers(t *testing.T) {
+	t.Parallel()
+	r := resilience.NewRegistry(resilience.WithPolicy(resilience.Policy{Attempts: 1}), resilience.WithBreaker(1, time.Minute))
+	m := newTestModel(t)
+	WithBreakers(r)(m)
+	m.updateLayout(160, 40)
+	assert.NotContains(t, m.statusView(), "OPEN")
+
+	cmd := m.waitForBreakersCmd()
+	require.NotNil(t, cmd)
+	tripBreaker(t, r, "oci/generativeai/us-chicago-1")
+	msg, ok := cmd().(breakersChangedMsg)
+	require.True(t, ok)
+	_, next := m.Update(msg)
+	assert.NotNil(t, next, "the listener is re-armed")
+	assert.Contains(t, m.statusView(), "⚠ oci/generativeai/us-chicago-1 OPEN")
+
+	tripBreaker(t, r, "k8s/dev")
+	m.Update(next().(breakersChangedMsg))
+	assert.Contains(t, m.statusView(), "⚠ k8s/dev OPEN +1")
+}
+
+func TestWaitForBreakersCmd_NilWithoutRegistry(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, newTestModel(t).waitForBreakersCmd())
+}
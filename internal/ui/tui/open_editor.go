@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jingle2008/toolkit/internal/ui/tui/actions"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// execProcess is the seam tests use to observe the editor launch without
+// suspending the program. Mirrors the clipboard seams.
+var execProcess = tea.ExecProcess
+
+// editorClosedMsg reports that the editor launched by openInEditor exited.
+type editorClosedMsg struct{ err error }
+
+// itemSource returns where item is declared in the repo, or nil for items
+// that are not repo-backed (or a nil row pointer from findItem).
+func itemSource(item any) *models.SourceLocation {
+	sourced, ok := item.(models.Sourced)
+	if !ok {
+		return nil
+	}
+	if v := reflect.ValueOf(item); v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+	return sourced.GetSource()
+}
+
+// openInEditor suspends the TUI and opens the item's source location in
+// $EDITOR; editorClosedMsg triggers the reload once the editor exits.
+func (m *Model) openInEditor(item any) tea.Cmd {
+	loc := itemSource(item)
+	if loc == nil {
+		return m.showToast("no source location for this item", toastError)
+	}
+	m.logger.Infow("action started", "action", "openInEditor", "source", loc.String())
+	return execProcess(actions.EditorCommand(*loc), func(err error) tea.Msg {
+		return editorClosedMsg{err: err}
+	})
+}
+
+// handleEditorClosed reloads the repo-backed data so edits show up
+// immediately, even when the repo watch is not running.
+func (m *Model) handleEditorClosed(msg editorClosedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Errorw("editor exited with error", "error", msg.err)
+		return m.showToast(fmt.Sprintf("editor: %v", msg.err), toastError)
+	}
+	return tea.Batch(m.reloadRepoCmds()...)
+}
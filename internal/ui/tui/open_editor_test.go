@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// captureExec swaps the execProcess seam for one that records the command
+// and immediately reports the editor as closed with err.
+func captureExec(t *testing.T, err error) *[]*exec.Cmd {
+	t.Helper()
+	var got []*exec.Cmd
+	orig := execProcess
+	t.Cleanup(func() { execProcess = orig })
+	execProcess = func(c *exec.Cmd, fn tea.ExecCallback) tea.Cmd {
+		got = append(got, c)
+		return func() tea.Msg { return fn(err) }
+	}
+	return &got
+}
+
+func newEditorModel(t *testing.T, mode common.ViewMode) *Model {
+	t.Helper()
+	m := newHistoryModel(t, &historyLoader{})
+	m.category = domain.LimitDefinition
+	m.viewMode = mode
+	m.keys = keys.ResolveKeys(m.category, m.viewMode)
+	m.selectedKey = "gpu-count"
+	m.dataset = &models.Dataset{LimitDefinitionGroup: models.LimitDefinitionGroup{Values: []models.LimitDefinition{
+		{Name: "gpu-count", Source: &models.SourceLocation{File: "/repo/limits.json", Line: 42}},
+		{Name: "no-source"},
+	}}}
+	return m
+}
+
+//nolint:paralleltest // mutates the package-global execProcess seam
+func TestOpenInEditor_DetailsView(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano")
+	launched := captureExec(t, nil)
+	m := newEditorModel(t, common.DetailsView)
+
+	_, cmd := m.updateDetailView(tea.KeyMsg{Type: tea.KeyCtrlE})
+	require.NotNil(t, cmd)
+	require.Len(t, *launched, 1)
+	assert.Equal(t, []string{"nano", "+42", "/repo/limits.json"}, (*launched)[0].Args)
+
+	// A clean exit reloads the repo data.
+	assert.NotNil(t, m.handleEditorClosed(editorClosedMsg{}))
+	assert.Nil(t, m.toasts.active)
+}
+
+//nolint:paralleltest // mutates the package-global execProcess seam
+func TestOpenInEditor_NoSourceOrFailure(t *testing.T) {
+	launched := captureExec(t, nil)
+	m := newEditorModel(t, common.DetailsView)
+	m.selectedKey = "no-source"
+
+	m.updateDetailView(tea.KeyMsg{Type: tea.KeyCtrlE})
+	assert.Empty(t, *launched)
+	require.NotNil(t, m.toasts.active)
+	assert.Contains(t, m.toasts.active.msg, "no source location")
+
+	m.handleEditorClosed(editorClosedMsg{err: errors.New("exit status 1")})
+	assert.Contains(t, m.toasts.active.msg, "exit status 1")
+}
+
+func TestItemSource(t *testing.T) {
+	t.Parallel()
+	loc := &models.SourceLocation{File: "f"}
+	assert.Same(t, loc, itemSource(&models.GPUPool{Source: loc}))
+	assert.Nil(t, itemSource((*models.GPUPool)(nil)))
+	assert.Nil(t, itemSource(&models.GPUNode{}))
+	assert.Nil(t, itemSource(nil))
+}
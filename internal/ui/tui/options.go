@@ -7,6 +7,7 @@ import (
 
 	"github.com/jingle2008/toolkit/internal/domain"
 	loader "github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
 	logging "github.com/jingle2008/toolkit/pkg/infra/logging"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
@@ -37,6 +38,14 @@ func WithKubeConfig(kubeConfig string) ModelOption {
 	}
 }
 
+// WithKubeContexts sets the environment-to-kube-context mapping that
+// mutations and pod logs fall back to for items without a cluster.
+func WithKubeContexts(contexts models.KubeContexts) ModelOption {
+	return func(m *Model) {
+		m.kubeContexts = contexts
+	}
+}
+
 // WithEnvironment sets the environment field.
 func WithEnvironment(env models.Environment) ModelOption {
 	return func(m *Model) {
@@ -84,6 +93,14 @@ func WithLogStore(s *logging.RingSink) ModelOption {
 	}
 }
 
+// WithBreakers shows the circuit breakers of r that are not closed in the
+// status bar, subscribing to their changes.
+func WithBreakers(r *resilience.Registry) ModelOption {
+	return func(m *Model) {
+		m.breakers = breakerState{registry: r, notify: r.Notify()}
+	}
+}
+
 // WithFilter sets a starting filter before Init().
 func WithFilter(filter string) ModelOption {
 	return func(m *Model) {
@@ -30,6 +30,15 @@ func TestWithKubeConfig(t *testing.T) {
 	assert.Equal(t, "kube.yaml", m.kubeConfig)
 }
 
+func TestWithKubeContexts(t *testing.T) {
+	t.Parallel()
+	m := &Model{environment: models.Environment{Region: "us-phoenix-1", Type: "dev", Realm: "oc1"}}
+	WithKubeContexts(models.KubeContexts{"dev-phx": {"phx-a", "phx-b"}})(m)
+	assert.Equal(t, "phx-b", m.kubeContext("phx-b"), "an item's cluster wins")
+	assert.Equal(t, "phx-a", m.kubeContext(""), "items without one go to the primary context")
+	assert.Contains(t, m.infoView(), "phx-a, phx-b")
+}
+
 func TestWithEnvironment(t *testing.T) {
 	t.Parallel()
 	m := &Model{}
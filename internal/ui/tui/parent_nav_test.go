@@ -43,7 +43,7 @@ func TestJumpToParent(t *testing.T) {
 		m := newTestModel(t)
 		m.category = domain.DedicatedAICluster
 		m.scope = nil
-		m.rawRows = []table.Row{{"dac1", "tenant1"}} // row[1] is the parent tenant
+		m.index = rowIndexOf([]table.Row{{"dac1", "tenant1"}}, nil) // row[1] is the parent tenant
 
 		cmd := m.jumpToParent()
 
@@ -59,7 +59,7 @@ func TestJumpToParent(t *testing.T) {
 		m := newTestModel(t)
 		m.category = domain.LimitRegionalOverride
 		m.scope = nil
-		m.rawRows = []table.Row{{"some-limit", "us-region"}} // row[0] is the definition
+		m.index = rowIndexOf([]table.Row{{"some-limit", "us-region"}}, nil) // row[0] is the definition
 
 		cmd := m.jumpToParent()
 
@@ -90,7 +90,7 @@ func TestJumpToParent(t *testing.T) {
 		m := newTestModel(t)
 		m.category = domain.LimitTenancyOverride
 		m.scope = nil
-		m.rawRows = []table.Row{{"o1", "tenantX"}}
+		m.index = rowIndexOf([]table.Row{{"o1", "tenantX"}}, nil)
 		require.Nil(t, m.jumpToParent())
 	})
 
@@ -99,7 +99,7 @@ func TestJumpToParent(t *testing.T) {
 		m := newTestModel(t)
 		m.category = domain.Tenant
 		m.scope = nil
-		m.rawRows = []table.Row{{"tenant1"}}
+		m.index = rowIndexOf([]table.Row{{"tenant1"}}, nil)
 		require.Nil(t, m.jumpToParent())
 	})
 }
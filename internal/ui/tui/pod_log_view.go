@@ -0,0 +1,622 @@
+package tui
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	keys "github.com/jingle2008/toolkit/internal/ui/tui/keys"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// podLogMaxLines caps the lines the pod log view keeps (oldest dropped
+// first). It is also the tail requested when a stream opens, so a
+// restart never downloads more than the view can hold.
+const podLogMaxLines = 5000
+
+// podLogBatchMax bounds the lines one podLogLinesMsg carries, so a burst
+// of output re-renders once per batch rather than once per line.
+const podLogBatchMax = 500
+
+// Seams over the k8s calls so tests can drive the view without a cluster.
+var (
+	podContainers = k8s.PodContainers
+	streamPodLogs = k8s.StreamPodLogs
+)
+
+// podLogState holds the GPU workload log view: which container and mode
+// the stream was opened with, the buffered lines, and the search state.
+// returnView restores the prior view when it closes.
+type podLogState struct {
+	viewport   *viewport.Model
+	search     *textinput.Model
+	returnView common.ViewMode
+
+	workload   models.GPUWorkload
+	containers []string
+	container  int
+	follow     bool
+	previous   bool
+
+	lines     []string
+	dropped   int // lines discarded from the head to stay within podLogMaxLines
+	streaming bool
+	err       error
+	cancel    context.CancelFunc
+
+	searching bool
+	query     string
+	matches   []int // indices into lines that contain query
+	match     int   // index into matches of the current match
+}
+
+func (s *podLogState) containerName() string {
+	if s.container < len(s.containers) {
+		return s.containers[s.container]
+	}
+	return ""
+}
+
+// podLogStream pumps lines from a log reader to the TUI. err is written
+// before lines is closed, so a reader that observes the close may read it.
+type podLogStream struct {
+	lines chan string
+	err   error
+}
+
+// newPodLogStream starts the reader goroutine. It exits when the log ends,
+// the read fails, or ctx is cancelled (which also unblocks a pending send
+// once the view has stopped listening).
+func newPodLogStream(ctx context.Context, rc io.ReadCloser) *podLogStream {
+	s := &podLogStream{lines: make(chan string, podLogBatchMax)}
+	go func() {
+		defer close(s.lines)
+		defer rc.Close()
+		r := bufio.NewReader(rc)
+		for {
+			line, err := r.ReadString('\n')
+			if line != "" {
+				select {
+				case s.lines <- strings.TrimRight(line, "\r\n"):
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					s.err = err
+				}
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// waitForPodLogCmd blocks for the next line, then drains whatever else is
+// already buffered (up to podLogBatchMax) into one podLogLinesMsg. A closed
+// stream yields podLogEndedMsg.
+func waitForPodLogCmd(s *podLogStream, gen int) tea.Cmd {
+	return func() tea.Msg {
+		first, ok := <-s.lines
+		if !ok {
+			return podLogEndedMsg{err: s.err, gen: gen}
+		}
+		batch := []string{first}
+		for len(batch) < podLogBatchMax {
+			select {
+			case line, ok := <-s.lines:
+				if !ok {
+					return podLogLinesMsg{lines: batch, stream: s, gen: gen}
+				}
+				batch = append(batch, line)
+			default:
+				return podLogLinesMsg{lines: batch, stream: s, gen: gen}
+			}
+		}
+		return podLogLinesMsg{lines: batch, stream: s, gen: gen}
+	}
+}
+
+// openPodLogs switches to the log view for the selected GPU workload and
+// looks up its containers; the stream starts once they arrive.
+func (m *Model) openPodLogs(item any) tea.Cmd {
+	w, ok := item.(*models.GPUWorkload)
+	if !ok || w == nil {
+		return nil
+	}
+	m.stopPodLogStream()
+	m.podLog = podLogState{
+		viewport:   m.podLog.viewport,
+		search:     m.podLog.search,
+		returnView: m.viewMode,
+		workload:   *w,
+		follow:     true,
+		streaming:  true,
+	}
+	m.podLog.viewport.SetContent("")
+	m.podLog.viewport.SetYOffset(0)
+	m.viewMode = common.PodLogView
+	m.logger.Infow("action started", "action", "viewLogs", "pod", w.Namespace+"/"+w.Name)
+
+	gen := m.gens.nextPodLog()
+	ctx, cancel := m.opCtx()
+	kubeCfg, kubeCtx := m.kubeConfig, m.kubeContext(w.Cluster)
+	return func() tea.Msg {
+		defer cancel()
+		names, err := podContainers(ctx, kubeCfg, kubeCtx, w.Namespace, w.Name)
+		return podLogContainersMsg{containers: names, err: err, gen: gen}
+	}
+}
+
+// closePodLogs stops the stream and restores the view the log was opened from.
+func (m *Model) closePodLogs() {
+	m.stopPodLogStream()
+	m.podLog.searching = false
+	m.viewMode = m.podLog.returnView
+}
+
+// stopPodLogStream cancels the active stream, if any, and bumps the
+// generation so batches already in flight are dropped.
+func (m *Model) stopPodLogStream() {
+	if m.podLog.cancel != nil {
+		m.podLog.cancel()
+		m.podLog.cancel = nil
+	}
+	m.podLog.streaming = false
+	m.gens.nextPodLog()
+}
+
+// startPodLogStream (re)opens the stream for the current container and
+// mode, discarding the buffered lines. The stream outlives navigation
+// (it derives from the session context) and is stopped explicitly when
+// the view closes or the mode changes.
+func (m *Model) startPodLogStream() tea.Cmd {
+	m.stopPodLogStream()
+	gen := m.gens.podLog
+	ctx, cancel := context.WithCancel(m.sessionCtx())
+	m.podLog.cancel = cancel
+	m.podLog.lines = nil
+	m.podLog.dropped = 0
+	m.podLog.err = nil
+	m.podLog.streaming = true
+	m.podLog.matches = nil
+	m.podLog.match = 0
+
+	opts := k8s.LogOptions{
+		Container: m.podLog.containerName(),
+		Follow:    m.podLog.follow && !m.podLog.previous,
+		Previous:  m.podLog.previous,
+		TailLines: podLogMaxLines,
+	}
+	w := m.podLog.workload
+	kubeCfg, kubeCtx := m.kubeConfig, m.kubeContext(w.Cluster)
+	return func() tea.Msg {
+		rc, err := streamPodLogs(ctx, kubeCfg, kubeCtx, w.Namespace, w.Name, opts)
+		if err != nil {
+			return podLogEndedMsg{err: err, gen: gen}
+		}
+		return podLogStartedMsg{stream: newPodLogStream(ctx, rc), gen: gen}
+	}
+}
+
+func (m *Model) handlePodLogContainers(msg podLogContainersMsg) tea.Cmd {
+	if msg.gen != m.gens.podLog {
+		return nil
+	}
+	if msg.err != nil {
+		m.podLog.streaming = false
+		m.podLog.err = msg.err
+		return nil
+	}
+	m.podLog.containers = msg.containers
+	return m.startPodLogStream()
+}
+
+func (m *Model) handlePodLogStarted(msg podLogStartedMsg) tea.Cmd {
+	if msg.gen != m.gens.podLog {
+		return nil
+	}
+	return waitForPodLogCmd(msg.stream, msg.gen)
+}
+
+func (m *Model) handlePodLogLines(msg podLogLinesMsg) tea.Cmd {
+	if msg.gen != m.gens.podLog {
+		return nil
+	}
+	m.appendPodLogLines(msg.lines)
+	return waitForPodLogCmd(msg.stream, msg.gen)
+}
+
+func (m *Model) handlePodLogEnded(msg podLogEndedMsg) {
+	if msg.gen != m.gens.podLog {
+		return
+	}
+	m.podLog.streaming = false
+	m.podLog.err = msg.err
+	if m.podLog.cancel != nil {
+		m.podLog.cancel()
+		m.podLog.cancel = nil
+	}
+}
+
+// appendPodLogLines adds a batch to the buffer, dropping the oldest lines
+// past podLogMaxLines and keeping the search matches in step.
+func (m *Model) appendPodLogLines(lines []string) {
+	s := &m.podLog
+	start := len(s.lines)
+	s.lines = append(s.lines, lines...)
+	if s.query != "" {
+		for i := start; i < len(s.lines); i++ {
+			if containsFold(s.lines[i], s.query) {
+				s.matches = append(s.matches, i)
+			}
+		}
+	}
+	over := len(s.lines) - podLogMaxLines
+	if over <= 0 {
+		return
+	}
+	s.lines = append([]string(nil), s.lines[over:]...)
+	s.dropped += over
+	kept := s.matches[:0]
+	for _, i := range s.matches {
+		if i >= over {
+			kept = append(kept, i-over)
+		}
+	}
+	removed := len(s.matches) - len(kept)
+	s.matches = kept
+	s.match = max(0, s.match-removed)
+	m.podLog.viewport.SetYOffset(max(0, m.podLog.viewport.YOffset-over))
+}
+
+// applyPodLogSearch records query, recomputes the matches and jumps to the
+// first match at or below the current scroll position (wrapping to the top).
+func (m *Model) applyPodLogSearch(query string) {
+	s := &m.podLog
+	s.query = query
+	s.matches = nil
+	s.match = 0
+	if query == "" {
+		return
+	}
+	for i, line := range s.lines {
+		if containsFold(line, query) {
+			s.matches = append(s.matches, i)
+		}
+	}
+	for i, line := range s.matches {
+		if line >= s.viewport.YOffset {
+			s.match = i
+			break
+		}
+	}
+	m.scrollToPodLogMatch()
+}
+
+// stepPodLogMatch moves to the next (delta=1) or previous (delta=-1)
+// match, wrapping at either end.
+func (m *Model) stepPodLogMatch(delta int) {
+	s := &m.podLog
+	if len(s.matches) == 0 {
+		return
+	}
+	s.match = (s.match + delta + len(s.matches)) % len(s.matches)
+	m.scrollToPodLogMatch()
+}
+
+// scrollToPodLogMatch centres the current match in the viewport. Lines
+// are not wrapped, so line index and viewport row coincide.
+func (m *Model) scrollToPodLogMatch() {
+	s := &m.podLog
+	if len(s.matches) == 0 {
+		return
+	}
+	s.viewport.SetContent(m.renderPodLogLines())
+	s.viewport.SetYOffset(max(0, s.matches[s.match]-s.viewport.Height/2))
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+var (
+	podLogMatchStyle   = lipgloss.NewStyle().Background(lipgloss.Color("220")).Foreground(lipgloss.Color("16"))
+	podLogCurrentStyle = lipgloss.NewStyle().Background(lipgloss.Color("208")).Foreground(lipgloss.Color("16")).Bold(true)
+)
+
+// highlightFold styles each case-insensitive occurrence of query in line.
+// Lines whose lowercase form changes byte length (rare non-ASCII case
+// folds) are returned unstyled rather than mis-sliced.
+func highlightFold(line, query string, style lipgloss.Style) string {
+	lower, q := strings.ToLower(line), strings.ToLower(query)
+	if q == "" || len(lower) != len(line) {
+		return line
+	}
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, q)
+		if i < 0 {
+			b.WriteString(line)
+			return b.String()
+		}
+		b.WriteString(line[:i])
+		b.WriteString(style.Render(line[i : i+len(q)]))
+		line, lower = line[i+len(q):], lower[i+len(q):]
+	}
+}
+
+// renderPodLogLines builds the viewport body, highlighting search matches
+// (the current match in a stronger color).
+func (m *Model) renderPodLogLines() string {
+	s := &m.podLog
+	if len(s.lines) == 0 {
+		switch {
+		case s.err != nil:
+			return fmt.Sprintf("(failed to read logs: %v)", s.err)
+		case s.streaming:
+			return "(waiting for log output…)"
+		default:
+			return "(no log output)"
+		}
+	}
+	current := -1
+	if len(s.matches) > 0 {
+		current = s.matches[s.match]
+	}
+	out := make([]string, len(s.lines))
+	copy(out, s.lines)
+	for _, i := range s.matches {
+		style := podLogMatchStyle
+		if i == current {
+			style = podLogCurrentStyle
+		}
+		out[i] = highlightFold(s.lines[i], s.query, style)
+	}
+	if s.err != nil {
+		out = append(out, fmt.Sprintf("(stream ended: %v)", s.err))
+	}
+	return strings.Join(out, "\n")
+}
+
+// podLogTitle summarizes the stream: pod, container, and mode.
+func (m *Model) podLogTitle() (string, lipgloss.Color) {
+	s := &m.podLog
+	target := s.workload.Namespace + "/" + s.workload.Name
+	if c := s.containerName(); c != "" {
+		target += " [" + c
+		if len(s.containers) > 1 {
+			target += fmt.Sprintf(" %d/%d", s.container+1, len(s.containers))
+		}
+		target += "]"
+	}
+	var state string
+	color := lipgloss.Color("24") // teal: live tail
+	switch {
+	case s.err != nil:
+		state, color = "ERROR", lipgloss.Color("160")
+	case s.previous:
+		state, color = "previous instance", lipgloss.Color("97")
+	case s.streaming && s.follow && !s.viewport.AtBottom():
+		state, color = "PAUSED", lipgloss.Color("130")
+	case s.streaming && s.follow:
+		state = "following"
+	default:
+		state, color = "snapshot", lipgloss.Color("240")
+	}
+	title := fmt.Sprintf("LOGS %s — %s", target, state)
+	if s.dropped > 0 {
+		title += fmt.Sprintf(" · %d older lines dropped", s.dropped)
+	}
+	if s.query != "" {
+		if len(s.matches) == 0 {
+			title += fmt.Sprintf(" · /%s: no matches", s.query)
+		} else {
+			title += fmt.Sprintf(" · /%s: %d/%d", s.query, s.match+1, len(s.matches))
+		}
+	}
+	return title, color
+}
+
+// podLogView renders the log view: a title bar, the scrollable body and
+// either the search prompt or the key hints. Like the log overlay, the
+// body tails new output while the user is at the bottom in follow mode
+// and leaves the offset alone once they scroll up.
+func (m *Model) podLogView() string {
+	s := &m.podLog
+	width := m.viewWidth
+	s.viewport.Width = width
+	s.viewport.Height = max(1, m.viewHeight-2) // title + footer lines
+
+	atBottom := s.viewport.AtBottom()
+	s.viewport.SetContent(m.renderPodLogLines())
+	if s.follow && atBottom && len(s.matches) == 0 {
+		s.viewport.GotoBottom()
+	}
+
+	text, color := m.podLogTitle()
+	title := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("231")).
+		Background(color).
+		Width(width).
+		MaxWidth(width).
+		Render(text)
+	footer := m.help.ShortHelpView(keys.PodLogKeys)
+	if s.searching {
+		footer = s.search.View()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, title, s.viewport.View(), footer)
+}
+
+// updatePodLogView handles input while the log view is open: the search
+// prompt when it is up, close/quit, the log actions, the home/end follow
+// controls (the viewport keymap lacks them), and otherwise scrolling.
+func (m *Model) updatePodLogView(msg tea.Msg) (tea.Model, tea.Cmd) {
+	km, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	if m.podLog.searching {
+		return m, m.updatePodLogSearch(km)
+	}
+	switch {
+	case key.Matches(km, keys.Quit):
+		m.stopPodLogStream()
+		m.cancelInFlight()
+		return m, tea.Quit
+	case key.Matches(km, keys.Back):
+		// esc clears an active search first, then closes the view.
+		if m.podLog.query != "" {
+			m.applyPodLogSearch("")
+		} else {
+			m.closePodLogs()
+		}
+		return m, nil
+	}
+	if cmd, handled := m.handlePodLogActionKey(km); handled {
+		return m, cmd
+	}
+	switch km.String() {
+	case "end":
+		m.podLog.viewport.GotoBottom()
+		return m, nil
+	case "home":
+		m.podLog.viewport.SetYOffset(0)
+		return m, nil
+	}
+	vp, cmd := m.podLog.viewport.Update(msg)
+	m.podLog.viewport = &vp
+	return m, cmd
+}
+
+// handlePodLogActionKey dispatches the keys.PodLogKeys actions. handled is
+// false for any other key.
+func (m *Model) handlePodLogActionKey(km tea.KeyMsg) (tea.Cmd, bool) {
+	switch {
+	case key.Matches(km, keys.LogContainer):
+		return m.nextPodLogContainer(), true
+	case key.Matches(km, keys.LogFollow):
+		return m.togglePodLogFollow(), true
+	case key.Matches(km, keys.LogPrevious):
+		return m.togglePodLogPrevious(), true
+	case key.Matches(km, keys.LogSearch):
+		m.podLog.searching = true
+		m.podLog.search.SetValue(m.podLog.query)
+		m.podLog.search.CursorEnd()
+		return m.podLog.search.Focus(), true
+	case key.Matches(km, keys.LogNextMatch):
+		m.stepPodLogMatch(1)
+		return nil, true
+	case key.Matches(km, keys.LogPrevMatch):
+		m.stepPodLogMatch(-1)
+		return nil, true
+	case key.Matches(km, keys.LogSave):
+		return savePodLogCmd(".", m.podLogFilename(), m.podLog.lines), true
+	}
+	return nil, false
+}
+
+func (m *Model) nextPodLogContainer() tea.Cmd {
+	if len(m.podLog.containers) < 2 {
+		return m.showToast("pod has a single container", toastInfo)
+	}
+	m.podLog.container = (m.podLog.container + 1) % len(m.podLog.containers)
+	return m.startPodLogStream()
+}
+
+func (m *Model) togglePodLogFollow() tea.Cmd {
+	if m.podLog.previous {
+		return m.showToast("a previous instance has no new output to follow", toastInfo)
+	}
+	m.podLog.follow = !m.podLog.follow
+	return m.startPodLogStream()
+}
+
+// togglePodLogPrevious switches between the running and the last
+// terminated container instance. Only offered once the pod has restarted;
+// a restart of a different container still surfaces as the API's
+// "previous terminated container not found" error in the view.
+func (m *Model) togglePodLogPrevious() tea.Cmd {
+	if m.podLog.workload.Restarts == 0 {
+		return m.showToast("no previous instance: the pod has not restarted", toastInfo)
+	}
+	m.podLog.previous = !m.podLog.previous
+	return m.startPodLogStream()
+}
+
+// updatePodLogSearch edits the search prompt: enter applies the query,
+// esc abandons the edit and keeps the previous query.
+func (m *Model) updatePodLogSearch(km tea.KeyMsg) tea.Cmd {
+	switch km.Type { //nolint:exhaustive // only the prompt's terminators are special
+	case tea.KeyEnter:
+		m.podLog.searching = false
+		m.podLog.search.Blur()
+		m.applyPodLogSearch(strings.TrimSpace(m.podLog.search.Value()))
+		return nil
+	case tea.KeyEsc:
+		m.podLog.searching = false
+		m.podLog.search.Blur()
+		return nil
+	}
+	ti, cmd := m.podLog.search.Update(km)
+	m.podLog.search = &ti
+	return cmd
+}
+
+// podLogFilename names a saved log after the pod, container and mode,
+// stamped so repeated saves don't overwrite each other.
+func (m *Model) podLogFilename() string {
+	s := &m.podLog
+	parts := []string{s.workload.Name}
+	if c := s.containerName(); c != "" {
+		parts = append(parts, c)
+	}
+	if s.previous {
+		parts = append(parts, "previous")
+	}
+	parts = append(parts, time.Now().Format("20060102-150405"))
+	return strings.Join(parts, "_") + ".log"
+}
+
+// savePodLogCmd writes lines to dir/name off the UI goroutine. The slice
+// is copied first: the buffer keeps growing while the write runs.
+func savePodLogCmd(dir, name string, lines []string) tea.Cmd {
+	snapshot := append([]string(nil), lines...)
+	return func() tea.Msg {
+		path, err := filepath.Abs(filepath.Join(dir, name))
+		if err != nil {
+			return podLogSavedMsg{err: err}
+		}
+		body := strings.Join(snapshot, "\n")
+		if len(snapshot) > 0 {
+			body += "\n"
+		}
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			return podLogSavedMsg{err: err}
+		}
+		return podLogSavedMsg{path: path, lines: len(snapshot)}
+	}
+}
+
+func (m *Model) handlePodLogSaved(msg podLogSavedMsg) tea.Cmd {
+	if msg.err != nil {
+		return m.showToast(fmt.Sprintf("failed to save log: %v", msg.err), toastError)
+	}
+	return m.showToast(fmt.Sprintf("saved %d lines to %s", msg.lines, msg.path), toastInfo)
+}
@@ -0,0 +1,220 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/infra/k8s"
+	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// fakePodLogs swaps the podContainers/streamPodLogs seams: the pod has the
+// given containers and every stream serves body. Each opened stream's
+// options are recorded in order.
+func fakePodLogs(t *testing.T, containers []string, body string) *[]k8s.LogOptions {
+	t.Helper()
+	origContainers, origStream := podContainers, streamPodLogs
+	t.Cleanup(func() { podContainers, streamPodLogs = origContainers, origStream })
+	var opened []k8s.LogOptions
+	podContainers = func(context.Context, string, string, string, string) ([]string, error) {
+		return containers, nil
+	}
+	streamPodLogs = func(_ context.Context, _, _, _, _ string, opts k8s.LogOptions) (io.ReadCloser, error) {
+		opened = append(opened, opts)
+		return io.NopCloser(strings.NewReader(body)), nil
+	}
+	return &opened
+}
+
+// pump runs cmd and feeds each resulting message back through Update until
+// the chain ends. Batches are flattened; the pod log pipeline is a single
+// chain so nothing is lost.
+func pump(t *testing.T, m *Model, cmd tea.Cmd) {
+	t.Helper()
+	for range 100 {
+		if cmd == nil {
+			return
+		}
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				pump(t, m, c)
+			}
+			return
+		}
+		if msg == nil {
+			return
+		}
+		_, cmd = m.Update(msg)
+	}
+	t.Fatal("message chain did not settle")
+}
+
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+//nolint:paralleltest // mutates the package-global pod log seams
+func TestPodLogView_OpenStreamSwitchClose(t *testing.T) {
+	opened := fakePodLogs(t, []string{"main", "sidecar"}, "starting\nloaded model\nERROR: CUDA OOM\n")
+	m := newTestModel(t)
+	w := &models.GPUWorkload{Name: "serv-0", Namespace: "ns1", Node: "gpu-1"}
+
+	pump(t, m, m.openPodLogs(w))
+
+	require.Equal(t, common.PodLogView, m.viewMode)
+	assert.Equal(t, []string{"starting", "loaded model", "ERROR: CUDA OOM"}, m.podLog.lines)
+	assert.False(t, m.podLog.streaming, "the canned body ends, which ends the stream")
+	require.NoError(t, m.podLog.err)
+	require.Len(t, *opened, 1)
+	assert.Equal(t, k8s.LogOptions{Container: "main", Follow: true, TailLines: podLogMaxLines}, (*opened)[0])
+	assert.Contains(t, m.View(), "ns1/serv-0 [main 1/2]")
+
+	// Next container re-opens the stream on the sidecar.
+	_, cmd := m.Update(runeKey("C"))
+	pump(t, m, cmd)
+	require.Len(t, *opened, 2)
+	assert.Equal(t, "sidecar", (*opened)[1].Container)
+
+	// Follow off re-opens as a snapshot.
+	_, cmd = m.Update(runeKey("f"))
+	pump(t, m, cmd)
+	require.Len(t, *opened, 3)
+	assert.False(t, (*opened)[2].Follow)
+
+	// No restarts: previous is refused without opening a stream.
+	_, _ = m.Update(runeKey("p"))
+	assert.False(t, m.podLog.previous)
+	assert.Len(t, *opened, 3)
+
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, common.ListView, m.viewMode)
+}
+
+//nolint:paralleltest // mutates the package-global pod log seams
+func TestPodLogView_PreviousForRestartedPod(t *testing.T) {
+	opened := fakePodLogs(t, []string{"main"}, "panic: boom\n")
+	m := newTestModel(t)
+	pump(t, m, m.openPodLogs(&models.GPUWorkload{Name: "serv-0", Namespace: "ns1", Restarts: 2}))
+
+	_, cmd := m.Update(runeKey("p"))
+	pump(t, m, cmd)
+	require.Len(t, *opened, 2)
+	assert.True(t, (*opened)[1].Previous)
+	assert.False(t, (*opened)[1].Follow, "a terminated instance is never followed")
+	assert.Equal(t, []string{"panic: boom"}, m.podLog.lines)
+}
+
+func TestPodLogView_StaleBatchDropped(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.viewMode = common.PodLogView
+	stale := m.gens.podLog
+	m.gens.nextPodLog()
+
+	cmd := m.handlePodLogLines(podLogLinesMsg{lines: []string{"old"}, gen: stale})
+	assert.Nil(t, cmd)
+	assert.Empty(t, m.podLog.lines)
+}
+
+func TestAppendPodLogLines_CapsBuffer(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.podLog.query = "hit"
+	lines := make([]string, podLogMaxLines)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	lines[0], lines[10] = "hit first", "hit second"
+	m.appendPodLogLines(lines)
+	require.Equal(t, []int{0, 10}, m.podLog.matches)
+
+	m.appendPodLogLines([]string{"hit tail", "x"})
+
+	assert.Len(t, m.podLog.lines, podLogMaxLines)
+	assert.Equal(t, 2, m.podLog.dropped)
+	assert.Equal(t, []int{8, podLogMaxLines - 2}, m.podLog.matches,
+		"the dropped head match is gone and the rest shift down")
+}
+
+func TestPodLogSearch(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.viewMode = common.PodLogView
+	m.appendPodLogLines([]string{"ok", "Error one", "ok", "error two"})
+
+	_, _ = m.Update(runeKey("/"))
+	require.True(t, m.podLog.searching)
+	for _, r := range "ERROR" {
+		_, _ = m.Update(runeKey(string(r)))
+	}
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.False(t, m.podLog.searching)
+	assert.Equal(t, "ERROR", m.podLog.query)
+	assert.Equal(t, []int{1, 3}, m.podLog.matches, "search is case-insensitive")
+	assert.Equal(t, 0, m.podLog.match)
+
+	_, _ = m.Update(runeKey("n"))
+	assert.Equal(t, 1, m.podLog.match)
+	_, _ = m.Update(runeKey("n"))
+	assert.Equal(t, 0, m.podLog.match, "next wraps to the first match")
+	_, _ = m.Update(runeKey("N"))
+	assert.Equal(t, 1, m.podLog.match, "previous wraps to the last match")
+
+	// esc clears the search before it closes the view.
+	_, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Empty(t, m.podLog.query)
+	assert.Equal(t, common.PodLogView, m.viewMode)
+}
+
+func TestHighlightFold(t *testing.T) {
+	t.Parallel()
+	style := podLogMatchStyle
+	got := highlightFold("an Error and an error", "error", style)
+	assert.Equal(t, "an "+style.Render("Error")+" and an "+style.Render("error"), got)
+	assert.Equal(t, "no match", highlightFold("no match", "error", style))
+}
+
+func TestSavePodLogCmd(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	msg := savePodLogCmd(dir, "serv-0_main.log", []string{"a", "b"})()
+
+	saved, ok := msg.(podLogSavedMsg)
+	require.True(t, ok)
+	require.NoError(t, saved.err)
+	assert.Equal(t, filepath.Join(dir, "serv-0_main.log"), saved.path)
+	assert.Equal(t, 2, saved.lines)
+	body, err := os.ReadFile(saved.path)
+	require.NoError(t, err)
+	assert.Equal(t, "a\nb\n", string(body))
+}
+
+type failingReader struct{ err error }
+
+func (r failingReader) Read([]byte) (int, error) { return 0, r.err }
+func (failingReader) Close() error               { return nil }
+
+func TestNewPodLogStream_ReportsReadError(t *testing.T) {
+	t.Parallel()
+	boom := errors.New("connection reset")
+	s := newPodLogStream(context.Background(), failingReader{err: boom})
+
+	msg := waitForPodLogCmd(s, 7)()
+
+	ended, ok := msg.(podLogEndedMsg)
+	require.True(t, ok)
+	require.ErrorIs(t, ended.err, boom)
+	assert.Equal(t, 7, ended.gen)
+}
@@ -52,6 +52,12 @@ func (m *Model) handleItemActions(msg tea.KeyMsg) tea.Cmd {
 		return m.enterEditTenantView()
 	case key.Matches(msg, keys.OpenMetrics):
 		return m.openMetrics(item)
+	case key.Matches(msg, keys.ViewMetrics):
+		return m.viewMetrics(item)
+	case key.Matches(msg, keys.ViewLogs):
+		return m.openPodLogs(item)
+	case key.Matches(msg, keys.OpenEditor):
+		return m.openInEditor(item)
 	case key.Matches(msg, keys.Refresh):
 		return m.handleRefresh()
 	case key.Matches(msg, keys.ToggleCordon):
@@ -94,13 +100,14 @@ func (m *Model) scaleUpGPUPool(item any, itemKey models.ItemKey) tea.Cmd {
 	}
 
 	m.logger.Infow("action started", "action", "scaleUpGPUPool", "pool", itemKeyString(itemKey))
+	job := m.startJob("scale up", "GPU pool", itemKeyString(itemKey))
 	return tea.Batch(
 		func() tea.Msg { return gpuPoolScaleStartedMsg{key: itemKey} },
 		func() tea.Msg {
 			ctx, cancel := m.opCtx()
 			defer cancel()
 			err := actions.IncreasePoolSize(ctx, pool, m.environment, m.logger)
-			return gpuPoolScaleResultMsg{key: itemKey, err: err}
+			return gpuPoolScaleResultMsg{key: itemKey, job: job, err: err}
 		},
 	)
 }
@@ -121,11 +128,12 @@ func (m *Model) cordonNode(item any, itemKey models.ItemKey) tea.Cmd {
 		return nil
 	}
 	m.logger.Infow("action started", "action", "toggleCordon", "node", itemKeyString(itemKey))
+	job := m.startJob("toggle cordon", "node", itemKeyString(itemKey))
 	return func() tea.Msg {
 		ctx, cancel := m.opCtx()
 		defer cancel()
-		state, err := k8s.ToggleCordon(ctx, m.kubeConfig, m.environment.KubeContext(), node.Name)
-		return cordonNodeResultMsg{key: itemKey, state: state, err: err}
+		state, err := k8s.ToggleCordon(ctx, m.kubeConfig, m.kubeContext(node.Cluster), node.Name)
+		return cordonNodeResultMsg{key: itemKey, state: state, job: job, err: err}
 	}
 }
 
@@ -140,14 +148,22 @@ func (m *Model) drainNode(item any, itemKey models.ItemKey) tea.Cmd {
 		return nil
 	}
 	m.logger.Infow("action started", "action", "drainNode", "node", itemKeyString(itemKey))
+	job := m.startJob("drain", "node", itemKeyString(itemKey))
 	return func() tea.Msg {
 		ctx, cancel := m.opCtx()
 		defer cancel()
-		err := k8s.DrainNode(ctx, m.kubeConfig, m.environment.KubeContext(), node.Name)
-		return drainNodeResultMsg{key: itemKey, err: err}
+		err := k8s.DrainNode(ctx, m.kubeConfig, m.kubeContext(node.Cluster), node.Name)
+		return drainNodeResultMsg{key: itemKey, job: job, err: err}
 	}
 }
 
+// kubeContext returns the kube context a mutation of an item loaded from
+// cluster goes to: cluster itself, or the environment's primary context
+// for items loaded without one.
+func (m *Model) kubeContext(cluster string) string {
+	return m.kubeContexts.Resolve(m.environment, cluster)
+}
+
 // selectedItem returns the currently selected item in the table.
 func (m *Model) selectedItem() any {
 	itemKey := itemKeyFrom(m.category, m.selectedRawRow())
@@ -160,37 +176,38 @@ type metricsOpenErrMsg struct{ err error }
 // metricsWindow is how far back the metrics dashboard looks.
 const metricsWindow = 7 * 24 * time.Hour
 
-// importedModelNamePrefix is the OCID resource-id prefix carried by
-// tenant-owned imported/finetune model names and by DAC names. A model name
-// with this prefix resolves against the imported catalog, anything else
-// against the base catalog. A workload/imported-model namespace with this
-// prefix is a DAC name.
-const importedModelNamePrefix = "amaaaaaa"
-
 // openMetricsTriggerMsg is the second step of openMetrics's sequence: it
 // fires after the model catalog has been loaded and applied, so its handler
 // resolves the plan against the now-populated dataset on the Update loop. gen
-// pins it to the load it followed; item is the selection to resolve.
+// pins it to the load it followed; item is the selection to resolve; inline
+// selects the in-terminal metrics panel over the browser dashboard.
 type openMetricsTriggerMsg struct {
-	item any
-	cat  domain.Category
-	gen  int
+	item   any
+	cat    domain.Category
+	gen    int
+	inline bool
 }
 
 // openMetrics opens the OCI Telemetry MQL dashboard for the selected item
-// (DAC, ImportedModel, or GPUWorkload). If the plan needs a model catalog
+// (DAC, Endpoint, ImportedModel, or GPUWorkload). If the plan needs a model catalog
 // that isn't loaded yet, the catalog is fetched (and cached for later
 // navigation) before the dashboard opens. Items that can't produce metrics
 // are a no-op or an error toast (resolveMetricsPlan).
 func (m *Model) openMetrics(item any) tea.Cmd {
+	return m.withMetricsPlan(item, false)
+}
+
+// withMetricsPlan loads the model catalog item's plan needs, if any, then
+// finishes it: in the browser dashboard, or the metrics panel when inline.
+func (m *Model) withMetricsPlan(item any, inline bool) tea.Cmd {
 	cat, need := m.metricsCatalog(item)
 	if !need || m.catalogLoaded(cat) {
-		return m.finishMetrics(item)
+		return m.finishMetrics(item, inline)
 	}
 	gen := m.bumpGen()
 	return tea.Sequence(
 		tea.Batch(m.beginTask(), m.catalogLoadCmd(cat, gen)),
-		func() tea.Msg { return openMetricsTriggerMsg{item: item, cat: cat, gen: gen} },
+		func() tea.Msg { return openMetricsTriggerMsg{item: item, cat: cat, gen: gen, inline: inline} },
 	)
 }
 
@@ -205,11 +222,20 @@ func (m *Model) metricsCatalog(item any) (domain.Category, bool) {
 			return domain.BaseModel, false
 		}
 		return modelCatalog(it.ModelName), true
+	case *models.Endpoint:
+		if it == nil {
+			return domain.BaseModel, false
+		}
+		name := telemetry.EndpointModelName(m.dataset, it)
+		if name == "" {
+			return domain.BaseModel, false
+		}
+		return modelCatalog(name), true
 	case *models.GPUWorkload:
 		if it == nil || it.Model == "" {
 			return domain.BaseModel, false
 		}
-		if strings.HasPrefix(it.Namespace, importedModelNamePrefix) {
+		if strings.HasPrefix(it.Namespace, telemetry.ImportedModelPrefix) {
 			return modelCatalog(it.Model), true // dedicated
 		}
 		return domain.BaseModel, true // on-demand matches the base catalog
@@ -219,9 +245,9 @@ func (m *Model) metricsCatalog(item any) (domain.Category, bool) {
 }
 
 // modelCatalog routes a model NAME to the catalog that holds it: imported/
-// finetune names carry importedModelNamePrefix, everything else is base.
+// finetune names carry telemetry.ImportedModelPrefix, everything else is base.
 func modelCatalog(modelName string) domain.Category {
-	if strings.HasPrefix(modelName, importedModelNamePrefix) {
+	if strings.HasPrefix(modelName, telemetry.ImportedModelPrefix) {
 		return domain.ImportedModel
 	}
 	return domain.BaseModel
@@ -261,12 +287,13 @@ func (m *Model) handleOpenMetricsTrigger(msg openMetricsTriggerMsg) tea.Cmd {
 	if msg.gen != m.gens.msg || !m.catalogLoaded(msg.cat) {
 		return nil
 	}
-	return m.finishMetrics(msg.item)
+	return m.finishMetrics(msg.item, msg.inline)
 }
 
 // finishMetrics resolves the item's plan and either launches the dashboard
-// or shows an error toast. A no-op item (empty reason) yields nil.
-func (m *Model) finishMetrics(item any) tea.Cmd {
+// (or, inline, opens the metrics panel) or shows an error toast. A no-op
+// item (empty reason) yields nil.
+func (m *Model) finishMetrics(item any, inline bool) tea.Cmd {
 	filter, capability, ok, reason := m.resolveMetricsPlan(item)
 	if !ok {
 		if reason == "" {
@@ -274,79 +301,20 @@ func (m *Model) finishMetrics(item any) tea.Cmd {
 		}
 		return m.showToast(reason, toastError)
 	}
+	if inline {
+		return m.openMetricsPanel(metricsTarget(item), telemetry.Plan{Filter: filter, Capability: capability})
+	}
 	return m.launchMetrics(filter, capability)
 }
 
-// resolveMetricsPlan maps a selected item to its metrics plan: the MQL filter
-// and capability, plus ok/reason. ok=false with a non-empty reason is a
-// user-facing error toast; ok=false with empty reason is a silent no-op
-// (unknown/nil item). Reads m.dataset, which is non-nil whenever a catalog
-// was required (guaranteed loaded before this runs). Task 4 adds the
-// ImportedModel and GPUWorkload cases.
-//
-//nolint:cyclop // per-item-type metrics resolution; the switch is the routing surface
+// resolveMetricsPlan maps a selected item to its metrics plan against the
+// model's environment and dataset (see telemetry.ResolvePlan). ok=false with
+// a non-empty reason is a user-facing error toast; ok=false with an empty
+// reason is a silent no-op. m.dataset is non-nil whenever a catalog was
+// required (guaranteed loaded before this runs).
 func (m *Model) resolveMetricsPlan(item any) (telemetry.Filter, telemetry.Capability, bool, string) {
-	realm, region := m.environment.Realm, m.environment.Region
-	switch it := item.(type) {
-	case *models.DedicatedAICluster:
-		if it == nil {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, ""
-		}
-		filter := telemetry.Filter{Key: telemetry.FilterDacID, Value: it.OCID(realm, region)}
-		if it.ModelName == "" {
-			return filter, telemetry.CapabilityChat, true, ""
-		}
-		return m.dedicatedPlan(filter, m.dataset.FindModelByName(it.ModelName))
-	case *models.ImportedModel:
-		if it == nil {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, ""
-		}
-		if !strings.HasPrefix(it.Namespace, importedModelNamePrefix) {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, "imported model is not tied to a dedicated AI cluster"
-		}
-		ocid := models.DedicatedAICluster{Name: it.Namespace}.OCID(realm, region)
-		filter := telemetry.Filter{Key: telemetry.FilterDacID, Value: ocid}
-		return m.dedicatedPlan(filter, &it.BaseModel)
-	case *models.GPUWorkload:
-		if it == nil {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, ""
-		}
-		if it.Model == "" {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, "workload has no model"
-		}
-		if strings.HasPrefix(it.Namespace, importedModelNamePrefix) {
-			ocid := models.DedicatedAICluster{Name: it.Namespace}.OCID(realm, region)
-			filter := telemetry.Filter{Key: telemetry.FilterDacID, Value: ocid}
-			return m.dedicatedPlan(filter, m.dataset.FindModelByName(it.Model))
-		}
-		bm := m.dataset.FindBaseModelByName(it.Model)
-		if bm == nil {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, "model not found in base catalog"
-		}
-		capability := capabilityForModel(bm)
-		if !capability.Supported() {
-			return telemetry.Filter{}, telemetry.CapabilityChat, false, "metrics not supported for this model"
-		}
-		return telemetry.Filter{Key: telemetry.FilterResourceID, Value: bm.DisplayName}, capability, true, ""
-	default:
-		return telemetry.Filter{}, telemetry.CapabilityChat, false, ""
-	}
-}
-
-// dedicatedPlan finalizes a DacId-filtered (dedicated-mode) plan. A capability
-// with no dashboard at all is unsupported; an unfilterable capability (the
-// fixed, unfiltered moderation sets) cannot be DacId-scoped, so it has no
-// dedicated-mode dashboard. Either yields a no-op error toast.
-func (m *Model) dedicatedPlan(filter telemetry.Filter, model *models.BaseModel) (telemetry.Filter, telemetry.Capability, bool, string) {
-	capability := capabilityForModel(model)
-	switch {
-	case !capability.Supported():
-		return telemetry.Filter{}, telemetry.CapabilityChat, false, "metrics not supported for this model"
-	case !capability.Filterable():
-		return telemetry.Filter{}, telemetry.CapabilityChat, false, "metrics not available for this model in dedicated mode"
-	default:
-		return filter, capability, true, ""
-	}
+	plan, ok, reason := telemetry.ResolvePlan(item, m.environment, m.dataset)
+	return plan.Filter, plan.Capability, ok, reason
 }
 
 // launchMetrics opens the dashboard URL in the browser off the UI goroutine,
@@ -365,51 +333,6 @@ func (m *Model) launchMetrics(filter telemetry.Filter, capability telemetry.Capa
 // (region/type), an MQL filter, a capability, and a window ending at now.
 // Pure; unit-testable without launching a browser.
 func metricsURL(env models.Environment, filter telemetry.Filter, capability telemetry.Capability, now time.Time) string {
-	fleet := "generative-ai-service-api-" + env.Type
-	return telemetry.MetricsURL(filter, capability, env.Region, telemetry.Project, fleet,
+	return telemetry.MetricsURL(filter, capability, env.Region, telemetry.Project, telemetry.Fleet(env.Type),
 		now.Add(-metricsWindow), now)
 }
-
-// capabilityPrecedence maps a model-capability string to its telemetry
-// capability, in priority order: the first capability the model declares
-// wins. CHAT is highest; the four unsupported capabilities are last, so any
-// supported capability outranks them (a purely-unsupported model resolves to
-// CapabilityUnsupported). Synonyms (EMBEDDING, CONTENT_MODERATION,
-// IMAGE_TEXT_TO_TEXT) map to the same telemetry capability as their primary.
-var capabilityPrecedence = []struct {
-	flag string
-	cap  telemetry.Capability
-}{
-	{models.CapabilityChat, telemetry.CapabilityChat},
-	{models.CapabilityTextToText, telemetry.CapabilityChat},
-	{models.CapabilityImageTextToText, telemetry.CapabilityChat},
-	{models.CapabilityTextRerank, telemetry.CapabilityTextRerank},
-	{models.CapabilityTextEmbeddings, telemetry.CapabilityTextEmbeddings},
-	{models.CapabilityEmbedding, telemetry.CapabilityTextEmbeddings},
-	{models.CapabilityTextToImage, telemetry.CapabilityTextToImage},
-	{models.CapabilityImageTextToImage, telemetry.CapabilityImageTextToImage},
-	{models.CapabilityTextToAudio, telemetry.CapabilityTextToAudio},
-	{models.CapabilityAudioToText, telemetry.CapabilityAudioToText},
-	{models.CapabilityTextClassification, telemetry.CapabilityTextClassification},
-	{models.CapabilityContentModeration, telemetry.CapabilityTextClassification},
-	{models.CapabilityImageContentModeration, telemetry.CapabilityImageContentModeration},
-	{models.CapabilityTextGeneration, telemetry.CapabilityUnsupported},
-	{models.CapabilityAudioToAudio, telemetry.CapabilityUnsupported},
-	{models.CapabilityRealtime, telemetry.CapabilityUnsupported},
-	{models.CapabilityPromptInjectionProtection, telemetry.CapabilityUnsupported},
-}
-
-// capabilityForModel maps a resolved model to its metric capability via
-// capabilityPrecedence (first declared match wins). nil / finetune / a model
-// declaring no recognized capability fall back to CapabilityChat.
-func capabilityForModel(model *models.BaseModel) telemetry.Capability {
-	if model == nil || model.Type == "Fine-tuning" {
-		return telemetry.CapabilityChat
-	}
-	for _, p := range capabilityPrecedence {
-		if model.HasCapability(p.flag) {
-			return p.cap
-		}
-	}
-	return telemetry.CapabilityChat
-}
@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/jingle2008/toolkit/internal/infra/resilience"
+)
+
+// waitForBreakersCmd blocks until a circuit breaker changes state and
+// returns breakersChangedMsg; nil without a registry or once the session
+// ends. Session-scoped: not gen-gated.
+func (m *Model) waitForBreakersCmd() tea.Cmd {
+	r, notify := m.breakers.registry, m.breakers.notify
+	if r == nil {
+		return nil
+	}
+	ctx := m.sessionCtx()
+	return func() tea.Msg {
+		select {
+		case <-notify:
+			return breakersChangedMsg{Open: openBreakers(r.Snapshot())}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleBreakersChanged records the breakers that are not closed, logging
+// each transition, and re-arms the listener.
+func (m *Model) handleBreakersChanged(msg breakersChangedMsg) tea.Cmd {
+	was := make(map[string]resilience.State, len(m.breakers.open))
+	for _, s := range m.breakers.open {
+		was[s.Service] = s.State
+	}
+	for _, s := range msg.Open {
+		if was[s.Service] != s.State {
+			m.logger.Warnw("circuit breaker "+s.State.String(), "service", s.Service,
+				"failures", s.Failures, "until", s.Until, "lastError", s.LastError)
+		}
+		delete(was, s.Service)
+	}
+	for service := range was {
+		m.logger.Infow("circuit breaker closed", "service", service)
+	}
+	m.breakers.open = msg.Open
+	return m.waitForBreakersCmd()
+}
+
+// openBreakers returns the statuses that are not closed.
+func openBreakers(all []resilience.Status) []resilience.Status {
+	var open []resilience.Status
+	for _, s := range all {
+		if s.State != resilience.Closed {
+			open = append(open, s)
+		}
+	}
+	return open
+}
+
+// breakerLabel is the status-bar text for the breakers that are not
+// closed: the first by name, and how many more.
+func breakerLabel(open []resilience.Status) string {
+	if len(open) == 0 {
+		return ""
+	}
+	label := fmt.Sprintf("⚠ %s %s", open[0].Service, strings.ToUpper(open[0].State.String()))
+	if len(open) > 1 {
+		label += fmt.Sprintf(" +%d", len(open)-1)
+	}
+	return label
+}
@@ -37,7 +37,11 @@ var categoryHandlers = map[domain.Category]handlerFn{
 	domain.GPUPool:                         func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleGPUPoolCategory(refresh, gen) },
 	domain.GPUNode:                         func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleGPUNodeCategory(refresh, gen) },
 	domain.GPUWorkload:                     func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleGPUWorkloadCategory(refresh, gen) },
+	domain.PendingGPUWorkload:              func(m *Model, refresh bool, gen int) tea.Cmd { return m.handlePendingGPUWorkloadCategory(refresh, gen) },
 	domain.DedicatedAICluster:              func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleDedicatedAIClusterCategory(refresh, gen) },
+	domain.Endpoint:                        func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleEndpointCategory(refresh, gen) },
+	domain.Event:                           func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleEventCategory(refresh, gen) },
+	domain.WorkRequest:                     func(m *Model, refresh bool, gen int) tea.Cmd { return m.handleWorkRequestCategory(refresh, gen) },
 	domain.LimitRegionalOverride:           func(m *Model, _ bool, gen int) tea.Cmd { return m.handleLimitRegionalOverrideCategory(gen) },
 	domain.ConsolePropertyRegionalOverride: func(m *Model, _ bool, gen int) tea.Cmd { return m.handleConsolePropertyRegionalOverrideCategory(gen) },
 	domain.PropertyRegionalOverride:        func(m *Model, _ bool, gen int) tea.Cmd { return m.handlePropertyRegionalOverrideCategory(gen) },
@@ -85,6 +89,9 @@ func (m *Model) updateCategoryCore(category domain.Category) []tea.Cmd {
 	)
 
 	m.newLoadContext()
+	// A fresh watch starts below; any reconnect in progress is moot.
+	m.watch.k8sReconnecting = false
+	m.watch.k8sAttempt = 0
 	if fn, ok := categoryHandlers[m.category]; ok {
 		gen := m.bumpGen()
 		cmd = fn(m, refresh, gen)
@@ -174,6 +181,20 @@ func (m *Model) handleGPUWorkloadCategory(refresh bool, gen int) tea.Cmd {
 	return nil
 }
 
+func (m *Model) handlePendingGPUWorkloadCategory(refresh bool, gen int) tea.Cmd {
+	if m.dataset == nil || m.dataset.PendingGPUWorkloads == nil || refresh {
+		return loadPendingGPUWorkloadsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	}
+	return nil
+}
+
+func (m *Model) handleWorkRequestCategory(refresh bool, gen int) tea.Cmd {
+	if m.dataset == nil || m.dataset.WorkRequests == nil || refresh {
+		return loadWorkRequestsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	}
+	return nil
+}
+
 func (m *Model) handleDedicatedAIClusterCategory(refresh bool, gen int) tea.Cmd {
 	if m.dataset == nil || m.dataset.DedicatedAIClusterMap == nil || refresh {
 		return loadDedicatedAIClustersCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
@@ -181,6 +202,20 @@ func (m *Model) handleDedicatedAIClusterCategory(refresh bool, gen int) tea.Cmd
 	return nil
 }
 
+func (m *Model) handleEndpointCategory(refresh bool, gen int) tea.Cmd {
+	if m.dataset == nil || m.dataset.EndpointMap == nil || refresh {
+		return loadEndpointsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	}
+	return nil
+}
+
+func (m *Model) handleEventCategory(refresh bool, gen int) tea.Cmd {
+	if m.dataset == nil || m.dataset.EventMap == nil || refresh {
+		return loadEventsCmd(m.loadCtx, m.loader, m.kubeConfig, m.environment, gen)
+	}
+	return nil
+}
+
 // enterDetailView switches the model into detail view mode.
 func (m *Model) enterDetailView() tea.Cmd {
 	row := m.selectedRawRow()
@@ -198,6 +233,8 @@ func (m *Model) enterDetailView() tea.Cmd {
 // exitDetailView exits detail view mode.
 func (m *Model) exitDetailView() {
 	m.viewMode = common.ListView
+	m.detailHistory = false
+	m.detailRaw = false
 	m.keys = keys.ResolveKeys(m.category, m.viewMode)
 	m.updateLayout(m.viewWidth, m.viewHeight)
 }
@@ -217,8 +254,8 @@ func (m *Model) changeCategory() tea.Cmd {
 }
 
 // jumpToParent navigates from a sub-category back to its parent category
-// and re-selects the parent row. The scope is kept/set so applyRows'
-// auto-select highlights the parent (computeTableRows ignores a scope
+// and re-selects the parent row. The scope is kept/set so applyIndex'
+// auto-select highlights the parent (computeRowIndex ignores a scope
 // that does not scope its own category, so the full parent list shows).
 //
 // When we drilled in from a parent, the existing scope identifies the
@@ -249,7 +286,7 @@ func (m *Model) enterContext() tea.Cmd {
 	target := row[0]
 	switch {
 	case m.category.IsScope():
-		m.scope = &domain.Scope{Category: m.category, Name: target}
+		m.scope = &domain.Scope{Category: m.category, Name: scopeName(m.category, row)}
 		return tea.Sequence(m.updateCategory(m.category.ScopedCategories()[0])...)
 	case m.category == domain.Environment:
 		envPtr := collections.FindByName(m.dataset.Environments, target)
@@ -260,6 +297,7 @@ func (m *Model) enterContext() tea.Cmd {
 		}
 		if !m.environment.Equals(*envPtr) {
 			m.environment = *envPtr
+			m.syncMultiCluster()
 			m.dataset.ResetRealmScopedFields()
 			return tea.Sequence(m.updateCategory(domain.Tenant)...)
 		}
@@ -4,6 +4,8 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/internal/infra/loader"
+	"github.com/jingle2008/toolkit/pkg/models"
 )
 
 // handleRepoWatchStarted records the live working-tree watch and arms the
@@ -15,21 +17,50 @@ func (m *Model) handleRepoWatchStarted(msg repoWatchStartedMsg) tea.Cmd {
 	return waitForRepoTriggerCmd(msg.Trigger)
 }
 
-// handleRepoWatchTriggered issues quiet background reloads (dataset, plus GPU
-// pools when loaded) and re-arms the listener. No beginTask: a working-tree
-// change must not flash the loading spinner.
-func (m *Model) handleRepoWatchTriggered() tea.Cmd {
-	m.logger.Debugw("repo watch triggered; reloading dataset")
+// handleRepoWatchTriggered issues quiet background reloads of the parts the
+// changed paths affect and re-arms the listener. A change that affects no
+// part (docs, scripts) reloads nothing. No beginTask: a working-tree change
+// must not flash the loading spinner.
+func (m *Model) handleRepoWatchTriggered(msg repoWatchTriggeredMsg) tea.Cmd {
+	cmds := m.reloadChangedCmds(msg.Paths)
+	if m.watch.repoTrigger != nil {
+		cmds = append(cmds, waitForRepoTriggerCmd(m.watch.repoTrigger))
+	}
+	return tea.Batch(cmds...)
+}
+
+// reloadRepoCmds returns the quiet background reloads of repo-owned data:
+// the dataset, plus GPU pools when they have been loaded.
+func (m *Model) reloadRepoCmds() []tea.Cmd {
 	cmds := []tea.Cmd{
 		reloadDatasetCmd(m.sessionCtx(), m.loader, m.repoPath, m.environment, m.logger),
 	}
 	if m.dataset != nil && m.dataset.GPUPools != nil {
 		cmds = append(cmds, reloadGPUPoolsCmd(m.sessionCtx(), m.loader, m.repoPath, m.environment, m.logger))
 	}
-	if m.watch.repoTrigger != nil {
-		cmds = append(cmds, waitForRepoTriggerCmd(m.watch.repoTrigger))
+	return cmds
+}
+
+// reloadChangedCmds maps changed paths to dataset parts and returns the
+// reloads for just those parts. Without paths or a RepoWatcher to map them it
+// falls back to reloadRepoCmds.
+func (m *Model) reloadChangedCmds(paths []string) []tea.Cmd {
+	rw, ok := m.loader.(loader.RepoWatcher)
+	if !ok || len(paths) == 0 {
+		m.logger.Debugw("repo watch triggered; reloading dataset")
+		return m.reloadRepoCmds()
 	}
-	return tea.Batch(cmds...)
+	parts := rw.ChangedParts(paths)
+	m.logger.Debugw("repo watch triggered", "paths", len(paths), "parts", parts.String())
+
+	var cmds []tea.Cmd
+	if dsParts := parts &^ models.RepoGPUPools; dsParts != 0 {
+		cmds = append(cmds, reloadDatasetPartsCmd(m.sessionCtx(), rw, m.repoPath, m.environment, dsParts, m.logger))
+	}
+	if parts.Has(models.RepoGPUPools) && m.dataset != nil && m.dataset.GPUPools != nil {
+		cmds = append(cmds, reloadGPUPoolsCmd(m.sessionCtx(), m.loader, m.repoPath, m.environment, m.logger))
+	}
+	return cmds
 }
 
 // handleRepoWatchClosed clears the live repo indicator. No auto-reconnect;
@@ -55,7 +86,8 @@ func (m *Model) maybeStartRepoWatchCmd() tea.Cmd {
 }
 
 // handleDatasetReloaded merges the freshly loaded repo-owned data into the
-// in-memory dataset (preserving live k8s fields). When the on-screen category
+// in-memory dataset (preserving live k8s fields, and for a partial reload
+// every part it did not carry). When the on-screen category
 // is repo-backed it refreshes the view, preserving the active filter and
 // selected-row cursor; when a k8s-backed category is showing, the merge cannot
 // have changed its visible rows, so the recompute is skipped (the merged data
@@ -64,9 +96,15 @@ func (m *Model) handleDatasetReloaded(msg datasetReloadedMsg) {
 	if msg.Dataset == nil {
 		return
 	}
-	if m.dataset == nil {
+	switch {
+	case msg.Parts != 0:
+		if m.dataset == nil {
+			return // a partial reload cannot stand in for the initial load
+		}
+		m.dataset.MergeRepoParts(msg.Dataset, msg.Parts)
+	case m.dataset == nil:
 		m.dataset = msg.Dataset
-	} else {
+	default:
 		m.dataset.MergeReloadedRepoData(msg.Dataset)
 	}
 	if !m.category.NeedsKubeConfig() {
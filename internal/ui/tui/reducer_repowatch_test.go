@@ -13,7 +13,7 @@ import (
 func TestHandleRepoWatchStarted_SetsWatchingAndArms(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
-	ch := make(chan struct{})
+	ch := make(chan []string)
 	cmd := m.handleRepoWatchStarted(repoWatchStartedMsg{Trigger: ch})
 	require.True(t, m.watch.repoActive)
 	require.NotNil(t, cmd, "must return a re-arm command")
@@ -50,11 +50,48 @@ func TestHandleRepoWatchTriggered_ReturnsBatch(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
 	m.dataset = &models.Dataset{GPUPools: []models.GPUPool{{Name: "p1"}}}
-	m.watch.repoTrigger = make(chan struct{})
-	cmd := m.handleRepoWatchTriggered()
+	m.watch.repoTrigger = make(chan []string)
+	cmd := m.handleRepoWatchTriggered(repoWatchTriggeredMsg{})
 	require.NotNil(t, cmd, "trigger must produce reload + re-arm commands")
 }
 
+func TestReloadChangedCmds_ReloadsOnlyAffectedParts(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.dataset = &models.Dataset{GPUPools: []models.GPUPool{{Name: "p1"}}}
+	rw := repoWatchLoader{
+		Composite: fakeLoader{},
+		parts:     models.RepoTenancyOverrides | models.RepoGPUPools,
+		dataset:   &models.Dataset{Tenants: []models.Tenant{{Name: "t"}}},
+	}
+	m.loader = rw
+
+	cmds := m.reloadChangedCmds([]string{"shared_modules/limits/x"})
+	require.Len(t, cmds, 2, "a dataset-parts reload plus the GPU pool reload")
+	msg, ok := cmds[0]().(datasetReloadedMsg)
+	require.True(t, ok)
+	require.Equal(t, models.RepoTenancyOverrides, msg.Parts, "GPU pools are not part of the dataset reload")
+
+	// Unrelated paths reload nothing.
+	m.loader = repoWatchLoader{Composite: fakeLoader{}}
+	require.Empty(t, m.reloadChangedCmds([]string{"README.md"}))
+}
+
+func TestHandleDatasetReloaded_MergesOnlyReloadedParts(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.dataset = &models.Dataset{
+		Tenants:          []models.Tenant{{Name: "old"}},
+		ServiceTenancies: []models.ServiceTenancy{{Name: "st"}},
+	}
+	m.handleDatasetReloaded(datasetReloadedMsg{
+		Dataset: &models.Dataset{Tenants: []models.Tenant{{Name: "new"}}},
+		Parts:   models.RepoTenancyOverrides,
+	})
+	require.Equal(t, "new", m.dataset.Tenants[0].Name)
+	require.Len(t, m.dataset.ServiceTenancies, 1, "parts not reloaded are kept")
+}
+
 func TestHandleDatasetReloaded_MergesPreservingK8s(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
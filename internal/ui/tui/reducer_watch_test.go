@@ -2,6 +2,7 @@ package tui
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,6 +99,111 @@ func TestHandleWatchUnavailable_StaleIgnored(t *testing.T) {
 	assert.True(t, m.watch.k8sActive, "stale k8sWatchUnavailableMsg must not clear the live indicator")
 }
 
+func TestHandleWatchClosed_StartsReconnecting(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	m.category = domain.GPUNode
+	m.watch.k8sActive = true
+	m.newLoadContext()
+
+	cmd := m.handleK8sWatchClosed(k8sWatchClosedMsg{Cat: domain.GPUNode, Gen: 2})
+	require.NotNil(t, cmd)
+	assert.True(t, m.watch.k8sReconnecting)
+	assert.Equal(t, 1, m.watch.k8sAttempt, "the first attempt is scheduled")
+	assert.Nil(t, m.watch.k8sTrigger)
+}
+
+func TestHandleWatchClosed_NoReconnectAfterCancel(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	m.category = domain.GPUNode
+	m.newLoadContext()
+	m.loadCancel()
+
+	m.handleK8sWatchClosed(k8sWatchClosedMsg{Cat: domain.GPUNode, Gen: 2})
+	assert.False(t, m.watch.k8sReconnecting)
+}
+
+func TestHandleWatchUnavailable_WhileReconnectingBacksOff(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	m.watch.k8sReconnecting = true
+	m.watch.k8sAttempt = 1
+
+	cmd := m.handleK8sWatchUnavailable(k8sWatchUnavailableMsg{Cat: domain.GPUNode, Gen: 2})
+	require.NotNil(t, cmd, "a failed attempt schedules the next one")
+	assert.True(t, m.watch.k8sReconnecting)
+	assert.Equal(t, 2, m.watch.k8sAttempt)
+}
+
+func TestHandleWatchStarted_AfterReconnect(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	m.category = domain.GPUNode
+	m.newLoadContext()
+	m.watch.k8sReconnecting = true
+	m.watch.k8sAttempt = 3
+
+	cmd := m.handleK8sWatchStarted(k8sWatchStartedMsg{Cat: domain.GPUNode, Trigger: make(chan struct{}), Gen: 2})
+	require.NotNil(t, cmd)
+	assert.True(t, m.watch.k8sActive)
+	assert.False(t, m.watch.k8sReconnecting)
+	assert.Equal(t, 3, m.watch.k8sAttempt, "the backoff is kept until the watch proves stable")
+	assert.Equal(t, 1, m.watch.k8sReconnects)
+}
+
+// A watch that drops again soon after coming back keeps backing off; one
+// that stayed up for watchStableAfter starts over from the first step.
+func TestHandleWatchClosed_ResetsBackoffOnlyWhenStable(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	m.category = domain.GPUNode
+	m.newLoadContext()
+
+	m.watch.k8sAttempt = 3
+	m.watch.k8sUpSince = time.Now()
+	m.handleK8sWatchClosed(k8sWatchClosedMsg{Cat: domain.GPUNode, Gen: 2})
+	assert.Equal(t, 4, m.watch.k8sAttempt, "a flapping watch continues its backoff")
+
+	m.watch.k8sAttempt = 3
+	m.watch.k8sUpSince = time.Now().Add(-watchStableAfter)
+	m.handleK8sWatchClosed(k8sWatchClosedMsg{Cat: domain.GPUNode, Gen: 2})
+	assert.Equal(t, 1, m.watch.k8sAttempt, "a stable watch restarts the backoff")
+}
+
+func TestHandleWatchReconnect_IgnoredUnlessReconnecting(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.gens.msg = 2
+	assert.Nil(t, m.handleK8sWatchReconnect(k8sWatchReconnectMsg{Cat: domain.GPUNode, Gen: 2}))
+	m.watch.k8sReconnecting = true
+	assert.Nil(t, m.handleK8sWatchReconnect(k8sWatchReconnectMsg{Cat: domain.GPUNode, Gen: 1}), "stale gen")
+	assert.NotNil(t, m.handleK8sWatchReconnect(k8sWatchReconnectMsg{Cat: domain.GPUNode, Gen: 2}))
+}
+
+func TestWatchReconnectDelay(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, time.Second, watchReconnectDelay(0))
+	assert.Equal(t, 4*time.Second, watchReconnectDelay(2))
+	assert.Equal(t, time.Minute, watchReconnectDelay(6))
+	assert.Equal(t, time.Minute, watchReconnectDelay(100))
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+	for range 100 {
+		d := jitter(10 * time.Second)
+		assert.GreaterOrEqual(t, d, 10*time.Second)
+		assert.Less(t, d, 12*time.Second)
+	}
+	assert.Equal(t, time.Duration(0), jitter(0))
+}
+
 // A live-watch reload of the on-screen category preserves the active filter and
 // the selected row — it does not behave like a navigation.
 func TestLiveReload_PreservesFilterAndSelection(t *testing.T) {
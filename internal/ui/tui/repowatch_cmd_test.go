@@ -15,17 +15,25 @@ import (
 // repoWatchLoader is a fake Composite that also implements RepoWatcher.
 type repoWatchLoader struct {
 	loader.Composite
-	trigger <-chan struct{}
+	trigger <-chan []string
 	err     error
+	parts   models.RepoParts
+	dataset *models.Dataset
 }
 
-func (f repoWatchLoader) WatchRepo(context.Context, string) (<-chan struct{}, error) {
+func (f repoWatchLoader) WatchRepo(context.Context, string) (<-chan []string, error) {
 	return f.trigger, f.err
 }
 
+func (f repoWatchLoader) ChangedParts([]string) models.RepoParts { return f.parts }
+
+func (f repoWatchLoader) LoadDatasetParts(context.Context, string, models.Environment, models.RepoParts) (*models.Dataset, error) {
+	return f.dataset, f.err
+}
+
 func TestStartRepoWatchCmd_Started(t *testing.T) {
 	t.Parallel()
-	ch := make(chan struct{})
+	ch := make(chan []string)
 	cmd := startRepoWatchCmd(context.Background(), repoWatchLoader{trigger: ch}, "/repo")
 	msg := cmd()
 	started, ok := msg.(repoWatchStartedMsg)
@@ -51,12 +59,13 @@ func TestStartRepoWatchCmd_SetupError(t *testing.T) {
 
 func TestWaitForRepoTriggerCmd_TickAndClose(t *testing.T) {
 	t.Parallel()
-	ch := make(chan struct{}, 1)
-	ch <- struct{}{}
-	_, ok := waitForRepoTriggerCmd(ch)().(repoWatchTriggeredMsg)
+	ch := make(chan []string, 1)
+	ch <- []string{"a.tf"}
+	msg, ok := waitForRepoTriggerCmd(ch)().(repoWatchTriggeredMsg)
 	require.True(t, ok, "a value on the channel must yield repoWatchTriggeredMsg")
+	require.Equal(t, []string{"a.tf"}, msg.Paths)
 
-	closed := make(chan struct{})
+	closed := make(chan []string)
 	close(closed)
 	_, ok = waitForRepoTriggerCmd(closed)().(repoWatchClosedMsg)
 	require.True(t, ok, "a closed channel must yield repoWatchClosedMsg")
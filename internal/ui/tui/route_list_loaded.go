@@ -21,8 +21,16 @@ func (m *Model) routeListLoadedMsg(msg tea.Msg) []tea.Cmd {
 		m.handleGPUNodesLoaded(msg.Items, msg.Gen)
 	case gpuWorkloadsLoadedMsg:
 		m.handleGPUWorkloadsLoaded(msg.Items, msg.Gen)
+	case pendingGPUWorkloadsLoadedMsg:
+		m.handlePendingGPUWorkloadsLoaded(msg.Items, msg.Gen)
+	case workRequestsLoadedMsg:
+		m.handleWorkRequestsLoaded(msg.Items, msg.Gen)
 	case dedicatedAIClustersLoadedMsg:
 		m.handleDedicatedAIClustersLoaded(msg.Items, msg.Gen)
+	case endpointsLoadedMsg:
+		m.handleEndpointsLoaded(msg.Items, msg.Gen)
+	case eventsLoadedMsg:
+		m.handleEventsLoaded(msg.Items, msg.Gen)
 	case tenancyOverridesLoadedMsg:
 		m.handleTenancyOverridesLoaded(msg.Group, msg.Gen)
 	case limitRegionalOverridesLoadedMsg:
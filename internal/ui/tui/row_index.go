@@ -0,0 +1,250 @@
+package tui
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/table"
+
+	"github.com/jingle2008/toolkit/internal/columns"
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+/*
+rowBase is the unfiltered row set of a list view: every item the category
+shows for the current scope and faulty toggle, in display order (sorted,
+when a sort column is set). Cells are rendered on demand, so building a
+base formats nothing but the sort column.
+
+A base is immutable once built. Indexes derived from it for different
+filters share it, and may be read from async commands while the model
+keeps using an older one.
+*/
+type rowBase struct {
+	n     int
+	cols  int
+	cell  func(item, col int) string // renders a cell of the item-th item
+	text  func(item int) string      // lowercased filterable text, NUL-separated
+	order []int                      // item numbers in display order
+
+	key baseKey // what the base was built from; zero for static rows
+
+	haystackOnce sync.Once
+	haystack     []string // text of every item, built on the first filter
+}
+
+// baseKey records the inputs a base was built from, so a filter change can
+// tell whether the current base still applies.
+type baseKey struct {
+	dataset    *models.Dataset
+	category   domain.Category
+	scope      domain.Scope
+	scoped     bool
+	faulty     bool
+	sortColumn string
+	sortAsc    bool
+	multi      bool // columns.MultiCluster: whether the Cluster column shows
+}
+
+// newBaseKey returns the key of a base built from these inputs. Like
+// computeRowIndex, it ignores a scope that is not valid for category.
+func newBaseKey(dataset *models.Dataset, category domain.Category, scope *domain.Scope, sortColumn string, sortAsc, faulty bool) baseKey {
+	k := baseKey{
+		dataset: dataset, category: category, faulty: faulty, sortColumn: sortColumn, sortAsc: sortAsc,
+		multi: columns.MultiCluster(),
+	}
+	if scope != nil && scope.Category.IsScopeOf(category) {
+		k.scope, k.scoped = *scope, true
+	}
+	return k
+}
+
+// newRowBase returns a base of n items of cols cells each, in their
+// natural order.
+func newRowBase(n, cols int, cell func(item, col int) string, text func(item int) string) *rowBase {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	return &rowBase{n: n, cols: cols, cell: cell, text: text, order: order}
+}
+
+// filterText joins an item's non-empty filterable fields, lowercased, with
+// NUL separators. A filter never contains NUL, so a substring match on the
+// result is a match on one of the fields, as in collections.IsMatch.
+func filterText(fields ...string) string {
+	var b strings.Builder
+	for _, f := range fields {
+		if f == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(0)
+		}
+		b.WriteString(strings.ToLower(f))
+	}
+	return b.String()
+}
+
+// matches reports whether the item-th item matches filter, which must be
+// lowercase and non-empty.
+func (b *rowBase) matches(item int, filter string) bool {
+	b.haystackOnce.Do(func() {
+		b.haystack = make([]string, b.n)
+		for i := range b.haystack {
+			b.haystack[i] = b.text(i)
+		}
+	})
+	return strings.Contains(b.haystack[item], filter)
+}
+
+// sortBy orders the base by column col. Each cell is rendered and parsed
+// once, not on every comparison; ties keep their natural order. It must
+// be called before the base is shared.
+func (b *rowBase) sortBy(col int, sortColumn string, asc bool) {
+	key := cellSortKey(sortColumn)
+	keys := make([]sortKey, b.n)
+	for i := range keys {
+		keys[i] = key(b.cell(i, col))
+	}
+	slices.SortStableFunc(b.order, func(x, y int) int {
+		if asc {
+			return keys[x].compare(keys[y])
+		}
+		return keys[y].compare(keys[x])
+	})
+}
+
+/*
+rowIndex is a filtered view of a base: the items matching the filter, in
+the base's order. Like the base it renders nothing until asked, so
+filtering 100k rows costs a substring scan, not a table rebuild. A nil
+*rowIndex is an empty one.
+*/
+type rowIndex struct {
+	base   *rowBase
+	filter string // lowercased
+	rows   []int  // item numbers, in display order
+	stats  tableStats
+}
+
+// newRowIndex returns the rows of base matching filter.
+func newRowIndex(base *rowBase, filter string) *rowIndex {
+	filter = strings.ToLower(filter)
+	return &rowIndex{base: base, filter: filter, rows: base.filterRows(base.order, filter)}
+}
+
+// filterRows returns the items of candidates matching filter.
+func (b *rowBase) filterRows(candidates []int, filter string) []int {
+	if filter == "" {
+		return candidates
+	}
+	out := make([]int, 0, len(candidates)/4)
+	for _, item := range candidates {
+		if b.matches(item, filter) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// withFilter returns the index for filter over the same base. A filter that
+// extends the current one (contains it) can only match fewer rows, so
+// typing narrows the current rows instead of rescanning the base.
+func (ix *rowIndex) withFilter(filter string) *rowIndex {
+	filter = strings.ToLower(filter)
+	candidates := ix.base.order
+	if ix.filter != "" && strings.Contains(filter, ix.filter) {
+		candidates = ix.rows
+	}
+	return &rowIndex{base: ix.base, filter: filter, rows: ix.base.filterRows(candidates, filter)}
+}
+
+// Len returns the number of rows.
+func (ix *rowIndex) Len() int {
+	if ix == nil {
+		return 0
+	}
+	return len(ix.rows)
+}
+
+// cell renders column col of row i.
+func (ix *rowIndex) cell(i, col int) string {
+	return ix.base.cell(ix.rows[i], col)
+}
+
+// row renders every cell of row i.
+func (ix *rowIndex) row(i int) table.Row {
+	row := make(table.Row, ix.base.cols)
+	for c := range row {
+		row[c] = ix.cell(i, c)
+	}
+	return row
+}
+
+// keyAt returns the item key of row i, rendering only the key columns
+// (see itemKeyWithCluster): the first two and, when clusterCol is not
+// -1, the Cluster column.
+func (ix *rowIndex) keyAt(category domain.Category, i, clusterCol int) models.ItemKey {
+	row := make(table.Row, min(ix.base.cols, max(2, clusterCol+1)))
+	for c := range row {
+		if c < 2 || c == clusterCol {
+			row[c] = ix.cell(i, c)
+		}
+	}
+	return itemKeyWithCluster(category, row, clusterCol)
+}
+
+// indexOfKey returns the index of the first row whose per-category item
+// key equals key, or -1 when key is nil or no row matches. Identity is the
+// itemKeyFrom key — the bare Name cell for flat categories, but
+// ScopedItemKey{Scope: row[1], Name: row[0]} for scoped ones, plus the
+// Cluster cell when that column is shown — so a reload
+// re-homes onto the same item even when several visible rows share a Name.
+// itemKeyFrom only ever yields a string, a ScopedItemKey, or nil (all
+// comparable), so the == comparison is panic-safe.
+func (ix *rowIndex) indexOfKey(category domain.Category, key models.ItemKey) int {
+	if key == nil {
+		return -1
+	}
+	clusterCol := clusterColumn(category)
+	for i := range ix.Len() {
+		if ix.keyAt(category, i, clusterCol) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// materialize renders every row: the eager form export uses.
+func (ix *rowIndex) materialize() []table.Row {
+	rows := make([]table.Row, ix.Len())
+	for i := range rows {
+		rows[i] = ix.row(i)
+	}
+	return rows
+}
+
+/*
+rowIndexOf wraps prebuilt rows in an index, for callers that already hold
+rendered rows (clearing the table, tests). Its base has a zero key, so a
+filter change always rebuilds from the dataset rather than narrowing it.
+Rows shorter than the longest one read as empty cells.
+*/
+func rowIndexOf(rows []table.Row, stats tableStats) *rowIndex {
+	cols := 0
+	for _, r := range rows {
+		cols = max(cols, len(r))
+	}
+	base := newRowBase(len(rows), cols,
+		func(item, col int) string {
+			if col >= len(rows[item]) {
+				return ""
+			}
+			return rows[item][col]
+		},
+		func(item int) string { return filterText(rows[item]...) })
+	return &rowIndex{base: base, rows: base.order, stats: stats}
+}
@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// renderedRows counts the table slots the window sync has filled.
+func renderedRows(rows []table.Row) int {
+	n := 0
+	for _, r := range rows {
+		if r != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// newWorkloadModel returns a list model showing GPU workloads across the
+// given number of nodes, perNode each.
+func newWorkloadModel(t testing.TB, nodes, perNode int) *Model {
+	t.Helper()
+	m := newTestModel(t)
+	m.category = domain.GPUWorkload
+	m.dataset.GPUWorkloadMap = syntheticWorkloads(nodes, perNode)
+	m.updateLayout(160, 40)
+	m.refreshDisplay()
+	return m
+}
+
+func TestRowIndex_WithFilterMatchesFreshIndex(t *testing.T) {
+	t.Parallel()
+	ds := &models.Dataset{GPUWorkloadMap: syntheticWorkloads(20, 50)}
+	ix := computeRowIndex(ds, domain.GPUWorkload, nil, "", "Age", true, false)
+
+	// Typing narrows, backspacing widens, and a group key ("node-…") is
+	// matched as well as item fields.
+	for _, f := range []string{"wl-00", "wl-000", "wl-0001", "wl-00", "node-01", "NODE-010", ""} {
+		ix = ix.withFilter(f)
+		fresh := computeRowIndex(ds, domain.GPUWorkload, nil, f, "Age", true, false)
+		require.Equal(t, fresh.materialize(), ix.materialize(), "filter %q", f)
+	}
+}
+
+func TestRowIndex_GroupKeyNotFilterableInKeyScope(t *testing.T) {
+	t.Parallel()
+	ds := &models.Dataset{GPUWorkloadMap: map[string][]models.GPUWorkload{
+		"pool-node": {{Name: "w1", Node: "n1"}},
+	}}
+	rows, _ := computeTableRows(ds, domain.GPUWorkload, nil, "pool-node", "", true, false)
+	assert.Len(t, rows, 1, "outside a key scope the group key matches")
+
+	scope := &domain.Scope{Category: domain.GPUNode, Name: "pool-node"}
+	rows, _ = computeTableRows(ds, domain.GPUWorkload, scope, "pool-node", "", true, false)
+	assert.Empty(t, rows, "inside the key scope only item fields match")
+}
+
+func TestApplyIndex_RendersOnlyTheWindow(t *testing.T) {
+	t.Parallel()
+	m := newWorkloadModel(t, 10, 100)
+	h := m.table.Height()
+	require.Positive(t, h)
+	require.Len(t, m.table.Rows(), 1000)
+	assert.LessOrEqual(t, renderedRows(m.table.Rows()), 2*h)
+	assert.Equal(t, m.index.row(0)[1], m.table.SelectedRow()[1], "the selected row is rendered")
+
+	m.table.GotoBottom()
+	m.syncTableWindow()
+	sel := m.table.SelectedRow()
+	require.NotNil(t, sel)
+	assert.Equal(t, m.index.row(999)[1], sel[1])
+	assert.Contains(t, m.table.View(), sel[0], "the bottom row is drawn")
+	// The top window plus [cursor-2h, cursor], cursor included.
+	assert.LessOrEqual(t, renderedRows(m.table.Rows()), 4*h+1)
+
+	// Resizing drops rows truncated for the old widths.
+	m.updateLayout(100, 40)
+	assert.LessOrEqual(t, renderedRows(m.table.Rows()), 2*m.table.Height()+1)
+	assert.NotNil(t, m.table.SelectedRow())
+}
+
+func TestRefilterRowsAsync_ReusesBase(t *testing.T) {
+	t.Parallel()
+	m := newWorkloadModel(t, 10, 100)
+	base := m.index.base
+
+	msg, ok := filterTableAsync(m, "wl-0001")().(tableRowsComputedMsg)
+	require.True(t, ok)
+	assert.Same(t, base, msg.Index.base, "a filter change re-filters the current base")
+	m.handleTableRowsComputedMsg(msg)
+	want, stats := computeTableRows(m.dataset, m.category, m.scope, "wl-0001", "", m.sortAsc, false)
+	assert.Equal(t, want, m.index.materialize())
+	assert.Equal(t, stats, m.stats)
+
+	// Any other input change rebuilds the base from the dataset.
+	m.sortColumn = "Age"
+	msg, ok = filterTableAsync(m, "wl-00")().(tableRowsComputedMsg)
+	require.True(t, ok)
+	assert.NotSame(t, base, msg.Index.base)
+}
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"slices"
+
 	"github.com/charmbracelet/bubbles/table"
 
 	"github.com/jingle2008/toolkit/internal/collections"
@@ -23,8 +25,8 @@ type rowCtx struct {
 	export  bool
 }
 
-// rowSource bundles a category's per-cell row builder, its
-// precomputed headers, and a by-key item lookup. Each entry in
+// rowSource bundles a category's row base builder, its
+// headers, and a by-key item lookup. Each entry in
 // rowSources is constructed by flatSource or groupedSource, which
 // capture the typed column set and dataset accessor in a closure so
 // the dispatch map can stay non-generic. All three fields are
@@ -33,15 +35,23 @@ type rowCtx struct {
 // drift. find may be nil for categories that don't represent
 // addressable entities (e.g. Alias, which is a categories index).
 type rowSource struct {
-	rows    func(rowCtx) []table.Row
-	headers []header
+	base    func(rowCtx) *rowBase
+	headers func() []header
 	find    func(*models.Dataset, models.ItemKey) any
 }
 
+// rows renders every row of the category matching rc.filter, in base
+// order. The live table goes through rowIndex instead and renders only
+// the visible window; this eager form serves export.
+func (s rowSource) rows(rc rowCtx) []table.Row {
+	return newRowIndex(s.base(rc), rc.filter).materialize()
+}
+
 // flatSource builds a rowSource for a category backed by a flat
 // columns.Set. pick projects the dataset to the typed slice. The
-// returned closure dispatches to the display or export row helper
-// based on rc.export — both share the same filter/faulty pipeline.
+// returned closure picks display or export cell rendering based on
+// rc.export — both share the same scope/faulty gates, and the text
+// filter is applied by the rowIndex built over the base.
 func flatSource[T models.NamedFilterable](
 	cols columns.Set[T],
 	pick func(*models.Dataset) []T,
@@ -65,28 +75,56 @@ func flatScopedSource[T models.NamedFilterable](
 	pick func(*models.Dataset) []T,
 ) rowSource {
 	return rowSource{
-		rows: func(rc rowCtx) []table.Row {
+		base: func(rc rowCtx) *rowBase {
 			var name *string
 			if rc.scope != nil && rc.scope.Category == owner {
 				name = &rc.scope.Name
 			}
-			items := pick(rc.dataset)
+			cell := flatCell[T]
 			if rc.export {
-				return tuiRowsFlatForExport(cols, items, name, rc.realm, rc.region, rc.filter, rc.faulty)
+				cell = flatExportCell[T](rc.realm, rc.region)
 			}
-			return tuiRowsFlat(cols, items, name, rc.filter, rc.faulty)
+			return flatBase(cols, pick(rc.dataset), name, rc.faulty, cell)
 		},
-		headers: headersFromSet(cols.Columns),
+		headers: func() []header { return headersFromSet(cols.Defaults()) },
 		find: func(d *models.Dataset, key models.ItemKey) any {
-			name, ok := key.(string)
-			if !ok {
-				return nil
+			switch k := key.(type) {
+			case string:
+				return collections.FindByName(pick(d), k)
+			case models.ScopedItemKey:
+				return findKeyed(pick(d), k.Name, k.Cluster)
 			}
-			return collections.FindByName(pick(d), name)
+			return nil
 		},
 	}
 }
 
+// flatNamespacedSource is a flatSource for namespaced k8s items, whose
+// names are unique only within a namespace: rows are keyed by
+// ScopedItemKey{Scope: namespace, Name} (see itemKeyFrom), and find
+// matches the namespace too.
+func flatNamespacedSource[T models.NamedFilterable](
+	cols columns.Set[T],
+	pick func(*models.Dataset) []T,
+	namespace func(T) string,
+) rowSource {
+	src := flatSource(cols, pick)
+	src.find = func(d *models.Dataset, key models.ItemKey) any {
+		k, ok := key.(models.ScopedItemKey)
+		if !ok {
+			return nil
+		}
+		items := pick(d)
+		for i := range items {
+			if items[i].GetName() == k.Name && namespace(items[i]) == k.Scope && inCluster(items[i], k.Cluster) {
+				return &items[i]
+			}
+		}
+		return nil
+	}
+	return src
+}
+
 // groupedSource is the grouped counterpart to flatSource. pick
 // projects the dataset to the typed scope→items map; scopeCategory
 // is the category that owns the grouping key (e.g. domain.Tenant
@@ -96,23 +134,41 @@ func groupedSource[T models.NamedFilterable](
 	cols columns.GroupedSet[T],
 	scopeCategory domain.Category,
 	pick func(*models.Dataset) map[string][]T,
+) rowSource {
+	return groupedMultiScopeSource(cols, []domain.Category{scopeCategory}, pick)
+}
+
+// groupedMultiScopeSource is a groupedSource whose grouping key can be
+// owned by more than one category (e.g. Event, keyed by the involved
+// object's name, which is a GPUNode or a GPUWorkload). When rc.scope
+// names any of owners, that owner is treated as the scope category so
+// the key gate applies; otherwise owners[0] is used, exactly as
+// groupedSource would.
+func groupedMultiScopeSource[T models.NamedFilterable](
+	cols columns.GroupedSet[T],
+	owners []domain.Category,
+	pick func(*models.Dataset) map[string][]T,
 ) rowSource {
 	return rowSource{
-		rows: func(rc rowCtx) []table.Row {
-			data := pick(rc.dataset)
+		base: func(rc rowCtx) *rowBase {
+			scopeCategory := owners[0]
+			if rc.scope != nil && slices.Contains(owners, rc.scope.Category) {
+				scopeCategory = rc.scope.Category
+			}
+			cell := groupedCell[T]
 			if rc.export {
-				return tuiRowsGroupedForExport(cols, data, scopeCategory, rc.scope, rc.realm, rc.region, rc.filter, rc.faulty)
+				cell = groupedExportCell[T](rc.realm, rc.region)
 			}
-			return tuiRowsGrouped(cols, data, scopeCategory, rc.scope, rc.filter, rc.faulty)
+			return groupedBase(cols, pick(rc.dataset), scopeCategory, rc.scope, rc.faulty, cell)
 		},
-		headers: headersFromGroupedSet(cols.Columns),
+		headers: func() []header { return headersFromGroupedSet(cols.Defaults()) },
 		find: func(d *models.Dataset, key models.ItemKey) any {
 			k, ok := key.(models.ScopedItemKey)
 			if !ok {
 				return nil
 			}
 			if items, ok := pick(d)[k.Scope]; ok {
-				return collections.FindByName(items, k.Name)
+				return findKeyed(items, k.Name, k.Cluster)
 			}
 			return nil
 		},
@@ -196,6 +252,15 @@ var rowSources = map[domain.Category]rowSource{
 		func(d *models.Dataset) map[string][]models.GPUNode { return d.GPUNodeMap }),
 	domain.GPUWorkload: groupedSource(columns.GPUWorkloadColumns, domain.GPUNode,
 		func(d *models.Dataset) map[string][]models.GPUWorkload { return d.GPUWorkloadMap }),
+	domain.PendingGPUWorkload: flatNamespacedSource(columns.PendingGPUWorkloadColumns,
+		func(d *models.Dataset) []models.PendingGPUWorkload { return d.PendingGPUWorkloads },
+		func(w models.PendingGPUWorkload) string { return w.Namespace }),
+	domain.WorkRequest: flatSource(columns.WorkRequestColumns,
+		func(d *models.Dataset) []models.WorkRequest { return d.WorkRequests }),
 	domain.DedicatedAICluster: groupedSource(columns.DACColumns, domain.Tenant,
 		func(d *models.Dataset) map[string][]models.DedicatedAICluster { return d.DedicatedAIClusterMap }),
+	domain.Endpoint: groupedSource(columns.EndpointColumns, domain.DedicatedAICluster,
+		func(d *models.Dataset) map[string][]models.Endpoint { return d.EndpointMap }),
+	domain.Event: groupedMultiScopeSource(columns.EventColumns, []domain.Category{domain.GPUNode, domain.GPUWorkload},
+		func(d *models.Dataset) map[string][]models.Event { return d.EventMap }),
 }
@@ -92,3 +92,28 @@ func TestRegionalOverrideSource_UnrelatedScopeNotFiltered(t *testing.T) {
 	})
 	require.Len(t, rows, 2, "a non-owning scope must not filter the rows")
 }
+
+// TestEventSource_ScopedByNodeOrWorkload covers the multi-owner grouped
+// source: Event is keyed by the involved object (a node's name, a pod's
+// namespace/name), so scoping to
+// either a GPUNode or a GPUWorkload must narrow by key, not by event name.
+func TestEventSource_ScopedByNodeOrWorkload(t *testing.T) {
+	t.Parallel()
+	ds := &models.Dataset{EventMap: map[string][]models.Event{
+		"gpu-1":    {{Name: "gpu-1.a", Object: "gpu-1", ObjectKind: models.EventObjectNode}},
+		"ns1/serv": {{Name: "serv.a", Object: "ns1/serv", ObjectKind: models.EventObjectPod}, {Name: "serv.b", Object: "ns1/serv", ObjectKind: models.EventObjectPod}},
+		"ns2/serv": {{Name: "serv.c", Object: "ns2/serv", ObjectKind: models.EventObjectPod}},
+	}}
+	for _, scope := range []*domain.Scope{
+		{Category: domain.GPUNode, Name: "gpu-1"},
+		{Category: domain.GPUWorkload, Name: "ns1/serv"},
+	} {
+		rows, _ := computeTableRows(ds, domain.Event, scope, "", "", true, false)
+		require.NotEmptyf(t, rows, "scope %v", scope)
+		for _, r := range rows {
+			require.Equalf(t, scope.Name, r[1], "scope %v leaked a row for another object", scope)
+		}
+	}
+	rows, _ := computeTableRows(ds, domain.Event, nil, "", "", true, false)
+	require.Len(t, rows, 4)
+}
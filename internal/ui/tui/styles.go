@@ -11,6 +11,9 @@ type Styles struct {
 	Context      lipgloss.Style
 	Stats        lipgloss.Style
 	Live         lipgloss.Style
+	Reconnecting lipgloss.Style
+	Breaker      lipgloss.Style
+	Jobs         lipgloss.Style
 	StatusText   lipgloss.Style
 	InfoKey      lipgloss.Style
 	InfoValue    lipgloss.Style
@@ -50,6 +53,18 @@ func DefaultStyles() Styles {
 		Background(lipgloss.Color("#2EA043")).
 		Bold(true)
 
+	reconnecting := statusNugget.
+		Background(lipgloss.Color("#D29922")).
+		Bold(true)
+
+	breaker := statusNugget.
+		Background(lipgloss.Color("#DA3633")).
+		Bold(true)
+
+	jobs := statusNugget.
+		Background(lipgloss.Color("#1F6FEB")).
+		Bold(true)
+
 	statusText := lipgloss.NewStyle().Inherit(statusBar)
 	infoKey := lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
 	infoValue := lipgloss.NewStyle().Width(30)
@@ -69,6 +84,9 @@ func DefaultStyles() Styles {
 		Context:      context,
 		Stats:        stats,
 		Live:         live,
+		Reconnecting: reconnecting,
+		Breaker:      breaker,
+		Jobs:         jobs,
 		StatusText:   statusText,
 		InfoKey:      infoKey,
 		InfoValue:    infoValue,
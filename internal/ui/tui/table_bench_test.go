@@ -0,0 +1,111 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jingle2008/toolkit/internal/domain"
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// syntheticWorkloads returns nodes×perNode GPU workloads keyed by node,
+// with names, tenants and ages varied enough that sorting and filtering
+// do real work. Names are zero-padded so prefixes narrow predictably.
+func syntheticWorkloads(nodes, perNode int) map[string][]models.GPUWorkload {
+	m := make(map[string][]models.GPUWorkload, nodes)
+	for n := range nodes {
+		node := fmt.Sprintf("node-%03d", n)
+		items := make([]models.GPUWorkload, perNode)
+		for i := range items {
+			id := n*perNode + i
+			items[i] = models.GPUWorkload{
+				Name:      fmt.Sprintf("wl-%06d", id),
+				Node:      node,
+				TenantID:  fmt.Sprintf("tenant-%03d", id%997),
+				Namespace: "ns",
+				GPUs:      1 + id%8,
+				Age:       fmt.Sprintf("%dd%dh", id%30, id%24),
+			}
+		}
+		m[node] = items
+	}
+	return m
+}
+
+// benchRows is the synthetic category size: 1000 nodes × 100 workloads.
+const benchNodes, benchPerNode = 1000, 100
+
+func benchDataset() *models.Dataset {
+	return &models.Dataset{GPUWorkloadMap: syntheticWorkloads(benchNodes, benchPerNode)}
+}
+
+// BenchmarkComputeRowIndex_100k builds and sorts the index, as a
+// category switch or reload does.
+func BenchmarkComputeRowIndex_100k(b *testing.B) {
+	ds := benchDataset()
+	b.ReportAllocs()
+	for b.Loop() {
+		computeRowIndex(ds, domain.GPUWorkload, nil, "", "Age", true, false)
+	}
+}
+
+// BenchmarkComputeTableRows_100k renders every row eagerly, as export
+// does: the cost the live table no longer pays.
+func BenchmarkComputeTableRows_100k(b *testing.B) {
+	ds := benchDataset()
+	b.ReportAllocs()
+	for b.Loop() {
+		computeTableRows(ds, domain.GPUWorkload, nil, "", "Age", true, false)
+	}
+}
+
+// BenchmarkRefilter_100k types a filter one keystroke at a time over a
+// built index.
+func BenchmarkRefilter_100k(b *testing.B) {
+	ix := computeRowIndex(benchDataset(), domain.GPUWorkload, nil, "", "Age", true, false)
+	ix.withFilter("w") // build the filter text once, as the first keystroke does
+	keystrokes := []string{"w", "wl", "wl-", "wl-0", "wl-00", "wl-001", "wl-0012"}
+	b.ReportAllocs()
+	for b.Loop() {
+		cur := ix
+		for _, f := range keystrokes {
+			cur = cur.withFilter(f)
+			computeStats(domain.GPUWorkload, cur)
+		}
+	}
+}
+
+// BenchmarkApplyIndex_100k hands a built index to the table and renders
+// the window around the re-homed cursor.
+func BenchmarkApplyIndex_100k(b *testing.B) {
+	m := newTestModel(b)
+	m.category = domain.GPUWorkload
+	m.dataset = benchDataset()
+	m.updateLayout(160, 50)
+	m.updateColumns()
+	ix := computeRowIndex(m.dataset, m.category, nil, "", "Age", true, false)
+	b.ReportAllocs()
+	for b.Loop() {
+		m.applyIndex(ix, true)
+	}
+}
+
+// BenchmarkScroll_100k moves the cursor down one row at a time through a
+// 100k-row table.
+func BenchmarkScroll_100k(b *testing.B) {
+	m := newTestModel(b)
+	m.category = domain.GPUWorkload
+	m.dataset = benchDataset()
+	m.updateLayout(160, 50)
+	m.updateColumns()
+	m.applyIndex(computeRowIndex(m.dataset, m.category, nil, "", "Age", true, false), false)
+	n := m.index.Len()
+	b.ReportAllocs()
+	for b.Loop() {
+		if m.table.Cursor() >= n-1 {
+			m.table.GotoTop()
+		}
+		m.table.MoveDown(1)
+		m.syncTableWindow()
+	}
+}
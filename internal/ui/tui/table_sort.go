@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"cmp"
 	"slices"
 	"strconv"
 	"strings"
@@ -11,77 +12,111 @@ import (
 	"github.com/jingle2008/toolkit/internal/ui/tui/common"
 )
 
-// sortRows sorts rows in-place by column & direction.
+// sortRows sorts rows in-place by column & direction. Ties keep their
+// order.
 func sortRows(rows []table.Row, headers []header, sortColumn string, asc bool) {
-	colIdx := slices.IndexFunc(headers, func(h header) bool {
-		return strings.EqualFold(h.text, sortColumn)
-	})
+	colIdx := sortColumnIndex(headers, sortColumn)
 	if colIdx < 0 {
 		return
 	}
+	sortRowsBy(rows, colIdx, cellSortKey(sortColumn), asc)
+}
+
+// sortColumnIndex returns the index of the header named sortColumn
+// (case-insensitively), or -1.
+func sortColumnIndex(headers []header, sortColumn string) int {
+	return slices.IndexFunc(headers, func(h header) bool {
+		return strings.EqualFold(h.text, sortColumn)
+	})
+}
 
+// sortKey is a cell's position in sort order: numeric columns compare n,
+// the rest compare s.
+type sortKey struct {
+	n int64
+	s string
+}
+
+func (k sortKey) compare(o sortKey) int {
+	if c := cmp.Compare(k.n, o.n); c != 0 {
+		return c
+	}
+	return strings.Compare(k.s, o.s)
+}
+
+// cellSortKey returns how cells of sortColumn are parsed for sorting.
+func cellSortKey(sortColumn string) func(cell string) sortKey {
 	intCols := map[string]struct{}{
 		common.FreeCol:    {},
 		common.ContextCol: {},
 	}
 
 	switch {
-	case strings.EqualFold(sortColumn, common.AgeCol):
-		sortByAge(rows, colIdx, asc)
+	case strings.EqualFold(sortColumn, common.AgeCol),
+		strings.EqualFold(sortColumn, common.LastSeenCol):
+		return ageKey
 	case strings.EqualFold(sortColumn, common.UsageCol):
-		sortByPercent(rows, colIdx, asc)
+		return percentKey
 	case strings.EqualFold(sortColumn, common.SizeCol):
-		sortBySize(rows, colIdx, asc)
+		return sizeKey
 	case hasIntHeader(intCols, sortColumn):
-		sortByInt(rows, colIdx, asc)
+		return intKey
 	default:
-		sortByString(rows, colIdx, asc)
+		return stringKey
 	}
 }
 
-func sortByAge(rows []table.Row, colIdx int, asc bool) {
-	slices.SortFunc(rows, func(a, b table.Row) int {
-		av := k8stime.ParseAge(a[colIdx])
-		bv := k8stime.ParseAge(b[colIdx])
-		if asc {
-			return int(av - bv)
-		}
-		return int(bv - av)
-	})
+// Unparsable numeric cells sort as 0.
+func ageKey(c string) sortKey { return sortKey{n: k8stime.ParseAge(c)} }
+
+func intKey(c string) sortKey {
+	v, _ := strconv.ParseInt(c, 10, 64)
+	return sortKey{n: v}
+}
+
+func percentKey(c string) sortKey {
+	v, _ := parsePercent(c)
+	return sortKey{n: v}
+}
+
+// sizeKey parses size strings like "6B", "3.5M", "1.2T".
+func sizeKey(c string) sortKey {
+	v, _ := parseSize(c)
+	return sortKey{n: v}
 }
 
-func sortByInt(rows []table.Row, colIdx int, asc bool) {
-	slices.SortFunc(rows, func(a, b table.Row) int {
-		av, _ := strconv.ParseInt(a[colIdx], 10, 64)
-		bv, _ := strconv.ParseInt(b[colIdx], 10, 64)
+func stringKey(c string) sortKey { return sortKey{s: c} }
+
+// sortRowsBy sorts rows by column colIdx, parsing each cell once rather
+// than on every comparison. The sort is stable.
+func sortRowsBy(rows []table.Row, colIdx int, key func(string) sortKey, asc bool) {
+	type keyed struct {
+		key sortKey
+		row table.Row
+	}
+	ks := make([]keyed, len(rows))
+	for i, r := range rows {
+		ks[i] = keyed{key(r[colIdx]), r}
+	}
+	slices.SortStableFunc(ks, func(a, b keyed) int {
 		if asc {
-			return int(av - bv)
+			return a.key.compare(b.key)
 		}
-		return int(bv - av)
+		return b.key.compare(a.key)
 	})
+	for i := range ks {
+		rows[i] = ks[i].row
+	}
 }
 
+func sortByInt(rows []table.Row, colIdx int, asc bool) { sortRowsBy(rows, colIdx, intKey, asc) }
+
 func sortByPercent(rows []table.Row, colIdx int, asc bool) {
-	slices.SortFunc(rows, func(a, b table.Row) int {
-		av, _ := parsePercent(a[colIdx])
-		bv, _ := parsePercent(b[colIdx])
-		if asc {
-			return int(av - bv)
-		}
-		return int(bv - av)
-	})
+	sortRowsBy(rows, colIdx, percentKey, asc)
 }
 
-// sortBySize sorts rows by size strings like "6B", "3.5M", "1.2T".
-func sortBySize(rows []table.Row, colIdx int, asc bool) {
-	slices.SortFunc(rows, func(a, b table.Row) int {
-		av, _ := parseSize(a[colIdx])
-		bv, _ := parseSize(b[colIdx])
-		if asc {
-			return int(av - bv)
-		}
-		return int(bv - av)
-	})
+func sortByString(rows []table.Row, colIdx int, asc bool) {
+	sortRowsBy(rows, colIdx, stringKey, asc)
 }
 
 // parseSize parses strings like "6B", "3.5M", "1.2T" into int64.
@@ -115,15 +150,6 @@ func parseSize(s string) (int64, error) {
 	return int64(num*mult + 0.5), nil // round to nearest int
 }
 
-func sortByString(rows []table.Row, colIdx int, asc bool) {
-	slices.SortFunc(rows, func(a, b table.Row) int {
-		if asc {
-			return strings.Compare(a[colIdx], b[colIdx])
-		}
-		return strings.Compare(b[colIdx], a[colIdx])
-	})
-}
-
 // hasIntHeader checks if the header is in the intHeaders set (case-insensitive).
 func hasIntHeader(set map[string]struct{}, header string) bool {
 	for k := range set {
@@ -134,3 +134,17 @@ func TestSortByAgeAndSize(t *testing.T) {
 		t.Errorf("sortRows by Size desc failed: got %v", rows)
 	}
 }
+
+func TestSortByLastSeen(t *testing.T) {
+	t.Parallel()
+	rows := []table.Row{
+		{"foo", "5m"},
+		{"bar", "2d"},
+		{"baz", "30s"},
+	}
+	headers := []header{{text: "Name"}, {text: "Last Seen"}}
+	sortRows(rows, headers, "Last Seen", true)
+	if rows[0][0] != "baz" || rows[2][0] != "bar" {
+		t.Errorf("sortRows by Last Seen asc failed: got %v", rows)
+	}
+}
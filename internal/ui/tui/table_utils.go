@@ -1,7 +1,6 @@
 package tui
 
 import (
-	"fmt"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +14,7 @@ import (
 )
 
 type header struct {
+	key            string
 	text           string
 	ratio          float64
 	truncateMiddle bool
@@ -26,6 +26,7 @@ var statsColumns = map[domain.Category][]string{
 	domain.GPUPool:            {common.SizeCol, "GPUs"},
 	domain.GPUNode:            {"Total", common.FreeCol},
 	domain.GPUWorkload:        {common.GpusCol},
+	domain.PendingGPUWorkload: {common.GpusCol},
 	domain.DedicatedAICluster: {common.SizeCol},
 }
 
@@ -36,7 +37,7 @@ func faultyPred[T models.Faulty](t T) bool {
 func headersFromSet[T any](cols []columns.Column[T]) []header {
 	out := make([]header, len(cols))
 	for i, c := range cols {
-		out[i] = header{text: c.Title, ratio: c.Ratio, truncateMiddle: c.TruncateMiddle}
+		out[i] = header{key: c.Key, text: c.Title, ratio: c.Ratio, truncateMiddle: c.TruncateMiddle}
 	}
 	return out
 }
@@ -44,35 +45,39 @@ func headersFromSet[T any](cols []columns.Column[T]) []header {
 func headersFromGroupedSet[T any](cols []columns.GroupedColumn[T]) []header {
 	out := make([]header, len(cols))
 	for i, c := range cols {
-		out[i] = header{text: c.Title, ratio: c.Ratio, truncateMiddle: c.TruncateMiddle}
+		out[i] = header{key: c.Key, text: c.Title, ratio: c.Ratio, truncateMiddle: c.TruncateMiddle}
 	}
 	return out
 }
 
-// headersFor returns the header strip for a category. Headers are
-// precomputed at rowSources construction so the live table, the
-// CSV export, and the header strip share one column set. Returns
-// nil for unregistered categories (e.g. CategoryUnknown).
+// headersFor returns the header strip for a category. Headers come
+// from the same column set as the rows, so the live table, the CSV
+// export, and the header strip share one column set; they are built
+// per call because the Cluster column depends on columns.SetMultiCluster.
+// Returns nil for unregistered categories (e.g. CategoryUnknown).
 func headersFor(category domain.Category) []header {
 	if src, ok := rowSources[category]; ok {
-		return src.headers
+		return src.headers()
 	}
 	return nil
 }
 
 /*
-computeTableRows returns the table rows for a given category, using the appropriate handler.
-If the scope is not valid for the category, it is set to nil.
-Returns: rows, stats (nil if not applicable)
+computeRowIndex builds the row index for a given category: every item the
+category shows under scope and the faulty toggle, sorted by sortColumn and
+narrowed by filter, with stats over the filtered rows. Cells are rendered
+on demand, so the cost is one pass over the sort column and, when filtering,
+over each item's filterable text. If the scope is not valid for the
+category, it is set to nil. Returns nil when there is nothing to show.
 */
-func computeTableRows(dataset *models.Dataset, category domain.Category, scope *domain.Scope, filter string, sortColumn string, sortAsc bool, faultyOnly bool) ([]table.Row, tableStats) {
+func computeRowIndex(dataset *models.Dataset, category domain.Category, scope *domain.Scope, filter string, sortColumn string, sortAsc bool, faultyOnly bool) *rowIndex {
 	// row-source closures dereference dataset to pull category-specific
 	// slices/maps; before the first successful load there's nothing to
 	// render. Bail out so refresh-paths driven by user navigation (now
 	// reachable since load failures no longer trap the user in
 	// ErrorView) don't NPE on Tenant.<Field>.
 	if dataset == nil {
-		return nil, nil
+		return nil
 	}
 	if scope != nil && !scope.Category.IsScopeOf(category) {
 		scope = nil
@@ -80,39 +85,56 @@ func computeTableRows(dataset *models.Dataset, category domain.Category, scope *
 
 	src, exists := rowSources[category]
 	if !exists {
-		return nil, nil
+		return nil
 	}
-	rows := src.rows(rowCtx{
+	base := src.base(rowCtx{
 		dataset: dataset,
 		scope:   scope,
-		filter:  filter,
 		faulty:  faultyOnly,
 	})
-	if sortColumn != "" && len(rows) > 0 {
-		headers := headersFor(category)
-		sortRows(rows, headers, sortColumn, sortAsc)
+	base.key = newBaseKey(dataset, category, scope, sortColumn, sortAsc, faultyOnly)
+	if sortColumn != "" && base.n > 0 {
+		if col := sortColumnIndex(headersFor(category), sortColumn); col >= 0 {
+			base.sortBy(col, sortColumn, sortAsc)
+		}
 	}
-	return rows, computeStats(category, rows)
+	ix := newRowIndex(base, filter)
+	ix.stats = computeStats(category, ix)
+	return ix
 }
 
-// computeStats calculates stats for the given category and rows.
-func computeStats(category domain.Category, rows []table.Row) tableStats {
-	if len(rows) == 0 {
+/*
+computeTableRows returns the table rows for a given category, rendered
+eagerly from computeRowIndex.
+Returns: rows, stats (nil if not applicable)
+*/
+func computeTableRows(dataset *models.Dataset, category domain.Category, scope *domain.Scope, filter string, sortColumn string, sortAsc bool, faultyOnly bool) ([]table.Row, tableStats) {
+	ix := computeRowIndex(dataset, category, scope, filter, sortColumn, sortAsc, faultyOnly)
+	if ix == nil {
+		return nil, nil
+	}
+	return ix.materialize(), ix.stats
+}
+
+// computeStats calculates stats for the given category over the rows of
+// ix, rendering only the columns the stats read.
+func computeStats(category domain.Category, ix *rowIndex) tableStats {
+	if ix.Len() == 0 {
 		return nil
 	}
 
-	stats := computeNumericStats(category, rows)
+	stats := computeNumericStats(category, ix)
 	if category == domain.DedicatedAICluster {
-		stats = appendDedicatedAIClusterStats(rows, stats)
+		stats = appendDedicatedAIClusterStats(ix, stats)
 	}
 
 	return stats
 }
 
 // computeNumericStats sums numeric columns defined for the category.
-func computeNumericStats(category domain.Category, rows []table.Row) tableStats {
+func computeNumericStats(category domain.Category, ix *rowIndex) tableStats {
 	cols, ok := statsColumns[category]
-	if !ok || len(rows) == 0 {
+	if !ok || ix.Len() == 0 {
 		return nil
 	}
 
@@ -129,8 +151,8 @@ func computeNumericStats(category domain.Category, rows []table.Row) tableStats
 			return nil // header missing, bail out
 		}
 		sum := 0
-		for _, r := range rows {
-			v, err := strconv.Atoi(r[columnIdx])
+		for i := range ix.Len() {
+			v, err := strconv.Atoi(ix.cell(i, columnIdx))
 			if err == nil {
 				sum += v
 			}
@@ -141,7 +163,7 @@ func computeNumericStats(category domain.Category, rows []table.Row) tableStats
 	return totals
 }
 
-func appendDedicatedAIClusterStats(rows []table.Row, stats tableStats) tableStats {
+func appendDedicatedAIClusterStats(ix *rowIndex, stats tableStats) tableStats {
 	headers := headersFor(domain.DedicatedAICluster)
 	statusIdx := -1
 	for i, h := range headers {
@@ -152,8 +174,8 @@ func appendDedicatedAIClusterStats(rows []table.Row, stats tableStats) tableStat
 	}
 
 	var active, failed int
-	for _, r := range rows {
-		switch strings.ToLower(strings.TrimSpace(r[statusIdx])) {
+	for i := range ix.Len() {
+		switch strings.ToLower(strings.TrimSpace(ix.cell(i, statusIdx))) {
 		case "active", "ready":
 			active++
 		case "fail", "failed":
@@ -170,6 +192,61 @@ func appendDedicatedAIClusterStats(rows []table.Row, stats tableStats) tableStat
 itemKeyFrom returns the ItemKey for a given category and table row.
 */
 func itemKeyFrom(category domain.Category, row table.Row) models.ItemKey {
+	return itemKeyWithCluster(category, row, clusterColumn(category))
+}
+
+// clusterColumn returns the index of category's Cluster column in its
+// rows, or -1 when the column is not shown (a single-cluster
+// environment, or a category not read from the cluster).
+func clusterColumn(category domain.Category) int {
+	return columnIndex(category, columns.ClusterKey)
+}
+
+// columnIndex returns the index of the header keyed key in category's
+// rows, or -1 when no such column is shown.
+func columnIndex(category domain.Category, key string) int {
+	return slices.IndexFunc(headersFor(category), func(h header) bool { return h.key == key })
+}
+
+// scopeName returns the name a scope entered from row of category
+// carries, i.e. the key its scoped categories are grouped under. That
+// is the row's Name, except for a GPUWorkload: Event groups pod events
+// by namespace/name (models.EventObjectKey), since pod names repeat
+// across namespaces.
+func scopeName(category domain.Category, row table.Row) string {
+	if len(row) == 0 {
+		return ""
+	}
+	if category == domain.GPUWorkload {
+		if ns := columnIndex(category, "namespace"); ns >= 0 && ns < len(row) {
+			return models.EventObjectKey(models.EventObjectPod, row[ns], row[0])
+		}
+	}
+	return row[0]
+}
+
+// itemKeyWithCluster is itemKeyFrom with the Cluster column's index
+// already resolved, so callers keying many rows look it up once. When
+// the column is shown, the key carries the row's cluster: flat
+// categories then key by ScopedItemKey{Name, Cluster} rather than the
+// bare name, because the same BaseModel (say) exists once per cluster.
+func itemKeyWithCluster(category domain.Category, row table.Row, clusterCol int) models.ItemKey {
+	key := baseItemKey(category, row)
+	if key == nil || clusterCol < 0 || clusterCol >= len(row) || row[clusterCol] == "" {
+		return key
+	}
+	switch k := key.(type) {
+	case string:
+		return models.ScopedItemKey{Name: k, Cluster: row[clusterCol]}
+	case models.ScopedItemKey:
+		k.Cluster = row[clusterCol]
+		return k
+	}
+	return key
+}
+
+// baseItemKey returns the cluster-less ItemKey for a category and row.
+func baseItemKey(category domain.Category, row table.Row) models.ItemKey {
 	if len(row) == 0 {
 		return nil
 	}
@@ -177,15 +254,18 @@ func itemKeyFrom(category domain.Category, row table.Row) models.ItemKey {
 	case domain.Tenant, domain.LimitDefinition, domain.Environment, domain.ServiceTenancy,
 		domain.ConsolePropertyDefinition, domain.PropertyDefinition, domain.GPUPool,
 		domain.LimitRegionalOverride, domain.ConsolePropertyRegionalOverride,
-		domain.PropertyRegionalOverride, domain.Alias, domain.BaseModel:
+		domain.PropertyRegionalOverride, domain.Alias, domain.BaseModel, domain.WorkRequest:
 		return row[0]
 	case domain.LimitTenancyOverride, domain.ConsolePropertyTenancyOverride,
 		domain.PropertyTenancyOverride, domain.GPUNode, domain.DedicatedAICluster,
-		domain.ImportedModel, domain.ModelArtifact, domain.GPUWorkload:
+		domain.ImportedModel, domain.ModelArtifact, domain.GPUWorkload, domain.Endpoint, domain.Event,
+		domain.PendingGPUWorkload:
 		// ModelArtifact row[1] is "Model Internal Name" which equals
 		// the ModelArtifactMap's parent BaseModel key — see
 		// columns/model_artifact.go. Treating it as a scoped key
 		// disambiguates artifacts that share a Name across BaseModels.
+		// PendingGPUWorkload row[1] is the pod's namespace: pod names
+		// are unique only within one.
 		if len(row) < 2 {
 			return nil
 		}
@@ -207,10 +287,10 @@ func itemKeyFrom(category domain.Category, row table.Row) models.ItemKey {
 // categories (no parent) also return ok=false.
 //
 // The parent's instance name lives in the rendered row, keyed on the
-// parent's type: grouped sub-categories (Tenant/GPUPool parents) carry
-// the parent key at row[1] (the same grouping key itemKeyFrom puts in
-// ScopedItemKey.Scope), while flat regional overrides are named after
-// their definition at row[0].
+// parent's type: grouped sub-categories (Tenant/GPUPool/GPUNode/DAC
+// parents) carry the parent key at row[1] (the same grouping key
+// itemKeyFrom puts in ScopedItemKey.Scope), while flat regional
+// overrides are named after their definition at row[0].
 func parentScope(category domain.Category, row table.Row) (domain.Scope, bool) {
 	parents := category.Parents()
 	if len(parents) != 1 {
@@ -219,7 +299,7 @@ func parentScope(category domain.Category, row table.Row) (domain.Scope, bool) {
 
 	parent := parents[0]
 	switch parent {
-	case domain.Tenant, domain.GPUPool, domain.GPUNode:
+	case domain.Tenant, domain.GPUPool, domain.GPUNode, domain.DedicatedAICluster:
 		if len(row) < 2 {
 			return domain.Scope{}, false
 		}
@@ -233,52 +313,20 @@ func parentScope(category domain.Category, row table.Row) (domain.Scope, bool) {
 	return domain.Scope{}, false
 }
 
-// cloneRows returns a deep copy of rows so callers can mutate one
-// without disturbing the other.
-func cloneRows(rows []table.Row) []table.Row {
-	if rows == nil {
-		return nil
-	}
-	out := make([]table.Row, len(rows))
-	for i, r := range rows {
-		out[i] = table.Row(slices.Clone(r))
-	}
-	return out
-}
-
 // selectedRawRow returns the un-truncated row at the table's cursor,
-// or the table's (possibly truncated) SelectedRow as a fallback when
-// the parallel rawRows index isn't populated. In normal production
-// flow rawRows is always populated by applyRows; the fallback exists
+// rendered from m.index, or the table's (possibly truncated) SelectedRow
+// as a fallback when the index isn't populated. In normal production
+// flow the index is always populated by applyIndex; the fallback exists
 // for tests that call m.table.SetRows directly and for genuinely
 // empty tables. Callers that derive ItemKey from cell values
 // (itemKeyFrom) must use this; the live SelectedRow may contain
 // "…" in Name/Tenant cells.
 func (m *Model) selectedRawRow() table.Row {
 	idx := m.table.Cursor()
-	if idx < 0 || idx >= len(m.rawRows) {
+	if idx < 0 || idx >= m.index.Len() {
 		return m.table.SelectedRow()
 	}
-	return m.rawRows[idx]
-}
-
-// indexOfItemKey returns the index of the first row whose per-category item
-// key equals key, or -1 when key is nil or no row matches. Identity is the
-// itemKeyFrom key — the bare Name cell for flat categories, but
-// ScopedItemKey{Scope: row[1], Name: row[0]} for scoped ones — so a reload
-// re-homes onto the same item even when several visible rows share a Name.
-// itemKeyFrom only ever yields a string, a ScopedItemKey, or nil (all
-// comparable), so the == comparison is panic-safe.
-func indexOfItemKey(rows []table.Row, category domain.Category, key models.ItemKey) int {
-	if key == nil {
-		return -1
-	}
-	for i, r := range rows {
-		if itemKeyFrom(category, r) == key {
-			return i
-		}
-	}
-	return -1
+	return m.index.row(idx)
 }
 
 // findItem looks up the item identified by (category, key) in the
@@ -305,6 +353,8 @@ func removeItemFromDataset(dataset *models.Dataset, category domain.Category, ke
 	switch category {
 	case domain.DedicatedAICluster:
 		deleteItemInMap(dataset.DedicatedAIClusterMap, key)
+	case domain.Endpoint:
+		deleteItemInMap(dataset.EndpointMap, key)
 	case domain.GPUNode:
 		deleteItemInMap(dataset.GPUNodeMap, key)
 	default:
@@ -316,12 +366,33 @@ func deleteItemInMap[T models.NamedItem](m map[string][]T, key models.ItemKey) {
 	k := key.(models.ScopedItemKey)
 	if items, ok := m[k.Scope]; ok {
 		items = slices.DeleteFunc(items, func(item T) bool {
-			return item.GetName() == k.Name
+			return item.GetName() == k.Name && inCluster(item, k.Cluster)
 		})
 		m[k.Scope] = items
 	}
 }
 
+// inCluster reports whether item was loaded from cluster; an empty
+// cluster matches every item.
+func inCluster(item any, cluster string) bool {
+	if cluster == "" {
+		return true
+	}
+	c, ok := item.(models.Clustered)
+	return ok && c.GetCluster() == cluster
+}
+
+// findKeyed returns the first of items named name and loaded from
+// cluster (any cluster when it is empty).
+func findKeyed[T models.NamedItem](items []T, name, cluster string) *T {
+	for i := range items {
+		if items[i].GetName() == name && inCluster(items[i], cluster) {
+			return &items[i]
+		}
+	}
+	return nil
+}
+
 /*
 itemKeyString returns a string representation of the ItemKey.
 */
@@ -329,7 +400,14 @@ func itemKeyString(key models.ItemKey) string {
 	if k, ok := key.(string); ok {
 		return k
 	} else if k, ok := key.(models.ScopedItemKey); ok {
-		return fmt.Sprintf("%s/%s", k.Scope, k.Name)
+		name := k.Name
+		if k.Scope != "" {
+			name = k.Scope + "/" + name
+		}
+		if k.Cluster != "" {
+			name += " (" + k.Cluster + ")"
+		}
+		return name
 	}
 
 	return "UNKNOWN"
@@ -10,6 +10,7 @@ import (
 	"github.com/jingle2008/toolkit/internal/columns"
 	"github.com/jingle2008/toolkit/internal/domain"
 	"github.com/jingle2008/toolkit/internal/ui/tui/common"
+	logging "github.com/jingle2008/toolkit/pkg/infra/logging"
 	"github.com/jingle2008/toolkit/pkg/models"
 )
 
@@ -390,6 +391,43 @@ func TestGetItemKeyString_Scoped(t *testing.T) {
 	assert.Equal(t, "scope/name", key)
 }
 
+func TestGetItemKeyString_Cluster(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "bm (ctx-b)", itemKeyString(models.ScopedItemKey{Name: "bm", Cluster: "ctx-b"}))
+	assert.Equal(t, "pool/n1 (ctx-b)", itemKeyString(models.ScopedItemKey{Scope: "pool", Name: "n1", Cluster: "ctx-b"}))
+}
+
+// With several clusters the same BaseModel is listed once per cluster;
+// the item key carries the cluster so each row opens, and re-homes onto,
+// its own item.
+//
+//nolint:paralleltest // toggles the process-wide columns.SetMultiCluster switch
+func TestItemKeyFrom_ClusterDisambiguates(t *testing.T) {
+	columns.SetMultiCluster(true)
+	defer columns.SetMultiCluster(false)
+	ds := &models.Dataset{BaseModels: []models.BaseModel{
+		{InternalName: "bm", Name: "BM", Cluster: "ctx-a"},
+		{InternalName: "bm", Name: "BM", Cluster: "ctx-b"},
+	}}
+	ix := computeRowIndex(ds, domain.BaseModel, nil, "", "", true, false)
+	require.Equal(t, 2, ix.Len())
+
+	keys := []models.ItemKey{itemKeyFrom(domain.BaseModel, ix.row(0)), itemKeyFrom(domain.BaseModel, ix.row(1))}
+	assert.NotEqual(t, keys[0], keys[1])
+	for i, key := range keys {
+		item, ok := findItem(ds, domain.BaseModel, key).(*models.BaseModel)
+		require.True(t, ok, "key %v", key)
+		assert.Equal(t, ds.BaseModels[i].Cluster, item.Cluster)
+		assert.Equal(t, i, ix.indexOfKey(domain.BaseModel, key))
+	}
+
+	nodes := &models.Dataset{GPUNodeMap: map[string][]models.GPUNode{"pool": {
+		{Name: "n1", Cluster: "ctx-a"}, {Name: "n1", Cluster: "ctx-b"},
+	}}}
+	removeItemFromDataset(nodes, domain.GPUNode, models.ScopedItemKey{Scope: "pool", Name: "n1", Cluster: "ctx-b"})
+	assert.Equal(t, []models.GPUNode{{Name: "n1", Cluster: "ctx-a"}}, nodes.GPUNodeMap["pool"])
+}
+
 func TestFilterRows(t *testing.T) {
 	t.Parallel()
 	items := []models.Environment{
@@ -494,3 +532,59 @@ func TestDeleteItem_RemoveDedicatedAICluster(t *testing.T) {
 	item := findItem(ds, domain.DedicatedAICluster, key)
 	assert.Nil(t, item)
 }
+
+//nolint:paralleltest // NewModel sets the process-wide columns.SetMultiCluster switch
+func TestHeadersFor_ClusterColumnWithSeveralContexts(t *testing.T) {
+	defer columns.SetMultiCluster(false)
+	env := models.Environment{Realm: "oc1", Type: "prod", Region: "us-ashburn-1"}
+	ds := &models.Dataset{GPUNodeMap: map[string][]models.GPUNode{"pool": {{Name: "n1", Cluster: "ctx-b"}}}}
+	titles := func() []string {
+		var out []string
+		for _, h := range headersFor(domain.GPUNode) {
+			out = append(out, h.text)
+		}
+		return out
+	}
+
+	_, err := NewModel(WithRepoPath("testrepo"), WithEnvironment(env), WithLoader(fakeLoader{}), WithLogger(logging.NewNoOpLogger()))
+	require.NoError(t, err)
+	assert.NotContains(t, titles(), "Cluster")
+
+	_, err = NewModel(WithRepoPath("testrepo"), WithEnvironment(env), WithLoader(fakeLoader{}), WithLogger(logging.NewNoOpLogger()),
+		WithKubeContexts(models.KubeContexts{env.GetName(): {"ctx-a", "ctx-b"}}))
+	require.NoError(t, err)
+	got := titles()
+	require.Contains(t, got, "Cluster")
+	rows, _ := computeTableRows(ds, domain.GPUNode, nil, "", "", true, false)
+	require.Len(t, rows, 1)
+	require.Len(t, rows[0], len(got))
+	assert.Equal(t, "ctx-b", rows[0][len(got)-1])
+}
+
+// Pending pods are keyed by namespace too: the same pod name in two
+// namespaces opens, and re-homes onto, the right one.
+func TestItemKeyFrom_PendingGPUWorkloadByNamespace(t *testing.T) {
+	t.Parallel()
+	ds := &models.Dataset{PendingGPUWorkloads: []models.PendingGPUWorkload{
+		{Name: "llama-0", Namespace: "team-a"},
+		{Name: "llama-0", Namespace: "team-b"},
+	}}
+	ix := computeRowIndex(ds, domain.PendingGPUWorkload, nil, "", "", true, false)
+	require.Equal(t, 2, ix.Len())
+	for i := range 2 {
+		key := itemKeyFrom(domain.PendingGPUWorkload, ix.row(i))
+		assert.Equal(t, models.ScopedItemKey{Scope: ds.PendingGPUWorkloads[i].Namespace, Name: "llama-0"}, key)
+		assert.Same(t, &ds.PendingGPUWorkloads[i], findItem(ds, domain.PendingGPUWorkload, key))
+		assert.Equal(t, i, ix.indexOfKey(domain.PendingGPUWorkload, key))
+	}
+	assert.Nil(t, findItem(ds, domain.PendingGPUWorkload, "llama-0"), "a bare name is not a pending pod key")
+}
+
+func TestScopeName_WorkloadCarriesNamespace(t *testing.T) {
+	t.Parallel()
+	row := table.Row{"serv", "gpu-1", "tenant-a", "ns1"}
+	assert.Equal(t, "ns1/serv", scopeName(domain.GPUWorkload, row),
+		"Event groups pod events by namespace/name")
+	assert.Equal(t, "gpu-1", scopeName(domain.GPUNode, table.Row{"gpu-1"}))
+	assert.Empty(t, scopeName(domain.GPUWorkload, nil))
+}
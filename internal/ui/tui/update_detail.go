@@ -37,6 +37,12 @@ func (m *Model) updateDetailView(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.enterHelpView()
 		case key.Matches(keyMsg, keys.CopyObject):
 			cmds = append(cmds, m.copyItemJSONByChoice())
+		case key.Matches(keyMsg, keys.ViewHistory) && m.hasContextKey(keys.ViewHistory):
+			cmds = append(cmds, m.toggleDetailHistory())
+		case key.Matches(keyMsg, keys.ViewRaw) && m.hasContextKey(keys.ViewRaw):
+			cmds = append(cmds, m.toggleDetailRaw())
+		case key.Matches(keyMsg, keys.OpenEditor) && m.hasContextKey(keys.OpenEditor):
+			cmds = append(cmds, m.openInEditor(findItem(m.dataset, m.category, m.selectedKey)))
 		}
 	}
 
@@ -54,10 +54,26 @@ func (dummyLoader) LoadGPUWorkloadsByNode(_ context.Context, _ string, _ models.
 	return nil, errDummy
 }
 
+func (dummyLoader) LoadPendingGPUWorkloads(_ context.Context, _ string, _ models.Environment) ([]models.PendingGPUWorkload, error) {
+	return nil, errDummy
+}
+
 func (dummyLoader) LoadDedicatedAIClusters(_ context.Context, _ string, _ models.Environment) (map[string][]models.DedicatedAICluster, error) {
 	return nil, errDummy
 }
 
+func (dummyLoader) LoadEndpoints(_ context.Context, _ string, _ models.Environment) (map[string][]models.Endpoint, error) {
+	return nil, errDummy
+}
+
+func (dummyLoader) LoadEvents(_ context.Context, _ string, _ models.Environment) (map[string][]models.Event, error) {
+	return nil, errDummy
+}
+
+func (dummyLoader) LoadWorkRequests(_ context.Context, _ string, _ models.Environment) ([]models.WorkRequest, error) {
+	return nil, errDummy
+}
+
 func (dummyLoader) LoadTenancyOverrideGroup(_ context.Context, _ string, _ models.Environment) (models.TenancyOverrideGroup, error) {
 	return models.TenancyOverrideGroup{}, errDummy
 }
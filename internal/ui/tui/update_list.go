@@ -19,14 +19,15 @@ func (m *Model) updateListView(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	updatedTable, cmd := m.table.Update(msg)
 	m.table = &updatedTable
+	m.syncTableWindow()
 	cmds = append(cmds, cmd)
 	return m, tea.Batch(cmds...)
 }
 
 func (m *Model) routeListMsg(msg tea.Msg) []tea.Cmd {
-	// dataMsg, datasetLoadedMsg, and the typed *LoadedMsg family are
-	// intercepted at the top of Update so they fire from any view —
-	// they don't reach this router.
+	// dataMsg, datasetLoadedMsg, the typed *LoadedMsg family and the
+	// mutation results are intercepted at the top of Update so they fire
+	// from any view — they don't reach this router.
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		return m.handleKeyMsg(msg)
@@ -36,22 +37,21 @@ func (m *Model) routeListMsg(msg tea.Msg) []tea.Cmd {
 		return []tea.Cmd{m.handleSetFilterMsg(msg)}
 	case filterApplyMsg:
 		return []tea.Cmd{m.handleFilterApplyMsg(msg)}
-	case deleteErrMsg:
-		m.handleDeleteErrMsg(msg)
-		return nil
-	case deleteDoneMsg:
-		m.handleDeleteDoneMsg(msg)
-		return nil
 	case updateDoneMsg:
 		m.handleUpdateDoneMsg(msg)
 		return nil
-	default:
-		return m.routeListAsyncMsg(msg)
 	}
+	return nil
 }
 
-func (m *Model) routeListAsyncMsg(msg tea.Msg) []tea.Cmd {
+// routeMutationMsg applies a mutation's result (and finishes its job)
+// whatever view is active.
+func (m *Model) routeMutationMsg(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
+	case deleteErrMsg:
+		m.handleDeleteErrMsg(msg)
+	case deleteDoneMsg:
+		return m.handleDeleteDoneMsg(msg)
 	case gpuPoolScaleStartedMsg:
 		m.handleGPUPoolScaleStartedMsg(msg)
 	case gpuPoolScaleResultMsg:
@@ -62,6 +62,8 @@ func (m *Model) routeListAsyncMsg(msg tea.Msg) []tea.Cmd {
 		m.handleDrainNodeResultMsg(msg)
 	case rebootNodeResultMsg:
 		m.handleRebootNodeResultMsg(msg)
+	case jobPolledMsg:
+		return m.handleJobPolled(msg)
 	}
 	return nil
 }
@@ -190,7 +192,7 @@ func (m *Model) enterHelpView() {
 
 func (m *Model) handleNextCategory() tea.Cmd {
 	next := int(m.category) + 1
-	if next > int(domain.DedicatedAICluster) {
+	if next > int(domain.WorkRequest) {
 		next = int(domain.Tenant)
 	}
 	category := domain.Category(next)
@@ -200,7 +202,7 @@ func (m *Model) handleNextCategory() tea.Cmd {
 func (m *Model) handlePrevCategory() tea.Cmd {
 	prev := int(m.category) - 1
 	if prev < int(domain.Tenant) {
-		prev = int(domain.DedicatedAICluster)
+		prev = int(domain.WorkRequest)
 	}
 	category := domain.Category(prev)
 	return tea.Sequence(m.updateCategory(category)...)
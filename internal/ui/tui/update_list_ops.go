@@ -10,12 +10,14 @@ import (
 
 /*
 deleteItem handles the generic delete action based on the current category.
-For DedicatedAICluster, it deletes via SDK and removes the row locally.
+For DedicatedAICluster and Endpoint, it deletes via SDK and removes the row locally.
 */
 func (m *Model) deleteItem(itemKey models.ItemKey) tea.Cmd {
 	switch m.category {
 	case domain.DedicatedAICluster:
 		return m.deleteDedicatedAICluster(itemKey)
+	case domain.Endpoint:
+		return m.deleteEndpoint(itemKey)
 	case domain.GPUNode:
 		return m.deleteGPUNode(itemKey)
 	default:
@@ -40,6 +42,7 @@ func (m *Model) deleteDedicatedAICluster(itemKey models.ItemKey) tea.Cmd {
 	prevState := dac.Status
 	dac.Status = "Deleting"
 	m.updateRows(false)
+	job := m.startJob("delete", "DAC", itemKeyString(itemKey))
 	return func() tea.Msg {
 		// DAC deletion is a multi-minute workflow with its own internal
 		// timeout; use longOpCtx so the 30s one-shot cap doesn't cancel it
@@ -51,11 +54,51 @@ func (m *Model) deleteDedicatedAICluster(itemKey models.ItemKey) tea.Cmd {
 				category:  domain.DedicatedAICluster,
 				key:       itemKey,
 				prevState: prevState,
+				job:       job,
 			}
 		}
 		return deleteDoneMsg{
 			category: domain.DedicatedAICluster,
 			key:      itemKey,
+			job:      job,
+		}
+	}
+}
+
+/*
+deleteEndpoint deletes an Endpoint item and updates the UI accordingly.
+*/
+func (m *Model) deleteEndpoint(itemKey models.ItemKey) tea.Cmd {
+	item := findItem(m.dataset, m.category, itemKey)
+	ep, ok := item.(*models.Endpoint)
+	if !ok || ep == nil {
+		m.logger.Errorw("item not found for delete operation", "category", m.category, "key", itemKey)
+		return nil
+	}
+	if ep.LifecycleState == models.EndpointStateDeleting {
+		return nil
+	}
+	prevState := ep.LifecycleState
+	ep.LifecycleState = models.EndpointStateDeleting
+	m.updateRows(false)
+	job := m.startJob("delete", "endpoint", itemKeyString(itemKey))
+	return func() tea.Msg {
+		// Endpoint deletion waits on its work request, which outlasts the
+		// 30s one-shot cap; use longOpCtx like the DAC delete.
+		ctx := m.longOpCtx()
+		if err := actions.DeleteEndpoint(ctx, ep, m.environment, m.logger); err != nil {
+			return deleteErrMsg{
+				err:       err,
+				category:  domain.Endpoint,
+				key:       itemKey,
+				prevState: prevState,
+				job:       job,
+			}
+		}
+		return deleteDoneMsg{
+			category: domain.Endpoint,
+			key:      itemKey,
+			job:      job,
 		}
 	}
 }
@@ -72,19 +115,24 @@ func (m *Model) deleteGPUNode(itemKey models.ItemKey) tea.Cmd {
 	}
 	node.SetStatus("Deleting")
 	m.updateRows(false)
+	job := m.startJob("terminate", "node", itemKeyString(itemKey))
 	return func() tea.Msg {
 		ctx, cancel := m.opCtx()
 		defer cancel()
-		if err := actions.TerminateInstance(ctx, node, m.environment, m.logger); err != nil {
+		workRequest, err := actions.TerminateInstance(ctx, node, m.environment, m.logger)
+		if err != nil {
 			return deleteErrMsg{
 				err:      err,
 				category: domain.GPUNode,
 				key:      itemKey,
+				job:      job,
 			}
 		}
 		return deleteDoneMsg{
-			category: domain.GPUNode,
-			key:      itemKey,
+			category:    domain.GPUNode,
+			key:         itemKey,
+			job:         job,
+			workRequest: workRequest,
 		}
 	}
 }
@@ -108,20 +156,24 @@ func (m *Model) rebootNode(item any, itemKey models.ItemKey) tea.Cmd {
 	node.SetStatus("Rebooting")
 	m.updateRows(false)
 
+	job := m.startJob("reboot", "node", itemKeyString(itemKey))
 	return func() tea.Msg {
 		ctx, cancel := m.opCtx()
 		defer cancel()
 		err := actions.SoftResetInstance(ctx, node, m.environment, m.logger)
-		return rebootNodeResultMsg{key: itemKey, err: err}
+		return rebootNodeResultMsg{key: itemKey, job: job, err: err}
 	}
 }
 
 func (m *Model) handleDeleteErrMsg(msg deleteErrMsg) {
 	m.logger.Errorw("failed to delete item", "key", msg.key, "error", msg.err)
+	m.finishJob(msg.job, msg.err)
 	item := findItem(m.dataset, msg.category, msg.key)
 
 	if dac, ok := item.(*models.DedicatedAICluster); ok {
 		dac.Status = msg.prevState
+	} else if ep, ok := item.(*models.Endpoint); ok {
+		ep.LifecycleState = msg.prevState
 	} else if node, ok := item.(*models.GPUNode); ok {
 		node.SetStatus(msg.prevState)
 	}
@@ -132,7 +184,9 @@ func (m *Model) handleDeleteErrMsg(msg deleteErrMsg) {
 	}
 }
 
-func (m *Model) handleDeleteDoneMsg(msg deleteDoneMsg) {
+// handleDeleteDoneMsg removes the deleted row. Its job stays running
+// while the work request that carries out the delete, if any, does.
+func (m *Model) handleDeleteDoneMsg(msg deleteDoneMsg) tea.Cmd {
 	removeItemFromDataset(m.dataset, msg.category, msg.key)
 
 	// update view if current
@@ -143,6 +197,7 @@ func (m *Model) handleDeleteDoneMsg(msg deleteDoneMsg) {
 		}
 		m.updateRows(false)
 	}
+	return m.trackWorkRequest(msg.job, msg.workRequest)
 }
 
 func (m *Model) handleUpdateDoneMsg(msg updateDoneMsg) {
@@ -170,6 +225,7 @@ func (m *Model) handleGPUPoolScaleStartedMsg(msg gpuPoolScaleStartedMsg) {
 }
 
 func (m *Model) handleGPUPoolScaleResultMsg(msg gpuPoolScaleResultMsg) {
+	m.finishJob(msg.job, msg.err)
 	item := findItem(m.dataset, domain.GPUPool, msg.key)
 	if pool, ok := item.(*models.GPUPool); ok && pool != nil {
 		if msg.err != nil {
@@ -186,6 +242,7 @@ func (m *Model) handleGPUPoolScaleResultMsg(msg gpuPoolScaleResultMsg) {
 }
 
 func (m *Model) handleCordonNodeResultMsg(msg cordonNodeResultMsg) {
+	m.finishJob(msg.job, msg.err)
 	item := findItem(m.dataset, domain.GPUNode, msg.key)
 	if node, ok := item.(*models.GPUNode); ok && node != nil {
 		if msg.err != nil {
@@ -202,6 +259,7 @@ func (m *Model) handleCordonNodeResultMsg(msg cordonNodeResultMsg) {
 }
 
 func (m *Model) handleDrainNodeResultMsg(msg drainNodeResultMsg) {
+	m.finishJob(msg.job, msg.err)
 	if msg.err != nil {
 		m.logger.Errorw("failed to drain node", "key", msg.key, "error", msg.err)
 	}
@@ -211,6 +269,7 @@ func (m *Model) handleDrainNodeResultMsg(msg drainNodeResultMsg) {
 }
 
 func (m *Model) handleRebootNodeResultMsg(msg rebootNodeResultMsg) {
+	m.finishJob(msg.job, msg.err)
 	item := findItem(m.dataset, domain.GPUNode, msg.key)
 	if node, ok := item.(*models.GPUNode); ok && node != nil {
 		if msg.err != nil {
@@ -83,6 +83,44 @@ func TestHandleDeleteErrMsg_GPUNodeRestoresStatus(t *testing.T) {
 	}
 }
 
+func TestHandleDeleteErrMsg_EndpointRestoresState(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.category = domain.Endpoint
+	m.dataset.EndpointMap = map[string][]models.Endpoint{
+		"dac1": {{Name: "ep1", DedicatedAICluster: "dac1", LifecycleState: models.EndpointStateDeleting}},
+	}
+	m.updateColumns()
+	m.updateRows(false)
+
+	m.handleDeleteErrMsg(deleteErrMsg{
+		err:       errors.New("boom"),
+		category:  domain.Endpoint,
+		key:       models.ScopedItemKey{Scope: "dac1", Name: "ep1"},
+		prevState: "ACTIVE",
+	})
+
+	assert.Equal(t, "ACTIVE", m.dataset.EndpointMap["dac1"][0].LifecycleState)
+}
+
+func TestHandleDeleteDoneMsg_EndpointRemoved(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.category = domain.Endpoint
+	m.dataset.EndpointMap = map[string][]models.Endpoint{
+		"dac1": {{Name: "ep1", DedicatedAICluster: "dac1"}, {Name: "ep2", DedicatedAICluster: "dac1"}},
+	}
+	m.updateColumns()
+	m.updateRows(false)
+
+	m.handleDeleteDoneMsg(deleteDoneMsg{
+		category: domain.Endpoint,
+		key:      models.ScopedItemKey{Scope: "dac1", Name: "ep1"},
+	})
+
+	assert.Equal(t, []models.Endpoint{{Name: "ep2", DedicatedAICluster: "dac1"}}, m.dataset.EndpointMap["dac1"])
+}
+
 func TestHandleDeleteDoneMsg_GPUNodeRemoved(t *testing.T) {
 	t.Parallel()
 	m := newTestModel(t)
@@ -241,3 +279,15 @@ func TestBackForwardKeyBindings(t *testing.T) {
 	assert.Equal(t, 1, m.historyIdx)
 	assert.NotNil(t, cmds)
 }
+
+func TestCategoryCycle_ReachesWorkRequest(t *testing.T) {
+	t.Parallel()
+	m := newTestModel(t)
+	m.category = domain.Event
+	m.handleNextCategory()
+	assert.Equal(t, domain.WorkRequest, m.category)
+	m.handleNextCategory()
+	assert.Equal(t, domain.Tenant, m.category, "wraps after the last category")
+	m.handlePrevCategory()
+	assert.Equal(t, domain.WorkRequest, m.category, "wraps back onto the last category")
+}
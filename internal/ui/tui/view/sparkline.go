@@ -0,0 +1,90 @@
+package view
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+// sparkBars are the eight block heights of a sparkline, lowest first.
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a line of block characters at most width
+// cells wide. Longer series are split into width consecutive buckets,
+// each drawn at its peak so short spikes stay visible. Bars are scaled
+// from zero (or the minimum, if negative) to the maximum; an empty
+// series or a non-positive width renders as "".
+func Sparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		buckets := make([]float64, width)
+		for i := range buckets {
+			lo, hi := i*len(values)/width, (i+1)*len(values)/width
+			buckets[i] = values[lo]
+			for _, v := range values[lo+1 : hi] {
+				buckets[i] = max(buckets[i], v)
+			}
+		}
+		values = buckets
+	}
+	lo, hi := 0.0, values[0]
+	for _, v := range values {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if hi > lo {
+			i = int(math.Round((v - lo) / (hi - lo) * float64(len(sparkBars)-1)))
+		}
+		b.WriteRune(sparkBars[i])
+	}
+	return b.String()
+}
+
+// CompactNumber formats v with at most one decimal and a k/M/G suffix,
+// e.g. "950", "12.3k", "4M", for the narrow summary columns beside a
+// sparkline.
+func CompactNumber(v float64) string {
+	for _, u := range []struct {
+		suffix string
+		scale  float64
+	}{{"G", 1e9}, {"M", 1e6}, {"k", 1e3}} {
+		if math.Abs(v) >= u.scale {
+			return strconv.FormatFloat(math.Round(v/u.scale*10)/10, 'f', -1, 64) + u.suffix
+		}
+	}
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
+
+// metricStatsWidth is the width of a metric line's summary columns:
+// "  last 12.3k  peak 12.3k  total 12.3k".
+const metricStatsWidth = 38
+
+// MetricLines renders one line per series, fitted to width where it
+// can be: the metric name, a sparkline of its points, then its latest
+// value, peak and total. A series without points reads "no data".
+func MetricLines(series []models.MetricSeries, width int) []string {
+	nameWidth := 0
+	for _, s := range series {
+		nameWidth = max(nameWidth, len(s.Name))
+	}
+	sparkWidth := max(width-nameWidth-2-metricStatsWidth, 10)
+	lines := make([]string, len(series))
+	for i, s := range series {
+		if len(s.Points) == 0 {
+			lines[i] = fmt.Sprintf("%-*s  no data", nameWidth, s.Name)
+			continue
+		}
+		last, peak, total := s.Summary()
+		lines[i] = fmt.Sprintf("%-*s  %-*s  last %-6s peak %-6s total %s",
+			nameWidth, s.Name, sparkWidth, Sparkline(s.Values(), sparkWidth),
+			CompactNumber(last), CompactNumber(peak), CompactNumber(total))
+	}
+	return lines
+}
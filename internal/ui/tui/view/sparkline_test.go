@@ -0,0 +1,58 @@
+package view
+
+import (
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jingle2008/toolkit/pkg/models"
+)
+
+func TestSparkline(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "▁▅█", Sparkline([]float64{0, 4, 7}, 10))
+	assert.Equal(t, "▁▁▁", Sparkline([]float64{0, 0, 0}, 10), "flat zero series")
+	assert.Equal(t, "███", Sparkline([]float64{5, 5, 5}, 10), "flat series scales from zero")
+	assert.Empty(t, Sparkline(nil, 10))
+	assert.Empty(t, Sparkline([]float64{1}, 0))
+}
+
+func TestSparkline_BucketsKeepPeaks(t *testing.T) {
+	t.Parallel()
+	values := make([]float64, 100)
+	values[42] = 10 // one spike in the 5th of 10 buckets
+	got := Sparkline(values, 10)
+	assert.Equal(t, 10, utf8.RuneCountInString(got))
+	assert.Equal(t, "▁▁▁▁█▁▁▁▁▁", got)
+}
+
+func TestCompactNumber(t *testing.T) {
+	t.Parallel()
+	for in, want := range map[float64]string{
+		0:         "0",
+		950:       "950",
+		12.34:     "12.3",
+		12345:     "12.3k",
+		4_000_000: "4M",
+		2.5e9:     "2.5G",
+		-1500:     "-1.5k",
+	} {
+		assert.Equal(t, want, CompactNumber(in), in)
+	}
+}
+
+func TestMetricLines(t *testing.T) {
+	t.Parallel()
+	t0 := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	series := []models.MetricSeries{
+		{Name: "chat.InputTokenLength", Points: []models.MetricPoint{{Time: t0, Value: 1000}, {Time: t0.Add(time.Minute), Value: 2500}}},
+		{Name: "chat.OutputTokenLength"},
+	}
+	got := MetricLines(series, 80)
+	assert.Equal(t, []string{
+		"chat.InputTokenLength   ▄█                  last 2.5k   peak 2.5k   total 3.5k",
+		"chat.OutputTokenLength  no data",
+	}, got)
+}
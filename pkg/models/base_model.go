@@ -31,6 +31,7 @@ type BaseModel struct {
 	Status               string           `json:"status"`
 	ParameterSize        string           `json:"parameterSize"`
 	StorageURI           string           `json:"storageUri,omitempty"`
+	Cluster              string           `json:"cluster,omitempty"`
 }
 
 // DACShapeConfigs holds compatible DAC shapes.
@@ -112,7 +113,7 @@ func (m BaseModel) FilterableFields() []string {
 	}
 
 	return append(m.Capabilities, m.Name, m.DisplayName, m.Status,
-		m.Type, m.Flags(), shapeName, m.Runtime, m.StorageURI)
+		m.Type, m.Flags(), shapeName, m.Runtime, m.StorageURI, m.Cluster)
 }
 
 // IsFaulty reports whether the model's Status is anything other than "Ready".
@@ -142,3 +143,6 @@ func (m BaseModel) Flags() string {
 
 	return strings.Join(flags, "/")
 }
+
+// GetCluster returns the kube context the BaseModel was loaded from.
+func (m BaseModel) GetCluster() string { return m.Cluster }
@@ -0,0 +1,36 @@
+package models
+
+// Clustered is implemented by items loaded from Kubernetes. GetCluster
+// returns the kube context the item was read from, which mutations use
+// to reach the right cluster when an environment spans several.
+type Clustered interface {
+	GetCluster() string
+}
+
+// KubeContexts maps environment names (Environment.GetName, e.g.
+// "prod-iad") to the kube contexts the environment spans.
+type KubeContexts map[string][]string
+
+// For returns the kube contexts configured for env, or its conventional
+// Environment.KubeContext when none are.
+func (k KubeContexts) For(env Environment) []string {
+	if ctxs := k[env.GetName()]; len(ctxs) > 0 {
+		return ctxs
+	}
+	return []string{env.KubeContext()}
+}
+
+// Primary returns the first kube context of env: the one used for
+// cluster-wide lookups that any of its clusters can answer, and for
+// mutations of items that carry no cluster.
+func (k KubeContexts) Primary(env Environment) string {
+	return k.For(env)[0]
+}
+
+// Resolve returns cluster when set, else the primary context of env.
+func (k KubeContexts) Resolve(env Environment, cluster string) string {
+	if cluster != "" {
+		return cluster
+	}
+	return k.Primary(env)
+}
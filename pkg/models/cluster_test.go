@@ -0,0 +1,21 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubeContexts(t *testing.T) {
+	t.Parallel()
+	dev := Environment{Type: "dev", Region: "us-phoenix-1", Realm: "oc1"}
+	prod := Environment{Type: "prod", Region: "us-ashburn-1", Realm: "oc1"}
+	k := KubeContexts{"prod-iad": {"prod-iad-a", "prod-iad-b"}}
+
+	assert.Equal(t, []string{"prod-iad-a", "prod-iad-b"}, k.For(prod))
+	assert.Equal(t, []string{"dp-dev-phx"}, k.For(dev), "unmapped environments keep the conventional context")
+	assert.Equal(t, []string{"dp-dev-phx"}, KubeContexts(nil).For(dev))
+	assert.Equal(t, "prod-iad-a", k.Primary(prod))
+	assert.Equal(t, "prod-iad-b", k.Resolve(prod, "prod-iad-b"))
+	assert.Equal(t, "prod-iad-a", k.Resolve(prod, ""))
+}
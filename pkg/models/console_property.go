@@ -2,9 +2,10 @@ package models
 
 // ConsolePropertyDefinition represents a console property definition.
 type ConsolePropertyDefinition struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Value       string `json:"value"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Value       string          `json:"value"`
+	Source      *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the console property definition.
@@ -12,6 +13,11 @@ func (c ConsolePropertyDefinition) GetName() string {
 	return c.Name
 }
 
+// GetSource returns where the console property definition is declared in the repo.
+func (c ConsolePropertyDefinition) GetSource() *SourceLocation {
+	return c.Source
+}
+
 // GetDescription returns the description of the console property definition.
 func (c ConsolePropertyDefinition) GetDescription() string {
 	return c.Description
@@ -41,6 +47,7 @@ type ConsolePropertyRegionalOverride struct {
 	Values  []struct {
 		Value string `json:"value"`
 	} `json:"values"`
+	Source *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the console property regional override.
@@ -48,6 +55,17 @@ func (o ConsolePropertyRegionalOverride) GetName() string {
 	return o.Name
 }
 
+// GetSource returns where the console property override is declared in the repo.
+func (o ConsolePropertyRegionalOverride) GetSource() *SourceLocation {
+	return o.Source
+}
+
+// SetSource records where the console property override was loaded from. Called by the
+// configloader after unmarshal, like SetTenantName.
+func (o *ConsolePropertyRegionalOverride) SetSource(loc *SourceLocation) {
+	o.Source = loc
+}
+
 // GetRegions returns the regions of the console property regional override.
 func (o ConsolePropertyRegionalOverride) GetRegions() []string {
 	return o.Regions
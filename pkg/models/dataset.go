@@ -22,7 +22,11 @@ type Dataset struct {
 	GPUPools                          []GPUPool
 	GPUNodeMap                        map[string][]GPUNode
 	GPUWorkloadMap                    map[string][]GPUWorkload
+	PendingGPUWorkloads               []PendingGPUWorkload
 	DedicatedAIClusterMap             map[string][]DedicatedAICluster
+	EndpointMap                       map[string][]Endpoint
+	EventMap                          map[string][]Event
+	WorkRequests                      []WorkRequest
 }
 
 // FindModelByName returns the BaseModel whose Name matches name, searching
@@ -150,23 +154,33 @@ func (d *Dataset) ResetRealmScopedFields() {
 	d.GPUPools = nil
 	d.GPUNodeMap = nil
 	d.GPUWorkloadMap = nil
+	d.PendingGPUWorkloads = nil
 	d.DedicatedAIClusterMap = nil
+	d.EndpointMap = nil
+	d.EventMap = nil
+	d.WorkRequests = nil
 }
 
 // MergeReloadedRepoData copies the repo-owned fields from fresh into d while
 // preserving the lazily-loaded, k8s-backed fields already present in d
 // (BaseModels, ImportedModelMap, GPUPools, GPUNodeMap, GPUWorkloadMap,
-// DedicatedAIClusterMap). It is used when a working-tree change triggers a
-// dataset reload: LoadDataset repopulates only the repo-owned fields, so a
-// wholesale assignment would wipe live k8s data. New repo-owned fields added
-// to Dataset are carried across automatically; only the small, stable set of
-// k8s fields is enumerated here.
+// PendingGPUWorkloads, DedicatedAIClusterMap, EndpointMap, EventMap,
+// WorkRequests). It is
+// used when a working-tree change triggers a dataset reload: LoadDataset
+// repopulates only the repo-owned fields, so a wholesale assignment would
+// wipe live k8s data. New repo-owned fields added to Dataset are carried
+// across automatically; only the small, stable set of k8s fields is
+// enumerated here.
 func (d *Dataset) MergeReloadedRepoData(fresh *Dataset) {
 	fresh.BaseModels = d.BaseModels
 	fresh.ImportedModelMap = d.ImportedModelMap
 	fresh.GPUPools = d.GPUPools
 	fresh.GPUNodeMap = d.GPUNodeMap
 	fresh.GPUWorkloadMap = d.GPUWorkloadMap
+	fresh.PendingGPUWorkloads = d.PendingGPUWorkloads
 	fresh.DedicatedAIClusterMap = d.DedicatedAIClusterMap
+	fresh.EndpointMap = d.EndpointMap
+	fresh.EventMap = d.EventMap
+	fresh.WorkRequests = d.WorkRequests
 	*d = *fresh
 }
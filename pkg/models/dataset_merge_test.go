@@ -36,3 +36,32 @@ func TestDataset_MergeReloadedRepoData_PreservesK8sFields(t *testing.T) {
 	require.Equal(t, pools, d.GPUPools)
 	require.Equal(t, nodes, d.GPUNodeMap)
 }
+
+func TestDataset_MergeRepoParts_OnlyReplacesGivenParts(t *testing.T) {
+	t.Parallel()
+	d := &Dataset{
+		Tenants:                []Tenant{{Name: "old"}},
+		LimitRegionalOverrides: []LimitRegionalOverride{{Name: "r-old"}},
+		ServiceTenancies:       []ServiceTenancy{{Name: "st"}},
+		GPUPools:               []GPUPool{{Name: "p1"}},
+	}
+	fresh := &Dataset{
+		Tenants:                []Tenant{{Name: "new"}},
+		LimitRegionalOverrides: []LimitRegionalOverride{{Name: "r-new"}},
+	}
+
+	d.MergeRepoParts(fresh, RepoTenancyOverrides|RepoGPUPools)
+
+	require.Equal(t, "new", d.Tenants[0].Name)
+	require.Equal(t, "r-old", d.LimitRegionalOverrides[0].Name, "parts not reloaded are kept")
+	require.Len(t, d.ServiceTenancies, 1)
+	require.Len(t, d.GPUPools, 1, "GPU pools are reloaded on their own")
+}
+
+func TestRepoParts_String(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "none", RepoParts(0).String())
+	require.Equal(t, "definitions,model-artifacts", (RepoDefinitions | RepoModelArtifacts).String())
+	require.True(t, AllRepoParts.Has(RepoGPUPools|RepoServiceTenancies))
+	require.False(t, RepoDefinitions.Has(RepoDefinitions|RepoGPUPools))
+}
@@ -155,7 +155,11 @@ func TestResetRealmScopedFields(t *testing.T) {
 		GPUPools:                          []GPUPool{{}},
 		GPUNodeMap:                        map[string][]GPUNode{"x": nil},
 		GPUWorkloadMap:                    map[string][]GPUWorkload{"x": nil},
+		PendingGPUWorkloads:               []PendingGPUWorkload{{}},
 		DedicatedAIClusterMap:             map[string][]DedicatedAICluster{"x": nil},
+		EndpointMap:                       map[string][]Endpoint{"x": nil},
+		EventMap:                          map[string][]Event{"x": nil},
+		WorkRequests:                      []WorkRequest{{}},
 	}
 	d.ResetRealmScopedFields()
 	if d.LimitTenancyOverrideMap != nil ||
@@ -170,7 +174,11 @@ func TestResetRealmScopedFields(t *testing.T) {
 		d.GPUPools != nil ||
 		d.GPUNodeMap != nil ||
 		d.GPUWorkloadMap != nil ||
-		d.DedicatedAIClusterMap != nil {
+		d.PendingGPUWorkloads != nil ||
+		d.DedicatedAIClusterMap != nil ||
+		d.EndpointMap != nil ||
+		d.EventMap != nil ||
+		d.WorkRequests != nil {
 		t.Errorf("ResetRealmScopedFields did not nil all fields")
 	}
 }
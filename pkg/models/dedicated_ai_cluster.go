@@ -19,6 +19,7 @@ type DedicatedAICluster struct {
 	TotalReplicas int     `json:"totalReplicas"`
 	IdleReplicas  int     `json:"idleReplicas"`
 	Age           string  `json:"age"`
+	Cluster       string  `json:"cluster,omitempty"`
 }
 
 // GetName returns the name of the dedicated AI cluster.
@@ -39,6 +40,7 @@ func (n DedicatedAICluster) FilterableFields() []string {
 		n.ModelName,
 		n.Usage(),
 		n.Age,
+		n.Cluster,
 	}
 }
 
@@ -96,3 +98,6 @@ func (n DedicatedAICluster) OCID(realm, region string) string {
 func (n DedicatedAICluster) TenancyOCID(realm string) string {
 	return fmt.Sprintf("ocid1.tenancy.%s..%s", realm, n.TenantID)
 }
+
+// GetCluster returns the kube context the DedicatedAICluster was loaded from.
+func (n DedicatedAICluster) GetCluster() string { return n.Cluster }
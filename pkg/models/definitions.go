@@ -20,6 +20,7 @@ type NamedFilterable interface {
 // Definition represents a definition item with description and value.
 type Definition interface {
 	NamedFilterable
+	Sourced
 	GetDescription() string
 	GetValue() string
 }
@@ -51,6 +52,7 @@ type RealmedTenancyID interface {
 // DefinitionOverride represents a definition override with regions and value.
 type DefinitionOverride interface {
 	NamedFilterable
+	Sourced
 	GetRegions() []string
 	GetValue() string
 }
@@ -16,6 +16,7 @@ func (testImpl) GetValue() string            { return "v" }
 func (testImpl) Environments() []Environment { return nil }
 func (testImpl) GetDescription() string      { return "desc" }
 func (testImpl) IsFaulty() bool              { return false }
+func (testImpl) GetSource() *SourceLocation  { return nil }
 
 func TestDefinitionInterfaces(t *testing.T) {
 	t.Parallel()
@@ -25,6 +26,7 @@ func TestDefinitionInterfaces(t *testing.T) {
 	var _ Definition = testImpl{}
 	var _ TenancyOverride = testImpl{}
 	var _ DefinitionOverride = testImpl{}
+	var _ Sourced = testImpl{}
 }
 
 // TestRealmedInterfaces pins the (DAC, ImportedModel) ↔
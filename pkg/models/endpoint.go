@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Endpoint lifecycle states the TUI sets or tests for. The rest of
+// the GenAI EndpointLifecycleState values are passed through verbatim.
+const (
+	EndpointStateDeleting = "DELETING"
+	EndpointStateFailed   = "FAILED"
+)
+
+// ContentModerationOff is Endpoint.ContentModeration when moderation is
+// disabled or not configured.
+const ContentModerationOff = "OFF"
+
+// Endpoint is a GenAI inference endpoint hosted on a dedicated AI
+// cluster. Endpoints are grouped by their hosting DAC: DedicatedAICluster
+// holds the DAC's name (its OCID suffix, same as DedicatedAICluster.Name)
+// and is the group key. Name is likewise the endpoint's OCID suffix, so
+// it is unique within a DAC where DisplayName need not be.
+type Endpoint struct {
+	Name               string `json:"name"`
+	DisplayName        string `json:"displayName,omitempty"`
+	DedicatedAICluster string `json:"dedicatedAiCluster"`
+	CompartmentID      string `json:"compartmentId"`
+	ModelID            string `json:"modelId"`
+	LifecycleState     string `json:"lifecycleState"`
+	// ContentModeration is the moderation mode (INFORM, BLOCK), "ON"
+	// when enabled without a mode, or ContentModerationOff.
+	ContentModeration string `json:"contentModeration"`
+	Age               string `json:"age"`
+}
+
+// GetName returns the endpoint's OCID suffix.
+func (e Endpoint) GetName() string {
+	return e.Name
+}
+
+// FilterableFields returns filterable fields for the endpoint.
+func (e Endpoint) FilterableFields() []string {
+	return []string{
+		e.Name,
+		e.DisplayName,
+		e.DedicatedAICluster,
+		e.ModelID,
+		e.LifecycleState,
+		e.ContentModeration,
+	}
+}
+
+// IsFaulty returns true if the endpoint is in the FAILED state.
+func (e Endpoint) IsFaulty() bool {
+	return strings.EqualFold(e.LifecycleState, EndpointStateFailed)
+}
+
+// ModelName returns the served model's OCID suffix — the key
+// Dataset.FindModelByName resolves for imported and fine-tuned models.
+func (e Endpoint) ModelName() string {
+	return e.ModelID[strings.LastIndex(e.ModelID, ".")+1:]
+}
+
+// OCID returns the full OCID for the Endpoint, built from realm +
+// region + the stored Name suffix. Mirrors DedicatedAICluster.OCID.
+func (e Endpoint) OCID(realm, region string) string {
+	region = normalizeRegion(region)
+	return fmt.Sprintf("ocid1.generativeaiendpoint.%s.%s.%s", realm, region, e.Name)
+}
+
+// DedicatedAIClusterOCID returns the full OCID of the hosting DAC.
+func (e Endpoint) DedicatedAIClusterOCID(realm, region string) string {
+	return DedicatedAICluster{Name: e.DedicatedAICluster}.OCID(realm, region)
+}
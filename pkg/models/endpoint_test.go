@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoint_Getters(t *testing.T) {
+	t.Parallel()
+	ep := Endpoint{
+		Name:               "amaaaaaaep1",
+		DisplayName:        "chat-prod",
+		DedicatedAICluster: "amaaaaaadac1",
+		ModelID:            "ocid1.generativeaimodel.oc1.us-chicago-1.amaaaaaamodel1",
+		LifecycleState:     "ACTIVE",
+		ContentModeration:  "BLOCK",
+	}
+	assert.Equal(t, "amaaaaaaep1", ep.GetName())
+	assert.Equal(t, []string{"amaaaaaaep1", "chat-prod", "amaaaaaadac1", ep.ModelID, "ACTIVE", "BLOCK"}, ep.FilterableFields())
+	assert.Equal(t, "amaaaaaamodel1", ep.ModelName())
+	assert.Equal(t, "", Endpoint{}.ModelName())
+}
+
+func TestEndpoint_IsFaulty(t *testing.T) {
+	t.Parallel()
+	assert.False(t, Endpoint{LifecycleState: "ACTIVE"}.IsFaulty())
+	assert.False(t, Endpoint{}.IsFaulty())
+	assert.True(t, Endpoint{LifecycleState: "FAILED"}.IsFaulty())
+	assert.True(t, Endpoint{LifecycleState: "failed"}.IsFaulty())
+}
+
+func TestEndpoint_OCID(t *testing.T) {
+	t.Parallel()
+	ep := Endpoint{Name: "amaaaaaaep1", DedicatedAICluster: "amaaaaaadac1"}
+	assert.Equal(t, "ocid1.generativeaiendpoint.oc1.phx.amaaaaaaep1", ep.OCID("oc1", "us-phoenix-1"))
+	assert.Equal(t, "ocid1.generativeaidedicatedaicluster.oc1.us-chicago-1.amaaaaaadac1",
+		ep.DedicatedAIClusterOCID("oc1", "us-chicago-1"))
+}
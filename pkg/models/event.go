@@ -0,0 +1,76 @@
+package models
+
+import (
+	"strings"
+)
+
+// Kubernetes event types (corev1.EventTypeNormal / EventTypeWarning).
+const (
+	EventTypeNormal  = "Normal"
+	EventTypeWarning = "Warning"
+)
+
+// Kinds of involved objects the Event category reports on.
+const (
+	EventObjectNode = "Node"
+	EventObjectPod  = "Pod"
+)
+
+// Event is a Kubernetes event about a GPU node or a GPU workload pod.
+// Events are grouped by the involved object's EventObjectKey (Object):
+// GPUNode.Name for node events and <namespace>/<GPUWorkload.Name> for pod
+// events, so either parent scopes them by the same group key. Name is
+// the event object's own name, unique within its namespace.
+type Event struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+	ObjectKind string `json:"objectKind"`
+	Object     string `json:"object"`
+	Type       string `json:"type"`
+	Reason     string `json:"reason"`
+	Count      int    `json:"count"`
+	// FirstSeen and LastSeen are ages (e.g. "5m") of the first and most
+	// recent occurrence.
+	FirstSeen string `json:"firstSeen"`
+	LastSeen  string `json:"lastSeen"`
+	Message   string `json:"message"`
+	Cluster   string `json:"cluster,omitempty"`
+}
+
+// EventObjectKey returns the group key of the events about an object:
+// the name of a node, and <namespace>/<name> of a pod, since pod names
+// are unique only within a namespace.
+func EventObjectKey(kind, namespace, name string) string {
+	if kind == EventObjectPod {
+		return namespace + "/" + name
+	}
+	return name
+}
+
+// GetName returns the event object's name.
+func (e Event) GetName() string {
+	return e.Name
+}
+
+// FilterableFields returns filterable fields for the event. The
+// seen-ages are excluded for the same reason as GPUNode.Age.
+func (e Event) FilterableFields() []string {
+	return []string{
+		e.Name,
+		e.Namespace,
+		e.ObjectKind,
+		e.Object,
+		e.Type,
+		e.Reason,
+		e.Message,
+		e.Cluster,
+	}
+}
+
+// IsFaulty returns true for Warning events.
+func (e Event) IsFaulty() bool {
+	return strings.EqualFold(e.Type, EventTypeWarning)
+}
+
+// GetCluster returns the kube context the Event was loaded from.
+func (e Event) GetCluster() string { return e.Cluster }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_Getters(t *testing.T) {
+	t.Parallel()
+	e := Event{
+		Name: "node-1.17a", ObjectKind: EventObjectNode, Object: "node-1",
+		Type: EventTypeWarning, Reason: "NodeNotReady", Count: 3,
+		FirstSeen: "1h", LastSeen: "5m", Message: "Node is not ready", Cluster: "dp-dev-phx",
+	}
+	assert.Equal(t, "node-1.17a", e.GetName())
+	assert.Equal(t, []string{"node-1.17a", "", "Node", "node-1", "Warning", "NodeNotReady", "Node is not ready", "dp-dev-phx"},
+		e.FilterableFields())
+}
+
+func TestEvent_IsFaulty(t *testing.T) {
+	t.Parallel()
+	assert.True(t, Event{Type: EventTypeWarning}.IsFaulty())
+	assert.True(t, Event{Type: "warning"}.IsFaulty())
+	assert.False(t, Event{Type: EventTypeNormal}.IsFaulty())
+	assert.False(t, Event{}.IsFaulty())
+}
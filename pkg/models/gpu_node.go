@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -18,7 +19,14 @@ type GPUNode struct {
 	IsSchedulingDisabled bool     `json:"isSchedulingDisabled"` // true if node is cordoned
 	Age                  string   `json:"age"`
 	Issues               []string `json:"issues"`
-	status               string
+	// Findings are the health rule results behind Issues, each with the
+	// rule's severity; Issues lists their messages.
+	Findings []HealthFinding `json:"findings,omitempty"`
+	// Resources breaks Allocatable and Allocated down per accelerator
+	// resource; the totals above are their sums.
+	Resources []GPUResource `json:"resources,omitempty"`
+	Cluster   string        `json:"cluster,omitempty"`
+	status    string
 }
 
 // GetName returns the name of the GPU node.
@@ -28,7 +36,39 @@ func (n GPUNode) GetName() string {
 
 // FilterableFields returns filterable fields for the GPU node.
 func (n GPUNode) FilterableFields() []string {
-	return []string{n.Name, n.InstanceType, n.NodePool, n.GetStatus()}
+	return []string{n.Name, n.InstanceType, n.NodePool, n.GetStatus(), n.Profiles(), n.Cluster}
+}
+
+// Profiles renders the node's MIG profiles as "<profile> <free>/<total>",
+// e.g. "mig-1g.10gb 5/7, mig-3g.40gb 1/1"; "" for unpartitioned nodes.
+func (n GPUNode) Profiles() string {
+	return joinProfiles(n.Resources,
+		func(r GPUResource) string { return r.Name },
+		func(r GPUResource) string { return fmt.Sprintf("%d/%d", r.Free(), r.Allocatable) })
+}
+
+// IsPartitioned reports whether the node exposes any MIG profile.
+func (n GPUNode) IsPartitioned() bool {
+	for _, r := range n.Resources {
+		if IsMIGResource(r.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Free returns the node's free count of resource. Nodes without a
+// per-resource breakdown report their total free count.
+func (n GPUNode) Free(resource string) int {
+	if len(n.Resources) == 0 {
+		return n.Allocatable - n.Allocated
+	}
+	for _, r := range n.Resources {
+		if r.Name == resource {
+			return r.Free()
+		}
+	}
+	return 0
 }
 
 // SetStatus sets the status of the GPU node.
@@ -36,7 +76,11 @@ func (n *GPUNode) SetStatus(status string) {
 	n.status = status
 }
 
-// GetStatus returns the status of the GPU node.
+// GetStatus returns the status of the GPU node. The allocatable count is
+// checked against the shape's GPU count (its last dot-separated part),
+// except on MIG-partitioned nodes, which advertise slices rather than
+// GPUs. Error findings rank above Not ready, warnings below it; both
+// name the first matching rule, e.g. "ERROR: gpu-bus +1".
 func (n GPUNode) GetStatus() string {
 	if n.status != "" {
 		return n.status
@@ -47,24 +91,64 @@ func (n GPUNode) GetStatus() string {
 	switch {
 	case n.IsSchedulingDisabled:
 		return "WARN: CORDONED"
-	case n.Allocatable != count:
+	case !n.IsPartitioned() && n.Allocatable != count:
 		return "ERROR: Missing GPUs"
 	case !n.IsHealthy():
+		if s := n.findingStatus(SeverityError); s != "" {
+			return "ERROR: " + s
+		}
 		return "ERROR: Unhealthy"
 	case !n.IsReady:
 		return "ERROR: Not ready"
 	}
+	if s := n.findingStatus(SeverityWarning); s != "" {
+		return "WARN: " + s
+	}
 	return "OK"
 }
 
+// findingStatus names the first finding of severity, with a "+N" suffix
+// for the others; "" when there is none.
+func (n GPUNode) findingStatus(severity string) string {
+	first, more := "", 0
+	for _, f := range n.Findings {
+		if f.Severity != severity {
+			continue
+		}
+		if first == "" {
+			first = f.Rule
+		} else {
+			more++
+		}
+	}
+	if first != "" && more > 0 {
+		return fmt.Sprintf("%s +%d", first, more)
+	}
+	return first
+}
+
 /*
-IsHealthy returns true if the GPU node has no issues.
+IsHealthy returns true if the GPU node has no error findings. Nodes
+without findings (built before health rules) fall back to having no
+issues.
 */
 func (n GPUNode) IsHealthy() bool {
-	return len(n.Issues) == 0
+	if len(n.Findings) == 0 {
+		return len(n.Issues) == 0
+	}
+	for _, f := range n.Findings {
+		if f.Severity == SeverityError {
+			return false
+		}
+	}
+	return true
 }
 
-// IsFaulty returns true if the node is cordoned, missing GPUs, unhealthy, or not ready.
+// IsFaulty returns true if the node is cordoned, missing GPUs, unhealthy,
+// not ready, or has warning findings.
 func (n GPUNode) IsFaulty() bool {
 	return n.GetStatus() != "OK"
 }
+
+// GetCluster returns the kube context the GPUNode was loaded from.
+func (n GPUNode) GetCluster() string { return n.Cluster }
@@ -15,9 +15,10 @@ func TestGPUNode_Getters(t *testing.T) {
 		Allocatable:  8,
 		Allocated:    4,
 		IsReady:      true,
+		Cluster:      "dp-dev-phx",
 	}
 	assert.Equal(t, "node1", node.GetName())
-	assert.ElementsMatch(t, []string{"node1", "NVIDIA.A100.8", "poolA", "OK"}, node.FilterableFields())
+	assert.ElementsMatch(t, []string{"node1", "NVIDIA.A100.8", "poolA", "OK", "", "dp-dev-phx"}, node.FilterableFields())
 	assert.Equal(t, "OK", node.GetStatus())
 
 	node2 := GPUNode{
@@ -86,3 +87,28 @@ func TestGPUNode_IsFaulty(t *testing.T) {
 	notReady := GPUNode{InstanceType: "NVIDIA.A100.8", Allocatable: 8, IsReady: false}
 	assert.True(t, notReady.IsFaulty())
 }
+
+func TestGPUNode_Profiles(t *testing.T) {
+	t.Parallel()
+	node := GPUNode{
+		InstanceType: "BM.GPU.A100-v2.8",
+		Allocatable:  10,
+		Allocated:    4,
+		IsReady:      true,
+		Resources: []GPUResource{
+			{Name: "nvidia.com/gpu", Allocatable: 1},
+			{Name: "nvidia.com/mig-1g.10gb", Allocatable: 7, Allocated: 3},
+			{Name: "nvidia.com/mig-3g.40gb", Allocatable: 2, Allocated: 1},
+		},
+	}
+	assert.True(t, node.IsPartitioned())
+	assert.Equal(t, "mig-1g.10gb 4/7, mig-3g.40gb 1/2", node.Profiles())
+	assert.Equal(t, "OK", node.GetStatus(), "partitioned nodes skip the shape count check")
+	assert.Equal(t, 4, node.Free("nvidia.com/mig-1g.10gb"))
+	assert.Zero(t, node.Free("amd.com/gpu"))
+
+	whole := GPUNode{InstanceType: "BM.GPU.MI300X.8", Allocatable: 8, Allocated: 2}
+	assert.False(t, whole.IsPartitioned())
+	assert.Empty(t, whole.Profiles())
+	assert.Equal(t, 6, whole.Free("amd.com/gpu"), "without a breakdown the total is used")
+}
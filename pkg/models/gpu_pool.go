@@ -7,15 +7,16 @@ import (
 
 // GPUPool represents a pool of GPUs.
 type GPUPool struct {
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	Shape              string `json:"shape"`
-	Size               int    `json:"size"`
-	ActualSize         int    `json:"actualSize"`
-	Status             string `json:"status"`
-	IsOkeManaged       bool   `json:"isOkeManaged"`
-	CapacityType       string `json:"capacityType"`
-	AvailabilityDomain string `json:"availabilityDomain"`
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	Shape              string          `json:"shape"`
+	Size               int             `json:"size"`
+	ActualSize         int             `json:"actualSize"`
+	Status             string          `json:"status"`
+	IsOkeManaged       bool            `json:"isOkeManaged"`
+	CapacityType       string          `json:"capacityType"`
+	AvailabilityDomain string          `json:"availabilityDomain"`
+	Source             *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the GPU pool.
@@ -23,6 +24,11 @@ func (p GPUPool) GetName() string {
 	return p.Name
 }
 
+// GetSource returns where the GPU pool is declared in the repo.
+func (p GPUPool) GetSource() *SourceLocation {
+	return p.Source
+}
+
 // FilterableFields returns filterable fields for the GPU pool.
 func (p GPUPool) FilterableFields() []string {
 	return []string{p.Name, p.Shape, p.CapacityType}
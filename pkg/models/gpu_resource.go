@@ -0,0 +1,52 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GPUResource is a node's count of one accelerator resource, e.g.
+// amd.com/gpu or the nvidia.com/mig-1g.10gb MIG profile.
+type GPUResource struct {
+	Name        string `json:"name"`
+	Allocatable int    `json:"allocatable"`
+	Allocated   int    `json:"allocated"`
+}
+
+// Free returns the resource's unallocated count.
+func (r GPUResource) Free() int {
+	return r.Allocatable - r.Allocated
+}
+
+// GPURequest is a workload's request of one accelerator resource.
+type GPURequest struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// IsMIGResource reports whether name is a MIG profile resource such as
+// nvidia.com/mig-1g.10gb.
+func IsMIGResource(name string) bool {
+	return strings.Contains(name, "/mig-")
+}
+
+// MIGProfile returns the profile part of a MIG resource name, e.g.
+// "mig-1g.10gb" for nvidia.com/mig-1g.10gb.
+func MIGProfile(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// joinProfiles renders the MIG entries of a breakdown, one "<profile>
+// <value>" per entry, comma-separated; non-MIG entries are skipped.
+func joinProfiles[T any](items []T, name func(T) string, value func(T) string) string {
+	parts := make([]string, 0, len(items))
+	for _, it := range items {
+		if n := name(it); IsMIGResource(n) {
+			parts = append(parts, fmt.Sprintf("%s %s", MIGProfile(n), value(it)))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
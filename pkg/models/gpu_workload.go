@@ -2,10 +2,15 @@ package models
 
 import "fmt"
 
+// ContainerStateCrashLoop is the waiting reason the kubelet reports for a
+// container it is backing off from restarting.
+const ContainerStateCrashLoop = "CrashLoopBackOff"
+
 // GPUWorkload is a GPU-consuming Kubernetes pod (any pod that requests
-// nvidia.com/gpu). Grouped by Node (spec.nodeName, which equals
+// a configured accelerator resource, nvidia.com/gpu by default). Grouped by Node (spec.nodeName, which equals
 // GPUNode.Name); its parent category is GPUNode. model/runtime/mode are
-// best-effort: blank for non-serving GPU pods.
+// best-effort: blank for non-serving GPU pods. Containers, CrashLoop and
+// LastRestart carry the triage detail behind FaultyReason.
 type GPUWorkload struct {
 	Name      string  `json:"name"`
 	Node      string  `json:"node"`
@@ -18,20 +23,138 @@ type GPUWorkload struct {
 	Age       string  `json:"age"`
 	Mode      string  `json:"mode,omitempty"`
 	Owner     *Tenant `json:"owner,omitempty"`
+	// Containers is the per-container status of the pod's main
+	// containers, in spec order.
+	Containers []ContainerState `json:"containers,omitempty"`
+	// CrashLoop is true when any container is in CrashLoopBackOff.
+	CrashLoop bool `json:"crashLoop,omitempty"`
+	// LastRestart is the age (e.g. "5m") of the most recent container
+	// termination; blank when no container has restarted.
+	LastRestart string `json:"lastRestart,omitempty"`
+	// Resources breaks GPUs down per accelerator resource; GPUs is their
+	// sum.
+	Resources []GPURequest `json:"resources,omitempty"`
+	Cluster   string       `json:"cluster,omitempty"`
+}
+
+// ContainerState is the triage-relevant status of one container of a
+// GPUWorkload pod.
+type ContainerState struct {
+	Name     string `json:"name"`
+	Ready    bool   `json:"ready"`
+	Restarts int    `json:"restarts"`
+	// State is "Running" or the waiting/terminated reason (e.g.
+	// "CrashLoopBackOff", "ContainerCreating").
+	State string `json:"state"`
+	// LastTerminationReason and LastExitCode describe how the previous
+	// instance exited (e.g. "OOMKilled", 137); blank when it never did.
+	LastTerminationReason string `json:"lastTerminationReason,omitempty"`
+	LastExitCode          int    `json:"lastExitCode,omitempty"`
+	// LastRestart is the age of the previous instance's termination.
+	LastRestart string `json:"lastRestart,omitempty"`
+}
+
+// lastExit formats the previous instance's exit, e.g. "OOMKilled (exit
+// 137)", or returns "" when the container never terminated.
+func (c ContainerState) lastExit() string {
+	if c.LastTerminationReason == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s (exit %d)", c.LastTerminationReason, c.LastExitCode)
 }
 
 // GetName returns the pod name.
 func (w GPUWorkload) GetName() string { return w.Name }
 
-// IsFaulty reports whether the workload's containers have restarted,
-// which usually signals a crash-looping or otherwise unhealthy pod.
-func (w GPUWorkload) IsFaulty() bool { return w.Restarts > 0 }
+// IsFaulty reports whether the workload has a FaultyReason: it is
+// crash-looping, has containers that are not ready, or has restarted.
+func (w GPUWorkload) IsFaulty() bool { return w.FaultyReason() != "" }
 
-// FilterableFields returns the fields matched by `--filter`. Age is
+// Ready returns the ready container count as "ready/total" (e.g. "1/2"),
+// or "" when the container statuses are unknown.
+func (w GPUWorkload) Ready() string {
+	if len(w.Containers) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", w.readyCount(), len(w.Containers))
+}
+
+func (w GPUWorkload) readyCount() int {
+	ready := 0
+	for _, c := range w.Containers {
+		if c.Ready {
+			ready++
+		}
+	}
+	return ready
+}
+
+// FaultyReason summarises why the workload is faulty, most severe first:
+// a crash loop (with how the last instance exited), a container stuck
+// waiting, unready containers, then past restarts. Returns "" for a
+// healthy workload.
+func (w GPUWorkload) FaultyReason() string {
+	if w.CrashLoop {
+		for _, c := range w.Containers {
+			if c.State == ContainerStateCrashLoop {
+				return w.withContainer(joinReason(ContainerStateCrashLoop, c.lastExit()), c)
+			}
+		}
+		return ContainerStateCrashLoop
+	}
+	for _, c := range w.Containers {
+		if !c.Ready && c.State != "" && c.State != "Running" {
+			return w.withContainer(c.State, c)
+		}
+	}
+	if w.readyCount() < len(w.Containers) {
+		return "NotReady " + w.Ready()
+	}
+	if w.Restarts == 0 {
+		return ""
+	}
+	reason := "Restarted"
+	if w.LastRestart != "" {
+		reason += " " + w.LastRestart + " ago"
+	}
+	for _, c := range w.Containers {
+		if c.LastRestart == w.LastRestart && c.lastExit() != "" {
+			return w.withContainer(joinReason(reason, c.lastExit()), c)
+		}
+	}
+	return reason
+}
+
+// withContainer names the container a reason is about when the pod has
+// more than one.
+func (w GPUWorkload) withContainer(reason string, c ContainerState) string {
+	if len(w.Containers) < 2 {
+		return reason
+	}
+	return reason + " [" + c.Name + "]"
+}
+
+func joinReason(head, detail string) string {
+	if detail == "" {
+		return head
+	}
+	return head + ": " + detail
+}
+
+// FilterableFields returns the fields matched by `--filter`, including
+// the faulty reason so e.g. "OOMKilled" finds the affected pods. Age is
 // excluded (matching GPUNode): a formatted duration like "3d" is noise
 // for text filtering.
 func (w GPUWorkload) FilterableFields() []string {
-	return []string{w.Name, w.Node, w.TenantID, w.Namespace, w.Model, w.Runtime, w.Mode}
+	return []string{w.Name, w.Node, w.TenantID, w.Namespace, w.Model, w.Runtime, w.Mode, w.FaultyReason(), w.Profiles(), w.Cluster}
+}
+
+// Profiles renders the workload's MIG requests as "<profile> x<count>",
+// e.g. "mig-1g.10gb x2"; "" when it requests whole GPUs only.
+func (w GPUWorkload) Profiles() string {
+	return joinProfiles(w.Resources,
+		func(r GPURequest) string { return r.Name },
+		func(r GPURequest) string { return fmt.Sprintf("x%d", r.Count) })
 }
 
 // TenancyOCID returns the full tenancy OCID from realm + tenancy-id suffix.
@@ -47,3 +170,6 @@ func (w GPUWorkload) TenantName() string {
 	}
 	return w.TenantID
 }
+
+// GetCluster returns the kube context the GPUWorkload was loaded from.
+func (w GPUWorkload) GetCluster() string { return w.Cluster }
@@ -48,3 +48,60 @@ func TestGPUWorkload(t *testing.T) {
 		}
 	}
 }
+
+func TestGPUWorkload_FaultyReason(t *testing.T) {
+	t.Parallel()
+	running := func(name string) ContainerState {
+		return ContainerState{Name: name, Ready: true, State: "Running"}
+	}
+	cases := []struct {
+		name  string
+		w     GPUWorkload
+		want  string
+		ready string
+	}{
+		{"healthy", GPUWorkload{Containers: []ContainerState{running("main")}}, "", "1/1"},
+		{"no container statuses", GPUWorkload{}, "", ""},
+		{"crash loop with last exit", GPUWorkload{
+			CrashLoop: true, Restarts: 7,
+			Containers: []ContainerState{{
+				Name: "main", Restarts: 7, State: ContainerStateCrashLoop,
+				LastTerminationReason: "OOMKilled", LastExitCode: 137, LastRestart: "2m",
+			}},
+		}, "CrashLoopBackOff: OOMKilled (exit 137)", "0/1"},
+		{"crash loop names the container in a multi-container pod", GPUWorkload{
+			CrashLoop: true,
+			Containers: []ContainerState{running("proxy"), {
+				Name: "main", State: ContainerStateCrashLoop, LastTerminationReason: "Error", LastExitCode: 1,
+			}},
+		}, "CrashLoopBackOff: Error (exit 1) [main]", "1/2"},
+		{"waiting container", GPUWorkload{
+			Containers: []ContainerState{{Name: "main", State: "ImagePullBackOff"}},
+		}, "ImagePullBackOff", "0/1"},
+		{"running but not ready", GPUWorkload{
+			Containers: []ContainerState{running("proxy"), {Name: "main", State: "Running"}},
+		}, "NotReady 1/2", "1/2"},
+		{"recovered after a restart", GPUWorkload{
+			Restarts: 1, LastRestart: "3h",
+			Containers: []ContainerState{{
+				Name: "main", Ready: true, State: "Running", Restarts: 1,
+				LastTerminationReason: "OOMKilled", LastExitCode: 137, LastRestart: "3h",
+			}},
+		}, "Restarted 3h ago: OOMKilled (exit 137)", "1/1"},
+		{"restarts without container detail", GPUWorkload{Restarts: 2}, "Restarted", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.w.FaultyReason(); got != tc.want {
+				t.Errorf("FaultyReason = %q, want %q", got, tc.want)
+			}
+			if got := tc.w.IsFaulty(); got != (tc.want != "") {
+				t.Errorf("IsFaulty = %v, want %v", got, tc.want != "")
+			}
+			if got := tc.w.Ready(); got != tc.ready {
+				t.Errorf("Ready = %q, want %q", got, tc.ready)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package models
+
+// Health finding severities.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// Node info fields a node health rule's field check may read.
+const (
+	NodeInfoContainerRuntime = "container-runtime"
+	NodeInfoKernelVersion    = "kernel-version"
+	NodeInfoKubeletVersion   = "kubelet-version"
+	NodeInfoOSImage          = "os-image"
+)
+
+// NodeInfoFields lists the node info fields, sorted.
+var NodeInfoFields = []string{
+	NodeInfoContainerRuntime, NodeInfoKernelVersion, NodeInfoKubeletVersion, NodeInfoOSImage,
+}
+
+// HealthFinding is one node health rule that matched: the rule's name and
+// severity and the message describing what it found.
+type HealthFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
@@ -3,8 +3,12 @@ package models
 // ItemKey represents a generic item key.
 type ItemKey any
 
-// ScopedItemKey represents an item key with a scope.
+// ScopedItemKey represents an item key with a scope. Cluster is the
+// kube context of a cluster-sourced item when the environment spans
+// several, since the same name can then exist once per cluster; it is
+// empty otherwise.
 type ScopedItemKey struct {
-	Name  string
-	Scope string
+	Name    string
+	Scope   string
+	Cluster string
 }
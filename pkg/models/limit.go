@@ -2,18 +2,19 @@ package models
 
 // LimitDefinition represents a limit definition for a service.
 type LimitDefinition struct {
-	Name                 string `json:"name"`
-	Description          string `json:"description"`
-	Type                 string `json:"type"`
-	Scope                string `json:"scope"`
-	IsReleasedToCustomer bool   `json:"is_released_to_customer"`
-	DefaultMin           string `json:"default_min"`
-	DefaultMax           string `json:"default_max"`
-	Service              string `json:"service"`
-	PublicName           string `json:"public_name"`
-	IsStaged             bool   `json:"is_staged"`
-	IsQuota              bool   `json:"is_quota"`
-	UsageSource          string `json:"usage_source"`
+	Name                 string          `json:"name"`
+	Description          string          `json:"description"`
+	Type                 string          `json:"type"`
+	Scope                string          `json:"scope"`
+	IsReleasedToCustomer bool            `json:"is_released_to_customer"`
+	DefaultMin           string          `json:"default_min"`
+	DefaultMax           string          `json:"default_max"`
+	Service              string          `json:"service"`
+	PublicName           string          `json:"public_name"`
+	IsStaged             bool            `json:"is_staged"`
+	IsQuota              bool            `json:"is_quota"`
+	UsageSource          string          `json:"usage_source"`
+	Source               *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the limit definition.
@@ -21,6 +22,11 @@ func (c LimitDefinition) GetName() string {
 	return c.Name
 }
 
+// GetSource returns where the limit definition is declared in the repo.
+func (c LimitDefinition) GetSource() *SourceLocation {
+	return c.Source
+}
+
 // GetDescription returns the description of the limit definition.
 func (c LimitDefinition) GetDescription() string {
 	return c.Description
@@ -44,11 +50,12 @@ type LimitRange struct {
 
 // LimitRegionalOverride represents a regional override for a limit.
 type LimitRegionalOverride struct {
-	Realms  []string     `json:"realms"`
-	Group   string       `json:"group"`
-	Name    string       `json:"name"`
-	Regions []string     `json:"regions"`
-	Values  []LimitRange `json:"values"`
+	Realms  []string        `json:"realms"`
+	Group   string          `json:"group"`
+	Name    string          `json:"name"`
+	Regions []string        `json:"regions"`
+	Values  []LimitRange    `json:"values"`
+	Source  *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the limit tenancy override.
@@ -56,6 +63,17 @@ func (o LimitRegionalOverride) GetName() string {
 	return o.Name
 }
 
+// GetSource returns where the limit override is declared in the repo.
+func (o LimitRegionalOverride) GetSource() *SourceLocation {
+	return o.Source
+}
+
+// SetSource records where the limit override was loaded from. Called by the
+// configloader after unmarshal, like SetTenantName.
+func (o *LimitRegionalOverride) SetSource(loc *SourceLocation) {
+	o.Source = loc
+}
+
 // FilterableFields returns filterable fields for the limit regional override.
 func (o LimitRegionalOverride) FilterableFields() []string {
 	return append(o.Regions, o.Name)
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// MetricSeries is one metric query's datapoints over a time window, as
+// summarized by OCI Monitoring. Name is the metric the query reads
+// (e.g. "chat.InputTokenLength"); Points are in time order and empty
+// when the query matched no data.
+type MetricSeries struct {
+	Name   string        `json:"name"`
+	Query  string        `json:"query"`
+	Points []MetricPoint `json:"points"`
+}
+
+// MetricPoint is one aggregated datapoint of a MetricSeries.
+type MetricPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// Values returns the series' values in time order.
+func (s MetricSeries) Values() []float64 {
+	out := make([]float64, len(s.Points))
+	for i, p := range s.Points {
+		out[i] = p.Value
+	}
+	return out
+}
+
+// Summary returns the series' latest value, its peak and the sum of
+// every datapoint; all zero for an empty series.
+func (s MetricSeries) Summary() (last, peak, total float64) {
+	for i, p := range s.Points {
+		if i == 0 || p.Value > peak {
+			peak = p.Value
+		}
+		total += p.Value
+	}
+	if n := len(s.Points); n > 0 {
+		last = s.Points[n-1].Value
+	}
+	return last, peak, total
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricSeries_ValuesAndSummary(t *testing.T) {
+	t.Parallel()
+	t0 := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	s := MetricSeries{Name: "chat.InputTokenLength", Points: []MetricPoint{
+		{Time: t0, Value: 3},
+		{Time: t0.Add(time.Minute), Value: 7},
+		{Time: t0.Add(2 * time.Minute), Value: 5},
+	}}
+	assert.Equal(t, []float64{3, 7, 5}, s.Values())
+	last, peak, total := s.Summary()
+	assert.InDelta(t, 5, last, 0)
+	assert.InDelta(t, 7, peak, 0)
+	assert.InDelta(t, 15, total, 0)
+}
+
+func TestMetricSeries_Empty(t *testing.T) {
+	t.Parallel()
+	var s MetricSeries
+	assert.Empty(t, s.Values())
+	last, peak, total := s.Summary()
+	assert.Zero(t, last)
+	assert.Zero(t, peak)
+	assert.Zero(t, total)
+}
@@ -4,11 +4,12 @@ import "fmt"
 
 // ModelArtifact represents a model artifact stored in object storage.
 type ModelArtifact struct {
-	Name            string `json:"name"`
-	TensorRTVersion string `json:"tensorrt_version"`
-	GPUCount        int    `json:"gpu_count"`
-	GPUShape        string `json:"gpu_shape"`
-	ModelName       string `json:"model_name"`
+	Name            string          `json:"name"`
+	TensorRTVersion string          `json:"tensorrt_version"`
+	GPUCount        int             `json:"gpu_count"`
+	GPUShape        string          `json:"gpu_shape"`
+	ModelName       string          `json:"model_name"`
+	Source          *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the model artifact.
@@ -16,6 +17,11 @@ func (m ModelArtifact) GetName() string {
 	return m.Name
 }
 
+// GetSource returns where the model artifact is declared in the repo.
+func (m ModelArtifact) GetSource() *SourceLocation {
+	return m.Source
+}
+
 // GPUConfig returns the GPU configuration string for the model artifact.
 func (m ModelArtifact) GPUConfig() string {
 	return fmt.Sprintf("%dx %s", m.GPUCount, m.GPUShape)
@@ -0,0 +1,34 @@
+package models
+
+// PendingGPUWorkload is a GPU-requesting pod the scheduler has not placed
+// on a node yet (status.phase=Pending, no spec.nodeName). Such pods never
+// show up as a GPUWorkload, which is grouped by the node it runs on.
+// Reason is the latest FailedScheduling message; Hint names the pools
+// that currently have a node with enough free GPUs for the pod.
+type PendingGPUWorkload struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	TenantID  string `json:"tenantId,omitempty"`
+	Model     string `json:"model,omitempty"`
+	GPUs      int    `json:"gpus"`
+	Age       string `json:"age"`
+	Reason    string `json:"reason,omitempty"`
+	Hint      string `json:"hint,omitempty"`
+	Cluster   string `json:"cluster,omitempty"`
+}
+
+// GetName returns the pod name.
+func (w PendingGPUWorkload) GetName() string { return w.Name }
+
+// IsFaulty reports whether the scheduler has rejected the pod. A pod that
+// is merely waiting its turn carries no reason yet.
+func (w PendingGPUWorkload) IsFaulty() bool { return w.Reason != "" }
+
+// FilterableFields returns the fields matched by `--filter`. Age is
+// excluded, matching GPUWorkload.
+func (w PendingGPUWorkload) FilterableFields() []string {
+	return []string{w.Name, w.Namespace, w.TenantID, w.Model, w.Reason, w.Hint, w.Cluster}
+}
+
+// GetCluster returns the kube context the PendingGPUWorkload was loaded from.
+func (w PendingGPUWorkload) GetCluster() string { return w.Cluster }
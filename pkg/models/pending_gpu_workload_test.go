@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingGPUWorkload_Getters(t *testing.T) {
+	t.Parallel()
+	w := PendingGPUWorkload{
+		Name: "serv-0", Namespace: "ns1", TenantID: "t1", Model: "llama", GPUs: 8, Age: "5m",
+		Reason: "0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", Hint: "pool-a (1 node)", Cluster: "dp-dev-phx",
+	}
+	assert.Equal(t, "serv-0", w.GetName())
+	assert.Equal(t, []string{"serv-0", "ns1", "t1", "llama",
+		"0/4 nodes are available: 4 Insufficient nvidia.com/gpu.", "pool-a (1 node)", "dp-dev-phx"}, w.FilterableFields())
+}
+
+func TestPendingGPUWorkload_IsFaulty(t *testing.T) {
+	t.Parallel()
+	assert.True(t, PendingGPUWorkload{Reason: "Insufficient nvidia.com/gpu"}.IsFaulty())
+	assert.False(t, PendingGPUWorkload{}.IsFaulty())
+}
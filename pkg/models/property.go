@@ -2,11 +2,12 @@ package models
 
 // PropertyDefinition represents a property definition.
 type PropertyDefinition struct {
-	Name         string   `json:"name"`
-	Description  string   `json:"description"`
-	Type         string   `json:"type"`
-	Options      []string `json:"options"`
-	DefaultValue string   `json:"default_value"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Type         string          `json:"type"`
+	Options      []string        `json:"options"`
+	DefaultValue string          `json:"default_value"`
+	Source       *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the property definition.
@@ -14,6 +15,11 @@ func (c PropertyDefinition) GetName() string {
 	return c.Name
 }
 
+// GetSource returns where the property definition is declared in the repo.
+func (c PropertyDefinition) GetSource() *SourceLocation {
+	return c.Source
+}
+
 // GetDescription returns the description of the property definition.
 func (c PropertyDefinition) GetDescription() string {
 	return c.Description
@@ -43,6 +49,7 @@ type PropertyRegionalOverride struct {
 	Values  []struct {
 		Value string `json:"value"`
 	} `json:"values"`
+	Source *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the property regional override.
@@ -50,6 +57,17 @@ func (o PropertyRegionalOverride) GetName() string {
 	return o.Name
 }
 
+// GetSource returns where the property override is declared in the repo.
+func (o PropertyRegionalOverride) GetSource() *SourceLocation {
+	return o.Source
+}
+
+// SetSource records where the property override was loaded from. Called by the
+// configloader after unmarshal, like SetTenantName.
+func (o *PropertyRegionalOverride) SetSource(loc *SourceLocation) {
+	o.Source = loc
+}
+
 // GetRegions returns the regions of the property regional override.
 func (o PropertyRegionalOverride) GetRegions() []string {
 	return o.Regions
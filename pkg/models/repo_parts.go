@@ -0,0 +1,85 @@
+package models
+
+import "strings"
+
+// RepoParts is a set of the independently reloadable, repo-owned parts of a
+// Dataset. A working-tree change is mapped to the parts it can affect so
+// only those are re-parsed.
+type RepoParts uint8
+
+// The repo-owned parts of a Dataset.
+const (
+	// RepoServiceTenancies covers ServiceTenancies and the Environments
+	// derived from them (shared_modules/shep_targets).
+	RepoServiceTenancies RepoParts = 1 << iota
+	// RepoDefinitions covers the limit, console property and property
+	// definition groups.
+	RepoDefinitions
+	// RepoTenancyOverrides covers the three tenancy override maps and the
+	// Tenants derived from them.
+	RepoTenancyOverrides
+	// RepoRegionalOverrides covers the three regional override lists.
+	RepoRegionalOverrides
+	// RepoModelArtifacts covers ModelArtifactMap.
+	RepoModelArtifacts
+	// RepoGPUPools covers GPUPools, which are loaded lazily and separately
+	// from the rest of the dataset.
+	RepoGPUPools
+
+	// AllRepoParts is every repo-owned part.
+	AllRepoParts = RepoServiceTenancies | RepoDefinitions | RepoTenancyOverrides |
+		RepoRegionalOverrides | RepoModelArtifacts | RepoGPUPools
+)
+
+var repoPartNames = []string{
+	"service-tenancies", "definitions", "tenancy-overrides", "regional-overrides", "model-artifacts", "gpu-pools",
+}
+
+// Has reports whether p includes every part in q.
+func (p RepoParts) Has(q RepoParts) bool {
+	return p&q == q
+}
+
+// String lists the parts in p, comma-separated, for logging.
+func (p RepoParts) String() string {
+	var names []string
+	for i, name := range repoPartNames {
+		if p.Has(1 << i) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ",")
+}
+
+// MergeRepoParts copies the given parts from fresh into d, leaving every
+// other field untouched. Unlike MergeReloadedRepoData it is safe to apply
+// several partial reloads in any order, since each only replaces what it
+// loaded. RepoGPUPools is ignored: GPU pools are reloaded on their own.
+func (d *Dataset) MergeRepoParts(fresh *Dataset, parts RepoParts) {
+	if parts.Has(RepoServiceTenancies) {
+		d.ServiceTenancies = fresh.ServiceTenancies
+		d.Environments = fresh.Environments
+	}
+	if parts.Has(RepoDefinitions) {
+		d.LimitDefinitionGroup = fresh.LimitDefinitionGroup
+		d.ConsolePropertyDefinitionGroup = fresh.ConsolePropertyDefinitionGroup
+		d.PropertyDefinitionGroup = fresh.PropertyDefinitionGroup
+	}
+	if parts.Has(RepoTenancyOverrides) {
+		d.LimitTenancyOverrideMap = fresh.LimitTenancyOverrideMap
+		d.ConsolePropertyTenancyOverrideMap = fresh.ConsolePropertyTenancyOverrideMap
+		d.PropertyTenancyOverrideMap = fresh.PropertyTenancyOverrideMap
+		d.Tenants = fresh.Tenants
+	}
+	if parts.Has(RepoRegionalOverrides) {
+		d.LimitRegionalOverrides = fresh.LimitRegionalOverrides
+		d.ConsolePropertyRegionalOverrides = fresh.ConsolePropertyRegionalOverrides
+		d.PropertyRegionalOverrides = fresh.PropertyRegionalOverrides
+	}
+	if parts.Has(RepoModelArtifacts) {
+		d.ModelArtifactMap = fresh.ModelArtifactMap
+	}
+}
@@ -2,11 +2,12 @@ package models
 
 // ServiceTenancy represents a service tenancy entity.
 type ServiceTenancy struct {
-	Name        string   `json:"tenancy_name"`
-	Realm       string   `json:"realm"`
-	HomeRegion  string   `json:"home_region"`
-	Regions     []string `json:"regions"`
-	Environment string   `json:"environment"`
+	Name        string          `json:"tenancy_name"`
+	Realm       string          `json:"realm"`
+	HomeRegion  string          `json:"home_region"`
+	Regions     []string        `json:"regions"`
+	Environment string          `json:"environment"`
+	Source      *SourceLocation `json:"source,omitempty"`
 }
 
 // GetName returns the name of the service tenancy.
@@ -14,6 +15,11 @@ func (t ServiceTenancy) GetName() string {
 	return t.Name
 }
 
+// GetSource returns where the service tenancy is declared in the repo.
+func (t ServiceTenancy) GetSource() *SourceLocation {
+	return t.Source
+}
+
 // FilterableFields returns filterable fields for the service tenancy.
 func (t ServiceTenancy) FilterableFields() []string {
 	return append(t.Regions, t.Name, t.Realm, t.HomeRegion, t.Environment)
@@ -0,0 +1,35 @@
+package models
+
+import "strconv"
+
+/*
+SourceLocation is where in the repo an item is declared: the file and, for
+items that share a file with others (definitions, Terraform-declared pools
+and artifacts), the 1-based line and column the item starts on. Line is 0
+when the whole file is the item, as with one-record override files.
+*/
+type SourceLocation struct {
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+// String formats the location as file, file:line or file:line:column,
+// the form editors and compilers print.
+func (l SourceLocation) String() string {
+	s := l.File
+	if l.Line > 0 {
+		s += ":" + strconv.Itoa(l.Line)
+		if l.Column > 0 {
+			s += ":" + strconv.Itoa(l.Column)
+		}
+	}
+	return s
+}
+
+// Sourced is implemented by items loaded from the repo. GetSource returns
+// nil when the loader could not attribute the item to a file (e.g. a value
+// assembled from several Terraform expressions).
+type Sourced interface {
+	GetSource() *SourceLocation
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceLocation_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "a.json", SourceLocation{File: "a.json"}.String())
+	assert.Equal(t, "a.json:12", SourceLocation{File: "a.json", Line: 12}.String())
+	assert.Equal(t, "main.tf:3:5", SourceLocation{File: "main.tf", Line: 3, Column: 5}.String())
+	// A column without a line is meaningless and dropped.
+	assert.Equal(t, "main.tf", SourceLocation{File: "main.tf", Column: 5}.String())
+}
+
+func TestSourced(t *testing.T) {
+	t.Parallel()
+	loc := &SourceLocation{File: "f"}
+	var o LimitTenancyOverride
+	// The setter on the embedded regional override is promoted.
+	o.SetSource(loc)
+	items := []Sourced{
+		LimitDefinition{Source: loc}, ConsolePropertyDefinition{Source: loc}, PropertyDefinition{Source: loc},
+		o, ServiceTenancy{Source: loc}, GPUPool{Source: loc}, ModelArtifact{Source: loc},
+	}
+	for _, item := range items {
+		assert.Same(t, loc, item.GetSource())
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Work request services: the OCI API a WorkRequest was listed from.
+const (
+	// WorkRequestServiceCompute is the Core Services work request API,
+	// which tracks compute and compute management operations
+	// (instance termination, instance pool updates).
+	WorkRequestServiceCompute = "compute"
+	// WorkRequestServiceGenAI is the Generative AI work request API,
+	// which tracks DAC and endpoint operations.
+	WorkRequestServiceGenAI = "genai"
+)
+
+// Work request statuses the toolkit tests for. Both services report
+// ACCEPTED and IN_PROGRESS (GenAI also WAITING) while a request runs.
+const (
+	WorkRequestStatusSucceeded = "SUCCEEDED"
+	WorkRequestStatusFailed    = "FAILED"
+	WorkRequestStatusCanceled  = "CANCELED"
+)
+
+// WorkRequest is an asynchronous OCI operation — a terminate, a pool
+// resize, a DAC deletion — as tracked by its service. ID is the full
+// work request OCID, which `toolkit wait workrequest` takes. Resources
+// lists the OCIDs of the resources it acts on (reported by GenAI only);
+// Errors holds the service's error messages for a failed request.
+type WorkRequest struct {
+	ID              string    `json:"id"`
+	Service         string    `json:"service"`
+	Operation       string    `json:"operation"`
+	Status          string    `json:"status"`
+	PercentComplete float32   `json:"percentComplete"`
+	Resources       []string  `json:"resources,omitempty"`
+	Errors          []string  `json:"errors,omitempty"`
+	Accepted        time.Time `json:"timeAccepted,omitzero"`
+	Finished        time.Time `json:"timeFinished,omitzero"`
+	Age             string    `json:"age"`
+}
+
+// GetName returns the work request OCID.
+func (w WorkRequest) GetName() string { return w.ID }
+
+// FilterableFields returns the fields matched by `--filter`.
+func (w WorkRequest) FilterableFields() []string {
+	fields := []string{w.ID, w.Service, w.Operation, w.Status}
+	fields = append(fields, w.Resources...)
+	return append(fields, w.Errors...)
+}
+
+// IsFaulty reports whether the work request failed.
+func (w WorkRequest) IsFaulty() bool {
+	return strings.EqualFold(w.Status, WorkRequestStatusFailed)
+}
+
+// IsDone reports whether the work request has finished, successfully
+// or not; its status will not change again.
+func (w WorkRequest) IsDone() bool {
+	switch strings.ToUpper(w.Status) {
+	case WorkRequestStatusSucceeded, WorkRequestStatusFailed, WorkRequestStatusCanceled:
+		return true
+	}
+	return false
+}
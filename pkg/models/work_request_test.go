@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkRequest_Getters(t *testing.T) {
+	t.Parallel()
+	w := WorkRequest{
+		ID: "ocid1.generativeaiworkrequest.oc1.iad.x", Service: WorkRequestServiceGenAI,
+		Operation: "DELETE_DEDICATED_AI_CLUSTER", Status: "FAILED",
+		Resources: []string{"ocid1.generativeaidedicatedaicluster.oc1.iad.d"}, Errors: []string{"quota exceeded"},
+	}
+	assert.Equal(t, "ocid1.generativeaiworkrequest.oc1.iad.x", w.GetName())
+	assert.Equal(t, []string{
+		"ocid1.generativeaiworkrequest.oc1.iad.x", "genai", "DELETE_DEDICATED_AI_CLUSTER", "FAILED",
+		"ocid1.generativeaidedicatedaicluster.oc1.iad.d", "quota exceeded",
+	}, w.FilterableFields())
+}
+
+func TestWorkRequest_Status(t *testing.T) {
+	t.Parallel()
+	for status, want := range map[string][2]bool{ // {IsFaulty, IsDone}
+		"ACCEPTED":    {false, false},
+		"IN_PROGRESS": {false, false},
+		"WAITING":     {false, false},
+		"SUCCEEDED":   {false, true},
+		"FAILED":      {true, true},
+		"CANCELED":    {false, true},
+	} {
+		w := WorkRequest{Status: status}
+		assert.Equal(t, want[0], w.IsFaulty(), status)
+		assert.Equal(t, want[1], w.IsDone(), status)
+	}
+}